@@ -0,0 +1,434 @@
+// Package genesis provides helpers to incrementally decode and encode genesis JSON,
+// such that large arrays can be imported and exported one element at a time.
+package genesis
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	"cosmossdk.io/core/appmodule"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// Opener opens a reader over a JSON value. It may be called multiple times to decode
+// the same value in multiple passes. A nil reader is returned if the value is not present.
+type Opener func() (io.ReadCloser, error)
+
+// SourceField returns an Opener over the value of the given field of the genesis source.
+func SourceField(source appmodule.GenesisSource, field string) Opener {
+	return func() (io.ReadCloser, error) {
+		return source(field)
+	}
+}
+
+// TargetField returns a function opening a writer for the value of the given field of the genesis target.
+func TargetField(target appmodule.GenesisTarget, field string) func() (io.WriteCloser, error) {
+	return func() (io.WriteCloser, error) {
+		return target(field)
+	}
+}
+
+// Decode opens a reader with the provided opener and calls fn with a Decoder over it.
+// If the value is not present, fn is called with a Decoder over an empty JSON object.
+func Decode(open Opener, cdc codec.JSONCodec, fn func(*Decoder) error) (err error) {
+	r, err := open()
+	if err != nil {
+		return err
+	}
+
+	if r == nil {
+		return fn(NewDecoder(strings.NewReader("{}"), cdc))
+	}
+
+	defer func() {
+		if closeErr := r.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+
+	return fn(NewDecoder(r, cdc))
+}
+
+// DecodeField decodes the JSON object opened by the provided opener, calling fn to decode
+// the value of the given field. All other fields are skipped.
+func DecodeField(open Opener, cdc codec.JSONCodec, field string, fn func(*Decoder) error) error {
+	return Decode(open, cdc, func(d *Decoder) error {
+		return d.Object(map[string]func() error{
+			field: func() error { return fn(d) },
+		})
+	})
+}
+
+// Encode opens a writer with the provided function and calls fn with an Encoder over it.
+// Writes are buffered and the writer is closed once fn returns.
+func Encode(open func() (io.WriteCloser, error), cdc codec.JSONCodec, fn func(*Encoder)) (err error) {
+	w, err := open()
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if closeErr := w.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+
+	bw := bufio.NewWriter(w)
+	enc := NewEncoder(bw, cdc)
+	fn(enc)
+
+	if err := enc.Err(); err != nil {
+		return err
+	}
+
+	return bw.Flush()
+}
+
+// Decoder decodes JSON values incrementally from a reader. Protobuf messages are
+// decoded with the proto JSON encoding of the codec.
+type Decoder struct {
+	dec *json.Decoder
+	cdc codec.JSONCodec
+}
+
+// NewDecoder returns a new Decoder reading from r.
+func NewDecoder(r io.Reader, cdc codec.JSONCodec) *Decoder {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+
+	return &Decoder{dec: dec, cdc: cdc}
+}
+
+// Object decodes a JSON object, calling the handler registered for every field encountered.
+// Each handler must decode the value of its field. The values of fields without a handler
+// are skipped without being buffered. A null value is decoded as an empty object.
+func (d *Decoder) Object(handlers map[string]func() error) error {
+	tok, err := d.dec.Token()
+	if err != nil {
+		return err
+	}
+
+	if tok == nil {
+		return nil
+	}
+
+	if tok != json.Delim('{') {
+		return fmt.Errorf("expected JSON object, got %v", tok)
+	}
+
+	for d.dec.More() {
+		tok, err := d.dec.Token()
+		if err != nil {
+			return err
+		}
+
+		field, ok := tok.(string)
+		if !ok {
+			return fmt.Errorf("expected JSON object key, got %v", tok)
+		}
+
+		handler, ok := handlers[field]
+		if !ok {
+			if err := d.Skip(); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := handler(); err != nil {
+			return fmt.Errorf("failed to decode field %s: %w", field, err)
+		}
+	}
+
+	_, err = d.dec.Token() // closing '}'
+	return err
+}
+
+// Elements decodes a JSON array, calling cb once for every element in order. Each call
+// must decode exactly one value, such that elements can themselves be decoded incrementally.
+// A null value is decoded as an empty array.
+func (d *Decoder) Elements(cb func() error) error {
+	tok, err := d.dec.Token()
+	if err != nil {
+		return err
+	}
+
+	if tok == nil {
+		return nil
+	}
+
+	if tok != json.Delim('[') {
+		return fmt.Errorf("expected JSON array, got %v", tok)
+	}
+
+	for i := 0; d.dec.More(); i++ {
+		if err := cb(); err != nil {
+			return fmt.Errorf("element %d: %w", i, err)
+		}
+	}
+
+	_, err = d.dec.Token() // closing ']'
+	return err
+}
+
+// Array decodes a JSON array, calling cb with the raw JSON of every element in order.
+// A null value is decoded as an empty array.
+func (d *Decoder) Array(cb func(json.RawMessage) error) error {
+	return d.Elements(func() error {
+		var element json.RawMessage
+		if err := d.dec.Decode(&element); err != nil {
+			return err
+		}
+
+		return cb(element)
+	})
+}
+
+// Message decodes a protobuf message.
+func (d *Decoder) Message(msg proto.Message) error {
+	var bz json.RawMessage
+	if err := d.dec.Decode(&bz); err != nil {
+		return err
+	}
+
+	return d.cdc.UnmarshalJSON(bz, msg)
+}
+
+// Uint64 decodes an unsigned integer encoded either as a JSON string or a JSON number.
+func (d *Decoder) Uint64() (uint64, error) {
+	tok, err := d.dec.Token()
+	if err != nil {
+		return 0, err
+	}
+
+	switch v := tok.(type) {
+	case string:
+		return strconv.ParseUint(v, 10, 64)
+	case json.Number:
+		return strconv.ParseUint(v.String(), 10, 64)
+	default:
+		return 0, fmt.Errorf("expected unsigned integer, got %v", tok)
+	}
+}
+
+// String decodes a JSON string.
+func (d *Decoder) String() (string, error) {
+	tok, err := d.dec.Token()
+	if err != nil {
+		return "", err
+	}
+
+	s, ok := tok.(string)
+	if !ok {
+		return "", fmt.Errorf("expected JSON string, got %v", tok)
+	}
+
+	return s, nil
+}
+
+// Bool decodes a JSON boolean.
+func (d *Decoder) Bool() (bool, error) {
+	tok, err := d.dec.Token()
+	if err != nil {
+		return false, err
+	}
+
+	b, ok := tok.(bool)
+	if !ok {
+		return false, fmt.Errorf("expected JSON boolean, got %v", tok)
+	}
+
+	return b, nil
+}
+
+// Skip skips the next JSON value token by token.
+func (d *Decoder) Skip() error {
+	var depth int
+	for {
+		tok, err := d.dec.Token()
+		if err != nil {
+			return err
+		}
+
+		switch tok {
+		case json.Delim('{'), json.Delim('['):
+			depth++
+		case json.Delim('}'), json.Delim(']'):
+			depth--
+		}
+
+		if depth == 0 {
+			return nil
+		}
+	}
+}
+
+// DecodeMessages decodes a JSON array of protobuf messages of type T, calling cb with every
+// decoded message in order. Only a single message is held in memory at a time.
+func DecodeMessages[T any, PT interface {
+	*T
+	proto.Message
+}](d *Decoder, cb func(PT) error,
+) error {
+	return d.Array(func(bz json.RawMessage) error {
+		msg := PT(new(T))
+		if err := d.cdc.UnmarshalJSON(bz, msg); err != nil {
+			return err
+		}
+
+		return cb(msg)
+	})
+}
+
+// Encoder encodes JSON values incrementally to a writer. Protobuf messages are encoded
+// with the proto JSON encoding of the codec. The first error encountered is retained and
+// all subsequent writes are skipped, see Err.
+type Encoder struct {
+	w   io.Writer
+	cdc codec.JSONCodec
+	// counts holds the number of fields or elements written to each open JSON object or array
+	counts []int
+	err    error
+}
+
+// NewEncoder returns a new Encoder writing to w.
+func NewEncoder(w io.Writer, cdc codec.JSONCodec) *Encoder {
+	return &Encoder{w: w, cdc: cdc}
+}
+
+// Err returns the first error encountered by the encoder.
+func (e *Encoder) Err() error {
+	return e.err
+}
+
+// Fail records err as the error of the encoder, skipping all subsequent writes.
+func (e *Encoder) Fail(err error) {
+	e.setErr(err)
+}
+
+// BeginObject begins a JSON object. Its fields are written by calling Field followed by the value.
+func (e *Encoder) BeginObject() {
+	e.write([]byte("{"))
+	e.counts = append(e.counts, 0)
+}
+
+// Field writes the key of the next field of the current JSON object.
+func (e *Encoder) Field(name string) {
+	e.next()
+	e.write([]byte(strconv.Quote(name) + ":"))
+}
+
+// EndObject ends the current JSON object.
+func (e *Encoder) EndObject() {
+	e.end()
+	e.write([]byte("}"))
+}
+
+// BeginArray begins a JSON array. Its elements are written by calling Element followed by the value.
+func (e *Encoder) BeginArray() {
+	e.write([]byte("["))
+	e.counts = append(e.counts, 0)
+}
+
+// Element prepares the current JSON array for its next element.
+func (e *Encoder) Element() {
+	e.next()
+}
+
+// EndArray ends the current JSON array.
+func (e *Encoder) EndArray() {
+	e.end()
+	e.write([]byte("]"))
+}
+
+// Array writes a JSON array. The iterate function is called with a function which writes
+// a single protobuf message element, such that elements can be written as they are iterated.
+func (e *Encoder) Array(iterate func(write func(proto.Message))) {
+	e.BeginArray()
+	iterate(func(msg proto.Message) {
+		e.Element()
+		e.Message(msg)
+	})
+	e.EndArray()
+}
+
+// Message writes a protobuf message.
+func (e *Encoder) Message(msg proto.Message) {
+	if e.err != nil {
+		return
+	}
+
+	bz, err := e.cdc.MarshalJSON(msg)
+	if err != nil {
+		e.setErr(err)
+		return
+	}
+
+	e.write(bz)
+}
+
+// Uint64 writes an unsigned integer as a JSON string, following the proto JSON encoding.
+func (e *Encoder) Uint64(v uint64) {
+	e.write([]byte(strconv.Quote(strconv.FormatUint(v, 10))))
+}
+
+// String writes a JSON string.
+func (e *Encoder) String(s string) {
+	bz, err := json.Marshal(s)
+	if err != nil {
+		e.setErr(err)
+		return
+	}
+
+	e.write(bz)
+}
+
+// Bool writes a JSON boolean.
+func (e *Encoder) Bool(b bool) {
+	e.write([]byte(strconv.FormatBool(b)))
+}
+
+// next writes the separator preceding the next field or element of the current object or array.
+func (e *Encoder) next() {
+	if len(e.counts) == 0 {
+		e.setErr(errors.New("no open JSON object or array"))
+		return
+	}
+
+	if e.counts[len(e.counts)-1] > 0 {
+		e.write([]byte(","))
+	}
+	e.counts[len(e.counts)-1]++
+}
+
+func (e *Encoder) end() {
+	if len(e.counts) == 0 {
+		e.setErr(errors.New("no open JSON object or array"))
+		return
+	}
+
+	e.counts = e.counts[:len(e.counts)-1]
+}
+
+func (e *Encoder) write(bz []byte) {
+	if e.err != nil {
+		return
+	}
+
+	_, err := e.w.Write(bz)
+	e.setErr(err)
+}
+
+func (e *Encoder) setErr(err error) {
+	if e.err == nil {
+		e.err = err
+	}
+}
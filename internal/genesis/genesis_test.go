@@ -0,0 +1,211 @@
+package genesis_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+
+	"github.com/cosmos/ibc-go/v8/internal/genesis"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+var cdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+func open(s string) genesis.Opener {
+	return func() (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader(s)), nil
+	}
+}
+
+func TestDecode(t *testing.T) {
+	testCases := []struct {
+		name     string
+		json     string
+		expSeq   uint64
+		expSeqs  []uint64
+		expError bool
+	}{
+		{
+			"sequence as string",
+			`{"sequence":"5","sequences":[{"sequence":"1"},{"sequence":"2"}]}`,
+			5,
+			[]uint64{1, 2},
+			false,
+		},
+		{
+			"sequence as number",
+			`{"sequence":5}`,
+			5,
+			nil,
+			false,
+		},
+		{
+			"unknown fields are skipped",
+			`{"unknown":{"nested":[1,{"a":[]}]},"sequence":"5","other":"value"}`,
+			5,
+			nil,
+			false,
+		},
+		{
+			"null object and array",
+			`{"sequences":null}`,
+			0,
+			nil,
+			false,
+		},
+		{
+			"empty value",
+			``,
+			0,
+			nil,
+			true,
+		},
+		{
+			"invalid sequence",
+			`{"sequence":"-1"}`,
+			0,
+			nil,
+			true,
+		},
+		{
+			"invalid array",
+			`{"sequences":{}}`,
+			0,
+			nil,
+			true,
+		},
+		{
+			"invalid message",
+			`{"sequences":[{"sequence":true}]}`,
+			0,
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				seq  uint64
+				seqs []uint64
+			)
+
+			err := genesis.Decode(open(tc.json), cdc, func(d *genesis.Decoder) error {
+				return d.Object(map[string]func() error{
+					"sequence": func() (err error) {
+						seq, err = d.Uint64()
+						return err
+					},
+					"sequences": func() error {
+						return genesis.DecodeMessages(d, func(ps *channeltypes.PacketSequence) error {
+							seqs = append(seqs, ps.Sequence)
+							return nil
+						})
+					},
+				})
+			})
+
+			if tc.expError {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expSeq, seq)
+			require.Equal(t, tc.expSeqs, seqs)
+		})
+	}
+}
+
+func TestDecodeMissingValue(t *testing.T) {
+	var called bool
+	err := genesis.DecodeField(func() (io.ReadCloser, error) { return nil, nil }, cdc, "field", func(*genesis.Decoder) error {
+		called = true
+		return nil
+	})
+
+	require.NoError(t, err)
+	require.False(t, called)
+}
+
+type writeCloser struct {
+	bytes.Buffer
+	closed bool
+}
+
+func (w *writeCloser) Close() error {
+	w.closed = true
+	return nil
+}
+
+func TestEncode(t *testing.T) {
+	w := &writeCloser{}
+	err := genesis.Encode(func() (io.WriteCloser, error) { return w, nil }, cdc, func(enc *genesis.Encoder) {
+		enc.BeginObject()
+
+		enc.Field("sequences")
+		enc.Array(func(write func(proto.Message)) {
+			for seq := uint64(1); seq <= 2; seq++ {
+				ps := channeltypes.NewPacketSequence("port", "channel", seq)
+				write(&ps)
+			}
+		})
+
+		enc.Field("empty")
+		enc.Array(func(func(proto.Message)) {})
+
+		enc.Field("sequence")
+		enc.Uint64(5)
+
+		enc.Field("name")
+		enc.String(`"quoted"`)
+
+		enc.Field("enabled")
+		enc.Bool(true)
+
+		enc.EndObject()
+	})
+
+	require.NoError(t, err)
+	require.True(t, w.closed)
+	require.JSONEq(t, `{
+		"sequences":[{"port_id":"port","channel_id":"channel","sequence":"1"},{"port_id":"port","channel_id":"channel","sequence":"2"}],
+		"empty":[],
+		"sequence":"5",
+		"name":"\"quoted\"",
+		"enabled":true
+	}`, w.String())
+}
+
+func TestEncodeError(t *testing.T) {
+	expErr := errors.New("failed")
+
+	w := &writeCloser{}
+	err := genesis.Encode(func() (io.WriteCloser, error) { return w, nil }, cdc, func(enc *genesis.Encoder) {
+		enc.BeginObject()
+		enc.Fail(expErr)
+		enc.Field("sequence")
+		enc.Uint64(5)
+		enc.EndObject()
+	})
+
+	require.ErrorIs(t, err, expErr)
+	require.True(t, w.closed)
+	require.Empty(t, w.String())
+
+	// fields must be written within an object
+	err = genesis.Encode(func() (io.WriteCloser, error) { return &writeCloser{}, nil }, cdc, func(enc *genesis.Encoder) {
+		enc.Field("sequence")
+	})
+	require.Error(t, err)
+}
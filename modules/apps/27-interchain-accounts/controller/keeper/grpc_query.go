@@ -2,13 +2,18 @@ package keeper
 
 import (
 	"context"
+	"strings"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/store/prefix"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
+	genesistypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/genesis/types"
 	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
 )
 
@@ -46,3 +51,41 @@ func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.Q
 		Params: &params,
 	}, nil
 }
+
+// Invariants implements the Query/Invariants gRPC method
+func (k Keeper) Invariants(goCtx context.Context, req *types.QueryInvariantsRequest) (*types.QueryInvariantsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var msg strings.Builder
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(icatypes.ActiveChannelKeyPrefix+"/"))
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		keySplit := strings.Split(string(key), "/")
+		if len(keySplit) != 2 {
+			return status.Errorf(codes.Internal, "invalid active channel key %s", key)
+		}
+
+		activeChannel := genesistypes.ActiveChannel{
+			PortId:       keySplit[0],
+			ConnectionId: keySplit[1],
+			ChannelId:    string(value),
+		}
+
+		msg.WriteString(k.checkActiveChannel(ctx, activeChannel))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.QueryInvariantsResponse{Pagination: pageRes}
+	if msg.Len() > 0 {
+		res.Broken = true
+		res.Message = sdk.FormatInvariant(icatypes.ModuleName, "controller active channels", msg.String())
+	}
+
+	return res, nil
+}
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"github.com/cosmos/cosmos-sdk/types/query"
+
 	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 )
@@ -81,3 +83,70 @@ func (suite *KeeperTestSuite) TestQueryParams() {
 	res, _ := suite.chainA.GetSimApp().ICAControllerKeeper.Params(ctx, &types.QueryParamsRequest{})
 	suite.Require().Equal(&expParams, res.Params)
 }
+
+func (suite *KeeperTestSuite) TestQueryInvariants() {
+	var req *types.QueryInvariantsRequest
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expBroken bool
+		expPass   bool
+	}{
+		{
+			"success",
+			func() {},
+			false,
+			true,
+		},
+		{
+			"success: broken invariant",
+			func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetActiveChannelID(suite.chainA.GetContext(), ibctesting.FirstConnectionID, TestPortID, ibctesting.InvalidID)
+			},
+			true,
+			true,
+		},
+		{
+			"success: broken invariant before the requested page",
+			func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetActiveChannelID(suite.chainA.GetContext(), ibctesting.FirstConnectionID, TestPortID, ibctesting.InvalidID)
+
+				// the active channel of the broken invariant is stored under a key ordered before the page key
+				req.Pagination = &query.PageRequest{Key: []byte(TestPortID + "/" + ibctesting.FirstConnectionID + "0")}
+			},
+			false,
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			req = &types.QueryInvariantsRequest{}
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().ICAControllerKeeper.Invariants(suite.chainA.GetContext(), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expBroken, res.Broken)
+				suite.Require().Equal(tc.expBroken, res.Message != "")
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/keeper"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func (suite *KeeperTestSuite) TestActiveChannelsInvariant() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expBroken bool
+	}{
+		{
+			"success",
+			func() {},
+			false,
+		},
+		{
+			"success: active channel closed",
+			func() {
+				suite.Require().NoError(path.EndpointA.SetChannelState(channeltypes.CLOSED))
+			},
+			false,
+		},
+		{
+			"active channel does not exist",
+			func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetActiveChannelID(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID, ibctesting.InvalidID)
+			},
+			true,
+		},
+		{
+			"active channel has not completed the handshake",
+			func() {
+				suite.Require().NoError(path.EndpointA.SetChannelState(channeltypes.TRYOPEN))
+			},
+			true,
+		},
+		{
+			"active channel is bound to a different connection",
+			func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetActiveChannelID(suite.chainA.GetContext(), ibctesting.InvalidID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			path.SetupConnections()
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			icaKeeper := suite.chainA.GetSimApp().ICAControllerKeeper
+			msg, broken := keeper.ActiveChannelsInvariant(&icaKeeper)(suite.chainA.GetContext())
+			suite.Require().Equal(tc.expBroken, broken)
+			suite.Require().Equal(tc.expBroken, msg != "")
+		})
+	}
+}
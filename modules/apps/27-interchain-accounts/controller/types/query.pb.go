@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -209,11 +210,123 @@ func (m *QueryParamsResponse) GetParams() *Params {
 	return nil
 }
 
+// QueryInvariantsRequest is the request type for the Query/Invariants RPC method.
+type QueryInvariantsRequest struct {
+	// pagination request
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryInvariantsRequest) Reset()         { *m = QueryInvariantsRequest{} }
+func (m *QueryInvariantsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInvariantsRequest) ProtoMessage()    {}
+func (*QueryInvariantsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df0d8b259d72854e, []int{4}
+}
+func (m *QueryInvariantsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInvariantsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInvariantsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInvariantsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInvariantsRequest.Merge(m, src)
+}
+func (m *QueryInvariantsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInvariantsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInvariantsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInvariantsRequest proto.InternalMessageInfo
+
+func (m *QueryInvariantsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryInvariantsResponse is the response type for the Query/Invariants RPC method.
+type QueryInvariantsResponse struct {
+	// true if any invariant of the ICA controller submodule is broken for an active channel of the page
+	Broken bool `protobuf:"varint,1,opt,name=broken,proto3" json:"broken,omitempty"`
+	// the description of the broken invariants, empty if no invariant is broken
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// pagination response
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryInvariantsResponse) Reset()         { *m = QueryInvariantsResponse{} }
+func (m *QueryInvariantsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInvariantsResponse) ProtoMessage()    {}
+func (*QueryInvariantsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df0d8b259d72854e, []int{5}
+}
+func (m *QueryInvariantsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInvariantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInvariantsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInvariantsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInvariantsResponse.Merge(m, src)
+}
+func (m *QueryInvariantsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInvariantsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInvariantsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInvariantsResponse proto.InternalMessageInfo
+
+func (m *QueryInvariantsResponse) GetBroken() bool {
+	if m != nil {
+		return m.Broken
+	}
+	return false
+}
+
+func (m *QueryInvariantsResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *QueryInvariantsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryInterchainAccountRequest)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryInterchainAccountRequest")
 	proto.RegisterType((*QueryInterchainAccountResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryInterchainAccountResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryInvariantsRequest)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryInvariantsRequest")
+	proto.RegisterType((*QueryInvariantsResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryInvariantsResponse")
 }
 
 func init() {
@@ -221,35 +334,43 @@ func init() {
 }
 
 var fileDescriptor_df0d8b259d72854e = []byte{
-	// 439 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x41, 0x8b, 0xd4, 0x30,
-	0x14, 0xc7, 0xa7, 0x23, 0x3b, 0x62, 0xd4, 0x83, 0x71, 0x0f, 0x43, 0xd1, 0x22, 0xf5, 0xe2, 0x65,
-	0x12, 0xb6, 0x0a, 0xca, 0x1c, 0x04, 0x15, 0x94, 0xbd, 0xed, 0xf6, 0x20, 0xb2, 0x07, 0x97, 0x34,
-	0x0d, 0xdd, 0x48, 0x9b, 0xd7, 0x4d, 0xd2, 0x91, 0x65, 0xd9, 0x8b, 0x9f, 0x40, 0xf0, 0xe6, 0x27,
-	0xf2, 0xb8, 0x20, 0x82, 0x47, 0x99, 0xf1, 0x63, 0x78, 0x90, 0x49, 0xa3, 0x9d, 0xc1, 0x51, 0x9c,
-	0xd1, 0x53, 0xc9, 0x0b, 0xef, 0xf7, 0x7f, 0xef, 0xff, 0x4f, 0xd1, 0x43, 0x99, 0x71, 0xca, 0xea,
-	0xba, 0x94, 0x9c, 0x59, 0x09, 0xca, 0x50, 0xa9, 0xac, 0xd0, 0xfc, 0x88, 0x49, 0x75, 0xc8, 0x38,
-	0x87, 0x46, 0x59, 0x43, 0x39, 0x28, 0xab, 0xa1, 0x2c, 0x85, 0xa6, 0x93, 0x1d, 0x7a, 0xdc, 0x08,
-	0x7d, 0x42, 0x6a, 0x0d, 0x16, 0x70, 0x22, 0x33, 0x4e, 0x16, 0xfb, 0xc9, 0x8a, 0x7e, 0xd2, 0xf5,
-	0x93, 0xc9, 0x4e, 0xf8, 0x64, 0x03, 0xcd, 0x05, 0x82, 0x13, 0x0e, 0x6f, 0x14, 0x00, 0x45, 0x29,
-	0x28, 0xab, 0x25, 0x65, 0x4a, 0x81, 0xf5, 0xf2, 0xee, 0x36, 0x3e, 0x40, 0x37, 0xf7, 0xe7, 0x53,
-	0xee, 0xfe, 0x04, 0x3f, 0x6a, 0xb9, 0xa9, 0x38, 0x6e, 0x84, 0xb1, 0x78, 0x1b, 0x6d, 0xc1, 0x6b,
-	0x25, 0xf4, 0x30, 0xb8, 0x15, 0xdc, 0xb9, 0x94, 0xb6, 0x07, 0x7c, 0x1b, 0x5d, 0xe5, 0xa0, 0x94,
-	0xe0, 0x73, 0xd6, 0xa1, 0xcc, 0x87, 0x7d, 0x77, 0x7b, 0xa5, 0x2b, 0xee, 0xe6, 0xf1, 0x18, 0x45,
-	0xbf, 0x63, 0x9b, 0x1a, 0x94, 0x11, 0x78, 0x88, 0x2e, 0xb2, 0x3c, 0xd7, 0xc2, 0x18, 0x8f, 0xff,
-	0x71, 0x8c, 0xb7, 0x11, 0x76, 0xbd, 0x7b, 0x4c, 0xb3, 0xca, 0xf8, 0x61, 0x62, 0x89, 0xae, 0x2f,
-	0x55, 0x3d, 0x26, 0x45, 0x83, 0xda, 0x55, 0x1c, 0xe5, 0x72, 0x32, 0x26, 0xeb, 0x9b, 0x4d, 0x3c,
-	0xd3, 0x93, 0x92, 0x6f, 0x17, 0xd0, 0x96, 0xd3, 0xc2, 0xef, 0xfb, 0xe8, 0xda, 0x2f, 0x2b, 0xe0,
-	0xfd, 0x4d, 0x34, 0xfe, 0x68, 0x75, 0x98, 0xfe, 0x4f, 0x64, 0x6b, 0x4d, 0xfc, 0xf2, 0xcd, 0xc7,
-	0xaf, 0xef, 0xfa, 0x2f, 0xf0, 0x73, 0xea, 0xdf, 0xd2, 0xdf, 0xbc, 0x21, 0x97, 0xb1, 0xa1, 0xa7,
-	0xee, 0x7b, 0x46, 0xbb, 0x50, 0x0d, 0x3d, 0x5d, 0x8a, 0xfd, 0x0c, 0x7f, 0x0a, 0xd0, 0xa0, 0x75,
-	0x0e, 0x3f, 0xdd, 0x78, 0xfc, 0xa5, 0x90, 0xc3, 0x67, 0xff, 0xcc, 0xf1, 0xbb, 0x8f, 0xdd, 0xee,
-	0xf7, 0x70, 0xb2, 0xce, 0xee, 0x6d, 0xfc, 0x8f, 0x5f, 0x7d, 0x98, 0x46, 0xc1, 0xf9, 0x34, 0x0a,
-	0xbe, 0x4c, 0xa3, 0xe0, 0xed, 0x2c, 0xea, 0x9d, 0xcf, 0xa2, 0xde, 0xe7, 0x59, 0xd4, 0x3b, 0xd8,
-	0x2b, 0xa4, 0x3d, 0x6a, 0x32, 0xc2, 0xa1, 0xa2, 0x1c, 0x4c, 0x05, 0x66, 0x8e, 0x1f, 0x15, 0x40,
-	0x27, 0x0f, 0x68, 0x05, 0x79, 0x53, 0x0a, 0xd3, 0x8a, 0x25, 0xf7, 0x47, 0x9d, 0xde, 0x68, 0x95,
-	0x9e, 0x3d, 0xa9, 0x85, 0xc9, 0x06, 0xee, 0x57, 0xbc, 0xfb, 0x3d, 0x00, 0x00, 0xff, 0xff, 0xae,
-	0x56, 0xc8, 0x35, 0x63, 0x04, 0x00, 0x00,
+	// 576 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcf, 0x6b, 0x53, 0x31,
+	0x1c, 0xef, 0xab, 0xb4, 0x6a, 0xd4, 0x83, 0x71, 0xcc, 0x52, 0xf4, 0x21, 0x4f, 0x50, 0x11, 0x9a,
+	0xd0, 0x2a, 0x3a, 0x7a, 0x18, 0xa8, 0xb0, 0x31, 0xbd, 0x74, 0xef, 0x20, 0xb2, 0x83, 0x33, 0x2f,
+	0x0d, 0x6f, 0xd1, 0x36, 0x79, 0x4b, 0xd2, 0xca, 0x18, 0xbb, 0xf8, 0x17, 0x08, 0x7a, 0xf2, 0x2f,
+	0xf2, 0x38, 0x10, 0xc1, 0xa3, 0xb4, 0xf3, 0xff, 0x90, 0x26, 0xe9, 0x5e, 0xeb, 0xa6, 0xae, 0xdd,
+	0x4e, 0x8f, 0x6f, 0x5e, 0xbe, 0x9f, 0x1f, 0xdf, 0x7c, 0x12, 0xb0, 0xcc, 0x13, 0x8a, 0x49, 0x96,
+	0x75, 0x38, 0x25, 0x86, 0x4b, 0xa1, 0x31, 0x17, 0x86, 0x29, 0xba, 0x45, 0xb8, 0xd8, 0x24, 0x94,
+	0xca, 0x9e, 0x30, 0x1a, 0x53, 0x29, 0x8c, 0x92, 0x9d, 0x0e, 0x53, 0xb8, 0x5f, 0xc7, 0xdb, 0x3d,
+	0xa6, 0x76, 0x50, 0xa6, 0xa4, 0x91, 0xb0, 0xc1, 0x13, 0x8a, 0x26, 0xfb, 0xd1, 0x31, 0xfd, 0x28,
+	0xef, 0x47, 0xfd, 0x7a, 0xf5, 0xd9, 0x1c, 0x9c, 0x13, 0x08, 0x96, 0xb8, 0x7a, 0x23, 0x95, 0x32,
+	0xed, 0x30, 0x4c, 0x32, 0x8e, 0x89, 0x10, 0xd2, 0x78, 0x7a, 0xf7, 0xf7, 0x3e, 0x95, 0xba, 0x2b,
+	0x35, 0x4e, 0x88, 0x66, 0x4e, 0x2f, 0xee, 0xd7, 0x13, 0x66, 0x48, 0x1d, 0x67, 0x24, 0xe5, 0xc2,
+	0x6e, 0x76, 0x7b, 0xa3, 0x0d, 0x70, 0x73, 0x7d, 0xb4, 0x63, 0xed, 0x50, 0xc4, 0x13, 0xa7, 0x21,
+	0x66, 0xdb, 0x3d, 0xa6, 0x0d, 0x5c, 0x00, 0x25, 0xf9, 0x5e, 0x30, 0x55, 0x09, 0x6e, 0x05, 0xf7,
+	0x2e, 0xc6, 0xae, 0x80, 0xb7, 0xc1, 0x15, 0x2a, 0x85, 0x60, 0x74, 0x04, 0xb5, 0xc9, 0xdb, 0x95,
+	0xa2, 0xfd, 0x7b, 0x39, 0x5f, 0x5c, 0x6b, 0x47, 0x4d, 0x10, 0xfe, 0x0d, 0x5b, 0x67, 0x52, 0x68,
+	0x06, 0x2b, 0xe0, 0x3c, 0x69, 0xb7, 0x15, 0xd3, 0xda, 0xc3, 0x8f, 0xcb, 0x68, 0x01, 0x40, 0xdb,
+	0xdb, 0x22, 0x8a, 0x74, 0xb5, 0x17, 0x13, 0x71, 0x70, 0x6d, 0x6a, 0xd5, 0xc3, 0xc4, 0xa0, 0x9c,
+	0xd9, 0x15, 0x8b, 0x72, 0xa9, 0xd1, 0x44, 0xb3, 0x1f, 0x0c, 0xf2, 0x98, 0x1e, 0x29, 0x7a, 0x03,
+	0x16, 0xbd, 0xf8, 0x3e, 0x51, 0x9c, 0x08, 0x33, 0x16, 0x01, 0x57, 0x00, 0xc8, 0xc7, 0xe8, 0x19,
+	0xef, 0x20, 0x37, 0x73, 0x34, 0x9a, 0x39, 0x72, 0x19, 0xf1, 0x33, 0x47, 0x2d, 0x92, 0x32, 0xdf,
+	0x1b, 0x4f, 0x74, 0x46, 0x9f, 0x03, 0x70, 0xfd, 0x08, 0x85, 0x77, 0xb4, 0x08, 0xca, 0x89, 0x92,
+	0xef, 0x98, 0xc3, 0xbf, 0x10, 0xfb, 0x6a, 0x34, 0xb0, 0x2e, 0xd3, 0x9a, 0xa4, 0xcc, 0x4f, 0x7c,
+	0x5c, 0xc2, 0xd5, 0x29, 0x55, 0xe7, 0xac, 0xaa, 0xbb, 0xff, 0x55, 0xe5, 0xe8, 0x26, 0x65, 0x35,
+	0x0e, 0x4a, 0xa0, 0x64, 0x65, 0xc1, 0x2f, 0x45, 0x70, 0xf5, 0xc8, 0xd9, 0xc1, 0xf5, 0x79, 0x86,
+	0xfb, 0xcf, 0x8c, 0x55, 0xe3, 0xb3, 0x84, 0x74, 0x96, 0xa2, 0xd7, 0x1f, 0xbe, 0x1d, 0x7c, 0x2a,
+	0xbe, 0x82, 0x2f, 0xb1, 0xbf, 0x70, 0x27, 0xb9, 0x68, 0x36, 0xdc, 0x1a, 0xef, 0xda, 0xef, 0x1e,
+	0xce, 0xd3, 0xac, 0xf1, 0xee, 0x54, 0xde, 0xf7, 0xe0, 0xf7, 0x00, 0x94, 0x5d, 0x64, 0xe0, 0xca,
+	0xdc, 0xf2, 0xa7, 0xd2, 0x5d, 0x5d, 0x3d, 0x35, 0x8e, 0xf7, 0xde, 0xb4, 0xde, 0x1f, 0xc2, 0xc6,
+	0x2c, 0xde, 0x5d, 0xee, 0xe1, 0xaf, 0x00, 0x80, 0x3c, 0x90, 0xf0, 0xf9, 0x29, 0x8e, 0xe6, 0x8f,
+	0x8b, 0x53, 0x7d, 0x71, 0x26, 0x58, 0xde, 0xe3, 0xb2, 0xf5, 0xb8, 0x04, 0x1f, 0xcd, 0xe2, 0x91,
+	0x1f, 0xe2, 0x3c, 0x7d, 0xfb, 0x75, 0x10, 0x06, 0xfb, 0x83, 0x30, 0xf8, 0x39, 0x08, 0x83, 0x8f,
+	0xc3, 0xb0, 0xb0, 0x3f, 0x0c, 0x0b, 0x3f, 0x86, 0x61, 0x61, 0xa3, 0x95, 0x72, 0xb3, 0xd5, 0x4b,
+	0x10, 0x95, 0x5d, 0xec, 0x5f, 0x52, 0x9e, 0xd0, 0x5a, 0x2a, 0x71, 0x7f, 0x09, 0x77, 0x65, 0xbb,
+	0xd7, 0x61, 0xda, 0x11, 0x36, 0x1e, 0xd7, 0x72, 0xce, 0xda, 0x71, 0x9c, 0x66, 0x27, 0x63, 0x3a,
+	0x29, 0xdb, 0xb7, 0xf6, 0xc1, 0xef, 0x01, 0x00, 0x22, 0x7d, 0xfc, 0x06, 0x70, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -268,6 +389,8 @@ type QueryClient interface {
 	InterchainAccount(ctx context.Context, in *QueryInterchainAccountRequest, opts ...grpc.CallOption) (*QueryInterchainAccountResponse, error)
 	// Params queries all parameters of the ICA controller submodule.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Invariants runs the invariants of the ICA controller submodule against a page of the active channels.
+	Invariants(ctx context.Context, in *QueryInvariantsRequest, opts ...grpc.CallOption) (*QueryInvariantsResponse, error)
 }
 
 type queryClient struct {
@@ -296,12 +419,23 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) Invariants(ctx context.Context, in *QueryInvariantsRequest, opts ...grpc.CallOption) (*QueryInvariantsResponse, error) {
+	out := new(QueryInvariantsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.controller.v1.Query/Invariants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// InterchainAccount returns the interchain account address for a given owner address on a given connection
 	InterchainAccount(context.Context, *QueryInterchainAccountRequest) (*QueryInterchainAccountResponse, error)
 	// Params queries all parameters of the ICA controller submodule.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Invariants runs the invariants of the ICA controller submodule against a page of the active channels.
+	Invariants(context.Context, *QueryInvariantsRequest) (*QueryInvariantsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -314,6 +448,9 @@ func (*UnimplementedQueryServer) InterchainAccount(ctx context.Context, req *Que
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) Invariants(ctx context.Context, req *QueryInvariantsRequest) (*QueryInvariantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Invariants not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -355,6 +492,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Invariants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInvariantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Invariants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.controller.v1.Query/Invariants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Invariants(ctx, req.(*QueryInvariantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.applications.interchain_accounts.controller.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -367,6 +522,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "Invariants",
+			Handler:    _Query_Invariants_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/applications/interchain_accounts/controller/v1/query.proto",
@@ -497,6 +656,93 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryInvariantsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInvariantsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInvariantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInvariantsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInvariantsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInvariantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Broken {
+		i--
+		if m.Broken {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -560,6 +806,39 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryInvariantsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInvariantsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Broken {
+		n += 2
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -898,6 +1177,230 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryInvariantsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInvariantsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInvariantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInvariantsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInvariantsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInvariantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Broken", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Broken = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -98,3 +98,38 @@ func GetCmdPacketEvents() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdInvariants returns the command handler for running the host submodule invariants against a page of the active channels.
+func GetCmdInvariants() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "invariants",
+		Short:   "Query the result of running the interchain-accounts host submodule invariants",
+		Long:    "Query the result of running the interchain-accounts host submodule invariants against a page of the active channels. A broken invariant is reported with a description of the inconsistency.",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query interchain-accounts host invariants --limit 100", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Invariants(cmd.Context(), &types.QueryInvariantsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "invariants")
+
+	return cmd
+}
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	genesistypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/genesis/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// RegisterInvariants registers all interchain accounts host invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
+	ir.RegisterRoute(icatypes.ModuleName, "host-active-channels",
+		ActiveChannelsInvariant(k))
+}
+
+// AllInvariants runs all invariants of the interchain accounts host submodule.
+func AllInvariants(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return ActiveChannelsInvariant(k)(ctx)
+	}
+}
+
+// ActiveChannelsInvariant checks that every active channel of the host submodule,
+// which is stored under the port identifier of the controller, points to an existing
+// channel on the host port and stored connection which has completed the channel
+// opening handshake. Active channels which have been closed, for example
+// by the timeout of a packet sent on an ordered channel, remain active until a new
+// channel is opened for the interchain account and are therefore not reported.
+func ActiveChannelsInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg strings.Builder
+
+		for _, activeChannel := range k.GetAllActiveChannels(ctx) {
+			msg.WriteString(k.checkActiveChannel(ctx, activeChannel))
+		}
+
+		if msg.Len() > 0 {
+			return sdk.FormatInvariant(icatypes.ModuleName, "host active channels", msg.String()), true
+		}
+
+		return "", false
+	}
+}
+
+// checkActiveChannel returns a description of the inconsistency if the active channel does not point to an
+// existing channel which has completed the channel opening handshake on the stored connection, or an empty
+// string otherwise.
+func (k *Keeper) checkActiveChannel(ctx sdk.Context, activeChannel genesistypes.ActiveChannel) string {
+	channel, found := k.channelKeeper.GetChannel(ctx, icatypes.HostPortID, activeChannel.ChannelId)
+	switch {
+	case !found:
+		return fmt.Sprintf("active channel %s for controller port %s does not exist\n", activeChannel.ChannelId, activeChannel.PortId)
+	case channel.State != channeltypes.OPEN && channel.State != channeltypes.FLUSHING &&
+		channel.State != channeltypes.FLUSHCOMPLETE && channel.State != channeltypes.CLOSED:
+		return fmt.Sprintf("active channel %s for controller port %s is in state %s\n", activeChannel.ChannelId, activeChannel.PortId, channel.State)
+	case len(channel.ConnectionHops) == 0 || channel.ConnectionHops[0] != activeChannel.ConnectionId:
+		return fmt.Sprintf("active channel %s for controller port %s is not bound to connection %s\n", activeChannel.ChannelId, activeChannel.PortId, activeChannel.ConnectionId)
+	}
+
+	return ""
+}
@@ -0,0 +1,73 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/keeper"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func (suite *KeeperTestSuite) TestActiveChannelsInvariant() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expBroken bool
+	}{
+		{
+			"success",
+			func() {},
+			false,
+		},
+		{
+			"success: active channel closed",
+			func() {
+				suite.Require().NoError(path.EndpointB.SetChannelState(channeltypes.CLOSED))
+			},
+			false,
+		},
+		{
+			"active channel does not exist",
+			func() {
+				suite.chainB.GetSimApp().ICAHostKeeper.SetActiveChannelID(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID, ibctesting.InvalidID)
+			},
+			true,
+		},
+		{
+			"active channel has not completed the handshake",
+			func() {
+				suite.Require().NoError(path.EndpointB.SetChannelState(channeltypes.TRYOPEN))
+			},
+			true,
+		},
+		{
+			"active channel is bound to a different connection",
+			func() {
+				suite.chainB.GetSimApp().ICAHostKeeper.SetActiveChannelID(suite.chainB.GetContext(), ibctesting.InvalidID, path.EndpointA.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB, icatypes.EncodingProtobuf)
+			path.SetupConnections()
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			icaKeeper := suite.chainB.GetSimApp().ICAHostKeeper
+			msg, broken := keeper.ActiveChannelsInvariant(&icaKeeper)(suite.chainB.GetContext())
+			suite.Require().Equal(tc.expBroken, broken)
+			suite.Require().Equal(tc.expBroken, msg != "")
+		})
+	}
+}
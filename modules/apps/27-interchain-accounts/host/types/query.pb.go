@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -111,9 +112,121 @@ func (m *QueryParamsResponse) GetParams() *Params {
 	return nil
 }
 
+// QueryInvariantsRequest is the request type for the Query/Invariants RPC method.
+type QueryInvariantsRequest struct {
+	// pagination request
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryInvariantsRequest) Reset()         { *m = QueryInvariantsRequest{} }
+func (m *QueryInvariantsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInvariantsRequest) ProtoMessage()    {}
+func (*QueryInvariantsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b7e23fc90c353a, []int{2}
+}
+func (m *QueryInvariantsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInvariantsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInvariantsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInvariantsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInvariantsRequest.Merge(m, src)
+}
+func (m *QueryInvariantsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInvariantsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInvariantsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInvariantsRequest proto.InternalMessageInfo
+
+func (m *QueryInvariantsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryInvariantsResponse is the response type for the Query/Invariants RPC method.
+type QueryInvariantsResponse struct {
+	// true if any invariant of the ICA host submodule is broken for an active channel of the page
+	Broken bool `protobuf:"varint,1,opt,name=broken,proto3" json:"broken,omitempty"`
+	// the description of the broken invariants, empty if no invariant is broken
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// pagination response
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryInvariantsResponse) Reset()         { *m = QueryInvariantsResponse{} }
+func (m *QueryInvariantsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInvariantsResponse) ProtoMessage()    {}
+func (*QueryInvariantsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b7e23fc90c353a, []int{3}
+}
+func (m *QueryInvariantsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInvariantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInvariantsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInvariantsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInvariantsResponse.Merge(m, src)
+}
+func (m *QueryInvariantsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInvariantsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInvariantsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInvariantsResponse proto.InternalMessageInfo
+
+func (m *QueryInvariantsResponse) GetBroken() bool {
+	if m != nil {
+		return m.Broken
+	}
+	return false
+}
+
+func (m *QueryInvariantsResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *QueryInvariantsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "ibc.applications.interchain_accounts.host.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "ibc.applications.interchain_accounts.host.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryInvariantsRequest)(nil), "ibc.applications.interchain_accounts.host.v1.QueryInvariantsRequest")
+	proto.RegisterType((*QueryInvariantsResponse)(nil), "ibc.applications.interchain_accounts.host.v1.QueryInvariantsResponse")
 }
 
 func init() {
@@ -121,27 +234,36 @@ func init() {
 }
 
 var fileDescriptor_e6b7e23fc90c353a = []byte{
-	// 312 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x91, 0x31, 0x4b, 0x33, 0x31,
-	0x18, 0xc7, 0x9b, 0x17, 0xde, 0x0e, 0x71, 0x8b, 0x0e, 0x52, 0x24, 0x48, 0x27, 0x87, 0x36, 0xa1,
-	0xb5, 0xd0, 0x8e, 0xea, 0x28, 0x0e, 0xea, 0xe8, 0x22, 0xb9, 0x34, 0x5c, 0x03, 0xbd, 0x3c, 0xe9,
-	0x25, 0x77, 0xd0, 0xd5, 0x4f, 0x20, 0xf8, 0x91, 0x5c, 0x5c, 0x84, 0x82, 0x8b, 0xa3, 0xdc, 0xf9,
-	0x41, 0xe4, 0x72, 0x07, 0x5a, 0x14, 0xe1, 0x70, 0x7d, 0xc2, 0xef, 0xf7, 0x7f, 0xfe, 0x4f, 0xf0,
-	0x4c, 0x47, 0x92, 0x0b, 0x6b, 0x97, 0x5a, 0x0a, 0xaf, 0xc1, 0x38, 0xae, 0x8d, 0x57, 0xa9, 0x5c,
-	0x08, 0x6d, 0x6e, 0x85, 0x94, 0x90, 0x19, 0xef, 0xf8, 0x02, 0x9c, 0xe7, 0xf9, 0x88, 0xaf, 0x32,
-	0x95, 0xae, 0x99, 0x4d, 0xc1, 0x03, 0x19, 0xe8, 0x48, 0xb2, 0xaf, 0x24, 0xfb, 0x81, 0x64, 0x15,
-	0xc9, 0xf2, 0x51, 0xef, 0x20, 0x06, 0x88, 0x97, 0x8a, 0x0b, 0xab, 0xb9, 0x30, 0x06, 0x7c, 0xc3,
-	0x04, 0x57, 0x6f, 0xda, 0x6a, 0x8b, 0xe0, 0x0c, 0x60, 0x7f, 0x0f, 0x93, 0xab, 0x6a, 0xa7, 0x4b,
-	0x91, 0x8a, 0xc4, 0x5d, 0xab, 0x55, 0xa6, 0x9c, 0xef, 0x4b, 0xbc, 0xbb, 0x35, 0x75, 0x16, 0x8c,
-	0x53, 0xe4, 0x02, 0x77, 0x6d, 0x98, 0xec, 0xa3, 0x43, 0x74, 0xb4, 0x33, 0x9e, 0xb0, 0x36, 0x15,
-	0x58, 0x63, 0x6b, 0x1c, 0xe3, 0x67, 0x84, 0xff, 0x87, 0x14, 0xf2, 0x88, 0x70, 0xb7, 0x7e, 0x24,
-	0x27, 0xed, 0x94, 0xdf, 0x77, 0xef, 0x9d, 0xfe, 0xc1, 0x50, 0xf7, 0xec, 0x4f, 0xee, 0x5e, 0xde,
-	0x1f, 0xfe, 0x31, 0x32, 0xe0, 0xcd, 0x59, 0x7f, 0x3f, 0x67, 0xdd, 0xe7, 0x6c, 0xfe, 0x54, 0x50,
-	0xb4, 0x29, 0x28, 0x7a, 0x2b, 0x28, 0xba, 0x2f, 0x69, 0x67, 0x53, 0xd2, 0xce, 0x6b, 0x49, 0x3b,
-	0x37, 0xe7, 0xb1, 0xf6, 0x8b, 0x2c, 0x62, 0x12, 0x12, 0x2e, 0xc1, 0x25, 0xe0, 0x2a, 0xf1, 0x30,
-	0x06, 0x9e, 0xcf, 0x78, 0x02, 0xf3, 0x6c, 0xa9, 0x5c, 0x1d, 0x33, 0x9e, 0x0e, 0x3f, 0x93, 0x86,
-	0xdb, 0x49, 0x7e, 0x6d, 0x95, 0x8b, 0xba, 0xe1, 0xdf, 0x8e, 0x3f, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0xbc, 0x5f, 0x87, 0x7c, 0x78, 0x02, 0x00, 0x00,
+	// 460 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xcd, 0x8a, 0x13, 0x31,
+	0x1c, 0x6f, 0x76, 0xb1, 0x6a, 0xbc, 0x45, 0x59, 0x4b, 0x91, 0x61, 0x99, 0x83, 0x2e, 0xb2, 0x4d,
+	0xec, 0xb8, 0xb0, 0x3d, 0xaa, 0xf8, 0x81, 0xe2, 0x61, 0x9d, 0xa3, 0x17, 0x4d, 0xb2, 0x61, 0x1a,
+	0xec, 0x24, 0xd9, 0x49, 0x66, 0x60, 0xaf, 0x3e, 0x81, 0xa0, 0x6f, 0xe0, 0xa3, 0x78, 0xf1, 0xb8,
+	0xb0, 0x17, 0x8f, 0xd2, 0xfa, 0x20, 0x32, 0x49, 0xd6, 0xb6, 0x54, 0xd4, 0xda, 0x53, 0x48, 0xc8,
+	0xef, 0x33, 0xff, 0xc0, 0x91, 0x64, 0x9c, 0x50, 0x63, 0x26, 0x92, 0x53, 0x27, 0xb5, 0xb2, 0x44,
+	0x2a, 0x27, 0x2a, 0x3e, 0xa6, 0x52, 0xbd, 0xa1, 0x9c, 0xeb, 0x5a, 0x39, 0x4b, 0xc6, 0xda, 0x3a,
+	0xd2, 0x0c, 0xc9, 0x49, 0x2d, 0xaa, 0x53, 0x6c, 0x2a, 0xed, 0x34, 0xda, 0x97, 0x8c, 0xe3, 0x45,
+	0x24, 0xfe, 0x0d, 0x12, 0xb7, 0x48, 0xdc, 0x0c, 0xfb, 0xb7, 0x0a, 0xad, 0x8b, 0x89, 0x20, 0xd4,
+	0x48, 0x42, 0x95, 0xd2, 0x2e, 0x62, 0x3c, 0x57, 0xff, 0x2e, 0xd7, 0xb6, 0xd4, 0x96, 0x30, 0x6a,
+	0x45, 0x10, 0x21, 0xcd, 0x90, 0x09, 0x47, 0x87, 0xc4, 0xd0, 0x42, 0x2a, 0x7f, 0x39, 0xde, 0x3d,
+	0x5c, 0xcb, 0x71, 0xbb, 0x06, 0x60, 0x7a, 0x03, 0xa2, 0x57, 0x2d, 0xf5, 0x11, 0xad, 0x68, 0x69,
+	0x73, 0x71, 0x52, 0x0b, 0xeb, 0x52, 0x0e, 0xaf, 0x2f, 0x9d, 0x5a, 0xa3, 0x95, 0x15, 0xe8, 0x25,
+	0xec, 0x1a, 0x7f, 0xd2, 0x03, 0xbb, 0x60, 0xef, 0x5a, 0x76, 0x80, 0xd7, 0x89, 0x8b, 0x23, 0x5b,
+	0xe4, 0x48, 0xdf, 0xc2, 0x1d, 0x2f, 0xf2, 0x5c, 0x35, 0xb4, 0x92, 0x54, 0xb9, 0x0b, 0x79, 0xf4,
+	0x14, 0xc2, 0x79, 0xc2, 0xa8, 0x75, 0x1b, 0x87, 0x3a, 0x70, 0x5b, 0x07, 0x0e, 0x9d, 0xc7, 0x3a,
+	0xf0, 0x11, 0x2d, 0x44, 0xc4, 0xe6, 0x0b, 0xc8, 0xf4, 0x13, 0x80, 0x37, 0x57, 0x24, 0x62, 0x96,
+	0x1d, 0xd8, 0x65, 0x95, 0x7e, 0x27, 0x02, 0xff, 0x95, 0x3c, 0xee, 0x50, 0x0f, 0x5e, 0x2e, 0x85,
+	0xb5, 0xb4, 0x10, 0xbd, 0xad, 0x5d, 0xb0, 0x77, 0x35, 0xbf, 0xd8, 0xa2, 0x67, 0x4b, 0xae, 0xb6,
+	0xbd, 0xab, 0x3b, 0x7f, 0x75, 0x15, 0xe4, 0x16, 0x6d, 0x65, 0x9f, 0xb7, 0xe1, 0x25, 0x6f, 0x0b,
+	0x7d, 0x01, 0xb0, 0x1b, 0x5a, 0x41, 0x0f, 0xd6, 0xeb, 0x72, 0xf5, 0xd1, 0xfa, 0x0f, 0x37, 0x60,
+	0x08, 0x2e, 0xd3, 0x83, 0xf7, 0xe7, 0x3f, 0x3e, 0x6e, 0x61, 0xb4, 0x4f, 0xe2, 0x3c, 0xfd, 0x79,
+	0x8e, 0xc2, 0x43, 0xa2, 0x73, 0x00, 0xe1, 0xbc, 0x61, 0xf4, 0xf8, 0x3f, 0x7c, 0xac, 0xcc, 0x40,
+	0xff, 0xc9, 0x86, 0x2c, 0x31, 0xd1, 0xc8, 0x27, 0xca, 0xd0, 0xbd, 0x7f, 0x4b, 0x24, 0x7f, 0x31,
+	0x3c, 0x3a, 0xfe, 0x3a, 0x4d, 0xc0, 0xd9, 0x34, 0x01, 0xdf, 0xa7, 0x09, 0xf8, 0x30, 0x4b, 0x3a,
+	0x67, 0xb3, 0xa4, 0xf3, 0x6d, 0x96, 0x74, 0x5e, 0xbf, 0x28, 0xa4, 0x1b, 0xd7, 0x0c, 0x73, 0x5d,
+	0x92, 0xf8, 0x47, 0x25, 0xe3, 0x83, 0x42, 0x93, 0x66, 0x44, 0x4a, 0x7d, 0x5c, 0x4f, 0x84, 0x0d,
+	0x52, 0xd9, 0xe1, 0x60, 0xae, 0x36, 0x58, 0x56, 0x73, 0xa7, 0x46, 0x58, 0xd6, 0xf5, 0xdf, 0xf0,
+	0xfe, 0xcf, 0x01, 0x00, 0x19, 0x7b, 0x8b, 0x35, 0x73, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -158,6 +280,8 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Params queries all parameters of the ICA host submodule.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Invariants runs the invariants of the ICA host submodule against a page of the active channels.
+	Invariants(ctx context.Context, in *QueryInvariantsRequest, opts ...grpc.CallOption) (*QueryInvariantsResponse, error)
 }
 
 type queryClient struct {
@@ -177,10 +301,21 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) Invariants(ctx context.Context, in *QueryInvariantsRequest, opts ...grpc.CallOption) (*QueryInvariantsResponse, error) {
+	out := new(QueryInvariantsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.host.v1.Query/Invariants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries all parameters of the ICA host submodule.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Invariants runs the invariants of the ICA host submodule against a page of the active channels.
+	Invariants(context.Context, *QueryInvariantsRequest) (*QueryInvariantsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -190,6 +325,9 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) Invariants(ctx context.Context, req *QueryInvariantsRequest) (*QueryInvariantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Invariants not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -213,6 +351,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Invariants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInvariantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Invariants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.host.v1.Query/Invariants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Invariants(ctx, req.(*QueryInvariantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.applications.interchain_accounts.host.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -221,6 +377,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "Invariants",
+			Handler:    _Query_Invariants_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/applications/interchain_accounts/host/v1/query.proto",
@@ -284,6 +444,93 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryInvariantsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInvariantsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInvariantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInvariantsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInvariantsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInvariantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Broken {
+		i--
+		if m.Broken {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -317,6 +564,39 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryInvariantsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInvariantsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Broken {
+		n += 2
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -459,6 +739,230 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryInvariantsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInvariantsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInvariantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInvariantsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInvariantsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInvariantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Broken", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Broken = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -37,6 +37,7 @@ var (
 	_ module.HasName             = (*AppModule)(nil)
 	_ module.HasConsensusVersion = (*AppModule)(nil)
 	_ module.HasServices         = (*AppModule)(nil)
+	_ module.HasInvariants       = (*AppModule)(nil)
 	_ module.HasProposalMsgs     = (*AppModule)(nil)
 	_ appmodule.AppModule        = (*AppModule)(nil)
 
@@ -149,6 +150,17 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	}
 }
 
+// RegisterInvariants registers the invariants of the enabled interchain accounts submodules.
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	if am.controllerKeeper != nil {
+		controllerkeeper.RegisterInvariants(ir, am.controllerKeeper)
+	}
+
+	if am.hostKeeper != nil {
+		hostkeeper.RegisterInvariants(ir, am.hostKeeper)
+	}
+}
+
 // InitGenesis performs genesis initialization for the interchain accounts module.
 // It returns no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) {
@@ -0,0 +1,29 @@
+package types
+
+import (
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+)
+
+// RegisterPacketDataSchemas registers the InterchainAccountPacketData schema for the host port
+// and all controller ports with the given packet data registry. A version unwrapper is also
+// registered to resolve the application version from the interchain accounts channel metadata.
+func RegisterPacketDataSchemas(registry *porttypes.PacketDataRegistry) {
+	schema := porttypes.PacketDataSchema{
+		ProtoMessage: &InterchainAccountPacketData{},
+	}
+
+	registry.
+		Register(HostPortID, Version, schema).
+		Register(ControllerPortPrefix+porttypes.PortWildcard, Version, schema).
+		RegisterVersionUnwrapper(UnwrapVersion)
+}
+
+// UnwrapVersion returns the application version contained in the JSON encoded interchain accounts metadata.
+func UnwrapVersion(version string) (string, bool) {
+	metadata, err := MetadataFromVersion(version)
+	if err != nil || metadata.Version == "" {
+		return "", false
+	}
+
+	return metadata.Version, true
+}
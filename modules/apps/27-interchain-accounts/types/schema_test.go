@@ -0,0 +1,43 @@
+package types_test
+
+import (
+	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	feetypes "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func (suite *TypesTestSuite) TestRegisterPacketDataSchemas() {
+	registry := porttypes.NewPacketDataRegistry()
+	types.RegisterPacketDataSchemas(registry)
+	feetypes.RegisterPacketDataSchemas(registry)
+
+	controllerPortID, err := types.NewControllerPortID(ibctesting.TestAccAddress)
+	suite.Require().NoError(err)
+
+	icaVersion := types.NewDefaultMetadataString(ibctesting.FirstConnectionID, ibctesting.FirstConnectionID)
+	feeVersion := string(feetypes.ModuleCdc.MustMarshalJSON(&feetypes.Metadata{FeeVersion: feetypes.Version, AppVersion: icaVersion}))
+
+	testCases := []struct {
+		name     string
+		portID   string
+		version  string
+		expFound bool
+	}{
+		{"host port", types.HostPortID, icaVersion, true},
+		{"controller port", controllerPortID, icaVersion, true},
+		{"fee enabled controller port", controllerPortID, feeVersion, true},
+		{"unknown port", ibctesting.MockPort, icaVersion, false},
+		{"unknown version", types.HostPortID, ibctesting.InvalidID, false},
+	}
+
+	for _, tc := range testCases {
+		schema, version, found := registry.GetSchema(tc.portID, tc.version)
+		suite.Require().Equal(tc.expFound, found, tc.name)
+
+		if tc.expFound {
+			suite.Require().Equal(types.Version, version, tc.name)
+			suite.Require().IsType(&types.InterchainAccountPacketData{}, schema.ProtoMessage, tc.name)
+		}
+	}
+}
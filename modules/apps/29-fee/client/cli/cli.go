@@ -22,6 +22,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdTotalAckFees(),
 		GetCmdTotalTimeoutFees(),
 		GetCmdIncentivizedPacketsForChannel(),
+		GetCmdInvariants(),
 		GetCmdPayee(),
 		GetCmdCounterpartyPayee(),
 		GetCmdFeeEnabledChannel(),
@@ -5,10 +5,14 @@ import (
 	"strconv"
 
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
@@ -437,3 +441,77 @@ func GetCmdIncentivizedPacketsForChannel() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdInvariants returns the command handler for running the fee module invariants against the current state.
+// The packet fees in escrow are queried page by page at a single height, such that each request is bounded by the
+// page limit, and the invariant is evaluated against the total of all pages.
+func GetCmdInvariants() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "invariants",
+		Short: "Query the result of running the fee module invariants",
+		Long: `Query the result of running the fee module invariants against the current state. The packet fees in escrow
+are queried in pages of the given limit at a single height and the fee module account balance is required to cover
+their total. A broken invariant is reported with a description of the inconsistency.`,
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query ibc-fee invariants --limit 500", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			var feesInEscrow sdk.Coins
+			var res *types.QueryInvariantsResponse
+			for {
+				var header metadata.MD
+				queryClient := types.NewQueryClient(clientCtx)
+				res, err = queryClient.Invariants(cmd.Context(), &types.QueryInvariantsRequest{Pagination: pageReq}, grpc.Header(&header))
+				if err != nil {
+					return err
+				}
+
+				feesInEscrow = feesInEscrow.Add(res.FeesInEscrow...)
+				if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+					break
+				}
+
+				// query the remaining pages at the height of the first page
+				if clientCtx.Height == 0 {
+					blockHeight := header.Get(grpctypes.GRPCBlockHeightHeader)
+					if len(blockHeight) != 1 {
+						return fmt.Errorf("unexpected block height header %v", blockHeight)
+					}
+
+					height, err := strconv.ParseInt(blockHeight[0], 10, 64)
+					if err != nil {
+						return err
+					}
+
+					clientCtx = clientCtx.WithHeight(height)
+				}
+
+				pageReq = &query.PageRequest{Key: res.Pagination.NextKey, Limit: pageReq.Limit}
+			}
+
+			res.FeesInEscrow = feesInEscrow
+			res.Broken = !res.EscrowBalance.IsAllGTE(feesInEscrow)
+			res.Message = ""
+			if res.Broken {
+				res.Message = fmt.Sprintf("fee module account balance %s is lower than the fees in escrow: %s", res.EscrowBalance, feesInEscrow)
+			}
+			res.Pagination = nil
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "invariants")
+
+	return cmd
+}
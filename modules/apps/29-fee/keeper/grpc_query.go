@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -265,3 +266,41 @@ func (k Keeper) FeeEnabledChannel(goCtx context.Context, req *types.QueryFeeEnab
 		FeeEnabled: isFeeEnabled,
 	}, nil
 }
+
+// Invariants implements the Query/Invariants gRPC method
+func (k Keeper) Invariants(goCtx context.Context, req *types.QueryInvariantsRequest) (*types.QueryInvariantsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var feesInEscrow sdk.Coins
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.FeesInEscrowPrefix))
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		for _, packetFee := range k.MustUnmarshalFees(value).PacketFees {
+			feesInEscrow = feesInEscrow.Add(packetFee.Fee.Total()...)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.QueryInvariantsResponse{
+		FeesInEscrow:  feesInEscrow,
+		EscrowBalance: k.bankKeeper.GetAllBalances(ctx, k.GetFeeModuleAddress()),
+		Pagination:    pageRes,
+	}
+
+	if !res.EscrowBalance.IsAllGTE(feesInEscrow) {
+		res.Broken = true
+		res.Message = sdk.FormatInvariant(
+			types.ModuleName,
+			"total fees in escrow invariance",
+			fmt.Sprintf("fee module account balance %s is lower than the fees in escrow: %s", res.EscrowBalance, feesInEscrow))
+	}
+
+	return res, nil
+}
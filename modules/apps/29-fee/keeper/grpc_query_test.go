@@ -790,3 +790,113 @@ func (suite *KeeperTestSuite) TestQueryFeeEnabledChannel() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryInvariants() {
+	var (
+		req             *types.QueryInvariantsRequest
+		packetID        channeltypes.PacketId
+		expFeesInEscrow sdk.Coins
+	)
+
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		expBroken  bool
+		expNextKey bool
+		expPass    bool
+	}{
+		{
+			"success",
+			func() {},
+			false,
+			false,
+			true,
+		},
+		{
+			"success: fee module account balance exceeding fees in escrow",
+			func() {
+				err := suite.chainA.GetSimApp().BankKeeper.SendCoinsFromAccountToModule(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), types.ModuleName, defaultRecvFee)
+				suite.Require().NoError(err)
+			},
+			false,
+			false,
+			true,
+		},
+		{
+			"success: broken invariant",
+			func() {
+				// store an additional fee in escrow which is not backed by the fee module account balance
+				packetFees, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeesInEscrow(suite.chainA.GetContext(), packetID)
+				suite.Require().True(found)
+
+				packetFees.PacketFees = append(packetFees.PacketFees, types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String(), nil))
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, packetFees)
+
+				expFeesInEscrow = expFeesInEscrow.Add(fee.Total()...)
+			},
+			true,
+			false,
+			true,
+		},
+		{
+			"success: fees in escrow of the first page",
+			func() {
+				// store fees in escrow for a second packet which are not part of the first page
+				nextPacketID := channeltypes.NewPacketID(packetID.PortId, packetID.ChannelId, packetID.Sequence+1)
+				packetFees := types.NewPacketFees([]types.PacketFee{types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String(), nil)})
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), nextPacketID, packetFees)
+
+				req.Pagination = &query.PageRequest{Limit: 1}
+			},
+			false,
+			true,
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			suite.path.Setup()
+
+			sequence, err := suite.path.EndpointA.SendPacket(suite.chainB.GetTimeoutHeight(), 0, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+
+			packetID = channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sequence)
+			msg := types.NewMsgPayPacketFeeAsync(packetID, types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String(), nil))
+
+			_, err = suite.chainA.SendMsgs(msg)
+			suite.Require().NoError(err)
+
+			req = &types.QueryInvariantsRequest{}
+			expFeesInEscrow = fee.Total()
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.Invariants(suite.chainA.GetContext(), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expBroken, res.Broken)
+				suite.Require().Equal(tc.expBroken, res.Message != "")
+				suite.Require().Equal(expFeesInEscrow, res.FeesInEscrow)
+				suite.Require().Equal(tc.expNextKey, len(res.Pagination.NextKey) != 0)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+)
+
+// RegisterInvariants registers all 29-fee invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "total-fees-in-escrow",
+		TotalFeesInEscrowInvariant(k))
+}
+
+// AllInvariants runs all invariants of the 29-fee module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return TotalFeesInEscrowInvariant(k)(ctx)
+	}
+}
+
+// TotalFeesInEscrowInvariant checks that the balance of the fee module account
+// covers the total amount of all packet fees in escrow. The balance is not required to equal
+// the fees in escrow, as any account may send coins to the fee module account with a bank
+// MsgSend. An equality check could therefore be broken by anyone, halting chains which
+// assert the invariant through the crisis module.
+func TotalFeesInEscrowInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var expectedTotalEscrowed sdk.Coins
+		for _, identifiedFees := range k.GetAllIdentifiedPacketFees(ctx) {
+			for _, packetFee := range identifiedFees.PacketFees {
+				expectedTotalEscrowed = expectedTotalEscrowed.Add(packetFee.Fee.Total()...)
+			}
+		}
+
+		if !k.EscrowAccountHasBalance(ctx, expectedTotalEscrowed) {
+			return sdk.FormatInvariant(
+				types.ModuleName,
+				"total fees in escrow invariance",
+				fmt.Sprintf("fee module account balance is lower than the total fees in escrow: %s", expectedTotalEscrowed)), true
+		}
+
+		return "", false
+	}
+}
@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-go/v8/modules/apps/29-fee/keeper"
+	"github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func (suite *KeeperTestSuite) TestTotalFeesInEscrowInvariant() {
+	var packetID channeltypes.PacketId
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"fails with broken invariant",
+			func() {
+				// store an additional fee in escrow which is not backed by the fee module account balance
+				fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+				packetFee := types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String(), nil)
+				packetFees, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeesInEscrow(suite.chainA.GetContext(), packetID)
+				suite.Require().True(found)
+
+				packetFees.PacketFees = append(packetFees.PacketFees, packetFee)
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, packetFees)
+			},
+			false,
+		},
+		{
+			"success with fee module account balance exceeding fees in escrow",
+			func() {
+				// anyone may send coins to the fee module account, which must not break the invariant
+				err := suite.chainA.GetSimApp().BankKeeper.SendCoinsFromAccountToModule(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), types.ModuleName, defaultRecvFee)
+				suite.Require().NoError(err)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			suite.path.Setup()
+
+			sequence, err := suite.path.EndpointA.SendPacket(clienttypes.NewHeight(1, 100), 0, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+
+			packetID = channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sequence)
+			fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+			msg := types.NewMsgPayPacketFeeAsync(packetID, types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String(), nil))
+
+			res, err := suite.chainA.SendMsgs(msg)
+			suite.Require().NoError(err)
+			suite.Require().NotNil(res)
+
+			tc.malleate()
+
+			out, broken := keeper.TotalFeesInEscrowInvariant(suite.chainA.GetSimApp().IBCFeeKeeper)(suite.chainA.GetContext())
+
+			if tc.expPass {
+				suite.Require().False(broken)
+				suite.Require().Empty(out)
+			} else {
+				suite.Require().True(broken)
+				suite.Require().NotEmpty(out)
+			}
+		})
+	}
+}
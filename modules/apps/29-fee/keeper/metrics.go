@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ibcmetrics "github.com/cosmos/ibc-go/v8/modules/core/metrics"
+	coretypes "github.com/cosmos/ibc-go/v8/modules/core/types"
+)
+
+// ReportEscrowMetrics reports the total amount of every denomination held in escrow by the fee module account.
+// It is a no-op if the ibc metrics are not enabled.
+func (k Keeper) ReportEscrowMetrics(ctx sdk.Context) {
+	if !ibcmetrics.Enabled() {
+		return
+	}
+
+	for _, coin := range k.bankKeeper.GetAllBalances(ctx, k.GetFeeModuleAddress()) {
+		amount, _ := new(big.Float).SetInt(coin.Amount.BigInt()).Float32()
+		ibcmetrics.SetGauge([]string{"ibc", "fee", "escrow_total"}, amount, ibcmetrics.Label(coretypes.LabelDenom, coin.Denom))
+	}
+}
@@ -0,0 +1,26 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func (suite *KeeperTestSuite) TestReportEscrowMetrics() {
+	sink := ibctesting.EnableMetrics(suite.T())
+
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+	// set fee in escrow account
+	err := suite.chainA.GetSimApp().BankKeeper.SendCoinsFromAccountToModule(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), types.ModuleName, fee.Total())
+	suite.Require().NoError(err)
+
+	suite.chainA.GetSimApp().IBCFeeKeeper.ReportEscrowMetrics(suite.chainA.GetContext())
+
+	escrowTotal, found := sink.Data()[0].Gauges[fmt.Sprintf("ibc.fee.escrow_total;denom=%s", sdk.DefaultBondDenom)]
+	suite.Require().True(found)
+	suite.Require().Equal(float32(fee.Total().AmountOf(sdk.DefaultBondDenom).Int64()), escrowTotal.Value)
+}
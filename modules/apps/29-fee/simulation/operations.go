@@ -0,0 +1,106 @@
+package simulation
+
+import (
+	"context"
+	"math/rand"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/29-fee/keeper"
+	"github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgPayPacketFeeAsync = "op_weight_msg_pay_packet_fee_async" // #nosec
+
+	DefaultWeightMsgPayPacketFeeAsync int = 50
+)
+
+// BankKeeper defines the bank keeper methods used by the 29-fee simulation operations.
+type BankKeeper interface {
+	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+	IsSendEnabledCoins(ctx context.Context, coins ...sdk.Coin) error
+}
+
+// WeightedOperations returns the weighted operations of the 29-fee module.
+func WeightedOperations(appParams simtypes.AppParams, k keeper.Keeper, ibcKeeper *ibckeeper.Keeper, bk BankKeeper) simulation.WeightedOperations {
+	var weightMsgPayPacketFeeAsync int
+	appParams.GetOrGenerate(OpWeightMsgPayPacketFeeAsync, &weightMsgPayPacketFeeAsync, nil,
+		func(_ *rand.Rand) { weightMsgPayPacketFeeAsync = DefaultWeightMsgPayPacketFeeAsync },
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgPayPacketFeeAsync,
+			SimulateMsgPayPacketFeeAsync(k, ibcKeeper, bk),
+		),
+	}
+}
+
+// SimulateMsgPayPacketFeeAsync returns an operation which escrows a random fee, paid in the bond denomination by a
+// random simulation account, for a random packet in flight on a fee enabled channel. The fee is distributed to the
+// relayers or refunded once the packet is acknowledged or timed out.
+func SimulateMsgPayPacketFeeAsync(k keeper.Keeper, ibcKeeper *ibckeeper.Keeper, bk BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, _ *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgPayPacketFeeAsync{})
+		if k.IsLocked(ctx) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "fee module is locked"), nil, nil
+		}
+
+		var packetIDs []channeltypes.PacketId
+		for _, channel := range k.GetAllFeeEnabledChannels(ctx) {
+			for _, sequence := range ibcKeeper.ChannelKeeper.GetInflightPacketSequences(ctx, channel.PortId, channel.ChannelId) {
+				packetIDs = append(packetIDs, channeltypes.NewPacketID(channel.PortId, channel.ChannelId, sequence))
+			}
+		}
+
+		if len(packetIDs) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no packets in flight on fee enabled channels"), nil, nil
+		}
+
+		packetID := packetIDs[r.Intn(len(packetIDs))]
+
+		payer, _ := simtypes.RandomAcc(r, accs)
+		bondDenom := sdk.DefaultBondDenom
+		budget := bk.SpendableCoins(ctx, payer.Address).AmountOf(bondDenom).QuoRaw(3)
+		if !budget.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "payer has insufficient spendable funds"), nil, nil
+		}
+
+		fee := types.NewFee(randomFee(r, bondDenom, budget), randomFee(r, bondDenom, budget), randomFee(r, bondDenom, budget))
+		if err := bk.IsSendEnabledCoins(ctx, fee.Total()...); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "fee transfers are disabled"), nil, nil
+		}
+
+		msg := types.NewMsgPayPacketFeeAsync(packetID, types.NewPacketFee(fee, payer.Address.String(), nil))
+
+		cacheCtx, writeFn := ctx.CacheContext()
+		if _, err := k.PayPacketFeeAsync(cacheCtx, msg); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "pay packet fee async failed"), nil, err
+		}
+
+		writeFn()
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// randomFee returns a fee of a random positive amount of the given denomination which does not exceed maxAmount.
+func randomFee(r *rand.Rand, denom string, maxAmount sdkmath.Int) sdk.Coins {
+	amount, err := simtypes.RandPositiveInt(r, maxAmount)
+	if err != nil {
+		return sdk.NewCoins()
+	}
+
+	return sdk.NewCoins(sdk.NewCoin(denom, amount))
+}
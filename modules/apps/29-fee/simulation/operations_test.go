@@ -0,0 +1,73 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/29-fee/keeper"
+	"github.com/cosmos/ibc-go/v8/modules/apps/29-fee/simulation"
+	"github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+	transfersimulation "github.com/cosmos/ibc-go/v8/modules/apps/transfer/simulation"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	ibcsimulation "github.com/cosmos/ibc-go/v8/modules/core/simulation"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func TestSimulateMsgPayPacketFeeAsync(t *testing.T) {
+	coord := ibctesting.NewCoordinator(t, 1)
+	chain := coord.GetChain(ibctesting.GetChainID(1))
+	app := chain.GetSimApp()
+
+	r := rand.New(rand.NewSource(1))
+	accs := []simtypes.Account{
+		{
+			PrivKey: chain.SenderPrivKey,
+			PubKey:  chain.SenderPrivKey.PubKey(),
+			Address: chain.SenderAccount.GetAddress(),
+		},
+	}
+
+	operation := simulation.SimulateMsgPayPacketFeeAsync(app.IBCFeeKeeper, app.IBCKeeper, app.BankKeeper)
+
+	// no fees are paid before a packet is sent on a fee enabled channel
+	operationMsg, _, err := operation(r, nil, chain.GetContext(), accs, "")
+	require.NoError(t, err)
+	require.False(t, operationMsg.OK)
+
+	feeVersion := string(types.ModuleCdc.MustMarshalJSON(&types.Metadata{FeeVersion: types.Version, AppVersion: transfertypes.Version}))
+	operationMsg, _, err = ibcsimulation.SimulateChannelOpenHandshake(app.IBCKeeper, app.TransferKeeper, []string{feeVersion})(r, nil, chain.GetContext(), accs, "")
+	require.NoError(t, err)
+	require.True(t, operationMsg.OK)
+
+	operationMsg, futureOps, err := transfersimulation.SimulateMsgTransfer(app.TransferKeeper, app.IBCKeeper, app.BankKeeper)(r, nil, chain.GetContext(), accs, "")
+	require.NoError(t, err)
+	require.True(t, operationMsg.OK)
+	require.Len(t, futureOps, 1)
+
+	operationMsg, _, err = operation(r, nil, chain.GetContext(), accs, "")
+	require.NoError(t, err)
+	require.True(t, operationMsg.OK)
+
+	var msg types.MsgPayPacketFeeAsync
+	require.NoError(t, app.AppCodec().Unmarshal(operationMsg.Msg, &msg))
+
+	packetFees, found := app.IBCFeeKeeper.GetFeesInEscrow(chain.GetContext(), msg.PacketId)
+	require.True(t, found)
+	require.Equal(t, []types.PacketFee{msg.PacketFee}, packetFees.PacketFees)
+
+	_, broken := keeper.AllInvariants(app.IBCFeeKeeper)(chain.GetContext())
+	require.False(t, broken)
+
+	// relaying the packet distributes the escrowed fees
+	operationMsg, _, err = futureOps[0].Op(r, nil, chain.GetContext(), accs, "")
+	require.NoError(t, err)
+	require.True(t, operationMsg.OK)
+
+	_, found = app.IBCFeeKeeper.GetFeesInEscrow(chain.GetContext(), msg.PacketId)
+	require.False(t, found)
+	require.True(t, app.BankKeeper.GetAllBalances(chain.GetContext(), app.IBCFeeKeeper.GetFeeModuleAddress()).IsZero())
+}
@@ -1068,6 +1068,134 @@ func (m *QueryFeeEnabledChannelResponse) GetFeeEnabled() bool {
 	return false
 }
 
+// QueryInvariantsRequest is the request type for the Query/Invariants RPC method.
+type QueryInvariantsRequest struct {
+	// pagination request
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryInvariantsRequest) Reset()         { *m = QueryInvariantsRequest{} }
+func (m *QueryInvariantsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInvariantsRequest) ProtoMessage()    {}
+func (*QueryInvariantsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0638a8a78ca2503c, []int{20}
+}
+func (m *QueryInvariantsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInvariantsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInvariantsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInvariantsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInvariantsRequest.Merge(m, src)
+}
+func (m *QueryInvariantsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInvariantsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInvariantsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInvariantsRequest proto.InternalMessageInfo
+
+func (m *QueryInvariantsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryInvariantsResponse is the response type for the Query/Invariants RPC method.
+type QueryInvariantsResponse struct {
+	// true if the fee module account balance does not cover the fees in escrow of the page
+	Broken bool `protobuf:"varint,1,opt,name=broken,proto3" json:"broken,omitempty"`
+	// the description of the broken invariant, empty if the invariant is not broken
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// the total of the packet fees in escrow of the page
+	FeesInEscrow github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=fees_in_escrow,json=feesInEscrow,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees_in_escrow"`
+	// the balance of the fee module account
+	EscrowBalance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=escrow_balance,json=escrowBalance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"escrow_balance"`
+	// pagination response
+	Pagination *query.PageResponse `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryInvariantsResponse) Reset()         { *m = QueryInvariantsResponse{} }
+func (m *QueryInvariantsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInvariantsResponse) ProtoMessage()    {}
+func (*QueryInvariantsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0638a8a78ca2503c, []int{21}
+}
+func (m *QueryInvariantsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInvariantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInvariantsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInvariantsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInvariantsResponse.Merge(m, src)
+}
+func (m *QueryInvariantsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInvariantsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInvariantsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInvariantsResponse proto.InternalMessageInfo
+
+func (m *QueryInvariantsResponse) GetBroken() bool {
+	if m != nil {
+		return m.Broken
+	}
+	return false
+}
+
+func (m *QueryInvariantsResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *QueryInvariantsResponse) GetFeesInEscrow() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeesInEscrow
+	}
+	return nil
+}
+
+func (m *QueryInvariantsResponse) GetEscrowBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.EscrowBalance
+	}
+	return nil
+}
+
+func (m *QueryInvariantsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryIncentivizedPacketsRequest)(nil), "ibc.applications.fee.v1.QueryIncentivizedPacketsRequest")
 	proto.RegisterType((*QueryIncentivizedPacketsResponse)(nil), "ibc.applications.fee.v1.QueryIncentivizedPacketsResponse")
@@ -1089,6 +1217,8 @@ func init() {
 	proto.RegisterType((*QueryFeeEnabledChannelsResponse)(nil), "ibc.applications.fee.v1.QueryFeeEnabledChannelsResponse")
 	proto.RegisterType((*QueryFeeEnabledChannelRequest)(nil), "ibc.applications.fee.v1.QueryFeeEnabledChannelRequest")
 	proto.RegisterType((*QueryFeeEnabledChannelResponse)(nil), "ibc.applications.fee.v1.QueryFeeEnabledChannelResponse")
+	proto.RegisterType((*QueryInvariantsRequest)(nil), "ibc.applications.fee.v1.QueryInvariantsRequest")
+	proto.RegisterType((*QueryInvariantsResponse)(nil), "ibc.applications.fee.v1.QueryInvariantsResponse")
 }
 
 func init() {
@@ -1096,86 +1226,93 @@ func init() {
 }
 
 var fileDescriptor_0638a8a78ca2503c = []byte{
-	// 1250 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x6f, 0x4f, 0x1c, 0x45,
-	0x18, 0x67, 0xae, 0xb4, 0xc0, 0x03, 0x4d, 0x64, 0x20, 0x29, 0xdd, 0xc0, 0x41, 0xb7, 0xd6, 0x22,
-	0xe6, 0x76, 0xe5, 0x9a, 0x0a, 0xbc, 0x52, 0x40, 0xa9, 0x68, 0xb5, 0x78, 0x92, 0x68, 0x8c, 0xe6,
-	0xba, 0xb7, 0x3b, 0xb7, 0x6c, 0x38, 0x76, 0xb6, 0xbb, 0x7b, 0x17, 0x29, 0xe2, 0xff, 0xaa, 0x89,
-	0x26, 0x35, 0xf1, 0x53, 0x68, 0xe2, 0x07, 0xf0, 0x1b, 0xf4, 0x55, 0x43, 0xd2, 0x17, 0x1a, 0x5f,
-	0xa8, 0x01, 0xe3, 0x67, 0xf0, 0x85, 0x26, 0x66, 0x67, 0x66, 0xef, 0xf6, 0xd8, 0x5d, 0xee, 0x0e,
-	0x0f, 0xfa, 0x8a, 0xdd, 0x99, 0x79, 0x9e, 0xe7, 0xf7, 0xfb, 0xcd, 0xec, 0x3c, 0xbf, 0x03, 0x2e,
-	0x5b, 0x25, 0x5d, 0xd5, 0x1c, 0xa7, 0x62, 0xe9, 0x9a, 0x6f, 0x51, 0xdb, 0x53, 0xcb, 0x84, 0xa8,
-	0xb5, 0x59, 0xf5, 0x4e, 0x95, 0xb8, 0xdb, 0x8a, 0xe3, 0x52, 0x9f, 0xe2, 0x0b, 0x56, 0x49, 0x57,
-	0xa2, 0x8b, 0x94, 0x32, 0x21, 0x4a, 0x6d, 0x56, 0x1a, 0x35, 0xa9, 0x49, 0xd9, 0x1a, 0x35, 0x78,
-	0xe2, 0xcb, 0xa5, 0x71, 0x93, 0x52, 0xb3, 0x42, 0x54, 0xcd, 0xb1, 0x54, 0xcd, 0xb6, 0xa9, 0x2f,
-	0x82, 0xf8, 0x6c, 0x56, 0xa7, 0xde, 0x16, 0xf5, 0xd4, 0x92, 0xe6, 0x05, 0x85, 0x4a, 0xc4, 0xd7,
-	0x66, 0x55, 0x9d, 0x5a, 0xb6, 0x98, 0x9f, 0x89, 0xce, 0x33, 0x14, 0xf5, 0x55, 0x8e, 0x66, 0x5a,
-	0x36, 0x4b, 0x26, 0xd6, 0x5e, 0x4a, 0x43, 0x1f, 0xe0, 0xe3, 0x4b, 0xae, 0xa4, 0x2d, 0x31, 0x89,
-	0x4d, 0x3c, 0xcb, 0x8b, 0x66, 0xd2, 0xa9, 0x4b, 0x54, 0x7d, 0x43, 0xb3, 0x6d, 0x52, 0x09, 0x96,
-	0x88, 0x47, 0xbe, 0x44, 0xfe, 0x06, 0xc1, 0xe4, 0x1b, 0x01, 0x9e, 0x55, 0x5b, 0x27, 0xb6, 0x6f,
-	0xd5, 0xac, 0xbb, 0xc4, 0x58, 0xd3, 0xf4, 0x4d, 0xe2, 0x7b, 0x05, 0x72, 0xa7, 0x4a, 0x3c, 0x1f,
-	0xaf, 0x00, 0x34, 0x40, 0x8e, 0xa1, 0x29, 0x34, 0x3d, 0x98, 0x7f, 0x4a, 0xe1, 0x8c, 0x94, 0x80,
-	0x91, 0xc2, 0x75, 0x15, 0x8c, 0x94, 0x35, 0xcd, 0x24, 0x22, 0xb6, 0x10, 0x89, 0xc4, 0x97, 0x60,
-	0x88, 0x2d, 0x2c, 0x6e, 0x10, 0xcb, 0xdc, 0xf0, 0xc7, 0x32, 0x53, 0x68, 0xba, 0xb7, 0x30, 0xc8,
-	0xc6, 0x5e, 0x66, 0x43, 0xf2, 0x23, 0x04, 0x53, 0xe9, 0x70, 0x3c, 0x87, 0xda, 0x1e, 0xc1, 0x65,
-	0x18, 0xb5, 0x22, 0xd3, 0x45, 0x87, 0xcf, 0x8f, 0xa1, 0xa9, 0x33, 0xd3, 0x83, 0xf9, 0x9c, 0x92,
-	0xb2, 0xb1, 0xca, 0xaa, 0x11, 0xc4, 0x94, 0xad, 0x30, 0xe3, 0x0a, 0x21, 0xde, 0x52, 0xef, 0x83,
-	0xdf, 0x26, 0x7b, 0x0a, 0x23, 0x56, 0xbc, 0x1e, 0xbe, 0xd1, 0xc4, 0x3b, 0xc3, 0x78, 0x5f, 0x6d,
-	0xc9, 0x9b, 0x83, 0x8c, 0x12, 0x97, 0xef, 0x21, 0xc8, 0xa6, 0xb0, 0x0a, 0x35, 0x7e, 0x01, 0x06,
-	0x38, 0x8d, 0xa2, 0x65, 0x08, 0x89, 0x27, 0x18, 0x91, 0x60, 0xfb, 0x94, 0x70, 0xcf, 0x6a, 0x41,
-	0x91, 0x60, 0xd5, 0xaa, 0x21, 0x80, 0xf7, 0x3b, 0xe2, 0xbd, 0x1d, 0x75, 0xbf, 0x4c, 0xdf, 0xec,
-	0xba, 0xb8, 0x06, 0x8c, 0x24, 0x88, 0x2b, 0x20, 0x1d, 0x4b, 0x5b, 0x1c, 0xd7, 0x56, 0x7e, 0x88,
-	0xe0, 0xe9, 0xb4, 0x7d, 0x5e, 0xa1, 0xee, 0x32, 0xe7, 0xdb, 0xed, 0x03, 0x78, 0x01, 0xfa, 0x1c,
-	0xea, 0x32, 0x89, 0x03, 0x75, 0x06, 0x0a, 0xe7, 0x82, 0xd7, 0x55, 0x03, 0x4f, 0x00, 0x08, 0x89,
-	0x83, 0xb9, 0x33, 0x6c, 0x6e, 0x40, 0x8c, 0x24, 0x48, 0xdb, 0x1b, 0x97, 0xf6, 0x67, 0x04, 0x33,
-	0xed, 0x10, 0x12, 0x2a, 0xdf, 0xee, 0xe2, 0x11, 0x3e, 0xe1, 0xc3, 0xfb, 0x1e, 0x5c, 0x64, 0xc4,
-	0xd6, 0xa9, 0xaf, 0x55, 0x0a, 0x44, 0xaf, 0xb1, 0x9a, 0xdd, 0x3a, 0xb6, 0xf2, 0x17, 0x08, 0xa4,
-	0xa4, 0xfc, 0x42, 0xa8, 0x0d, 0x18, 0x70, 0x89, 0x5e, 0x2b, 0x96, 0x09, 0x09, 0xd5, 0xb9, 0xd8,
-	0xc4, 0x22, 0xc4, 0xbf, 0x4c, 0x2d, 0x7b, 0xe9, 0xd9, 0x20, 0xf9, 0x0f, 0xbf, 0x4f, 0x4e, 0x9b,
-	0x96, 0xbf, 0x51, 0x2d, 0x29, 0x3a, 0xdd, 0x52, 0xc5, 0xcd, 0xcb, 0xff, 0xe4, 0x3c, 0x63, 0x53,
-	0xf5, 0xb7, 0x1d, 0xe2, 0xb1, 0x00, 0xaf, 0xd0, 0xef, 0x8a, 0x8a, 0xf2, 0xbb, 0x30, 0xd6, 0xc0,
-	0xb1, 0xa8, 0x6f, 0x76, 0x97, 0xe6, 0x67, 0x28, 0x2a, 0x63, 0x3d, 0x7d, 0xfd, 0x46, 0xeb, 0xd7,
-	0xf4, 0xcd, 0x13, 0x23, 0xd9, 0xa7, 0xf1, 0x7a, 0xf2, 0x6d, 0x18, 0x6f, 0x80, 0x58, 0xb7, 0xb6,
-	0x08, 0xad, 0xfa, 0xdd, 0xe5, 0x79, 0x1f, 0xc1, 0x44, 0x4a, 0x09, 0xc1, 0xd5, 0x86, 0x21, 0x9f,
-	0x0f, 0x9f, 0x18, 0xdf, 0x41, 0xbf, 0x51, 0x57, 0xbe, 0x09, 0xc3, 0x0c, 0xd0, 0x9a, 0xb6, 0x4d,
-	0xc2, 0x5b, 0xe1, 0xd0, 0x07, 0x8f, 0x0e, 0x7f, 0xf0, 0x63, 0xd0, 0xe7, 0x92, 0x8a, 0xb6, 0x4d,
-	0x5c, 0x71, 0x51, 0x84, 0xaf, 0xf2, 0x02, 0xe0, 0x68, 0x36, 0xc1, 0xe9, 0x32, 0x9c, 0x77, 0x82,
-	0x81, 0xa2, 0x66, 0x18, 0x2e, 0xf1, 0x3c, 0x91, 0x71, 0x88, 0x0d, 0x2e, 0xf2, 0x31, 0xf9, 0x6d,
-	0xa1, 0xcc, 0x32, 0xad, 0xda, 0x3e, 0x71, 0x1d, 0xcd, 0xf5, 0xbb, 0x04, 0xea, 0x96, 0x68, 0x2f,
-	0x09, 0x99, 0x05, 0xc0, 0x1c, 0x60, 0x3d, 0x32, 0x59, 0x64, 0xc0, 0x44, 0x89, 0x61, 0xfd, 0x70,
-	0x98, 0xfc, 0x75, 0xd8, 0xb0, 0x56, 0x08, 0x79, 0xc9, 0xd6, 0x4a, 0x15, 0x62, 0x88, 0x1b, 0xec,
-	0x71, 0x98, 0x82, 0x87, 0x61, 0xdb, 0x4a, 0x42, 0x23, 0x08, 0x96, 0x60, 0xb4, 0x4c, 0x48, 0x91,
-	0xf0, 0xe9, 0xa2, 0x50, 0x2d, 0x3c, 0x5d, 0x33, 0xa9, 0x17, 0x6a, 0x2c, 0x65, 0xd8, 0xb4, 0xca,
-	0xb1, 0x5a, 0xdd, 0xbb, 0x52, 0xdf, 0x12, 0x27, 0x21, 0x56, 0x3c, 0x14, 0x37, 0xd2, 0xa8, 0xd0,
-	0x11, 0x8d, 0x2a, 0x73, 0xe8, 0x88, 0xc8, 0x8b, 0x69, 0xdb, 0x56, 0xd7, 0x69, 0x12, 0x06, 0x23,
-	0x3a, 0xb1, 0xec, 0xfd, 0x05, 0x68, 0x90, 0xcd, 0xff, 0x35, 0x0c, 0x67, 0x59, 0x0e, 0xfc, 0x13,
-	0x82, 0x91, 0x84, 0x6e, 0x86, 0xe7, 0x53, 0xc5, 0x6c, 0x61, 0x24, 0xa5, 0x85, 0x63, 0x44, 0x72,
-	0xdc, 0x72, 0xee, 0xd3, 0x47, 0x7f, 0x7e, 0x97, 0xb9, 0x8a, 0xaf, 0xa8, 0xc2, 0xfa, 0xd6, 0x2d,
-	0x6f, 0x52, 0x1f, 0xc5, 0xf7, 0x33, 0x80, 0xe3, 0xe9, 0xf0, 0x5c, 0xa7, 0x00, 0x42, 0xe4, 0xf3,
-	0x9d, 0x07, 0x0a, 0xe0, 0xf7, 0x10, 0x43, 0xfe, 0x11, 0xde, 0x8d, 0x21, 0x0f, 0x0f, 0xa9, 0xba,
-	0x53, 0xbf, 0x74, 0x95, 0xc6, 0xee, 0xee, 0xaa, 0xc1, 0x9e, 0x37, 0x4d, 0x8a, 0x33, 0xb1, 0xab,
-	0x7a, 0x01, 0x2c, 0x5b, 0x27, 0x4d, 0xb3, 0xe1, 0xe0, 0x6e, 0x92, 0x24, 0xf8, 0x5f, 0x04, 0x13,
-	0x47, 0x7a, 0x13, 0xbc, 0xd4, 0xf1, 0xee, 0xc4, 0x9c, 0x9a, 0xb4, 0xfc, 0xbf, 0x72, 0x08, 0xc9,
-	0xde, 0x64, 0x8a, 0xbd, 0x86, 0x5f, 0x3d, 0x42, 0xb1, 0x24, 0x9d, 0x42, 0x75, 0x12, 0x4f, 0xc4,
-	0x3f, 0x08, 0xce, 0x37, 0x59, 0x0c, 0x9c, 0x3f, 0x1a, 0x6b, 0x92, 0xdf, 0x91, 0xae, 0x75, 0x14,
-	0x23, 0xf8, 0x7c, 0xc2, 0x8f, 0xc0, 0x0e, 0xde, 0x3e, 0xbd, 0x23, 0xe0, 0x07, 0x48, 0x8a, 0x75,
-	0xeb, 0x84, 0xff, 0x46, 0x30, 0x14, 0xb5, 0x1e, 0x78, 0xb6, 0x0d, 0x26, 0xcd, 0x2e, 0x48, 0xca,
-	0x77, 0x12, 0x22, 0xb8, 0x7f, 0xcc, 0xb9, 0xdf, 0xc5, 0xef, 0x9f, 0x36, 0xf7, 0xd0, 0x50, 0xe1,
-	0xaf, 0x32, 0xf0, 0xc4, 0x61, 0x37, 0x82, 0xaf, 0xb7, 0xc1, 0x25, 0x6e, 0x90, 0xa4, 0xe7, 0x3a,
-	0x0d, 0x13, 0x32, 0x7c, 0xce, 0x65, 0xf8, 0x10, 0x7f, 0x70, 0xda, 0x32, 0x44, 0xbd, 0x16, 0xfe,
-	0x1e, 0xc1, 0x59, 0xd6, 0xe1, 0xf1, 0xcc, 0xd1, 0x44, 0xa2, 0xbe, 0x44, 0x7a, 0xa6, 0xad, 0xb5,
-	0x82, 0xe9, 0x0d, 0x46, 0x74, 0x11, 0x3f, 0xdf, 0xe6, 0xc7, 0x2b, 0x3c, 0x8c, 0xa7, 0xee, 0x88,
-	0xa7, 0x5d, 0x95, 0x99, 0x13, 0xfc, 0x2b, 0x82, 0xe1, 0x98, 0xa1, 0xc1, 0x2d, 0x36, 0x20, 0xcd,
-	0x5b, 0x49, 0x73, 0x1d, 0xc7, 0x09, 0x3e, 0xeb, 0x8c, 0xcf, 0xeb, 0xf8, 0xe6, 0xf1, 0xf9, 0xc4,
-	0x9d, 0x17, 0xfe, 0x11, 0x01, 0x8e, 0xbb, 0x99, 0x56, 0xfd, 0x29, 0xd5, 0x8d, 0xb5, 0xea, 0x4f,
-	0xe9, 0xc6, 0x49, 0x7e, 0x92, 0xf1, 0xcb, 0xe2, 0xf1, 0x18, 0xbf, 0x88, 0x4f, 0xc0, 0x7b, 0x08,
-	0x86, 0x63, 0x49, 0x5a, 0x6d, 0x46, 0x9a, 0xbd, 0x91, 0xe6, 0x3a, 0x8e, 0x13, 0x60, 0x5f, 0x61,
-	0x60, 0x5f, 0xc4, 0x4b, 0xc7, 0xec, 0x0c, 0x11, 0x4a, 0x4b, 0xb7, 0x1e, 0xec, 0x67, 0xd1, 0xde,
-	0x7e, 0x16, 0xfd, 0xb1, 0x9f, 0x45, 0xdf, 0x1e, 0x64, 0x7b, 0xf6, 0x0e, 0xb2, 0x3d, 0xbf, 0x1c,
-	0x64, 0x7b, 0xde, 0xb9, 0x1e, 0xff, 0xa1, 0x61, 0x95, 0xf4, 0x9c, 0x49, 0xd5, 0xda, 0xbc, 0xba,
-	0x45, 0x8d, 0x6a, 0x85, 0x78, 0xbc, 0x78, 0x7e, 0x21, 0x17, 0xd4, 0x67, 0xbf, 0x3d, 0x4a, 0xe7,
-	0xd8, 0x7f, 0xd4, 0xae, 0xfd, 0x17, 0x00, 0x00, 0xff, 0xff, 0xdf, 0xe2, 0xa0, 0x5d, 0x7e, 0x14,
-	0x00, 0x00,
+	// 1375 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x5f, 0x6f, 0xdb, 0xd4,
+	0x1b, 0xee, 0xc9, 0xba, 0xad, 0x7d, 0xdb, 0x4d, 0xbf, 0x9e, 0x4d, 0x6b, 0xea, 0x5f, 0x9b, 0x76,
+	0x2e, 0x63, 0xa5, 0x28, 0xf6, 0x9a, 0x69, 0xb4, 0xbd, 0x82, 0xa6, 0xac, 0xa3, 0x30, 0x58, 0x09,
+	0x95, 0x40, 0x08, 0xe4, 0x3a, 0xce, 0x49, 0x6a, 0x35, 0xf5, 0x71, 0x6d, 0x27, 0xd0, 0x95, 0xf2,
+	0x7f, 0x80, 0x04, 0xd2, 0x90, 0xe0, 0x4b, 0x80, 0xc4, 0x07, 0xe0, 0x1b, 0xec, 0x6a, 0xaa, 0xb4,
+	0x0b, 0x10, 0x17, 0x80, 0x5a, 0x3e, 0x04, 0x12, 0x4c, 0x42, 0x3e, 0x3e, 0x4e, 0x9c, 0xd8, 0x6e,
+	0x92, 0x92, 0x96, 0xab, 0xc6, 0xe7, 0x9c, 0xf7, 0x7d, 0x9f, 0xe7, 0x39, 0xaf, 0xcf, 0x79, 0x5c,
+	0x98, 0xd4, 0xf3, 0x9a, 0xac, 0x9a, 0x66, 0x59, 0xd7, 0x54, 0x47, 0xa7, 0x86, 0x2d, 0x17, 0x09,
+	0x91, 0xab, 0x33, 0xf2, 0x56, 0x85, 0x58, 0xdb, 0x92, 0x69, 0x51, 0x87, 0xe2, 0x61, 0x3d, 0xaf,
+	0x49, 0xc1, 0x45, 0x52, 0x91, 0x10, 0xa9, 0x3a, 0x23, 0x5c, 0x2c, 0xd1, 0x12, 0x65, 0x6b, 0x64,
+	0xf7, 0x97, 0xb7, 0x5c, 0x18, 0x2d, 0x51, 0x5a, 0x2a, 0x13, 0x59, 0x35, 0x75, 0x59, 0x35, 0x0c,
+	0xea, 0xf0, 0x20, 0x6f, 0x36, 0xa5, 0x51, 0x7b, 0x93, 0xda, 0x72, 0x5e, 0xb5, 0xdd, 0x42, 0x79,
+	0xe2, 0xa8, 0x33, 0xb2, 0x46, 0x75, 0x83, 0xcf, 0x4f, 0x07, 0xe7, 0x19, 0x8a, 0xda, 0x2a, 0x53,
+	0x2d, 0xe9, 0x06, 0x4b, 0xc6, 0xd7, 0x5e, 0x8e, 0x43, 0xef, 0xe2, 0xf3, 0x96, 0x5c, 0x89, 0x5b,
+	0x52, 0x22, 0x06, 0xb1, 0x75, 0x3b, 0x98, 0x49, 0xa3, 0x16, 0x91, 0xb5, 0x75, 0xd5, 0x30, 0x48,
+	0xd9, 0x5d, 0xc2, 0x7f, 0x7a, 0x4b, 0xc4, 0xaf, 0x10, 0x8c, 0xbf, 0xea, 0xe2, 0x59, 0x36, 0x34,
+	0x62, 0x38, 0x7a, 0x55, 0xbf, 0x4b, 0x0a, 0x2b, 0xaa, 0xb6, 0x41, 0x1c, 0x3b, 0x47, 0xb6, 0x2a,
+	0xc4, 0x76, 0xf0, 0x12, 0x40, 0x1d, 0x64, 0x12, 0x4d, 0xa0, 0xa9, 0x81, 0xcc, 0x93, 0x92, 0xc7,
+	0x48, 0x72, 0x19, 0x49, 0x9e, 0xae, 0x9c, 0x91, 0xb4, 0xa2, 0x96, 0x08, 0x8f, 0xcd, 0x05, 0x22,
+	0xf1, 0x65, 0x18, 0x64, 0x0b, 0x95, 0x75, 0xa2, 0x97, 0xd6, 0x9d, 0x64, 0x62, 0x02, 0x4d, 0xf5,
+	0xe6, 0x06, 0xd8, 0xd8, 0x0b, 0x6c, 0x48, 0x7c, 0x84, 0x60, 0x22, 0x1e, 0x8e, 0x6d, 0x52, 0xc3,
+	0x26, 0xb8, 0x08, 0x17, 0xf5, 0xc0, 0xb4, 0x62, 0x7a, 0xf3, 0x49, 0x34, 0x71, 0x6a, 0x6a, 0x20,
+	0x93, 0x96, 0x62, 0x36, 0x56, 0x5a, 0x2e, 0xb8, 0x31, 0x45, 0xdd, 0xcf, 0xb8, 0x44, 0x88, 0x9d,
+	0xed, 0x7d, 0xf0, 0xeb, 0x78, 0x4f, 0xee, 0x82, 0x1e, 0xae, 0x87, 0x6f, 0x35, 0xf0, 0x4e, 0x30,
+	0xde, 0x57, 0x5b, 0xf2, 0xf6, 0x40, 0x06, 0x89, 0x8b, 0xf7, 0x10, 0xa4, 0x62, 0x58, 0xf9, 0x1a,
+	0x3f, 0x07, 0xfd, 0x1e, 0x0d, 0x45, 0x2f, 0x70, 0x89, 0xc7, 0x18, 0x11, 0x77, 0xfb, 0x24, 0x7f,
+	0xcf, 0xaa, 0x6e, 0x11, 0x77, 0xd5, 0x72, 0x81, 0x03, 0xef, 0x33, 0xf9, 0x73, 0x3b, 0xea, 0x7e,
+	0x1e, 0xbf, 0xd9, 0x35, 0x71, 0x0b, 0x70, 0x21, 0x42, 0x5c, 0x0e, 0xe9, 0x48, 0xda, 0xe2, 0xb0,
+	0xb6, 0xe2, 0x43, 0x04, 0x4f, 0xc5, 0xed, 0xf3, 0x12, 0xb5, 0x16, 0x3d, 0xbe, 0xdd, 0x6e, 0xc0,
+	0x61, 0x38, 0x6b, 0x52, 0x8b, 0x49, 0xec, 0xaa, 0xd3, 0x9f, 0x3b, 0xe3, 0x3e, 0x2e, 0x17, 0xf0,
+	0x18, 0x00, 0x97, 0xd8, 0x9d, 0x3b, 0xc5, 0xe6, 0xfa, 0xf9, 0x48, 0x84, 0xb4, 0xbd, 0x61, 0x69,
+	0x7f, 0x42, 0x30, 0xdd, 0x0e, 0x21, 0xae, 0xf2, 0x5a, 0x17, 0x5b, 0xf8, 0x98, 0x9b, 0xf7, 0x6d,
+	0x18, 0x61, 0xc4, 0x56, 0xa9, 0xa3, 0x96, 0x73, 0x44, 0xab, 0xb2, 0x9a, 0xdd, 0x6a, 0x5b, 0xf1,
+	0x33, 0x04, 0x42, 0x54, 0x7e, 0x2e, 0xd4, 0x3a, 0xf4, 0x5b, 0x44, 0xab, 0x2a, 0x45, 0x42, 0x7c,
+	0x75, 0x46, 0x1a, 0x58, 0xf8, 0xf8, 0x17, 0xa9, 0x6e, 0x64, 0xaf, 0xb9, 0xc9, 0xbf, 0xff, 0x6d,
+	0x7c, 0xaa, 0xa4, 0x3b, 0xeb, 0x95, 0xbc, 0xa4, 0xd1, 0x4d, 0xd9, 0x5b, 0xcc, 0xff, 0xa4, 0xed,
+	0xc2, 0x86, 0xec, 0x6c, 0x9b, 0xc4, 0x66, 0x01, 0x76, 0xae, 0xcf, 0xe2, 0x15, 0xc5, 0xb7, 0x20,
+	0x59, 0xc7, 0xb1, 0xa0, 0x6d, 0x74, 0x97, 0xe6, 0x27, 0x08, 0x46, 0x22, 0xd2, 0xd7, 0x4e, 0xb4,
+	0x3e, 0x55, 0xdb, 0x38, 0x36, 0x92, 0x67, 0x55, 0xaf, 0x9e, 0xb8, 0x06, 0xa3, 0x75, 0x10, 0xab,
+	0xfa, 0x26, 0xa1, 0x15, 0xa7, 0xbb, 0x3c, 0xef, 0x23, 0x18, 0x8b, 0x29, 0xc1, 0xb9, 0x1a, 0x30,
+	0xe8, 0x78, 0xc3, 0xc7, 0xc6, 0x77, 0xc0, 0xa9, 0xd7, 0x15, 0x6f, 0xc3, 0x10, 0x03, 0xb4, 0xa2,
+	0x6e, 0x13, 0xff, 0x54, 0x68, 0x7a, 0xe1, 0x51, 0xf3, 0x0b, 0x9f, 0x84, 0xb3, 0x16, 0x29, 0xab,
+	0xdb, 0xc4, 0xe2, 0x07, 0x85, 0xff, 0x28, 0xce, 0x03, 0x0e, 0x66, 0xe3, 0x9c, 0x26, 0xe1, 0x9c,
+	0xe9, 0x0e, 0x28, 0x6a, 0xa1, 0x60, 0x11, 0xdb, 0xe6, 0x19, 0x07, 0xd9, 0xe0, 0x82, 0x37, 0x26,
+	0xbe, 0xc1, 0x95, 0x59, 0xa4, 0x15, 0xc3, 0x21, 0x96, 0xa9, 0x5a, 0x4e, 0x97, 0x40, 0xdd, 0x81,
+	0x54, 0x5c, 0x66, 0x0e, 0x30, 0x0d, 0x58, 0x0b, 0x4c, 0x2a, 0x0c, 0x18, 0x2f, 0x31, 0xa4, 0x35,
+	0x87, 0x89, 0x5f, 0xfa, 0x17, 0xd6, 0x12, 0x21, 0x37, 0x0d, 0x35, 0x5f, 0x26, 0x05, 0x7e, 0x82,
+	0xfd, 0x17, 0xa6, 0xe0, 0xa1, 0x7f, 0x6d, 0x45, 0xa1, 0xe1, 0x04, 0xf3, 0x70, 0xb1, 0x48, 0x88,
+	0x42, 0xbc, 0x69, 0x85, 0xab, 0xe6, 0x77, 0xd7, 0x74, 0xec, 0x81, 0x1a, 0x4a, 0xe9, 0x5f, 0x5a,
+	0xc5, 0x50, 0xad, 0xee, 0x1d, 0xa9, 0xaf, 0xf3, 0x4e, 0x08, 0x15, 0xf7, 0xc5, 0x0d, 0x5c, 0x54,
+	0xe8, 0x90, 0x8b, 0x2a, 0xd1, 0xd4, 0x22, 0xe2, 0x42, 0xdc, 0xb6, 0xd5, 0x74, 0x1a, 0x87, 0x81,
+	0x80, 0x4e, 0x2c, 0x7b, 0x5f, 0x0e, 0xea, 0x64, 0xc5, 0x35, 0xb8, 0xc4, 0xef, 0xb1, 0xaa, 0x6a,
+	0xe9, 0xaa, 0xd1, 0x75, 0x1b, 0x28, 0xfe, 0x95, 0x80, 0xe1, 0x50, 0x09, 0x0e, 0xef, 0x12, 0x9c,
+	0xc9, 0x5b, 0x74, 0x83, 0x18, 0x1c, 0x19, 0x7f, 0x72, 0x7b, 0x7f, 0x93, 0xd8, 0xb6, 0x5a, 0x22,
+	0x7e, 0xef, 0xf3, 0x47, 0xbc, 0x05, 0xe7, 0xdd, 0x63, 0x44, 0xd1, 0x0d, 0x85, 0xd8, 0x9a, 0x45,
+	0xdf, 0x49, 0x9e, 0xea, 0xfe, 0x81, 0x32, 0xe8, 0x96, 0x58, 0x36, 0x6e, 0xb2, 0x02, 0xd8, 0x82,
+	0xf3, 0x5e, 0x29, 0x25, 0xaf, 0x96, 0x55, 0x43, 0x23, 0xc9, 0xde, 0xee, 0x97, 0x3c, 0xe7, 0x95,
+	0xc8, 0x7a, 0x15, 0x9a, 0x7a, 0xef, 0xf4, 0x91, 0x7b, 0x2f, 0xf3, 0x18, 0xc3, 0x69, 0xa6, 0x3e,
+	0xfe, 0x11, 0xc1, 0x85, 0x08, 0xb7, 0x82, 0xe7, 0x62, 0x5f, 0x96, 0x16, 0x1f, 0x0a, 0xc2, 0xfc,
+	0x11, 0x22, 0x3d, 0x88, 0x62, 0xfa, 0xe3, 0x47, 0x7f, 0x7c, 0x93, 0xb8, 0x8a, 0xaf, 0xc8, 0xfc,
+	0xd3, 0xa6, 0xf6, 0x49, 0x13, 0xe5, 0x93, 0xf0, 0xfd, 0x04, 0xe0, 0x70, 0x3a, 0x3c, 0xdb, 0x29,
+	0x00, 0x1f, 0xf9, 0x5c, 0xe7, 0x81, 0x1c, 0xf8, 0x3d, 0xc4, 0x90, 0x7f, 0x80, 0x77, 0x43, 0xc8,
+	0xfd, 0x43, 0x48, 0xde, 0xa9, 0x5d, 0xaa, 0x52, 0xfd, 0xed, 0xdd, 0x95, 0xdd, 0x77, 0xba, 0x61,
+	0x92, 0xbf, 0xf3, 0xbb, 0xb2, 0xed, 0xc2, 0x32, 0x34, 0xd2, 0x30, 0xeb, 0x0f, 0xee, 0x46, 0x49,
+	0x82, 0x1f, 0x23, 0x18, 0x3b, 0xd4, 0x7b, 0xe2, 0x6c, 0xc7, 0xbb, 0x13, 0x72, 0xe2, 0xc2, 0xe2,
+	0xbf, 0xca, 0xc1, 0x25, 0x7b, 0x8d, 0x29, 0xf6, 0x32, 0x7e, 0xe9, 0x10, 0xc5, 0xa2, 0x74, 0xf2,
+	0xd5, 0x89, 0xec, 0x88, 0xbf, 0x11, 0x9c, 0x6b, 0xb0, 0x90, 0x38, 0x73, 0x38, 0xd6, 0x28, 0x3f,
+	0x2b, 0x5c, 0xef, 0x28, 0x86, 0xf3, 0xf9, 0xc8, 0x6b, 0x81, 0x1d, 0xbc, 0x7d, 0x72, 0x2d, 0xe0,
+	0xb8, 0x48, 0x94, 0x9a, 0x35, 0xc6, 0x7f, 0x22, 0x18, 0x0c, 0x5a, 0x4b, 0x3c, 0xd3, 0x06, 0x93,
+	0x46, 0x97, 0x2b, 0x64, 0x3a, 0x09, 0xe1, 0xdc, 0x3f, 0xf4, 0xb8, 0xdf, 0xc5, 0xef, 0x9e, 0x34,
+	0x77, 0xdf, 0x30, 0xe3, 0x2f, 0x12, 0xf0, 0xbf, 0x66, 0xb7, 0x89, 0x6f, 0xb4, 0xc1, 0x25, 0x6c,
+	0x80, 0x85, 0x67, 0x3a, 0x0d, 0xe3, 0x32, 0x7c, 0xea, 0xc9, 0xf0, 0x3e, 0x7e, 0xef, 0xa4, 0x65,
+	0x08, 0x7a, 0x69, 0xfc, 0x1d, 0x82, 0xd3, 0xcc, 0xc1, 0xe1, 0xe9, 0xc3, 0x89, 0x04, 0x7d, 0xa7,
+	0xf0, 0x74, 0x5b, 0x6b, 0x39, 0xd3, 0x5b, 0x8c, 0xe8, 0x02, 0x7e, 0xb6, 0xcd, 0x97, 0x97, 0x7b,
+	0x54, 0x5b, 0xde, 0xe1, 0xbf, 0x76, 0x65, 0x66, 0x3e, 0xf1, 0x2f, 0x08, 0x86, 0x42, 0x86, 0x15,
+	0xb7, 0xd8, 0x80, 0x38, 0xef, 0x2c, 0xcc, 0x76, 0x1c, 0xc7, 0xf9, 0xac, 0x32, 0x3e, 0xaf, 0xe0,
+	0xdb, 0x47, 0xe7, 0x13, 0x76, 0xd6, 0xf8, 0x07, 0x04, 0x38, 0xec, 0x56, 0x5b, 0xdd, 0x4f, 0xb1,
+	0x6e, 0x5b, 0x98, 0xeb, 0x3c, 0x90, 0xf3, 0x7b, 0x82, 0xf1, 0x4b, 0xe1, 0xd1, 0x10, 0xbf, 0x80,
+	0x0f, 0xc4, 0x7b, 0x08, 0x86, 0x42, 0x49, 0x5a, 0x6d, 0x46, 0x9c, 0x7d, 0x15, 0x66, 0x3b, 0x8e,
+	0xe3, 0x60, 0x5f, 0x64, 0x60, 0x9f, 0xc7, 0xd9, 0x23, 0xde, 0x0c, 0x41, 0x4a, 0xdf, 0x22, 0x80,
+	0xba, 0xc3, 0xc4, 0x72, 0xab, 0x9b, 0xab, 0xc9, 0xee, 0x0a, 0xd7, 0xda, 0x0f, 0xe0, 0xe8, 0x27,
+	0x19, 0xfa, 0x31, 0xfc, 0xff, 0x08, 0x0f, 0xe3, 0x2f, 0xce, 0xde, 0x79, 0xb0, 0x9f, 0x42, 0x7b,
+	0xfb, 0x29, 0xf4, 0xfb, 0x7e, 0x0a, 0x7d, 0x7d, 0x90, 0xea, 0xd9, 0x3b, 0x48, 0xf5, 0xfc, 0x7c,
+	0x90, 0xea, 0x79, 0xf3, 0x46, 0xd8, 0x1b, 0xea, 0x79, 0x2d, 0x5d, 0xa2, 0x72, 0x75, 0x4e, 0xde,
+	0xa4, 0x85, 0x4a, 0x99, 0xd8, 0x5e, 0xd6, 0xcc, 0x7c, 0xda, 0x4d, 0xcc, 0xec, 0x62, 0xfe, 0x0c,
+	0xfb, 0x47, 0xee, 0xf5, 0x7f, 0x06, 0x00, 0xfa, 0x66, 0xdd, 0xa7, 0xf5, 0x16, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1210,6 +1347,10 @@ type QueryClient interface {
 	FeeEnabledChannels(ctx context.Context, in *QueryFeeEnabledChannelsRequest, opts ...grpc.CallOption) (*QueryFeeEnabledChannelsResponse, error)
 	// FeeEnabledChannel returns true if the provided port and channel identifiers belong to a fee enabled channel
 	FeeEnabledChannel(ctx context.Context, in *QueryFeeEnabledChannelRequest, opts ...grpc.CallOption) (*QueryFeeEnabledChannelResponse, error)
+	// Invariants runs the total fees in escrow invariant against a page of the packet fees in escrow. The invariant
+	// holds if the fee module account balance covers the sum of the fees in escrow of all pages, which must be
+	// queried at the same height.
+	Invariants(ctx context.Context, in *QueryInvariantsRequest, opts ...grpc.CallOption) (*QueryInvariantsResponse, error)
 }
 
 type queryClient struct {
@@ -1310,6 +1451,15 @@ func (c *queryClient) FeeEnabledChannel(ctx context.Context, in *QueryFeeEnabled
 	return out, nil
 }
 
+func (c *queryClient) Invariants(ctx context.Context, in *QueryInvariantsRequest, opts ...grpc.CallOption) (*QueryInvariantsResponse, error) {
+	out := new(QueryInvariantsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Query/Invariants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// IncentivizedPackets returns all incentivized packets and their associated fees
@@ -1332,6 +1482,10 @@ type QueryServer interface {
 	FeeEnabledChannels(context.Context, *QueryFeeEnabledChannelsRequest) (*QueryFeeEnabledChannelsResponse, error)
 	// FeeEnabledChannel returns true if the provided port and channel identifiers belong to a fee enabled channel
 	FeeEnabledChannel(context.Context, *QueryFeeEnabledChannelRequest) (*QueryFeeEnabledChannelResponse, error)
+	// Invariants runs the total fees in escrow invariant against a page of the packet fees in escrow. The invariant
+	// holds if the fee module account balance covers the sum of the fees in escrow of all pages, which must be
+	// queried at the same height.
+	Invariants(context.Context, *QueryInvariantsRequest) (*QueryInvariantsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1368,6 +1522,9 @@ func (*UnimplementedQueryServer) FeeEnabledChannels(ctx context.Context, req *Qu
 func (*UnimplementedQueryServer) FeeEnabledChannel(ctx context.Context, req *QueryFeeEnabledChannelRequest) (*QueryFeeEnabledChannelResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FeeEnabledChannel not implemented")
 }
+func (*UnimplementedQueryServer) Invariants(ctx context.Context, req *QueryInvariantsRequest) (*QueryInvariantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Invariants not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1553,6 +1710,24 @@ func _Query_FeeEnabledChannel_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Invariants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInvariantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Invariants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Query/Invariants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Invariants(ctx, req.(*QueryInvariantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.applications.fee.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1597,6 +1772,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FeeEnabledChannel",
 			Handler:    _Query_FeeEnabledChannel_Handler,
 		},
+		{
+			MethodName: "Invariants",
+			Handler:    _Query_Invariants_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/applications/fee/v1/query.proto",
@@ -2368,6 +2547,121 @@ func (m *QueryFeeEnabledChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryInvariantsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInvariantsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInvariantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInvariantsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInvariantsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInvariantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.EscrowBalance) > 0 {
+		for iNdEx := len(m.EscrowBalance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EscrowBalance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.FeesInEscrow) > 0 {
+		for iNdEx := len(m.FeesInEscrow) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeesInEscrow[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Broken {
+		i--
+		if m.Broken {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2684,6 +2978,51 @@ func (m *QueryFeeEnabledChannelResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryInvariantsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInvariantsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Broken {
+		n += 2
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.FeesInEscrow) > 0 {
+		for _, e := range m.FeesInEscrow {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.EscrowBalance) > 0 {
+		for _, e := range m.EscrowBalance {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4691,6 +5030,298 @@ func (m *QueryFeeEnabledChannelResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryInvariantsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInvariantsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInvariantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInvariantsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInvariantsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInvariantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Broken", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Broken = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesInEscrow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeesInEscrow = append(m.FeesInEscrow, types1.Coin{})
+			if err := m.FeesInEscrow[len(m.FeesInEscrow)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowBalance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowBalance = append(m.EscrowBalance, types1.Coin{})
+			if err := m.EscrowBalance[len(m.EscrowBalance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,22 @@
+package types
+
+import (
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+)
+
+// RegisterPacketDataSchemas registers a version unwrapper with the given packet data registry which
+// resolves the version of the underlying application on fee enabled channels. The fee middleware
+// does not modify packet data, the packet data schema of the underlying application is used.
+func RegisterPacketDataSchemas(registry *porttypes.PacketDataRegistry) {
+	registry.RegisterVersionUnwrapper(UnwrapVersion)
+}
+
+// UnwrapVersion returns the application version wrapped by the fee middleware version metadata.
+func UnwrapVersion(version string) (string, bool) {
+	metadata, err := MetadataFromVersion(version)
+	if err != nil || metadata.FeeVersion != Version {
+		return "", false
+	}
+
+	return metadata.AppVersion, true
+}
@@ -7,6 +7,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/modules/apps/callbacks/types"
@@ -15,6 +16,7 @@ import (
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibcmetrics "github.com/cosmos/ibc-go/v8/modules/core/metrics"
 )
 
 var (
@@ -283,6 +285,7 @@ func (IBCMiddleware) processCallback(
 	defer func() {
 		// consume the minimum of g.consumed and g.limit
 		ctx.GasMeter().ConsumeGas(cachedCtx.GasMeter().GasConsumedToLimit(), fmt.Sprintf("ibc %s callback", callbackType))
+		ibcmetrics.AddSample([]string{"ibc", "callbacks", "gas_used"}, float32(cachedCtx.GasMeter().GasConsumedToLimit()), telemetry.NewLabel(types.LabelCallbackType, string(callbackType)))
 
 		// recover from all panics except during SendPacket callbacks
 		if r := recover(); r != nil {
@@ -0,0 +1,6 @@
+package types
+
+// Prometheus metric labels.
+const (
+	LabelCallbackType = "callback_type"
+)
@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	"cosmossdk.io/core/appmodule"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/internal/genesis"
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+)
+
+// StreamInitGenesis initializes the ibc-transfer state from the provided genesis source and binds
+// to PortID. Denomination traces and total escrow amounts are decoded and stored one at a time.
+func (k Keeper) StreamInitGenesis(ctx sdk.Context, cdc codec.JSONCodec, source appmodule.GenesisSource) error {
+	var (
+		portID string
+		params types.Params
+	)
+
+	if err := genesis.Decode(genesis.SourceField(source, "port_id"), cdc, func(d *genesis.Decoder) (err error) {
+		portID, err = d.String()
+		return err
+	}); err != nil {
+		return fmt.Errorf("failed to decode port_id: %w", err)
+	}
+
+	if err := genesis.Decode(genesis.SourceField(source, "params"), cdc, func(d *genesis.Decoder) error {
+		return d.Message(&params)
+	}); err != nil {
+		return fmt.Errorf("failed to decode params: %w", err)
+	}
+
+	k.SetPort(ctx, portID)
+
+	if err := genesis.Decode(genesis.SourceField(source, "denom_traces"), cdc, func(d *genesis.Decoder) error {
+		return genesis.DecodeMessages(d, func(trace *types.DenomTrace) error {
+			k.SetDenomTrace(ctx, *trace)
+			k.setDenomMetadata(ctx, *trace)
+			return nil
+		})
+	}); err != nil {
+		return fmt.Errorf("failed to decode denom_traces: %w", err)
+	}
+
+	// Only try to bind to port if it is not already bound, since we may already own
+	// port capability from capability InitGenesis
+	if !k.hasCapability(ctx, portID) {
+		// transfer module binds to the transfer port on InitChain
+		// and claims the returned capability
+		if err := k.BindPort(ctx, portID); err != nil {
+			return fmt.Errorf("could not claim port capability: %w", err)
+		}
+	}
+
+	k.SetParams(ctx, params)
+
+	if err := genesis.Decode(genesis.SourceField(source, "total_escrowed"), cdc, func(d *genesis.Decoder) error {
+		return genesis.DecodeMessages(d, func(denomEscrow *sdk.Coin) error {
+			k.SetTotalEscrowForDenom(ctx, *denomEscrow)
+			return nil
+		})
+	}); err != nil {
+		return fmt.Errorf("failed to decode total_escrowed: %w", err)
+	}
+
+	return nil
+}
+
+// StreamExportGenesis writes the ibc-transfer module's exported genesis to the provided genesis
+// target. Denomination traces and total escrow amounts are written one at a time as they are
+// iterated. Unlike ExportGenesis, the denomination traces are written in store order rather than
+// sorted by their full denomination path.
+func (k Keeper) StreamExportGenesis(ctx sdk.Context, cdc codec.JSONCodec, target appmodule.GenesisTarget) error {
+	if err := genesis.Encode(genesis.TargetField(target, "port_id"), cdc, func(enc *genesis.Encoder) {
+		enc.String(k.GetPort(ctx))
+	}); err != nil {
+		return err
+	}
+
+	if err := genesis.Encode(genesis.TargetField(target, "denom_traces"), cdc, func(enc *genesis.Encoder) {
+		enc.Array(func(write func(proto.Message)) {
+			k.IterateDenomTraces(ctx, func(denomTrace types.DenomTrace) bool {
+				write(&denomTrace)
+				return false
+			})
+		})
+	}); err != nil {
+		return err
+	}
+
+	params := k.GetParams(ctx)
+	if err := genesis.Encode(genesis.TargetField(target, "params"), cdc, func(enc *genesis.Encoder) {
+		enc.Message(&params)
+	}); err != nil {
+		return err
+	}
+
+	// the total escrow amounts are iterated in denomination order, as sorted by sdk.Coins
+	return genesis.Encode(genesis.TargetField(target, "total_escrowed"), cdc, func(enc *genesis.Encoder) {
+		enc.Array(func(write func(proto.Message)) {
+			k.IterateTokensInEscrow(ctx, []byte(types.KeyTotalEscrowPrefix), func(denomEscrow sdk.Coin) bool {
+				if !denomEscrow.IsZero() {
+					write(&denomEscrow)
+				}
+				return false
+			})
+		})
+	})
+}
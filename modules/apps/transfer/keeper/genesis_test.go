@@ -3,11 +3,13 @@ package keeper_test
 import (
 	"fmt"
 
+	"cosmossdk.io/core/genesis"
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	"github.com/cosmos/ibc-go/v8/testing/simapp"
 )
 
 func (suite *KeeperTestSuite) TestGenesis() {
@@ -60,3 +62,51 @@ func (suite *KeeperTestSuite) TestGenesis() {
 		suite.Require().True(found)
 	}
 }
+
+func (suite *KeeperTestSuite) TestStreamGenesis() {
+	transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+	cdc := suite.chainA.GetSimApp().AppCodec()
+
+	var denomTraces types.Traces
+	for i := 0; i < 5; i++ {
+		denomTrace := types.DenomTrace{
+			BaseDenom: "uatom",
+			Path:      fmt.Sprintf("transfer/channelToChain%d", i),
+		}
+		denomTraces = append(denomTraces, denomTrace)
+		transferKeeper.SetDenomTrace(suite.chainA.GetContext(), denomTrace)
+		transferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), sdk.NewCoin(denomTrace.IBCDenom(), sdkmath.NewInt(int64(i+1))))
+	}
+
+	expGenesis := transferKeeper.ExportGenesis(suite.chainA.GetContext())
+
+	target := &genesis.RawJSONTarget{}
+	suite.Require().NoError(transferKeeper.StreamExportGenesis(suite.chainA.GetContext(), cdc, target.Target()))
+
+	bz, err := target.JSON()
+	suite.Require().NoError(err)
+
+	var gs types.GenesisState
+	suite.Require().NoError(cdc.UnmarshalJSON(bz, &gs))
+
+	suite.Require().Equal(expGenesis.PortId, gs.PortId)
+	suite.Require().Equal(expGenesis.Params, gs.Params)
+	suite.Require().Equal(expGenesis.TotalEscrowed, gs.TotalEscrowed)
+	suite.Require().ElementsMatch(expGenesis.DenomTraces, gs.DenomTraces)
+
+	source, err := genesis.SourceFromRawJSON(bz)
+	suite.Require().NoError(err)
+	suite.Require().NoError(types.StreamValidateGenesis(cdc, source))
+
+	app := simapp.Setup(suite.T(), false)
+	ctx := app.BaseApp.NewContext(false)
+	suite.Require().NoError(app.TransferKeeper.StreamInitGenesis(ctx, cdc, source))
+
+	suite.Require().Equal(expGenesis.PortId, app.TransferKeeper.GetPort(ctx))
+	suite.Require().Equal(expGenesis.DenomTraces, app.TransferKeeper.GetAllDenomTraces(ctx))
+	suite.Require().Equal(expGenesis.TotalEscrowed, app.TransferKeeper.GetAllTotalEscrowed(ctx))
+	for _, denomTrace := range denomTraces {
+		_, found := app.BankKeeper.GetDenomMetaData(ctx, denomTrace.IBCDenom())
+		suite.Require().True(found)
+	}
+}
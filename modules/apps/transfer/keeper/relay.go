@@ -139,6 +139,9 @@ func (k Keeper) sendTransfer(
 		return 0, err
 	}
 
+	packet := channeltypes.NewPacket(packetData.GetBytes(), sequence, sourcePort, sourceChannel, destinationPort, destinationChannel, timeoutHeight, timeoutTimestamp)
+	k.channelKeeper.IndexPacket(ctx, packet, packetData.Sender, packetData.Receiver)
+
 	defer func() {
 		if token.Amount.IsInt64() {
 			telemetry.SetGaugeWithLabels(
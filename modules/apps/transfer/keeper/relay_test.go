@@ -163,6 +163,34 @@ func (suite *KeeperTestSuite) TestSendTransfer() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestSendTransferIndexesPacket() {
+	path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+	params := channelKeeper.GetParams(suite.chainA.GetContext())
+	params.PacketIndexEnabled = true
+	channelKeeper.SetParams(suite.chainA.GetContext(), params)
+
+	sender := suite.chainA.SenderAccount.GetAddress().String()
+	receiver := suite.chainB.SenderAccount.GetAddress().String()
+	msg := types.NewMsgTransfer(
+		path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+		sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100)), sender, receiver,
+		suite.chainB.GetTimeoutHeight(), 0, "",
+	)
+
+	res, err := suite.chainA.GetSimApp().TransferKeeper.Transfer(suite.chainA.GetContext(), msg)
+	suite.Require().NoError(err)
+
+	indexedPacket, found := channelKeeper.GetIndexedPacket(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, res.Sequence)
+	suite.Require().True(found)
+	suite.Require().Equal(sender, indexedPacket.Sender)
+	suite.Require().Equal(receiver, indexedPacket.Receiver)
+	suite.Require().Equal(channeltypes.PENDING, indexedPacket.Status)
+	suite.Require().True(channelKeeper.HasPacketCommitment(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, res.Sequence))
+}
+
 func (suite *KeeperTestSuite) TestSendTransferSetsTotalEscrowAmountForSourceIBCToken() {
 	/*
 		Given the following flow of tokens:
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
 
@@ -17,6 +18,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 
+	"github.com/cosmos/ibc-go/v8/internal/genesis"
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/client/cli"
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/keeper"
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/simulation"
@@ -35,6 +37,7 @@ var (
 	_ module.HasServices         = (*AppModule)(nil)
 	_ module.HasProposalMsgs     = (*AppModule)(nil)
 	_ appmodule.AppModule        = (*AppModule)(nil)
+	_ appmodule.HasGenesis       = (*StreamingAppModule)(nil)
 
 	_ porttypes.IBCModule = (*IBCModule)(nil)
 )
@@ -153,6 +156,67 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(gs)
 }
 
+// StreamingAppModule wraps the ibc-transfer AppModule to import and export its genesis incrementally
+// through the genesis sources and targets of the core appmodule API. The genesis JSON format is unchanged.
+type StreamingAppModule struct {
+	AppModule
+	cdc codec.JSONCodec
+}
+
+// NewStreamingAppModule creates a new StreamingAppModule wrapping the provided AppModule.
+func NewStreamingAppModule(am AppModule, cdc codec.JSONCodec) StreamingAppModule {
+	return StreamingAppModule{
+		AppModule: am,
+		cdc:       cdc,
+	}
+}
+
+// DefaultGenesis writes the default genesis state of the ibc-transfer module to the genesis target.
+func (am StreamingAppModule) DefaultGenesis(target appmodule.GenesisTarget) error {
+	gs := types.DefaultGenesisState()
+
+	if err := genesis.Encode(genesis.TargetField(target, "port_id"), am.cdc, func(enc *genesis.Encoder) {
+		enc.String(gs.PortId)
+	}); err != nil {
+		return err
+	}
+
+	if err := genesis.Encode(genesis.TargetField(target, "denom_traces"), am.cdc, func(enc *genesis.Encoder) {
+		enc.Array(func(func(proto.Message)) {})
+	}); err != nil {
+		return err
+	}
+
+	if err := genesis.Encode(genesis.TargetField(target, "params"), am.cdc, func(enc *genesis.Encoder) {
+		enc.Message(&gs.Params)
+	}); err != nil {
+		return err
+	}
+
+	return genesis.Encode(genesis.TargetField(target, "total_escrowed"), am.cdc, func(enc *genesis.Encoder) {
+		enc.Array(func(func(proto.Message)) {})
+	})
+}
+
+// ValidateGenesis performs genesis state validation for the ibc-transfer module.
+func (am StreamingAppModule) ValidateGenesis(source appmodule.GenesisSource) error {
+	if err := types.StreamValidateGenesis(am.cdc, source); err != nil {
+		return fmt.Errorf("invalid %s genesis state: %w", types.ModuleName, err)
+	}
+
+	return nil
+}
+
+// InitGenesis performs genesis initialization for the ibc-transfer module from the genesis source.
+func (am StreamingAppModule) InitGenesis(ctx context.Context, source appmodule.GenesisSource) error {
+	return am.keeper.StreamInitGenesis(sdk.UnwrapSDKContext(ctx), am.cdc, source)
+}
+
+// ExportGenesis writes the exported genesis state of the ibc-transfer module to the genesis target.
+func (am StreamingAppModule) ExportGenesis(ctx context.Context, target appmodule.GenesisTarget) error {
+	return am.keeper.StreamExportGenesis(sdk.UnwrapSDKContext(ctx), am.cdc, target)
+}
+
 // ConsensusVersion implements AppModule/ConsensusVersion defining the current version of transfer.
 func (AppModule) ConsensusVersion() uint64 { return 5 }
 
@@ -0,0 +1,155 @@
+package simulation
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/keeper"
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
+	ibcsimulation "github.com/cosmos/ibc-go/v8/modules/core/simulation"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgTransfer = "op_weight_msg_transfer" // #nosec
+
+	DefaultWeightMsgTransfer int = 100
+)
+
+// BankKeeper defines the bank keeper methods used by the transfer simulation operations.
+type BankKeeper interface {
+	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+	IsSendEnabledCoin(ctx context.Context, coin sdk.Coin) bool
+}
+
+// WeightedOperations returns the weighted operations of the transfer module.
+func WeightedOperations(appParams simtypes.AppParams, k keeper.Keeper, ibcKeeper *ibckeeper.Keeper, bk BankKeeper) simulation.WeightedOperations {
+	var weightMsgTransfer int
+	appParams.GetOrGenerate(OpWeightMsgTransfer, &weightMsgTransfer, nil,
+		func(_ *rand.Rand) { weightMsgTransfer = DefaultWeightMsgTransfer },
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgTransfer,
+			SimulateMsgTransfer(k, ibcKeeper, bk),
+		),
+	}
+}
+
+// SimulateMsgTransfer returns an operation which transfers a random amount of a random spendable coin of a random
+// simulation account to another simulation account over an open transfer channel built upon the 09-localhost
+// connection. The packet times out after a few blocks or seconds and half of the transfers carry a memo registering
+// source and destination callbacks. Half of the packets are relayed within the same operation, the other half are
+// left in flight and relayed by a future operation scheduled a few blocks later.
+func SimulateMsgTransfer(k keeper.Keeper, ibcKeeper *ibckeeper.Keeper, bk BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgTransfer{})
+		if !k.GetParams(ctx).SendEnabled {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "transfers are disabled"), nil, nil
+		}
+
+		channels := openLocalhostChannels(ctx, ibcKeeper, k.GetPort(ctx))
+		if len(channels) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no open localhost transfer channels"), nil, nil
+		}
+
+		channel := channels[r.Intn(len(channels))]
+
+		sender, _ := simtypes.RandomAcc(r, accs)
+		receiver, _ := simtypes.RandomAcc(r, accs)
+
+		spendable := bk.SpendableCoins(ctx, sender.Address)
+		if spendable.Empty() {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "sender has no spendable coins"), nil, nil
+		}
+
+		coin := spendable[r.Intn(len(spendable))]
+		if !bk.IsSendEnabledCoin(ctx, coin) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "coin transfers are disabled"), nil, nil
+		}
+
+		amount, err := simtypes.RandPositiveInt(r, coin.Amount)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate amount"), nil, err
+		}
+
+		var (
+			timeoutHeight    clienttypes.Height
+			timeoutTimestamp uint64
+		)
+		if r.Intn(2) == 0 {
+			timeoutHeight = clienttypes.GetSelfHeight(ctx).Increment().(clienttypes.Height)
+			timeoutHeight.RevisionHeight += uint64(r.Intn(5))
+		} else {
+			timeoutTimestamp = uint64(ctx.BlockTime().Add(time.Duration(1+r.Intn(30)) * time.Second).UnixNano())
+		}
+
+		var memo string
+		if r.Intn(2) == 0 {
+			memo = fmt.Sprintf(`{"src_callback":{"address":"%s"},"dest_callback":{"address":"%s"}}`, sender.Address, receiver.Address)
+		}
+
+		msg := types.NewMsgTransfer(
+			channel.PortId, channel.ChannelId, sdk.NewCoin(coin.Denom, amount),
+			sender.Address.String(), receiver.Address.String(), timeoutHeight, timeoutTimestamp, memo,
+		)
+
+		cacheCtx, writeFn := ctx.CacheContext()
+
+		res, err := k.Transfer(cacheCtx, msg)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "transfer failed"), nil, err
+		}
+
+		packet, err := ibcsimulation.PacketFromEvents(cacheCtx.EventManager().Events(), channel.PortId, channel.ChannelId, res.Sequence)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to find sent packet"), nil, err
+		}
+
+		writeFn()
+
+		relayOp := ibcsimulation.SimulateRelayPacket(ibcKeeper, packet)
+		if r.Intn(2) == 0 {
+			if _, _, err := relayOp(r, app, ctx, accs, chainID); err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to relay packet"), nil, err
+			}
+
+			return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+		}
+
+		futureOps := []simtypes.FutureOperation{
+			{
+				BlockHeight: int(ctx.BlockHeight()) + 1 + r.Intn(5),
+				Op:          relayOp,
+			},
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), futureOps, nil
+	}
+}
+
+// openLocalhostChannels returns the open channels of the given port built upon the 09-localhost connection.
+func openLocalhostChannels(ctx sdk.Context, ibcKeeper *ibckeeper.Keeper, portID string) []channeltypes.IdentifiedChannel {
+	var channels []channeltypes.IdentifiedChannel
+	for _, channel := range ibcKeeper.ChannelKeeper.GetAllChannelsWithPortPrefix(ctx, portID) {
+		if channel.State == channeltypes.OPEN && channel.ConnectionHops[0] == exported.LocalhostConnectionID {
+			channels = append(channels, channel)
+		}
+	}
+
+	return channels
+}
@@ -0,0 +1,81 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/keeper"
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/simulation"
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	ibcsimulation "github.com/cosmos/ibc-go/v8/modules/core/simulation"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func TestSimulateMsgTransfer(t *testing.T) {
+	coord := ibctesting.NewCoordinator(t, 1)
+	chain := coord.GetChain(ibctesting.GetChainID(1))
+	app := chain.GetSimApp()
+
+	r := rand.New(rand.NewSource(1))
+	accs := []simtypes.Account{
+		{
+			PrivKey: chain.SenderPrivKey,
+			PubKey:  chain.SenderPrivKey.PubKey(),
+			Address: chain.SenderAccount.GetAddress(),
+		},
+	}
+
+	operation := simulation.SimulateMsgTransfer(app.TransferKeeper, app.IBCKeeper, app.BankKeeper)
+
+	// no transfers are sent before a localhost transfer channel is opened
+	operationMsg, futureOps, err := operation(r, nil, chain.GetContext(), accs, "")
+	require.NoError(t, err)
+	require.False(t, operationMsg.OK)
+	require.Empty(t, futureOps)
+
+	operationMsg, _, err = ibcsimulation.SimulateChannelOpenHandshake(app.IBCKeeper, app.TransferKeeper, []string{types.Version})(r, nil, chain.GetContext(), accs, "")
+	require.NoError(t, err)
+	require.True(t, operationMsg.OK)
+
+	balancesBefore := app.BankKeeper.GetAllBalances(chain.GetContext(), chain.SenderAccount.GetAddress())
+
+	operationMsg, futureOps, err = operation(r, nil, chain.GetContext(), accs, "")
+	require.NoError(t, err)
+	require.True(t, operationMsg.OK)
+	require.Len(t, futureOps, 1)
+	require.Greater(t, futureOps[0].BlockHeight, int(chain.GetContext().BlockHeight()))
+
+	var msg types.MsgTransfer
+	require.NoError(t, app.AppCodec().Unmarshal(operationMsg.Msg, &msg))
+
+	ctx := chain.GetContext()
+	require.True(t, app.IBCKeeper.ChannelKeeper.HasInflightPackets(ctx, msg.SourcePort, msg.SourceChannel))
+
+	// the future operation relays the packet, the sender and receiver are the same account
+	operationMsg, _, err = futureOps[0].Op(r, nil, chain.GetContext(), accs, "")
+	require.NoError(t, err)
+	require.True(t, operationMsg.OK)
+
+	ctx = chain.GetContext()
+	require.False(t, app.IBCKeeper.ChannelKeeper.HasInflightPackets(ctx, msg.SourcePort, msg.SourceChannel))
+
+	channel, found := app.IBCKeeper.ChannelKeeper.GetChannel(ctx, msg.SourcePort, msg.SourceChannel)
+	require.True(t, found)
+
+	voucherDenom := types.ParseDenomTrace(types.GetPrefixedDenom(channel.Counterparty.PortId, channel.Counterparty.ChannelId, msg.Token.Denom)).IBCDenom()
+	expBalances := balancesBefore.Sub(msg.Token).Add(sdk.NewCoin(voucherDenom, msg.Token.Amount))
+	require.Equal(t, expBalances, app.BankKeeper.GetAllBalances(ctx, chain.SenderAccount.GetAddress()))
+
+	// the packet is skipped once it has been relayed
+	operationMsg, _, err = futureOps[0].Op(r, nil, chain.GetContext(), accs, "")
+	require.NoError(t, err)
+	require.False(t, operationMsg.OK)
+
+	_, broken := keeper.AllInvariants(&app.TransferKeeper)(ctx)
+	require.False(t, broken)
+}
@@ -39,6 +39,7 @@ type ChannelKeeper interface {
 	GetChannel(ctx sdk.Context, srcPort, srcChan string) (channel channeltypes.Channel, found bool)
 	GetNextSequenceSend(ctx sdk.Context, portID, channelID string) (uint64, bool)
 	GetAllChannelsWithPortPrefix(ctx sdk.Context, portPrefix string) []channeltypes.IdentifiedChannel
+	IndexPacket(ctx sdk.Context, packet channeltypes.Packet, sender, receiver string)
 }
 
 // ClientKeeper defines the expected IBC client keeper
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/core/appmodule"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/internal/genesis"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// StreamValidateGenesis performs the basic genesis state validation of GenesisState.Validate
+// on the provided genesis source, decoding denomination traces and total escrow amounts one
+// at a time. Only the hashes of the denomination traces are held in memory.
+func StreamValidateGenesis(cdc codec.JSONCodec, source appmodule.GenesisSource) error {
+	var portID string
+	if err := genesis.Decode(genesis.SourceField(source, "port_id"), cdc, func(d *genesis.Decoder) (err error) {
+		portID, err = d.String()
+		return err
+	}); err != nil {
+		return fmt.Errorf("failed to decode port_id: %w", err)
+	}
+
+	if err := host.PortIdentifierValidator(portID); err != nil {
+		return err
+	}
+
+	seenTraces := make(map[string]bool)
+	if err := genesis.Decode(genesis.SourceField(source, "denom_traces"), cdc, func(d *genesis.Decoder) error {
+		return genesis.DecodeMessages(d, func(trace *DenomTrace) error {
+			hash := trace.Hash().String()
+			if seenTraces[hash] {
+				return fmt.Errorf("duplicated denomination trace with hash %s", hash)
+			}
+
+			if err := trace.Validate(); err != nil {
+				return err
+			}
+			seenTraces[hash] = true
+			return nil
+		})
+	}); err != nil {
+		return err
+	}
+
+	// total escrow amounts must be sorted by denomination without duplicates as in sdk.Coins.Validate,
+	// such that only the previous denomination needs to be compared.
+	var previous *sdk.Coin
+	return genesis.Decode(genesis.SourceField(source, "total_escrowed"), cdc, func(d *genesis.Decoder) error {
+		return genesis.DecodeMessages(d, func(denomEscrow *sdk.Coin) error {
+			coins := sdk.Coins{*denomEscrow}
+			if previous != nil {
+				coins = sdk.Coins{*previous, *denomEscrow}
+			}
+
+			if err := coins.Validate(); err != nil {
+				return err
+			}
+			previous = denomEscrow
+			return nil
+		})
+	})
+}
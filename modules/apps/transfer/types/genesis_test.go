@@ -5,6 +5,12 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/core/genesis"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer"
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 )
 
@@ -45,3 +51,75 @@ func TestValidateGenesis(t *testing.T) {
 		}
 	}
 }
+
+func TestStreamValidateGenesis(t *testing.T) {
+	denomTrace := types.ParseDenomTrace("transfer/channel-0/uatom")
+
+	testCases := []struct {
+		name     string
+		genState *types.GenesisState
+		expPass  bool
+	}{
+		{
+			"default",
+			types.DefaultGenesisState(),
+			true,
+		},
+		{
+			"valid genesis",
+			types.NewGenesisState(types.PortID, types.Traces{denomTrace}, types.DefaultParams(), sdk.NewCoins(sdk.NewInt64Coin("atom", 1), sdk.NewInt64Coin(denomTrace.IBCDenom(), 1))),
+			true,
+		},
+		{
+			"invalid port",
+			types.NewGenesisState("(INVALIDPORT)", types.Traces{}, types.DefaultParams(), sdk.Coins{}),
+			false,
+		},
+		{
+			"duplicated denomination trace",
+			types.NewGenesisState(types.PortID, types.Traces{denomTrace, denomTrace}, types.DefaultParams(), sdk.Coins{}),
+			false,
+		},
+		{
+			"invalid denomination trace",
+			types.NewGenesisState(types.PortID, types.Traces{{Path: "transfer", BaseDenom: "uatom"}}, types.DefaultParams(), sdk.Coins{}),
+			false,
+		},
+		{
+			"unsorted total escrow",
+			types.NewGenesisState(types.PortID, types.Traces{}, types.DefaultParams(), sdk.Coins{sdk.NewInt64Coin("btc", 1), sdk.NewInt64Coin("atom", 1)}),
+			false,
+		},
+		{
+			"duplicated total escrow denomination",
+			types.NewGenesisState(types.PortID, types.Traces{}, types.DefaultParams(), sdk.Coins{sdk.NewInt64Coin("atom", 1), sdk.NewInt64Coin("atom", 1)}),
+			false,
+		},
+		{
+			"zero total escrow",
+			types.NewGenesisState(types.PortID, types.Traces{}, types.DefaultParams(), sdk.Coins{sdk.NewInt64Coin("atom", 0)}),
+			false,
+		},
+	}
+
+	cdc := moduletestutil.MakeTestEncodingConfig(transfer.AppModuleBasic{}).Codec
+
+	for _, tc := range testCases {
+		tc := tc
+
+		bz, err := cdc.MarshalJSON(tc.genState)
+		require.NoError(t, err, tc.name)
+
+		source, err := genesis.SourceFromRawJSON(bz)
+		require.NoError(t, err, tc.name)
+
+		err = types.StreamValidateGenesis(cdc, source)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.NoError(t, tc.genState.Validate(), tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+			require.Error(t, tc.genState.Validate(), tc.name)
+		}
+	}
+}
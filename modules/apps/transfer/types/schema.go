@@ -0,0 +1,13 @@
+package types
+
+import (
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+)
+
+// RegisterPacketDataSchemas registers the FungibleTokenPacketData schema for the provided
+// transfer port identifier with the given packet data registry.
+func RegisterPacketDataSchemas(registry *porttypes.PacketDataRegistry, portID string) {
+	registry.Register(portID, Version, porttypes.PacketDataSchema{
+		ProtoMessage: &FungibleTokenPacketData{},
+	})
+}
@@ -15,6 +15,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
@@ -127,6 +128,88 @@ func (suite *CapabilityTestSuite) TestInitializeMemStore() {
 	suite.Require().True(newKeeper.IsInitialized(ctx), "memstore initialized flag not set")
 }
 
+func (suite *CapabilityTestSuite) TestMemStoreOwnersInvariant() {
+	var (
+		scopedKeeper keeper.ScopedKeeper
+		cap1         *types.Capability
+	)
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expBroken bool
+	}{
+		{
+			"success",
+			func() {},
+			false,
+		},
+		{
+			"success: released capability",
+			func() {
+				err := scopedKeeper.ReleaseCapability(suite.ctx, cap1)
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+		{
+			"reverse mapping missing from memstore",
+			func() {
+				suite.ctx.KVStore(suite.memStoreKey).Delete(types.RevCapabilityKey(banktypes.ModuleName, "transfer"))
+			},
+			true,
+		},
+		{
+			"forward mapping missing from memstore",
+			func() {
+				suite.ctx.KVStore(suite.memStoreKey).Delete(types.FwdCapabilityKey(banktypes.ModuleName, cap1))
+			},
+			true,
+		},
+		{
+			"reverse mapping without persisted owner",
+			func() {
+				suite.ctx.KVStore(suite.memStoreKey).Set(types.RevCapabilityKey(stakingtypes.ModuleName, "transfer"), sdk.Uint64ToBigEndian(cap1.GetIndex()))
+			},
+			true,
+		},
+		{
+			"persisted owner without reverse mapping",
+			func() {
+				owners := types.NewCapabilityOwners()
+				suite.Require().NoError(owners.Set(types.NewOwner(banktypes.ModuleName, "transfer")))
+				suite.Require().NoError(owners.Set(types.NewOwner(stakingtypes.ModuleName, "transfer")))
+
+				suite.keeper.SetOwners(suite.ctx, cap1.GetIndex(), *owners)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			scopedKeeper = suite.keeper.ScopeToModule(banktypes.ModuleName)
+			suite.keeper.Seal()
+
+			suite.keeper.InitMemStore(suite.ctx)
+
+			var err error
+			cap1, err = scopedKeeper.NewCapability(suite.ctx, "transfer")
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			msg, broken := keeper.AllInvariants(*suite.keeper)(suite.ctx)
+			suite.Require().Equal(tc.expBroken, broken)
+			suite.Require().Equal(tc.expBroken, msg != "")
+		})
+	}
+}
+
 func TestCapabilityTestSuite(t *testing.T) {
 	testifysuite.Run(t, new(CapabilityTestSuite))
 }
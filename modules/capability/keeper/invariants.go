@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/modules/capability/types"
+)
+
+// revCapabilityKeySeparator is the separator between the module and capability name of the
+// reverse lookup keys stored in the in-memory store. See types.RevCapabilityKey.
+const revCapabilityKeySeparator = "/rev/"
+
+// RegisterInvariants registers all capability invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "memstore-owners",
+		MemStoreOwnersInvariant(k))
+}
+
+// AllInvariants runs all invariants of the capability module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return MemStoreOwnersInvariant(k)(ctx)
+	}
+}
+
+// MemStoreOwnersInvariant checks that the in-memory store matches the persisted capability owners.
+// Every persisted owner must have a capability in the capability map with forward and reverse
+// mappings in the in-memory store, and every reverse mapping in the in-memory store must belong
+// to a persisted owner. The invariant is only checked once the in-memory store has been initialized.
+func MemStoreOwnersInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		if !k.IsInitialized(ctx) {
+			return "", false
+		}
+
+		var msg strings.Builder
+
+		memStore := ctx.KVStore(k.memKey)
+		prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIndexCapability)
+
+		iterator := storetypes.KVStorePrefixIterator(prefixStore, nil)
+		defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+		for ; iterator.Valid(); iterator.Next() {
+			index := types.IndexFromKey(iterator.Key())
+
+			var capOwners types.CapabilityOwners
+			k.cdc.MustUnmarshal(iterator.Value(), &capOwners)
+
+			capability := k.capMap[index]
+			if capability == nil {
+				msg.WriteString(fmt.Sprintf("capability with index %d is missing from the capability map\n", index))
+				continue
+			}
+
+			for _, owner := range capOwners.Owners {
+				if !bytes.Equal(memStore.Get(types.RevCapabilityKey(owner.Module, owner.Name)), sdk.Uint64ToBigEndian(index)) {
+					msg.WriteString(fmt.Sprintf("reverse mapping of owner %s does not point to capability with index %d\n", owner, index))
+				}
+
+				if string(memStore.Get(types.FwdCapabilityKey(owner.Module, capability))) != owner.Name {
+					msg.WriteString(fmt.Sprintf("forward mapping of capability with index %d does not point to owner %s\n", index, owner))
+				}
+			}
+		}
+
+		memIterator := memStore.Iterator(nil, nil)
+		defer sdk.LogDeferred(ctx.Logger(), func() error { return memIterator.Close() })
+
+		for ; memIterator.Valid(); memIterator.Next() {
+			module, name, found := strings.Cut(string(memIterator.Key()), revCapabilityKeySeparator)
+			if !found {
+				continue
+			}
+
+			index := sdk.BigEndianToUint64(memIterator.Value())
+			owner := types.NewOwner(module, name)
+
+			bz := prefixStore.Get(types.IndexToKey(index))
+			if len(bz) == 0 {
+				msg.WriteString(fmt.Sprintf("reverse mapping of owner %s points to capability with index %d which has no persisted owners\n", owner, index))
+				continue
+			}
+
+			var capOwners types.CapabilityOwners
+			k.cdc.MustUnmarshal(bz, &capOwners)
+
+			if _, found := capOwners.Get(owner); !found {
+				msg.WriteString(fmt.Sprintf("reverse mapping of owner %s points to capability with index %d which is not owned by it\n", owner, index))
+			}
+		}
+
+		if msg.Len() > 0 {
+			return sdk.FormatInvariant(types.ModuleName, "memstore owners", msg.String()), true
+		}
+
+		return "", false
+	}
+}
@@ -60,6 +60,19 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
 
 // ExportGenesis returns the ibc client submodule's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
+	return ExportGenesisWithOptions(ctx, k, types.ExportOptions{})
+}
+
+// ExportGenesisWithOptions returns the ibc client submodule's exported genesis, omitting
+// the pruneable state selected by the provided export options.
+func ExportGenesisWithOptions(ctx sdk.Context, k keeper.Keeper, opts types.ExportOptions) types.GenesisState {
+	if opts.OmitExpiredConsensusStates {
+		// expired consensus states are pruned on a cache context which is never written,
+		// such that both the consensus states and their metadata are omitted from the export.
+		ctx, _ = ctx.CacheContext()
+		k.PruneExpiredConsensusStates(ctx)
+	}
+
 	genClients := k.GetAllGenesisClients(ctx)
 	clientsMetadata, err := k.GetAllClientMetadata(ctx, genClients)
 	if err != nil {
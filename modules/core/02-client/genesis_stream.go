@@ -0,0 +1,291 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/internal/genesis"
+	"github.com/cosmos/ibc-go/v8/modules/core/02-client/keeper"
+	"github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// StreamInitGenesis initializes the ibc client submodule's state from the genesis JSON
+// opened by the provided function. Clients, consensus states and metadata are decoded and
+// stored one at a time. The genesis JSON is decoded in multiple passes, such that the params
+// and client metadata are set before any clients, as in InitGenesis.
+func StreamInitGenesis(ctx sdk.Context, k keeper.Keeper, cdc codec.JSONCodec, open func() (io.ReadCloser, error)) error {
+	gs, err := decodeGenesisScalars(open, cdc)
+	if err != nil {
+		return err
+	}
+
+	if err := gs.Params.Validate(); err != nil {
+		return fmt.Errorf("invalid ibc client genesis state parameters: %w", err)
+	}
+	k.SetParams(ctx, gs.Params)
+
+	// Set all client metadata first. This will allow client keeper to overwrite client and consensus state keys
+	// if clients accidentally write to ClientKeeper reserved keys.
+	if err := genesis.DecodeField(open, cdc, "clients_metadata", func(d *genesis.Decoder) error {
+		return genesis.DecodeMessages(d, func(metadata *types.IdentifiedGenesisMetadata) error {
+			k.SetAllClientMetadata(ctx, []types.IdentifiedGenesisMetadata{*metadata})
+			return nil
+		})
+	}); err != nil {
+		return err
+	}
+
+	if err := genesis.DecodeField(open, cdc, "clients", func(d *genesis.Decoder) error {
+		return genesis.DecodeMessages(d, func(client *types.IdentifiedClientState) error {
+			cs, ok := client.ClientState.GetCachedValue().(exported.ClientState)
+			if !ok {
+				return errors.New("invalid client state")
+			}
+
+			if !gs.Params.IsAllowedClient(cs.ClientType()) {
+				return fmt.Errorf("client state type %s is not registered on the allowlist", cs.ClientType())
+			}
+
+			k.SetClientState(ctx, client.ClientId, cs)
+			return nil
+		})
+	}); err != nil {
+		return err
+	}
+
+	if err := genesis.DecodeField(open, cdc, "clients_consensus", func(d *genesis.Decoder) error {
+		return decodeClientsConsensus(d, func(clientID string, consState *types.ConsensusStateWithHeight) error {
+			consensusState, ok := consState.ConsensusState.GetCachedValue().(exported.ConsensusState)
+			if !ok {
+				return fmt.Errorf("invalid consensus state with client ID %s at height %s", clientID, consState.Height)
+			}
+
+			k.SetClientConsensusState(ctx, clientID, consState.Height, consensusState)
+			return nil
+		})
+	}); err != nil {
+		return err
+	}
+
+	k.SetNextClientSequence(ctx, gs.NextClientSequence)
+
+	// if the localhost already exists in state (included in the genesis file),
+	// it must be overwritten to ensure its stored height equals the context block height
+	if err := k.CreateLocalhostClient(ctx); err != nil {
+		return fmt.Errorf("failed to initialize localhost client: %w", err)
+	}
+
+	return nil
+}
+
+// StreamExportGenesis writes the ibc client submodule's exported genesis to the writer opened
+// by the provided function, omitting the pruneable state selected by the provided export options.
+// Clients and consensus states are written one at a time, client metadata is written per client.
+func StreamExportGenesis(ctx sdk.Context, k keeper.Keeper, cdc codec.JSONCodec, opts types.ExportOptions, open func() (io.WriteCloser, error)) error {
+	if opts.OmitExpiredConsensusStates {
+		// expired consensus states are pruned on a cache context which is never written,
+		// such that both the consensus states and their metadata are omitted from the export.
+		ctx, _ = ctx.CacheContext()
+		k.PruneExpiredConsensusStates(ctx)
+	}
+
+	return genesis.Encode(open, cdc, func(enc *genesis.Encoder) {
+		var clients []types.IdentifiedClientState
+
+		enc.BeginObject()
+
+		enc.Field("clients")
+		enc.Array(func(write func(proto.Message)) {
+			k.IterateClientStates(ctx, nil, func(clientID string, cs exported.ClientState) bool {
+				client := types.NewIdentifiedClientState(clientID, cs)
+				clients = append(clients, client)
+				write(&client)
+				return false
+			})
+		})
+
+		enc.Field("clients_consensus")
+		enc.BeginArray()
+		var currentClientID string
+		k.IterateConsensusStates(ctx, func(clientID string, cs types.ConsensusStateWithHeight) bool {
+			if clientID != currentClientID {
+				if currentClientID != "" {
+					enc.EndArray()
+					enc.EndObject()
+				}
+
+				enc.Element()
+				enc.BeginObject()
+				enc.Field("client_id")
+				enc.String(clientID)
+				enc.Field("consensus_states")
+				enc.BeginArray()
+
+				currentClientID = clientID
+			}
+
+			enc.Element()
+			enc.Message(&cs)
+			return false
+		})
+		if currentClientID != "" {
+			enc.EndArray()
+			enc.EndObject()
+		}
+		enc.EndArray()
+
+		enc.Field("clients_metadata")
+		enc.Array(func(write func(proto.Message)) {
+			for _, client := range clients {
+				clientsMetadata, err := k.GetAllClientMetadata(ctx, []types.IdentifiedClientState{client})
+				if err != nil {
+					enc.Fail(err)
+					return
+				}
+
+				for i := range clientsMetadata {
+					write(&clientsMetadata[i])
+				}
+			}
+		})
+
+		params := k.GetParams(ctx)
+		enc.Field("params")
+		enc.Message(&params)
+
+		// Warning: CreateLocalhost is deprecated
+		enc.Field("create_localhost")
+		enc.Bool(false)
+
+		enc.Field("next_client_sequence")
+		enc.Uint64(k.GetNextClientSequence(ctx))
+
+		enc.EndObject()
+	})
+}
+
+// StreamValidateGenesis performs the basic genesis state validation of GenesisState.Validate
+// on the genesis JSON opened by the provided function, decoding consensus states and metadata
+// one at a time. Only the clients are held in memory.
+func StreamValidateGenesis(cdc codec.JSONCodec, open func() (io.ReadCloser, error)) error {
+	gs, err := decodeGenesisScalars(open, cdc)
+	if err != nil {
+		return err
+	}
+
+	// every element is validated as part of a genesis state containing only that element,
+	// all checks of GenesisState.Validate apply to the elements individually.
+	if err := gs.Validate(); err != nil {
+		return err
+	}
+
+	clients := make(map[string]types.IdentifiedClientState)
+	if err := genesis.DecodeField(open, cdc, "clients", func(d *genesis.Decoder) error {
+		return genesis.DecodeMessages(d, func(client *types.IdentifiedClientState) error {
+			clients[client.ClientId] = *client
+			return types.GenesisState{
+				Clients:            []types.IdentifiedClientState{*client},
+				Params:             gs.Params,
+				NextClientSequence: gs.NextClientSequence,
+			}.Validate()
+		})
+	}); err != nil {
+		return err
+	}
+
+	clientsOf := func(clientID string) []types.IdentifiedClientState {
+		if client, ok := clients[clientID]; ok {
+			return []types.IdentifiedClientState{client}
+		}
+		return nil
+	}
+
+	if err := genesis.DecodeField(open, cdc, "clients_consensus", func(d *genesis.Decoder) error {
+		return decodeClientsConsensus(d, func(clientID string, consState *types.ConsensusStateWithHeight) error {
+			return types.GenesisState{
+				Clients:            clientsOf(clientID),
+				ClientsConsensus:   types.ClientsConsensusStates{types.NewClientConsensusStates(clientID, []types.ConsensusStateWithHeight{*consState})},
+				Params:             gs.Params,
+				NextClientSequence: gs.NextClientSequence,
+			}.Validate()
+		})
+	}); err != nil {
+		return err
+	}
+
+	return genesis.DecodeField(open, cdc, "clients_metadata", func(d *genesis.Decoder) error {
+		return genesis.DecodeMessages(d, func(metadata *types.IdentifiedGenesisMetadata) error {
+			return types.GenesisState{
+				Clients:            clientsOf(metadata.ClientId),
+				ClientsMetadata:    []types.IdentifiedGenesisMetadata{*metadata},
+				Params:             gs.Params,
+				NextClientSequence: gs.NextClientSequence,
+			}.Validate()
+		})
+	})
+}
+
+// decodeGenesisScalars decodes the params and next client sequence of the client genesis,
+// skipping all other fields.
+func decodeGenesisScalars(open func() (io.ReadCloser, error), cdc codec.JSONCodec) (types.GenesisState, error) {
+	var gs types.GenesisState
+	err := genesis.Decode(open, cdc, func(d *genesis.Decoder) error {
+		return d.Object(map[string]func() error{
+			"params": func() error {
+				return d.Message(&gs.Params)
+			},
+			"next_client_sequence": func() (err error) {
+				gs.NextClientSequence, err = d.Uint64()
+				return err
+			},
+		})
+	})
+
+	return gs, err
+}
+
+// decodeClientsConsensus decodes a JSON array of ClientConsensusStates, calling cb with every
+// consensus state in order. The consensus states of a client are buffered only if they precede
+// the client identifier in the JSON object.
+func decodeClientsConsensus(d *genesis.Decoder, cb func(clientID string, consState *types.ConsensusStateWithHeight) error) error {
+	return d.Elements(func() error {
+		var (
+			clientID string
+			buffered []*types.ConsensusStateWithHeight
+		)
+
+		if err := d.Object(map[string]func() error{
+			"client_id": func() (err error) {
+				clientID, err = d.String()
+				return err
+			},
+			"consensus_states": func() error {
+				return genesis.DecodeMessages(d, func(consState *types.ConsensusStateWithHeight) error {
+					if clientID == "" {
+						buffered = append(buffered, consState)
+						return nil
+					}
+
+					return cb(clientID, consState)
+				})
+			},
+		}); err != nil {
+			return err
+		}
+
+		for _, consState := range buffered {
+			if err := cb(clientID, consState); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
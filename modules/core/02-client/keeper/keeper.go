@@ -233,6 +233,27 @@ func (k Keeper) GetAllConsensusStates(ctx sdk.Context) types.ClientsConsensusSta
 	return clientConsStates.Sort()
 }
 
+// PruneExpiredConsensusStates prunes all expired consensus states, together with their metadata,
+// of every tendermint client and returns the number of consensus states pruned.
+func (k Keeper) PruneExpiredConsensusStates(ctx sdk.Context) int {
+	var clientIDs []string
+	var clientStates []*ibctm.ClientState
+	k.IterateClientStates(ctx, []byte(exported.Tendermint), func(clientID string, clientState exported.ClientState) bool {
+		if tmClientState, ok := clientState.(*ibctm.ClientState); ok {
+			clientIDs = append(clientIDs, clientID)
+			clientStates = append(clientStates, tmClientState)
+		}
+		return false
+	})
+
+	var totalPruned int
+	for i, clientID := range clientIDs {
+		totalPruned += ibctm.PruneAllExpiredConsensusStates(ctx, k.ClientStore(ctx, clientID), k.cdc, clientStates[i])
+	}
+
+	return totalPruned
+}
+
 // HasClientConsensusState returns if keeper has a ConsensusState for a particular
 // client at the given height
 func (k Keeper) HasClientConsensusState(ctx sdk.Context, clientID string, height exported.Height) bool {
@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/hashicorp/go-metrics"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibcmetrics "github.com/cosmos/ibc-go/v8/modules/core/metrics"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+)
+
+// clientStatuses defines the client statuses reported by the client status metrics.
+var clientStatuses = []exported.Status{exported.Active, exported.Frozen, exported.Expired, exported.Unknown, exported.Unauthorized}
+
+// clientLabels defines the label values of the metrics reported for a client.
+type clientLabels struct {
+	clientID   string
+	clientType string
+}
+
+// clientMetrics defines the metrics reported for the clients sharing the same label values.
+type clientMetrics struct {
+	statusCounts            map[exported.Status]int
+	trustingPeriodRemaining *time.Duration
+}
+
+// ReportClientMetrics reports the status of every client, along with the number of clients of every status.
+// The trusting period remaining before the latest consensus state expires is reported for tendermint clients.
+// It is a no-op if the ibc metrics are not enabled.
+//
+// Clients whose identifier exceeds the maximum number of label values share the OtherLabelValue label, their
+// metrics are therefore aggregated before being reported: the status gauges count the clients of every status
+// and the trusting period remaining is the minimum of the trusting periods remaining of the clients.
+//
+// The ibc metrics are enabled per node, the client statuses are therefore determined on a cached context which
+// is discarded along with its own infinite gas meter. Light clients may consume gas or write to state when their
+// status is queried, such as the contract gas accounting of 08-wasm clients, which must never affect consensus.
+func (k Keeper) ReportClientMetrics(ctx sdk.Context) {
+	if !ibcmetrics.Enabled() {
+		return
+	}
+
+	// the cached context is never written
+	ctx, _ = ctx.CacheContext()
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	var labelOrder []clientLabels
+	clients := make(map[clientLabels]*clientMetrics)
+	statusCounts := make(map[exported.Status]int)
+	k.IterateClientStates(ctx, nil, func(clientID string, clientState exported.ClientState) bool {
+		status := k.GetClientStatus(ctx, clientState, clientID)
+		statusCounts[status]++
+
+		labels := clientLabels{
+			clientID:   ibcmetrics.Label(types.LabelClientID, clientID).Value,
+			clientType: clientState.ClientType(),
+		}
+
+		m, ok := clients[labels]
+		if !ok {
+			m = &clientMetrics{statusCounts: make(map[exported.Status]int)}
+			clients[labels] = m
+			labelOrder = append(labelOrder, labels)
+		}
+
+		m.statusCounts[status]++
+
+		if remaining, ok := k.trustingPeriodRemaining(ctx, clientID, clientState); ok {
+			if m.trustingPeriodRemaining == nil || remaining < *m.trustingPeriodRemaining {
+				m.trustingPeriodRemaining = &remaining
+			}
+		}
+
+		return false
+	})
+
+	for _, labels := range labelOrder {
+		m := clients[labels]
+		metricLabels := []metrics.Label{
+			telemetry.NewLabel(types.LabelClientID, labels.clientID),
+			telemetry.NewLabel(types.LabelClientType, labels.clientType),
+		}
+
+		// every status is reported for every client, such that exactly one status gauge of a client is set to one,
+		// while the status gauges of aggregated clients are set to the number of clients of every status
+		for _, s := range clientStatuses {
+			ibcmetrics.SetGauge([]string{"ibc", "client", "status"}, float32(m.statusCounts[s]), append(metricLabels, telemetry.NewLabel(types.LabelStatus, s.String()))...)
+		}
+
+		if m.trustingPeriodRemaining != nil {
+			ibcmetrics.SetGauge([]string{"ibc", "client", "trusting_period_remaining_seconds"}, float32(m.trustingPeriodRemaining.Seconds()), metricLabels...)
+		}
+	}
+
+	for _, status := range clientStatuses {
+		ibcmetrics.SetGauge([]string{"ibc", "clients"}, float32(statusCounts[status]), telemetry.NewLabel(types.LabelStatus, status.String()))
+	}
+}
+
+// trustingPeriodRemaining returns the time remaining before the latest consensus state of a tendermint client
+// falls outside of its trusting period, or zero if it already has. It returns false for other client types.
+func (k Keeper) trustingPeriodRemaining(ctx sdk.Context, clientID string, clientState exported.ClientState) (time.Duration, bool) {
+	tmClientState, ok := clientState.(*ibctm.ClientState)
+	if !ok {
+		return 0, false
+	}
+
+	consensusState, found := k.GetLatestClientConsensusState(ctx, clientID)
+	if !found {
+		return 0, false
+	}
+
+	expiry := time.Unix(0, int64(consensusState.GetTimestamp())).Add(tmClientState.TrustingPeriod)
+	return max(expiry.Sub(ctx.BlockTime()), 0), true
+}
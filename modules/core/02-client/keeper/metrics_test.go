@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibcmetrics "github.com/cosmos/ibc-go/v8/modules/core/metrics"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func (suite *KeeperTestSuite) TestReportClientMetrics() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupClients()
+
+	sink := ibctesting.EnableMetrics(suite.T())
+
+	clientLabels := fmt.Sprintf("client_id=%s;client_type=%s", path.EndpointA.ClientID, exported.Tendermint)
+	statusGauge := func(status exported.Status) float32 {
+		return sink.Data()[0].Gauges[fmt.Sprintf("ibc.client.status;%s;status=%s", clientLabels, status)].Value
+	}
+	trustingPeriodRemaining := func() float32 {
+		return sink.Data()[0].Gauges["ibc.client.trusting_period_remaining_seconds;"+clientLabels].Value
+	}
+	statusCount := func(status exported.Status) float32 {
+		return sink.Data()[0].Gauges[fmt.Sprintf("ibc.clients;status=%s", status)].Value
+	}
+
+	// reporting the metrics consumes no gas of the provided context
+	ctx := suite.chainA.GetContext()
+	suite.chainA.App.GetIBCKeeper().ClientKeeper.ReportClientMetrics(ctx)
+	suite.Require().Zero(ctx.GasMeter().GasConsumed())
+
+	suite.Require().Equal(float32(1), statusGauge(exported.Active))
+	suite.Require().Equal(float32(0), statusGauge(exported.Expired))
+	suite.Require().Equal(float32(0), statusCount(exported.Expired))
+	suite.Require().Positive(trustingPeriodRemaining())
+	suite.Require().LessOrEqual(trustingPeriodRemaining(), float32(ibctesting.TrustingPeriod.Seconds()))
+
+	// expire the client
+	suite.coordinator.IncrementTimeBy(ibctesting.TrustingPeriod + time.Hour)
+
+	suite.chainA.App.GetIBCKeeper().ClientKeeper.ReportClientMetrics(suite.chainA.GetContext())
+
+	suite.Require().Equal(float32(0), statusGauge(exported.Active))
+	suite.Require().Equal(float32(1), statusGauge(exported.Expired))
+	suite.Require().Equal(float32(1), statusCount(exported.Expired))
+	suite.Require().Zero(trustingPeriodRemaining())
+}
+
+func (suite *KeeperTestSuite) TestReportClientMetricsOtherLabel() {
+	var paths []*ibctesting.Path
+	for i := 0; i < 3; i++ {
+		path := ibctesting.NewPath(suite.chainA, suite.chainB)
+		path.SetupClients()
+		paths = append(paths, path)
+	}
+
+	sink := ibctesting.EnableMetrics(suite.T())
+
+	// only the first client is reported with its own identifier
+	ibcmetrics.Configure(ibcmetrics.Config{Enabled: true, MaxLabelValues: 1})
+
+	statusGauge := func(clientID string, status exported.Status) float32 {
+		return sink.Data()[0].Gauges[fmt.Sprintf("ibc.client.status;client_id=%s;client_type=%s;status=%s", clientID, exported.Tendermint, status)].Value
+	}
+
+	suite.chainA.App.GetIBCKeeper().ClientKeeper.ReportClientMetrics(suite.chainA.GetContext())
+
+	suite.Require().Equal(float32(1), statusGauge(paths[0].EndpointA.ClientID, exported.Active))
+	suite.Require().Equal(float32(2), statusGauge(ibcmetrics.OtherLabelValue, exported.Active))
+	suite.Require().Equal(float32(0), statusGauge(ibcmetrics.OtherLabelValue, exported.Expired))
+
+	// expire all clients
+	suite.coordinator.IncrementTimeBy(ibctesting.TrustingPeriod + time.Hour)
+
+	suite.chainA.App.GetIBCKeeper().ClientKeeper.ReportClientMetrics(suite.chainA.GetContext())
+
+	suite.Require().Equal(float32(1), statusGauge(paths[0].EndpointA.ClientID, exported.Expired))
+	suite.Require().Equal(float32(0), statusGauge(ibcmetrics.OtherLabelValue, exported.Active))
+	suite.Require().Equal(float32(2), statusGauge(ibcmetrics.OtherLabelValue, exported.Expired))
+}
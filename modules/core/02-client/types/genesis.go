@@ -205,6 +205,15 @@ func (gs GenesisState) Validate() error {
 	return nil
 }
 
+// ExportOptions defines the options used to omit pruneable state from the exported
+// client submodule genesis.
+type ExportOptions struct {
+	// OmitExpiredConsensusStates omits the expired consensus states of tendermint clients
+	// together with their metadata. The consensus state at the latest height of an expired
+	// client is omitted as well, the client remains expired.
+	OmitExpiredConsensusStates bool
+}
+
 // NewGenesisMetadata is a constructor for GenesisMetadata
 func NewGenesisMetadata(key, val []byte) GenesisMetadata {
 	return GenesisMetadata{
@@ -6,4 +6,5 @@ const (
 	LabelClientID   = "client_id"
 	LabelUpdateType = "update_type"
 	LabelMsgType    = "msg_type"
+	LabelStatus     = "status"
 )
@@ -12,6 +12,8 @@ import (
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
 	for _, connection := range gs.Connections {
 		conn := types.NewConnectionEnd(connection.State, connection.ClientId, connection.Counterparty, connection.Versions, connection.DelayPeriod)
+		conn.UpgradeSequence = connection.UpgradeSequence
+		conn.ProposedUpgrade = connection.ProposedUpgrade
 		k.SetConnection(ctx, connection.Id, conn)
 	}
 	for _, connPaths := range gs.ClientConnectionPaths {
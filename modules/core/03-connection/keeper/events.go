@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	"fmt"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
@@ -72,3 +75,64 @@ func emitConnectionOpenConfirmEvent(ctx sdk.Context, connectionID string, connec
 		),
 	})
 }
+
+// emitConnectionUpgradeInitEvent emits a connection upgrade init event
+func emitConnectionUpgradeInitEvent(ctx sdk.Context, connectionID string, connectionEnd types.ConnectionEnd) {
+	emitConnectionUpgradeEvent(ctx, types.EventTypeConnectionUpgradeInit, connectionID, connectionEnd)
+}
+
+// emitConnectionUpgradeTryEvent emits a connection upgrade try event
+func emitConnectionUpgradeTryEvent(ctx sdk.Context, connectionID string, connectionEnd types.ConnectionEnd) {
+	emitConnectionUpgradeEvent(ctx, types.EventTypeConnectionUpgradeTry, connectionID, connectionEnd)
+}
+
+// emitConnectionUpgradeAckEvent emits a connection upgrade ack event
+func emitConnectionUpgradeAckEvent(ctx sdk.Context, connectionID string, connectionEnd types.ConnectionEnd) {
+	emitConnectionUpgradeEvent(ctx, types.EventTypeConnectionUpgradeAck, connectionID, connectionEnd)
+}
+
+// emitConnectionUpgradeConfirmEvent emits a connection upgrade confirm event
+func emitConnectionUpgradeConfirmEvent(ctx sdk.Context, connectionID string, connectionEnd types.ConnectionEnd) {
+	emitConnectionUpgradeEvent(ctx, types.EventTypeConnectionUpgradeConfirm, connectionID, connectionEnd)
+}
+
+// emitConnectionUpgradeCancelEvent emits a connection upgrade cancel event
+func emitConnectionUpgradeCancelEvent(ctx sdk.Context, connectionID string, connectionEnd types.ConnectionEnd) {
+	emitConnectionUpgradeEvent(ctx, types.EventTypeConnectionUpgradeCancel, connectionID, connectionEnd)
+}
+
+// emitConnectionUpgradeTimeoutEvent emits a connection upgrade timeout event
+func emitConnectionUpgradeTimeoutEvent(ctx sdk.Context, connectionID string, connectionEnd types.ConnectionEnd) {
+	emitConnectionUpgradeEvent(ctx, types.EventTypeConnectionUpgradeTimeout, connectionID, connectionEnd)
+}
+
+// emitConnectionUpgradeEvent emits a connection upgrade event of the given type. The versions and delay
+// period attributes refer to the proposed upgrade if one is in progress, otherwise to the connection end.
+func emitConnectionUpgradeEvent(ctx sdk.Context, eventType string, connectionID string, connectionEnd types.ConnectionEnd) {
+	versions, delayPeriod := connectionEnd.Versions, connectionEnd.DelayPeriod
+	if connectionEnd.HasUpgrade() {
+		versions, delayPeriod = connectionEnd.ProposedUpgrade.Versions, connectionEnd.ProposedUpgrade.DelayPeriod
+	}
+
+	versionStrs := make([]string, len(versions))
+	for i, version := range versions {
+		versionStrs[i] = version.String()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyConnectionID, connectionID),
+			sdk.NewAttribute(types.AttributeKeyClientID, connectionEnd.ClientId),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyClientID, connectionEnd.Counterparty.ClientId),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyConnectionID, connectionEnd.Counterparty.ConnectionId),
+			sdk.NewAttribute(types.AttributeKeyUpgradeSequence, fmt.Sprintf("%d", connectionEnd.UpgradeSequence)),
+			sdk.NewAttribute(types.AttributeKeyUpgradeVersions, strings.Join(versionStrs, ",")),
+			sdk.NewAttribute(types.AttributeKeyUpgradeDelayPeriod, fmt.Sprintf("%d", delayPeriod)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
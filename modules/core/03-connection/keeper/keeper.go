@@ -219,6 +219,34 @@ func (k Keeper) addConnectionToClient(ctx sdk.Context, clientID, connectionID st
 	return nil
 }
 
+// GetCounterpartyUpgrade returns the upgrade fields proposed by the counterparty of the given connection, as
+// verified in ConnUpgradeTry.
+func (k Keeper) GetCounterpartyUpgrade(ctx sdk.Context, connectionID string) (types.ConnectionUpgradeFields, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.CounterpartyUpgradeKey(connectionID))
+	if bz == nil {
+		return types.ConnectionUpgradeFields{}, false
+	}
+
+	var upgradeFields types.ConnectionUpgradeFields
+	k.cdc.MustUnmarshal(bz, &upgradeFields)
+
+	return upgradeFields, true
+}
+
+// SetCounterpartyUpgrade sets the upgrade fields proposed by the counterparty of the given connection.
+func (k Keeper) SetCounterpartyUpgrade(ctx sdk.Context, connectionID string, upgradeFields types.ConnectionUpgradeFields) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&upgradeFields)
+	store.Set(types.CounterpartyUpgradeKey(connectionID), bz)
+}
+
+// deleteCounterpartyUpgrade deletes the upgrade fields proposed by the counterparty of the given connection.
+func (k Keeper) deleteCounterpartyUpgrade(ctx sdk.Context, connectionID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.CounterpartyUpgradeKey(connectionID))
+}
+
 // GetParams returns the total set of ibc-connection parameters.
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	store := ctx.KVStore(k.storeKey)
@@ -193,8 +193,10 @@ func (suite *KeeperTestSuite) TestSetAndGetParams() {
 		expPass bool
 	}{
 		{"success: set default params", types.DefaultParams(), true},
-		{"success: valid value for MaxExpectedTimePerBlock", types.NewParams(10), true},
-		{"failure: invalid value for MaxExpectedTimePerBlock", types.NewParams(0), false},
+		{"success: valid value for MaxExpectedTimePerBlock", types.NewParams(10, uint64(types.DefaultUpgradeTimeout), false), true},
+		{"success: counterparty upgrades allowed", types.NewParams(10, uint64(types.DefaultUpgradeTimeout), true), true},
+		{"failure: invalid value for MaxExpectedTimePerBlock", types.NewParams(0, uint64(types.DefaultUpgradeTimeout), false), false},
+		{"failure: invalid value for UpgradeTimeout", types.NewParams(10, 0, false), false},
 	}
 
 	for _, tc := range testCases {
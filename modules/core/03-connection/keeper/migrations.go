@@ -28,7 +28,7 @@ func (m Migrator) Migrate3to4(ctx sdk.Context) error {
 // This migration takes the parameters that are currently stored and managed by x/params
 // and stores them directly in the ibc module's state.
 func (m Migrator) MigrateParams(ctx sdk.Context) error {
-	var params types.Params
+	params := types.DefaultParams()
 	m.keeper.legacySubspace.GetParamSet(ctx, &params)
 	if err := params.Validate(); err != nil {
 		return err
@@ -38,3 +38,16 @@ func (m Migrator) MigrateParams(ctx sdk.Context) error {
 	m.keeper.Logger(ctx).Info("successfully migrated connection to self-manage params")
 	return nil
 }
+
+// Migrate6to7 migrates from consensus version 6 to 7.
+// This migration sets the connection upgrade timeout parameter to its default value. Connection
+// upgrades initiated by the counterparty remain disallowed until enabled by the authority.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	if params.UpgradeTimeout == 0 {
+		params.UpgradeTimeout = uint64(types.DefaultUpgradeTimeout)
+	}
+
+	m.keeper.SetParams(ctx, params)
+	return nil
+}
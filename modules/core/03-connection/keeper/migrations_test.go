@@ -41,3 +41,18 @@ func (suite *KeeperTestSuite) TestMigrateParams() {
 		})
 	}
 }
+
+// TestMigrate6to7 tests that the connection upgrade params are set to their default values
+func (suite *KeeperTestSuite) TestMigrate6to7() {
+	ctx := suite.chainA.GetContext()
+	connectionKeeper := suite.chainA.GetSimApp().IBCKeeper.ConnectionKeeper
+
+	connectionKeeper.SetParams(ctx, types.Params{MaxExpectedTimePerBlock: uint64(types.DefaultTimePerBlock)})
+
+	migrator := keeper.NewMigrator(connectionKeeper)
+	err := migrator.Migrate6to7(ctx)
+	suite.Require().NoError(err)
+
+	params := connectionKeeper.GetParams(ctx)
+	suite.Require().Equal(types.DefaultParams(), params)
+}
@@ -0,0 +1,363 @@
+package keeper
+
+import (
+	"bytes"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// ConnUpgradeInit initializes a connection upgrade on chain A. The connection upgrade sequence is
+// incremented and the proposed upgrade fields are stored on the connection end. Any previously
+// proposed upgrade is replaced. The connection remains OPEN for the duration of the upgrade handshake.
+func (k Keeper) ConnUpgradeInit(ctx sdk.Context, connectionID string, upgradeFields types.ConnectionUpgradeFields) (uint64, error) {
+	connection, found := k.GetConnection(ctx, connectionID)
+	if !found {
+		return 0, errorsmod.Wrap(types.ErrConnectionNotFound, connectionID)
+	}
+
+	if connection.State != types.OPEN {
+		return 0, errorsmod.Wrapf(types.ErrInvalidConnectionState, "expected %s, got %s", types.OPEN, connection.State)
+	}
+
+	if upgradeFields.TimeoutTimestamp != 0 {
+		return 0, errorsmod.Wrap(types.ErrInvalidUpgrade, "upgrade timeout is set by the counterparty in ConnUpgradeTry")
+	}
+
+	if err := k.validateUpgradeFields(connection, upgradeFields); err != nil {
+		return 0, err
+	}
+
+	connection.UpgradeSequence++
+	connection.ProposedUpgrade = &upgradeFields
+	k.SetConnection(ctx, connectionID, connection)
+
+	k.Logger(ctx).Info("connection upgrade init succeeded", "connection-id", connectionID, "upgrade-sequence", connection.UpgradeSequence)
+
+	defer telemetry.IncrCounter(1, "ibc", "connection", "upgrade-init")
+
+	emitConnectionUpgradeInitEvent(ctx, connectionID, connection)
+
+	return connection.UpgradeSequence, nil
+}
+
+// ConnUpgradeTry agrees to the connection upgrade proposed by the counterparty on chain B. The
+// counterparty connection end is verified to contain the proposed upgrade fields at the given
+// upgrade sequence. If no upgrade was initialized on chain B, the counterparty versions and delay
+// period are adopted if the connection params allow upgrades initiated by the counterparty. The
+// current counterparty prefix is then retained, as the counterparty only proposes the prefix it
+// stores for chain B. The upgrade times out after the upgrade timeout param if it is not applied
+// by the counterparty.
+func (k Keeper) ConnUpgradeTry(
+	ctx sdk.Context,
+	connectionID string,
+	counterpartyUpgradeFields types.ConnectionUpgradeFields,
+	counterpartyUpgradeSequence uint64,
+	connectionProof []byte,
+	proofHeight exported.Height,
+) (uint64, error) {
+	connection, found := k.GetConnection(ctx, connectionID)
+	if !found {
+		return 0, errorsmod.Wrap(types.ErrConnectionNotFound, connectionID)
+	}
+
+	if connection.State != types.OPEN {
+		return 0, errorsmod.Wrapf(types.ErrInvalidConnectionState, "expected %s, got %s", types.OPEN, connection.State)
+	}
+
+	if counterpartyUpgradeSequence < connection.UpgradeSequence {
+		return 0, errorsmod.Wrapf(types.ErrInvalidUpgradeSequence, "counterparty upgrade sequence (%d) is less than the upgrade sequence (%d)", counterpartyUpgradeSequence, connection.UpgradeSequence)
+	}
+
+	expectedConnection := k.expectedCounterpartyConnection(connectionID, connection, connection.Versions, connection.DelayPeriod)
+	expectedConnection.UpgradeSequence = counterpartyUpgradeSequence
+	expectedConnection.ProposedUpgrade = &counterpartyUpgradeFields
+
+	if err := k.VerifyConnectionState(
+		ctx, connection, proofHeight, connectionProof, connection.Counterparty.ConnectionId,
+		expectedConnection,
+	); err != nil {
+		return 0, err
+	}
+
+	// a proposed upgrade at the same upgrade sequence indicates both ends initialized an upgrade (crossing hellos)
+	params := k.GetParams(ctx)
+	proposedUpgrade := types.NewConnectionUpgradeFields(counterpartyUpgradeFields.Versions, counterpartyUpgradeFields.DelayPeriod, connection.Counterparty.Prefix)
+	if connection.HasUpgrade() && connection.UpgradeSequence == counterpartyUpgradeSequence {
+		proposedUpgrade = *connection.ProposedUpgrade
+		if !proposedUpgrade.IsCompatible(counterpartyUpgradeFields) {
+			return 0, errorsmod.Wrapf(types.ErrIncompatibleUpgrade, "proposed upgrade (%s) is incompatible with counterparty upgrade (%s)", &proposedUpgrade, &counterpartyUpgradeFields)
+		}
+	} else if !params.AllowCounterpartyUpgrades {
+		return 0, errorsmod.Wrapf(types.ErrUpgradeNotPermitted, "connection upgrades initiated by the counterparty are not allowed, an upgrade must be initialized for connection %s", connectionID)
+	}
+
+	if err := k.validateUpgradeFields(connection, proposedUpgrade); err != nil {
+		return 0, err
+	}
+
+	proposedUpgrade.TimeoutTimestamp = uint64(ctx.BlockTime().UnixNano()) + params.UpgradeTimeout
+
+	connection.UpgradeSequence = counterpartyUpgradeSequence
+	connection.ProposedUpgrade = &proposedUpgrade
+	k.SetConnection(ctx, connectionID, connection)
+	k.SetCounterpartyUpgrade(ctx, connectionID, counterpartyUpgradeFields)
+
+	k.Logger(ctx).Info("connection upgrade try succeeded", "connection-id", connectionID, "upgrade-sequence", connection.UpgradeSequence)
+
+	defer telemetry.IncrCounter(1, "ibc", "connection", "upgrade-try")
+
+	emitConnectionUpgradeTryEvent(ctx, connectionID, connection)
+
+	return connection.UpgradeSequence, nil
+}
+
+// ConnUpgradeAck is called on chain A once chain B has agreed to the connection upgrade. The
+// counterparty connection end is verified to contain a compatible upgrade at the same upgrade
+// sequence, after which the proposed upgrade is applied to the connection end on chain A. The
+// upgrade can no longer be applied once the timeout set by chain B in ConnUpgradeTry has passed.
+func (k Keeper) ConnUpgradeAck(
+	ctx sdk.Context,
+	connectionID string,
+	counterpartyUpgradeFields types.ConnectionUpgradeFields,
+	connectionProof []byte,
+	proofHeight exported.Height,
+) error {
+	connection, found := k.GetConnection(ctx, connectionID)
+	if !found {
+		return errorsmod.Wrap(types.ErrConnectionNotFound, connectionID)
+	}
+
+	if connection.State != types.OPEN {
+		return errorsmod.Wrapf(types.ErrInvalidConnectionState, "expected %s, got %s", types.OPEN, connection.State)
+	}
+
+	if !connection.HasUpgrade() {
+		return errorsmod.Wrapf(types.ErrUpgradeNotFound, "connection upgrade not found for connection %s", connectionID)
+	}
+
+	expectedConnection := k.expectedCounterpartyConnection(connectionID, connection, connection.Versions, connection.DelayPeriod)
+	expectedConnection.UpgradeSequence = connection.UpgradeSequence
+	expectedConnection.ProposedUpgrade = &counterpartyUpgradeFields
+
+	if err := k.VerifyConnectionState(
+		ctx, connection, proofHeight, connectionProof, connection.Counterparty.ConnectionId,
+		expectedConnection,
+	); err != nil {
+		return err
+	}
+
+	if !connection.ProposedUpgrade.IsCompatible(counterpartyUpgradeFields) {
+		return errorsmod.Wrapf(types.ErrIncompatibleUpgrade, "proposed upgrade (%s) is incompatible with counterparty upgrade (%s)", connection.ProposedUpgrade, &counterpartyUpgradeFields)
+	}
+
+	if counterpartyUpgradeFields.TimeoutTimestamp == 0 {
+		return errorsmod.Wrap(types.ErrInvalidUpgrade, "counterparty has not agreed to the upgrade in ConnUpgradeTry")
+	}
+
+	if blockTime := uint64(ctx.BlockTime().UnixNano()); blockTime >= counterpartyUpgradeFields.TimeoutTimestamp {
+		return errorsmod.Wrapf(types.ErrUpgradeTimeout, "block timestamp (%d) >= upgrade timeout timestamp (%d)", blockTime, counterpartyUpgradeFields.TimeoutTimestamp)
+	}
+
+	connection.ApplyUpgrade()
+	k.SetConnection(ctx, connectionID, connection)
+	k.deleteCounterpartyUpgrade(ctx, connectionID)
+
+	k.Logger(ctx).Info("connection upgrade ack succeeded", "connection-id", connectionID, "upgrade-sequence", connection.UpgradeSequence)
+
+	defer telemetry.IncrCounter(1, "ibc", "connection", "upgrade-ack")
+
+	emitConnectionUpgradeAckEvent(ctx, connectionID, connection)
+
+	return nil
+}
+
+// ConnUpgradeConfirm is called on chain B once the connection upgrade has been applied on chain A.
+// The counterparty connection end is verified to have applied the agreed upgrade at the same
+// upgrade sequence, including the counterparty prefix it proposed in the upgrade agreed to in
+// ConnUpgradeTry, after which the proposed upgrade is applied to the connection end on chain B.
+// The upgrade may be confirmed after its timeout as chain A has already applied it.
+func (k Keeper) ConnUpgradeConfirm(
+	ctx sdk.Context,
+	connectionID string,
+	connectionProof []byte,
+	proofHeight exported.Height,
+) error {
+	connection, found := k.GetConnection(ctx, connectionID)
+	if !found {
+		return errorsmod.Wrap(types.ErrConnectionNotFound, connectionID)
+	}
+
+	if connection.State != types.OPEN {
+		return errorsmod.Wrapf(types.ErrInvalidConnectionState, "expected %s, got %s", types.OPEN, connection.State)
+	}
+
+	if !connection.HasUpgrade() {
+		return errorsmod.Wrapf(types.ErrUpgradeNotFound, "connection upgrade not found for connection %s", connectionID)
+	}
+
+	counterpartyUpgradeFields, found := k.GetCounterpartyUpgrade(ctx, connectionID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrUpgradeNotFound, "counterparty upgrade not found for connection %s", connectionID)
+	}
+
+	expectedConnection := k.expectedUpgradedCounterpartyConnection(connectionID, connection, counterpartyUpgradeFields)
+	expectedConnection.UpgradeSequence = connection.UpgradeSequence
+
+	if err := k.VerifyConnectionState(
+		ctx, connection, proofHeight, connectionProof, connection.Counterparty.ConnectionId,
+		expectedConnection,
+	); err != nil {
+		return err
+	}
+
+	connection.ApplyUpgrade()
+	k.SetConnection(ctx, connectionID, connection)
+	k.deleteCounterpartyUpgrade(ctx, connectionID)
+
+	k.Logger(ctx).Info("connection upgrade confirm succeeded", "connection-id", connectionID, "upgrade-sequence", connection.UpgradeSequence)
+
+	defer telemetry.IncrCounter(1, "ibc", "connection", "upgrade-confirm")
+
+	emitConnectionUpgradeConfirmEvent(ctx, connectionID, connection)
+
+	return nil
+}
+
+// ConnUpgradeCancel abandons the connection upgrade in progress on the connection end. The upgrade
+// sequence is retained so that a subsequent upgrade handshake uses a new upgrade sequence.
+func (k Keeper) ConnUpgradeCancel(ctx sdk.Context, connectionID string) error {
+	connection, found := k.GetConnection(ctx, connectionID)
+	if !found {
+		return errorsmod.Wrap(types.ErrConnectionNotFound, connectionID)
+	}
+
+	if !connection.HasUpgrade() {
+		return errorsmod.Wrapf(types.ErrUpgradeNotFound, "connection upgrade not found for connection %s", connectionID)
+	}
+
+	connection.ProposedUpgrade = nil
+	k.SetConnection(ctx, connectionID, connection)
+	k.deleteCounterpartyUpgrade(ctx, connectionID)
+
+	k.Logger(ctx).Info("connection upgrade cancelled", "connection-id", connectionID, "upgrade-sequence", connection.UpgradeSequence)
+
+	defer telemetry.IncrCounter(1, "ibc", "connection", "upgrade-cancel")
+
+	emitConnectionUpgradeCancelEvent(ctx, connectionID, connection)
+
+	return nil
+}
+
+// ConnUpgradeTimeout abandons the connection upgrade in progress on the connection end once it has
+// timed out. The timeout set in ConnUpgradeTry on this connection end is used, or else the one set
+// on the counterparty. The counterparty connection end is verified, at a height whose consensus state
+// timestamp is at or after the timeout, to have not applied the upgrade at the same upgrade sequence.
+func (k Keeper) ConnUpgradeTimeout(
+	ctx sdk.Context,
+	connectionID string,
+	counterpartyConnection types.ConnectionEnd,
+	connectionProof []byte,
+	proofHeight exported.Height,
+) error {
+	connection, found := k.GetConnection(ctx, connectionID)
+	if !found {
+		return errorsmod.Wrap(types.ErrConnectionNotFound, connectionID)
+	}
+
+	if !connection.HasUpgrade() {
+		return errorsmod.Wrapf(types.ErrUpgradeNotFound, "connection upgrade not found for connection %s", connectionID)
+	}
+
+	if counterpartyConnection.UpgradeSequence != connection.UpgradeSequence {
+		return errorsmod.Wrapf(types.ErrInvalidUpgradeSequence, "counterparty upgrade sequence (%d) does not match the upgrade sequence (%d)", counterpartyConnection.UpgradeSequence, connection.UpgradeSequence)
+	}
+
+	// the counterparty can only have applied the upgrade once it has been agreed to in ConnUpgradeTry
+	if counterpartyUpgradeFields, found := k.GetCounterpartyUpgrade(ctx, connectionID); found {
+		upgradedConnection := k.expectedUpgradedCounterpartyConnection(connectionID, connection, counterpartyUpgradeFields)
+		upgradedConnection.UpgradeSequence = connection.UpgradeSequence
+		if bytes.Equal(k.cdc.MustMarshal(&upgradedConnection), k.cdc.MustMarshal(&counterpartyConnection)) {
+			return errorsmod.Wrap(types.ErrUpgradeTimeoutFailed, "counterparty connection has applied the upgrade")
+		}
+	}
+
+	timeoutTimestamp := connection.ProposedUpgrade.TimeoutTimestamp
+	if timeoutTimestamp == 0 && counterpartyConnection.HasUpgrade() {
+		timeoutTimestamp = counterpartyConnection.ProposedUpgrade.TimeoutTimestamp
+	}
+
+	if timeoutTimestamp == 0 {
+		return errorsmod.Wrap(types.ErrUpgradeTimeoutFailed, "upgrade has not been agreed to in ConnUpgradeTry")
+	}
+
+	proofTimestamp, err := k.GetTimestampAtHeight(ctx, connection, proofHeight)
+	if err != nil {
+		return err
+	}
+
+	if proofTimestamp < timeoutTimestamp {
+		return errorsmod.Wrapf(types.ErrUpgradeTimeoutFailed, "proof timestamp (%d) < upgrade timeout timestamp (%d)", proofTimestamp, timeoutTimestamp)
+	}
+
+	if err := k.VerifyConnectionState(
+		ctx, connection, proofHeight, connectionProof, connection.Counterparty.ConnectionId,
+		counterpartyConnection,
+	); err != nil {
+		return err
+	}
+
+	connection.ProposedUpgrade = nil
+	k.SetConnection(ctx, connectionID, connection)
+	k.deleteCounterpartyUpgrade(ctx, connectionID)
+
+	k.Logger(ctx).Info("connection upgrade timed out", "connection-id", connectionID, "upgrade-sequence", connection.UpgradeSequence)
+
+	defer telemetry.IncrCounter(1, "ibc", "connection", "upgrade-timeout")
+
+	emitConnectionUpgradeTimeoutEvent(ctx, connectionID, connection)
+
+	return nil
+}
+
+// expectedCounterpartyConnection returns the OPEN connection end expected to be stored by the counterparty
+// with the provided versions and delay period.
+func (k Keeper) expectedCounterpartyConnection(connectionID string, connection types.ConnectionEnd, versions []*types.Version, delayPeriod uint64) types.ConnectionEnd {
+	prefix := k.GetCommitmentPrefix()
+	expectedCounterparty := types.NewCounterparty(connection.ClientId, connectionID, commitmenttypes.NewMerklePrefix(prefix.Bytes()))
+	return types.NewConnectionEnd(types.OPEN, connection.Counterparty.ClientId, expectedCounterparty, versions, delayPeriod)
+}
+
+// expectedUpgradedCounterpartyConnection returns the OPEN connection end expected to be stored by the
+// counterparty once it has applied the provided upgrade fields it proposed.
+func (k Keeper) expectedUpgradedCounterpartyConnection(connectionID string, connection types.ConnectionEnd, counterpartyUpgradeFields types.ConnectionUpgradeFields) types.ConnectionEnd {
+	expectedCounterparty := types.NewCounterparty(connection.ClientId, connectionID, counterpartyUpgradeFields.CounterpartyPrefix)
+	return types.NewConnectionEnd(types.OPEN, connection.Counterparty.ClientId, expectedCounterparty, counterpartyUpgradeFields.Versions, counterpartyUpgradeFields.DelayPeriod)
+}
+
+// validateUpgradeFields validates that the proposed upgrade fields are supported by this chain
+// and that they modify at least one field of the connection end.
+func (Keeper) validateUpgradeFields(connection types.ConnectionEnd, upgradeFields types.ConnectionUpgradeFields) error {
+	if err := upgradeFields.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "proposed upgrade fields are invalid")
+	}
+
+	for _, version := range upgradeFields.Versions {
+		if !types.IsSupportedVersion(types.GetCompatibleVersions(), version) {
+			return errorsmod.Wrapf(types.ErrInvalidVersion, "proposed version %s is not supported", version)
+		}
+	}
+
+	currentFields := types.NewConnectionUpgradeFields(connection.Versions, connection.DelayPeriod, connection.Counterparty.Prefix)
+	if currentFields.IsCompatible(upgradeFields) && bytes.Equal(currentFields.CounterpartyPrefix.KeyPrefix, upgradeFields.CounterpartyPrefix.KeyPrefix) {
+		return errorsmod.Wrap(types.ErrInvalidUpgrade, "proposed upgrade fields are identical to the current connection end")
+	}
+
+	return nil
+}
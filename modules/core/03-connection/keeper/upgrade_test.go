@@ -0,0 +1,745 @@
+package keeper_test
+
+import (
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+var upgradeDelayPeriod = uint64(time.Second.Nanoseconds())
+
+// proposedUpgradeFields returns upgrade fields for the given endpoint which only modify the delay period.
+func proposedUpgradeFields(endpoint *ibctesting.Endpoint) types.ConnectionUpgradeFields {
+	connection := endpoint.GetConnection()
+	return types.NewConnectionUpgradeFields(connection.Versions, upgradeDelayPeriod, connection.Counterparty.Prefix)
+}
+
+// setAllowCounterpartyUpgrades sets whether the given chain agrees to connection upgrades initiated by the counterparty.
+func setAllowCounterpartyUpgrades(chain *ibctesting.TestChain, allow bool) {
+	connectionKeeper := chain.App.GetIBCKeeper().ConnectionKeeper
+	params := connectionKeeper.GetParams(chain.GetContext())
+	params.AllowCounterpartyUpgrades = allow
+	connectionKeeper.SetParams(chain.GetContext(), params)
+}
+
+func (suite *KeeperTestSuite) TestConnUpgradeInit() {
+	var (
+		path          *ibctesting.Path
+		upgradeFields types.ConnectionUpgradeFields
+		expSequence   uint64
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: replaces existing upgrade",
+			func() {
+				_, err := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.ConnUpgradeInit(suite.chainA.GetContext(), path.EndpointA.ConnectionID, upgradeFields)
+				suite.Require().NoError(err)
+
+				upgradeFields.DelayPeriod++
+				expSequence = 2
+			},
+			nil,
+		},
+		{
+			"success: version with reduced feature set",
+			func() {
+				upgradeFields.Versions = []*types.Version{types.NewVersion(types.DefaultIBCVersionIdentifier, []string{"ORDER_ORDERED", "ORDER_UNORDERED"})}
+				upgradeFields.DelayPeriod = path.EndpointA.GetConnection().DelayPeriod
+			},
+			nil,
+		},
+		{
+			"connection not found",
+			func() {
+				path.EndpointA.ConnectionID = ibctesting.InvalidID
+			},
+			types.ErrConnectionNotFound,
+		},
+		{
+			"connection state is not OPEN",
+			func() {
+				connection := path.EndpointA.GetConnection()
+				connection.State = types.TRYOPEN
+				path.EndpointA.SetConnection(connection)
+			},
+			types.ErrInvalidConnectionState,
+		},
+		{
+			"unsupported version",
+			func() {
+				upgradeFields.Versions = []*types.Version{types.NewVersion("2", []string{"ORDER_ORDERED"})}
+			},
+			types.ErrInvalidVersion,
+		},
+		{
+			"empty counterparty prefix",
+			func() {
+				upgradeFields.CounterpartyPrefix = commitmenttypes.MerklePrefix{}
+			},
+			types.ErrInvalidCounterparty,
+		},
+		{
+			"upgrade fields are identical to the connection end",
+			func() {
+				upgradeFields.DelayPeriod = path.EndpointA.GetConnection().DelayPeriod
+			},
+			types.ErrInvalidUpgrade,
+		},
+		{
+			"upgrade timeout is set",
+			func() {
+				upgradeFields.TimeoutTimestamp = uint64(suite.chainA.GetContext().BlockTime().UnixNano())
+			},
+			types.ErrInvalidUpgrade,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupConnections()
+
+			upgradeFields = proposedUpgradeFields(path.EndpointA)
+			expSequence = 1
+
+			tc.malleate()
+
+			upgradeSequence, err := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.ConnUpgradeInit(suite.chainA.GetContext(), path.EndpointA.ConnectionID, upgradeFields)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expSequence, upgradeSequence)
+
+				connection := path.EndpointA.GetConnection()
+				suite.Require().Equal(expSequence, connection.UpgradeSequence)
+				suite.Require().Equal(&upgradeFields, connection.ProposedUpgrade)
+				suite.Require().Equal(types.OPEN, connection.State)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestConnUpgradeTry() {
+	var (
+		path                        *ibctesting.Path
+		counterpartyUpgradeFields   types.ConnectionUpgradeFields
+		counterpartyUpgradeSequence uint64
+		expPrefix                   commitmenttypes.MerklePrefix
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: crossing hellos",
+			func() {
+				// upgrades initialized by the authority are agreed to regardless of the params
+				setAllowCounterpartyUpgrades(suite.chainB, false)
+
+				upgradeFields := proposedUpgradeFields(path.EndpointB)
+				upgradeFields.CounterpartyPrefix = commitmenttypes.NewMerklePrefix([]byte("upgraded"))
+				_, err := suite.chainB.App.GetIBCKeeper().ConnectionKeeper.ConnUpgradeInit(suite.chainB.GetContext(), path.EndpointB.ConnectionID, upgradeFields)
+				suite.Require().NoError(err)
+
+				expPrefix = upgradeFields.CounterpartyPrefix
+			},
+			nil,
+		},
+		{
+			"success: stale upgrade is replaced",
+			func() {
+				upgradeFields := proposedUpgradeFields(path.EndpointB)
+				upgradeFields.DelayPeriod++
+				_, err := suite.chainB.App.GetIBCKeeper().ConnectionKeeper.ConnUpgradeInit(suite.chainB.GetContext(), path.EndpointB.ConnectionID, upgradeFields)
+				suite.Require().NoError(err)
+
+				// a new upgrade sequence on chainA supersedes the upgrade proposed on chainB
+				err = path.EndpointA.ConnUpgradeInit(proposedUpgradeFields(path.EndpointA))
+				suite.Require().NoError(err)
+
+				err = path.EndpointB.UpdateClient()
+				suite.Require().NoError(err)
+
+				counterpartyUpgradeSequence = path.EndpointA.GetConnection().UpgradeSequence
+			},
+			nil,
+		},
+		{
+			"connection not found",
+			func() {
+				path.EndpointB.ConnectionID = ibctesting.InvalidID
+			},
+			types.ErrConnectionNotFound,
+		},
+		{
+			"counterparty upgrade sequence is less than the upgrade sequence",
+			func() {
+				connection := path.EndpointB.GetConnection()
+				connection.UpgradeSequence = 5
+				path.EndpointB.SetConnection(connection)
+			},
+			types.ErrInvalidUpgradeSequence,
+		},
+		{
+			"crossing hellos with incompatible upgrades",
+			func() {
+				upgradeFields := proposedUpgradeFields(path.EndpointB)
+				upgradeFields.DelayPeriod++
+				_, err := suite.chainB.App.GetIBCKeeper().ConnectionKeeper.ConnUpgradeInit(suite.chainB.GetContext(), path.EndpointB.ConnectionID, upgradeFields)
+				suite.Require().NoError(err)
+			},
+			types.ErrIncompatibleUpgrade,
+		},
+		{
+			"counterparty upgrade fields do not match proof",
+			func() {
+				counterpartyUpgradeFields.DelayPeriod++
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"counterparty initiated upgrades are not permitted",
+			func() {
+				setAllowCounterpartyUpgrades(suite.chainB, false)
+			},
+			types.ErrUpgradeNotPermitted,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupConnections()
+			setAllowCounterpartyUpgrades(suite.chainB, true)
+
+			err := path.EndpointA.ConnUpgradeInit(proposedUpgradeFields(path.EndpointA))
+			suite.Require().NoError(err)
+
+			err = path.EndpointB.UpdateClient()
+			suite.Require().NoError(err)
+
+			connectionA := path.EndpointA.GetConnection()
+			counterpartyUpgradeFields = *connectionA.ProposedUpgrade
+			counterpartyUpgradeSequence = connectionA.UpgradeSequence
+			expPrefix = path.EndpointB.GetConnection().Counterparty.Prefix
+
+			tc.malleate()
+
+			connectionProof, proofHeight := suite.chainA.QueryProof(host.ConnectionKey(path.EndpointA.ConnectionID))
+
+			upgradeSequence, err := suite.chainB.App.GetIBCKeeper().ConnectionKeeper.ConnUpgradeTry(
+				suite.chainB.GetContext(), path.EndpointB.ConnectionID, counterpartyUpgradeFields, counterpartyUpgradeSequence,
+				connectionProof, proofHeight,
+			)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(counterpartyUpgradeSequence, upgradeSequence)
+
+				connection := path.EndpointB.GetConnection()
+				suite.Require().Equal(counterpartyUpgradeSequence, connection.UpgradeSequence)
+				suite.Require().NotNil(connection.ProposedUpgrade)
+				suite.Require().True(connection.ProposedUpgrade.IsCompatible(counterpartyUpgradeFields))
+				suite.Require().Equal(expPrefix, connection.ProposedUpgrade.CounterpartyPrefix)
+
+				expTimeout := uint64(suite.chainB.GetContext().BlockTime().UnixNano()) + suite.chainB.App.GetIBCKeeper().ConnectionKeeper.GetParams(suite.chainB.GetContext()).UpgradeTimeout
+				suite.Require().Equal(expTimeout, connection.ProposedUpgrade.TimeoutTimestamp)
+
+				storedUpgradeFields, found := suite.chainB.App.GetIBCKeeper().ConnectionKeeper.GetCounterpartyUpgrade(suite.chainB.GetContext(), path.EndpointB.ConnectionID)
+				suite.Require().True(found)
+				suite.Require().Equal(counterpartyUpgradeFields, storedUpgradeFields)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestConnUpgradeAck() {
+	var (
+		path                      *ibctesting.Path
+		counterpartyUpgradeFields types.ConnectionUpgradeFields
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"connection not found",
+			func() {
+				path.EndpointA.ConnectionID = ibctesting.InvalidID
+			},
+			types.ErrConnectionNotFound,
+		},
+		{
+			"connection upgrade not found",
+			func() {
+				err := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.ConnUpgradeCancel(suite.chainA.GetContext(), path.EndpointA.ConnectionID)
+				suite.Require().NoError(err)
+			},
+			types.ErrUpgradeNotFound,
+		},
+		{
+			"proposed upgrade is incompatible with counterparty upgrade",
+			func() {
+				connection := path.EndpointA.GetConnection()
+				connection.ProposedUpgrade.DelayPeriod++
+				path.EndpointA.SetConnection(connection)
+			},
+			types.ErrIncompatibleUpgrade,
+		},
+		{
+			"counterparty upgrade fields do not match proof",
+			func() {
+				counterpartyUpgradeFields.DelayPeriod++
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"upgrade timed out",
+			func() {
+				suite.coordinator.IncrementTimeBy(types.DefaultUpgradeTimeout)
+			},
+			types.ErrUpgradeTimeout,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupConnections()
+			setAllowCounterpartyUpgrades(suite.chainB, true)
+
+			err := path.EndpointA.ConnUpgradeInit(proposedUpgradeFields(path.EndpointA))
+			suite.Require().NoError(err)
+
+			err = path.EndpointB.ConnUpgradeTry()
+			suite.Require().NoError(err)
+
+			err = path.EndpointA.UpdateClient()
+			suite.Require().NoError(err)
+
+			counterpartyUpgradeFields = *path.EndpointB.GetConnection().ProposedUpgrade
+
+			tc.malleate()
+
+			connectionProof, proofHeight := suite.chainB.QueryProof(host.ConnectionKey(path.EndpointB.ConnectionID))
+
+			err = suite.chainA.App.GetIBCKeeper().ConnectionKeeper.ConnUpgradeAck(
+				suite.chainA.GetContext(), path.EndpointA.ConnectionID, counterpartyUpgradeFields, connectionProof, proofHeight,
+			)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+
+				connection := path.EndpointA.GetConnection()
+				suite.Require().Nil(connection.ProposedUpgrade)
+				suite.Require().Equal(upgradeDelayPeriod, connection.DelayPeriod)
+				suite.Require().Equal(uint64(1), connection.UpgradeSequence)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestConnUpgradeConfirm() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"connection not found",
+			func() {
+				path.EndpointB.ConnectionID = ibctesting.InvalidID
+			},
+			types.ErrConnectionNotFound,
+		},
+		{
+			"connection upgrade not found",
+			func() {
+				err := suite.chainB.App.GetIBCKeeper().ConnectionKeeper.ConnUpgradeCancel(suite.chainB.GetContext(), path.EndpointB.ConnectionID)
+				suite.Require().NoError(err)
+			},
+			types.ErrUpgradeNotFound,
+		},
+		{
+			"success: upgrade timed out after being applied by the counterparty",
+			func() {
+				suite.coordinator.IncrementTimeBy(types.DefaultUpgradeTimeout)
+			},
+			nil,
+		},
+		{
+			"counterparty upgrade not found",
+			func() {
+				// cancelling the upgrade deletes the counterparty upgrade, after which the upgrade is restored
+				connection := path.EndpointB.GetConnection()
+				err := suite.chainB.App.GetIBCKeeper().ConnectionKeeper.ConnUpgradeCancel(suite.chainB.GetContext(), path.EndpointB.ConnectionID)
+				suite.Require().NoError(err)
+
+				path.EndpointB.SetConnection(connection)
+			},
+			types.ErrUpgradeNotFound,
+		},
+		{
+			"counterparty has not applied the upgrade",
+			func() {
+				upgradeFields := proposedUpgradeFields(path.EndpointB)
+				upgradeFields.DelayPeriod++
+				suite.chainB.App.GetIBCKeeper().ConnectionKeeper.SetCounterpartyUpgrade(suite.chainB.GetContext(), path.EndpointB.ConnectionID, upgradeFields)
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupConnections()
+			setAllowCounterpartyUpgrades(suite.chainB, true)
+
+			err := path.EndpointA.ConnUpgradeInit(proposedUpgradeFields(path.EndpointA))
+			suite.Require().NoError(err)
+
+			err = path.EndpointB.ConnUpgradeTry()
+			suite.Require().NoError(err)
+
+			err = path.EndpointA.ConnUpgradeAck()
+			suite.Require().NoError(err)
+
+			err = path.EndpointB.UpdateClient()
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			connectionProof, proofHeight := suite.chainA.QueryProof(host.ConnectionKey(path.EndpointA.ConnectionID))
+
+			err = suite.chainB.App.GetIBCKeeper().ConnectionKeeper.ConnUpgradeConfirm(
+				suite.chainB.GetContext(), path.EndpointB.ConnectionID, connectionProof, proofHeight,
+			)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+
+				connection := path.EndpointB.GetConnection()
+				suite.Require().Nil(connection.ProposedUpgrade)
+				suite.Require().Equal(upgradeDelayPeriod, connection.DelayPeriod)
+				suite.Require().Equal(path.EndpointA.GetConnection().Versions, connection.Versions)
+
+				_, found := suite.chainB.App.GetIBCKeeper().ConnectionKeeper.GetCounterpartyUpgrade(suite.chainB.GetContext(), path.EndpointB.ConnectionID)
+				suite.Require().False(found)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+// TestConnUpgradeConfirmCounterpartyPrefix asserts that an upgrade of the counterparty prefix proposed by chainA
+// is confirmed on chainB against the prefix applied by chainA.
+func (suite *KeeperTestSuite) TestConnUpgradeConfirmCounterpartyPrefix() {
+	suite.SetupTest()
+
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupConnections()
+	setAllowCounterpartyUpgrades(suite.chainB, true)
+
+	upgradeFields := proposedUpgradeFields(path.EndpointA)
+	upgradeFields.CounterpartyPrefix = commitmenttypes.NewMerklePrefix([]byte("upgraded"))
+	prefixA := path.EndpointB.GetConnection().Counterparty.Prefix
+
+	suite.Require().NoError(path.EndpointA.ConnUpgradeInit(upgradeFields))
+	suite.Require().NoError(path.EndpointB.ConnUpgradeTry())
+	suite.Require().NoError(path.EndpointA.ConnUpgradeAck())
+	suite.Require().NoError(path.EndpointB.ConnUpgradeConfirm())
+
+	connectionA := path.EndpointA.GetConnection()
+	suite.Require().Nil(connectionA.ProposedUpgrade)
+	suite.Require().Equal(upgradeFields.CounterpartyPrefix, connectionA.Counterparty.Prefix)
+
+	connectionB := path.EndpointB.GetConnection()
+	suite.Require().Nil(connectionB.ProposedUpgrade)
+	suite.Require().Equal(upgradeDelayPeriod, connectionB.DelayPeriod)
+	suite.Require().Equal(prefixA, connectionB.Counterparty.Prefix)
+}
+
+func (suite *KeeperTestSuite) TestConnUpgradeTimeout() {
+	var (
+		path                   *ibctesting.Path
+		endpoint               *ibctesting.Endpoint
+		counterpartyConnection types.ConnectionEnd
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: timeout on the initiating chain",
+			func() {
+				endpoint = path.EndpointA
+				counterpartyConnection = path.EndpointB.GetConnection()
+			},
+			nil,
+		},
+		{
+			"success: upgrade cancelled on the counterparty",
+			func() {
+				err := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.ConnUpgradeCancel(suite.chainA.GetContext(), path.EndpointA.ConnectionID)
+				suite.Require().NoError(err)
+				suite.coordinator.CommitBlock(suite.chainA)
+
+				counterpartyConnection = path.EndpointA.GetConnection()
+			},
+			nil,
+		},
+		{
+			"connection not found",
+			func() {
+				path.EndpointB.ConnectionID = ibctesting.InvalidID
+			},
+			types.ErrConnectionNotFound,
+		},
+		{
+			"connection upgrade not found",
+			func() {
+				err := suite.chainB.App.GetIBCKeeper().ConnectionKeeper.ConnUpgradeCancel(suite.chainB.GetContext(), path.EndpointB.ConnectionID)
+				suite.Require().NoError(err)
+			},
+			types.ErrUpgradeNotFound,
+		},
+		{
+			"counterparty upgrade sequence does not match",
+			func() {
+				err := path.EndpointA.ConnUpgradeInit(proposedUpgradeFields(path.EndpointA))
+				suite.Require().NoError(err)
+
+				counterpartyConnection = path.EndpointA.GetConnection()
+			},
+			types.ErrInvalidUpgradeSequence,
+		},
+		{
+			"counterparty has applied the upgrade",
+			func() {
+				err := path.EndpointA.ConnUpgradeAck()
+				suite.Require().NoError(err)
+
+				counterpartyConnection = path.EndpointA.GetConnection()
+			},
+			types.ErrUpgradeTimeoutFailed,
+		},
+		{
+			"upgrade has not timed out",
+			func() {
+				suite.coordinator.IncrementTimeBy(-time.Minute)
+			},
+			types.ErrUpgradeTimeoutFailed,
+		},
+		{
+			"counterparty connection does not match proof",
+			func() {
+				counterpartyConnection.DelayPeriod++
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupConnections()
+			setAllowCounterpartyUpgrades(suite.chainB, true)
+			endpoint = path.EndpointB
+
+			err := path.EndpointA.ConnUpgradeInit(proposedUpgradeFields(path.EndpointA))
+			suite.Require().NoError(err)
+
+			err = path.EndpointB.ConnUpgradeTry()
+			suite.Require().NoError(err)
+
+			counterpartyConnection = path.EndpointA.GetConnection()
+
+			tc.malleate()
+
+			suite.coordinator.IncrementTimeBy(types.DefaultUpgradeTimeout)
+
+			err = endpoint.UpdateClient()
+			suite.Require().NoError(err)
+
+			connectionProof, proofHeight := endpoint.Counterparty.QueryProof(host.ConnectionKey(endpoint.Counterparty.ConnectionID))
+
+			err = endpoint.Chain.App.GetIBCKeeper().ConnectionKeeper.ConnUpgradeTimeout(
+				endpoint.Chain.GetContext(), endpoint.ConnectionID, counterpartyConnection, connectionProof, proofHeight,
+			)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+
+				connection := endpoint.GetConnection()
+				suite.Require().Nil(connection.ProposedUpgrade)
+				suite.Require().NotEqual(upgradeDelayPeriod, connection.DelayPeriod)
+				suite.Require().Equal(uint64(1), connection.UpgradeSequence)
+
+				_, found := endpoint.Chain.App.GetIBCKeeper().ConnectionKeeper.GetCounterpartyUpgrade(endpoint.Chain.GetContext(), endpoint.ConnectionID)
+				suite.Require().False(found)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestConnUpgradeCancel() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"connection not found",
+			func() {
+				path.EndpointA.ConnectionID = ibctesting.InvalidID
+			},
+			types.ErrConnectionNotFound,
+		},
+		{
+			"connection upgrade not found",
+			func() {
+				connection := path.EndpointA.GetConnection()
+				connection.ProposedUpgrade = nil
+				path.EndpointA.SetConnection(connection)
+			},
+			types.ErrUpgradeNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupConnections()
+
+			err := path.EndpointA.ConnUpgradeInit(proposedUpgradeFields(path.EndpointA))
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			err = suite.chainA.App.GetIBCKeeper().ConnectionKeeper.ConnUpgradeCancel(suite.chainA.GetContext(), path.EndpointA.ConnectionID)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+
+				connection := path.EndpointA.GetConnection()
+				suite.Require().Nil(connection.ProposedUpgrade)
+				suite.Require().Equal(uint64(1), connection.UpgradeSequence)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+// TestConnectionUpgradeHandshake performs a full connection upgrade handshake and asserts that packets
+// can be relayed over channels using the upgraded connection.
+func (suite *KeeperTestSuite) TestConnectionUpgradeHandshake() {
+	suite.SetupTest()
+
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.Setup()
+	setAllowCounterpartyUpgrades(suite.chainB, true)
+
+	suite.Require().NoError(path.EndpointA.ConnUpgradeInit(proposedUpgradeFields(path.EndpointA)))
+	suite.Require().NoError(path.EndpointB.ConnUpgradeTry())
+	suite.Require().NoError(path.EndpointA.ConnUpgradeAck())
+	suite.Require().NoError(path.EndpointB.ConnUpgradeConfirm())
+
+	for _, endpoint := range []*ibctesting.Endpoint{path.EndpointA, path.EndpointB} {
+		connection := endpoint.GetConnection()
+		suite.Require().Equal(types.OPEN, connection.State)
+		suite.Require().Equal(upgradeDelayPeriod, connection.DelayPeriod)
+		suite.Require().Equal(uint64(1), connection.UpgradeSequence)
+		suite.Require().Nil(connection.ProposedUpgrade)
+	}
+
+	sequence, err := path.EndpointA.SendPacket(clienttypes.NewHeight(1, 1000), 0, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+
+	packet := channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(1, 1000), 0)
+	suite.Require().NoError(path.RelayPacket(packet))
+}
@@ -357,7 +357,7 @@ func (suite *KeeperTestSuite) TestVerifyPacketCommitment() {
 
 			// set time per block param
 			if timePerBlock != 0 {
-				suite.chainB.App.GetIBCKeeper().ConnectionKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(timePerBlock))
+				suite.chainB.App.GetIBCKeeper().ConnectionKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(timePerBlock, uint64(types.DefaultUpgradeTimeout), false))
 			}
 
 			commitment := channeltypes.CommitPacket(suite.chainB.App.GetIBCKeeper().Codec(), packet)
@@ -459,7 +459,7 @@ func (suite *KeeperTestSuite) TestVerifyPacketAcknowledgement() {
 
 			// set time per block param
 			if timePerBlock != 0 {
-				suite.chainA.App.GetIBCKeeper().ConnectionKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(timePerBlock))
+				suite.chainA.App.GetIBCKeeper().ConnectionKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(timePerBlock, uint64(types.DefaultUpgradeTimeout), false))
 			}
 
 			err = suite.chainA.App.GetIBCKeeper().ConnectionKeeper.VerifyPacketAcknowledgement(
@@ -565,7 +565,7 @@ func (suite *KeeperTestSuite) TestVerifyPacketReceiptAbsence() {
 
 			// set time per block param
 			if timePerBlock != 0 {
-				suite.chainA.App.GetIBCKeeper().ConnectionKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(timePerBlock))
+				suite.chainA.App.GetIBCKeeper().ConnectionKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(timePerBlock, uint64(types.DefaultUpgradeTimeout), false))
 			}
 
 			err = suite.chainA.App.GetIBCKeeper().ConnectionKeeper.VerifyPacketReceiptAbsence(
@@ -582,6 +582,93 @@ func (suite *KeeperTestSuite) TestVerifyPacketReceiptAbsence() {
 	}
 }
 
+// TestVerifyPacketReceipt has chainA verify the packet receipt written on
+// channelB. The channels on chainA and chainB are fully opened and a packet
+// is sent from chainA to chainB and received.
+func (suite *KeeperTestSuite) TestVerifyPacketReceipt() {
+	var (
+		path       *ibctesting.Path
+		packet     channeltypes.Packet
+		receipt    []byte
+		heightDiff uint64
+	)
+
+	cases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"verification success", func() {}, true},
+		{"verification success: timeout receipt", func() {
+			suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetPacketTimeoutReceipt(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+			receipt = channeltypes.TimeoutReceipt
+		}, true},
+		{"client state not found - changed client ID", func() {
+			connection := path.EndpointA.GetConnection()
+			connection.ClientId = ibctesting.InvalidID
+			path.EndpointA.SetConnection(connection)
+		}, false},
+		{"consensus state not found - increased proof height", func() {
+			heightDiff = 5
+		}, false},
+		{"verification failed - receipt is different", func() {
+			receipt = channeltypes.TimeoutReceipt
+		}, false},
+		{"client status is not active - client is expired", func() {
+			clientState := path.EndpointA.GetClientState().(*ibctm.ClientState)
+			clientState.FrozenHeight = clienttypes.NewHeight(0, 1)
+			path.EndpointA.SetClientState(clientState)
+		}, false},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			sequence, err := path.EndpointA.SendPacket(defaultTimeoutHeight, 0, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+			packet = channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, 0)
+
+			err = path.EndpointB.RecvPacket(packet)
+			suite.Require().NoError(err)
+
+			// reset variables
+			heightDiff = 0
+			receipt = []byte{byte(1)}
+			tc.malleate()
+
+			connection := path.EndpointA.GetConnection()
+
+			clientState := path.EndpointA.GetClientState().(*ibctm.ClientState)
+			if clientState.FrozenHeight.IsZero() {
+				// need to update height to prove the receipt
+				suite.coordinator.CommitBlock(suite.chainA, suite.chainB)
+				err = path.EndpointA.UpdateClient()
+				suite.Require().NoError(err)
+			}
+
+			packetReceiptKey := host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+			proof, proofHeight := suite.chainB.QueryProof(packetReceiptKey)
+
+			err = suite.chainA.App.GetIBCKeeper().ConnectionKeeper.VerifyPacketReceipt(
+				suite.chainA.GetContext(), connection, malleateHeight(proofHeight, heightDiff), proof,
+				packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(), receipt,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 // TestVerifyNextSequenceRecv has chainA verify the next sequence receive on
 // channelB. The channels on chainA and chainB are fully opened and a packet
 // is sent from chainA to chainB and received.
@@ -662,7 +749,7 @@ func (suite *KeeperTestSuite) TestVerifyNextSequenceRecv() {
 
 			// set time per block param
 			if timePerBlock != 0 {
-				suite.chainA.App.GetIBCKeeper().ConnectionKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(timePerBlock))
+				suite.chainA.App.GetIBCKeeper().ConnectionKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(timePerBlock, uint64(types.DefaultUpgradeTimeout), false))
 			}
 
 			connection := path.EndpointA.GetConnection()
@@ -28,6 +28,12 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgConnectionOpenTry{},
 		&MsgConnectionOpenAck{},
 		&MsgConnectionOpenConfirm{},
+		&MsgConnectionUpgradeInit{},
+		&MsgConnectionUpgradeTry{},
+		&MsgConnectionUpgradeAck{},
+		&MsgConnectionUpgradeConfirm{},
+		&MsgConnectionUpgradeCancel{},
+		&MsgConnectionUpgradeTimeout{},
 		&MsgUpdateParams{},
 	)
 
@@ -62,6 +62,11 @@ func (c ConnectionEnd) ValidateBasic() error {
 			return err
 		}
 	}
+	if c.ProposedUpgrade != nil {
+		if err := c.ProposedUpgrade.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "invalid proposed upgrade")
+		}
+	}
 	return c.Counterparty.ValidateBasic()
 }
 
@@ -110,12 +115,14 @@ func (c Counterparty) ValidateBasic() error {
 // NewIdentifiedConnection creates a new IdentifiedConnection instance
 func NewIdentifiedConnection(connectionID string, conn ConnectionEnd) IdentifiedConnection {
 	return IdentifiedConnection{
-		Id:           connectionID,
-		ClientId:     conn.ClientId,
-		Versions:     conn.Versions,
-		State:        conn.State,
-		Counterparty: conn.Counterparty,
-		DelayPeriod:  conn.DelayPeriod,
+		Id:              connectionID,
+		ClientId:        conn.ClientId,
+		Versions:        conn.Versions,
+		State:           conn.State,
+		Counterparty:    conn.Counterparty,
+		DelayPeriod:     conn.DelayPeriod,
+		UpgradeSequence: conn.UpgradeSequence,
+		ProposedUpgrade: conn.ProposedUpgrade,
 	}
 }
 
@@ -125,5 +132,6 @@ func (ic IdentifiedConnection) ValidateBasic() error {
 		return errorsmod.Wrap(err, "invalid connection ID")
 	}
 	connection := NewConnectionEnd(ic.State, ic.ClientId, ic.Counterparty, ic.Versions, ic.DelayPeriod)
+	connection.ProposedUpgrade = ic.ProposedUpgrade
 	return connection.ValidateBasic()
 }
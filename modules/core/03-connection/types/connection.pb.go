@@ -80,6 +80,12 @@ type ConnectionEnd struct {
 	// packet-verification NOTE: delay period logic is only implemented by some
 	// clients.
 	DelayPeriod uint64 `protobuf:"varint,5,opt,name=delay_period,json=delayPeriod,proto3" json:"delay_period,omitempty"`
+	// upgrade sequence of the most recent connection upgrade handshake. It is
+	// incremented each time a connection upgrade is initialized.
+	UpgradeSequence uint64 `protobuf:"varint,6,opt,name=upgrade_sequence,json=upgradeSequence,proto3" json:"upgrade_sequence,omitempty"`
+	// connection upgrade currently in progress, if any. The proposed fields are
+	// only applied once the counterparty has agreed to the upgrade.
+	ProposedUpgrade *ConnectionUpgradeFields `protobuf:"bytes,7,opt,name=proposed_upgrade,json=proposedUpgrade,proto3" json:"proposed_upgrade,omitempty"`
 }
 
 func (m *ConnectionEnd) Reset()         { *m = ConnectionEnd{} }
@@ -115,6 +121,54 @@ func (m *ConnectionEnd) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ConnectionEnd proto.InternalMessageInfo
 
+// ConnectionUpgradeFields defines the fields of a connection end which may be
+// changed through a connection upgrade handshake.
+type ConnectionUpgradeFields struct {
+	// IBC versions to be used by the connection once the upgrade completes.
+	Versions []*Version `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	// delay period to be used by the connection once the upgrade completes.
+	DelayPeriod uint64 `protobuf:"varint,2,opt,name=delay_period,json=delayPeriod,proto3" json:"delay_period,omitempty"`
+	// commitment merkle prefix of the counterparty chain to be used once the
+	// upgrade completes.
+	CounterpartyPrefix types.MerklePrefix `protobuf:"bytes,3,opt,name=counterparty_prefix,json=counterpartyPrefix,proto3" json:"counterparty_prefix"`
+	// block timestamp (in nanoseconds) after which the upgrade times out. It is
+	// set by the connection end agreeing to the upgrade in ConnUpgradeTry.
+	TimeoutTimestamp uint64 `protobuf:"varint,4,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+}
+
+func (m *ConnectionUpgradeFields) Reset()         { *m = ConnectionUpgradeFields{} }
+func (m *ConnectionUpgradeFields) String() string { return proto.CompactTextString(m) }
+func (*ConnectionUpgradeFields) ProtoMessage()    {}
+func (*ConnectionUpgradeFields) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90572467c054e43a, []int{1}
+}
+func (m *ConnectionUpgradeFields) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConnectionUpgradeFields) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConnectionUpgradeFields.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConnectionUpgradeFields) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConnectionUpgradeFields.Merge(m, src)
+}
+func (m *ConnectionUpgradeFields) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConnectionUpgradeFields) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConnectionUpgradeFields.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConnectionUpgradeFields proto.InternalMessageInfo
+
 // IdentifiedConnection defines a connection with additional connection
 // identifier field.
 type IdentifiedConnection struct {
@@ -131,13 +185,17 @@ type IdentifiedConnection struct {
 	Counterparty Counterparty `protobuf:"bytes,5,opt,name=counterparty,proto3" json:"counterparty"`
 	// delay period associated with this connection.
 	DelayPeriod uint64 `protobuf:"varint,6,opt,name=delay_period,json=delayPeriod,proto3" json:"delay_period,omitempty"`
+	// upgrade sequence of the most recent connection upgrade handshake.
+	UpgradeSequence uint64 `protobuf:"varint,7,opt,name=upgrade_sequence,json=upgradeSequence,proto3" json:"upgrade_sequence,omitempty"`
+	// connection upgrade currently in progress, if any.
+	ProposedUpgrade *ConnectionUpgradeFields `protobuf:"bytes,8,opt,name=proposed_upgrade,json=proposedUpgrade,proto3" json:"proposed_upgrade,omitempty"`
 }
 
 func (m *IdentifiedConnection) Reset()         { *m = IdentifiedConnection{} }
 func (m *IdentifiedConnection) String() string { return proto.CompactTextString(m) }
 func (*IdentifiedConnection) ProtoMessage()    {}
 func (*IdentifiedConnection) Descriptor() ([]byte, []int) {
-	return fileDescriptor_90572467c054e43a, []int{1}
+	return fileDescriptor_90572467c054e43a, []int{2}
 }
 func (m *IdentifiedConnection) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -182,7 +240,7 @@ func (m *Counterparty) Reset()         { *m = Counterparty{} }
 func (m *Counterparty) String() string { return proto.CompactTextString(m) }
 func (*Counterparty) ProtoMessage()    {}
 func (*Counterparty) Descriptor() ([]byte, []int) {
-	return fileDescriptor_90572467c054e43a, []int{2}
+	return fileDescriptor_90572467c054e43a, []int{3}
 }
 func (m *Counterparty) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -221,7 +279,7 @@ func (m *ClientPaths) Reset()         { *m = ClientPaths{} }
 func (m *ClientPaths) String() string { return proto.CompactTextString(m) }
 func (*ClientPaths) ProtoMessage()    {}
 func (*ClientPaths) Descriptor() ([]byte, []int) {
-	return fileDescriptor_90572467c054e43a, []int{3}
+	return fileDescriptor_90572467c054e43a, []int{4}
 }
 func (m *ClientPaths) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -269,7 +327,7 @@ func (m *ConnectionPaths) Reset()         { *m = ConnectionPaths{} }
 func (m *ConnectionPaths) String() string { return proto.CompactTextString(m) }
 func (*ConnectionPaths) ProtoMessage()    {}
 func (*ConnectionPaths) Descriptor() ([]byte, []int) {
-	return fileDescriptor_90572467c054e43a, []int{4}
+	return fileDescriptor_90572467c054e43a, []int{5}
 }
 func (m *ConnectionPaths) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -325,7 +383,7 @@ func (m *Version) Reset()         { *m = Version{} }
 func (m *Version) String() string { return proto.CompactTextString(m) }
 func (*Version) ProtoMessage()    {}
 func (*Version) Descriptor() ([]byte, []int) {
-	return fileDescriptor_90572467c054e43a, []int{5}
+	return fileDescriptor_90572467c054e43a, []int{6}
 }
 func (m *Version) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -360,13 +418,19 @@ type Params struct {
 	// largest amount of time that the chain might reasonably take to produce the next block under normal operating
 	// conditions. A safe choice is 3-5x the expected time per block.
 	MaxExpectedTimePerBlock uint64 `protobuf:"varint,1,opt,name=max_expected_time_per_block,json=maxExpectedTimePerBlock,proto3" json:"max_expected_time_per_block,omitempty"`
+	// duration (in nanoseconds) after which a connection upgrade agreed to in ConnUpgradeTry times out if the
+	// counterparty has not applied it.
+	UpgradeTimeout uint64 `protobuf:"varint,2,opt,name=upgrade_timeout,json=upgradeTimeout,proto3" json:"upgrade_timeout,omitempty"`
+	// allow agreeing to connection upgrades initiated by the counterparty without a matching upgrade initiated by
+	// the authority.
+	AllowCounterpartyUpgrades bool `protobuf:"varint,3,opt,name=allow_counterparty_upgrades,json=allowCounterpartyUpgrades,proto3" json:"allow_counterparty_upgrades,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_90572467c054e43a, []int{6}
+	return fileDescriptor_90572467c054e43a, []int{7}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -402,9 +466,24 @@ func (m *Params) GetMaxExpectedTimePerBlock() uint64 {
 	return 0
 }
 
+func (m *Params) GetUpgradeTimeout() uint64 {
+	if m != nil {
+		return m.UpgradeTimeout
+	}
+	return 0
+}
+
+func (m *Params) GetAllowCounterpartyUpgrades() bool {
+	if m != nil {
+		return m.AllowCounterpartyUpgrades
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterEnum("ibc.core.connection.v1.State", State_name, State_value)
 	proto.RegisterType((*ConnectionEnd)(nil), "ibc.core.connection.v1.ConnectionEnd")
+	proto.RegisterType((*ConnectionUpgradeFields)(nil), "ibc.core.connection.v1.ConnectionUpgradeFields")
 	proto.RegisterType((*IdentifiedConnection)(nil), "ibc.core.connection.v1.IdentifiedConnection")
 	proto.RegisterType((*Counterparty)(nil), "ibc.core.connection.v1.Counterparty")
 	proto.RegisterType((*ClientPaths)(nil), "ibc.core.connection.v1.ClientPaths")
@@ -418,49 +497,59 @@ func init() {
 }
 
 var fileDescriptor_90572467c054e43a = []byte{
-	// 661 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x5d, 0x4b, 0xdb, 0x50,
-	0x18, 0x4e, 0xd2, 0xb4, 0xd6, 0xd3, 0xd6, 0xb9, 0x83, 0x6c, 0x21, 0xb2, 0x34, 0xd3, 0xc1, 0xca,
-	0xc0, 0x66, 0x2a, 0x8c, 0xb1, 0x79, 0x63, 0x6b, 0x84, 0xb0, 0xad, 0x0b, 0xb1, 0x0a, 0xf3, 0x26,
-	0xa4, 0xc9, 0xb1, 0x1e, 0x6c, 0x72, 0x42, 0x72, 0x5a, 0xea, 0x3f, 0x10, 0xaf, 0x76, 0x37, 0x36,
-	0x10, 0x06, 0xbb, 0xdf, 0xef, 0xf0, 0xd2, 0xcb, 0x5d, 0x8d, 0xa1, 0x7f, 0x64, 0xe4, 0xc3, 0x26,
-	0xca, 0xec, 0xc5, 0xb6, 0xbb, 0xf7, 0xe3, 0x79, 0x9e, 0xbc, 0x79, 0xde, 0x97, 0x03, 0x9e, 0xe2,
-	0x9e, 0xad, 0xd8, 0x24, 0x40, 0x8a, 0x4d, 0x3c, 0x0f, 0xd9, 0x14, 0x13, 0x4f, 0x19, 0xad, 0xe6,
-	0xb2, 0xa6, 0x1f, 0x10, 0x4a, 0xe0, 0x03, 0xdc, 0xb3, 0x9b, 0x11, 0xb0, 0x99, 0x6b, 0x8d, 0x56,
-	0xc5, 0x85, 0x3e, 0xe9, 0x93, 0x18, 0xa2, 0x44, 0x51, 0x82, 0x16, 0xf3, 0xb2, 0xae, 0x8b, 0xa9,
-	0x8b, 0x3c, 0x9a, 0xc8, 0x5e, 0x67, 0x09, 0x70, 0xe9, 0x13, 0x07, 0x6a, 0xed, 0x89, 0xa0, 0xea,
-	0x39, 0x70, 0x11, 0xcc, 0xda, 0x03, 0x8c, 0x3c, 0x6a, 0x62, 0x47, 0x60, 0x65, 0xb6, 0x31, 0x6b,
-	0x94, 0x93, 0x82, 0xe6, 0xc0, 0xd7, 0xa0, 0x3c, 0x42, 0x41, 0x88, 0x89, 0x17, 0x0a, 0x9c, 0x5c,
-	0x68, 0x54, 0xd6, 0xea, 0xcd, 0x3f, 0x0f, 0xd6, 0xdc, 0x4b, 0x70, 0xc6, 0x84, 0x00, 0xd7, 0x41,
-	0x31, 0xa4, 0x16, 0x45, 0x42, 0x41, 0x66, 0x1b, 0x73, 0x6b, 0x8f, 0xee, 0x62, 0xee, 0x44, 0x20,
-	0x23, 0xc1, 0xc2, 0x0e, 0xa8, 0xda, 0x64, 0xe8, 0x51, 0x14, 0xf8, 0x56, 0x40, 0x8f, 0x05, 0x5e,
-	0x66, 0x1b, 0x95, 0xb5, 0x27, 0x77, 0x71, 0xdb, 0x39, 0x6c, 0x8b, 0x3f, 0xff, 0x59, 0x67, 0x8c,
-	0x1b, 0x7c, 0xf8, 0x18, 0x54, 0x1d, 0x34, 0xb0, 0x8e, 0x4d, 0x1f, 0x05, 0x98, 0x38, 0x42, 0x51,
-	0x66, 0x1b, 0xbc, 0x51, 0x89, 0x6b, 0x7a, 0x5c, 0x7a, 0xc5, 0x9f, 0x7c, 0xad, 0x33, 0x4b, 0xdf,
-	0x39, 0xb0, 0xa0, 0x39, 0xc8, 0xa3, 0xf8, 0x00, 0x23, 0x27, 0xf3, 0x08, 0xce, 0x01, 0x6e, 0xe2,
-	0x0c, 0x87, 0x6f, 0x19, 0xc6, 0x4d, 0x31, 0xac, 0xf0, 0xd7, 0x86, 0xf1, 0xff, 0x60, 0x58, 0xf1,
-	0x3f, 0x1b, 0x56, 0xba, 0xcb, 0xb0, 0x2f, 0x2c, 0xa8, 0xe6, 0xd5, 0xa6, 0x5f, 0xd2, 0x32, 0xa8,
-	0x65, 0x83, 0x64, 0xce, 0x55, 0xb3, 0xa2, 0xe6, 0xc0, 0x16, 0x28, 0xf9, 0x01, 0x3a, 0xc0, 0xe3,
-	0xf8, 0x64, 0x6e, 0xfd, 0xc5, 0xe4, 0x92, 0x47, 0xab, 0xcd, 0x77, 0x28, 0x38, 0x1a, 0x20, 0x3d,
-	0xc6, 0xa6, 0x7f, 0x91, 0x32, 0xd3, 0xe1, 0x96, 0x41, 0xa5, 0x1d, 0x7f, 0x5a, 0xb7, 0xe8, 0x61,
-	0x08, 0x17, 0x40, 0xd1, 0x8f, 0x02, 0x81, 0x95, 0x0b, 0x8d, 0x59, 0x23, 0x49, 0x96, 0xb6, 0xc0,
-	0xbd, 0x6c, 0xcf, 0x09, 0x70, 0xea, 0x3f, 0x4c, 0x54, 0xb8, 0xbc, 0xca, 0x1b, 0x30, 0x93, 0xae,
-	0x12, 0x4a, 0x00, 0xe0, 0xeb, 0x13, 0x0a, 0x52, 0x7a, 0xae, 0x02, 0x45, 0x50, 0x3e, 0x40, 0x16,
-	0x1d, 0x06, 0xe8, 0x5a, 0x63, 0x92, 0xa7, 0x73, 0x6f, 0x83, 0x92, 0x6e, 0x05, 0x96, 0x1b, 0xc2,
-	0x0d, 0xb0, 0xe8, 0x5a, 0x63, 0x13, 0x8d, 0x7d, 0x64, 0x53, 0xe4, 0x98, 0x14, 0xbb, 0x28, 0xda,
-	0x89, 0xd9, 0x1b, 0x10, 0xfb, 0x28, 0x16, 0xe7, 0x8d, 0x87, 0xae, 0x35, 0x56, 0x53, 0x44, 0x17,
-	0xbb, 0x48, 0x47, 0x41, 0x2b, 0x6a, 0x3f, 0xfb, 0xcc, 0x82, 0x62, 0x7c, 0x26, 0xf0, 0x05, 0xa8,
-	0xef, 0x74, 0x37, 0xbb, 0xaa, 0xb9, 0xdb, 0xd1, 0x3a, 0x5a, 0x57, 0xdb, 0x7c, 0xab, 0xed, 0xab,
-	0x5b, 0xe6, 0x6e, 0x67, 0x47, 0x57, 0xdb, 0xda, 0xb6, 0xa6, 0x6e, 0xcd, 0x33, 0xe2, 0xfd, 0xd3,
-	0x33, 0xb9, 0x76, 0x03, 0x00, 0x05, 0x00, 0x12, 0x5e, 0x54, 0x9c, 0x67, 0xc5, 0xf2, 0xe9, 0x99,
-	0xcc, 0x47, 0x31, 0x94, 0x40, 0x2d, 0xe9, 0x74, 0x8d, 0x0f, 0xef, 0x75, 0xb5, 0x33, 0xcf, 0x89,
-	0x95, 0xd3, 0x33, 0x79, 0x26, 0x4d, 0x33, 0x66, 0xdc, 0x2c, 0x24, 0xcc, 0x28, 0x16, 0xf9, 0x93,
-	0x6f, 0x12, 0xd3, 0xda, 0x3b, 0xbf, 0x94, 0xd8, 0x8b, 0x4b, 0x89, 0xfd, 0x75, 0x29, 0xb1, 0x1f,
-	0xaf, 0x24, 0xe6, 0xe2, 0x4a, 0x62, 0x7e, 0x5c, 0x49, 0xcc, 0xfe, 0x46, 0x1f, 0xd3, 0xc3, 0x61,
-	0x2f, 0x5a, 0xb6, 0x62, 0x93, 0xd0, 0x25, 0xa1, 0x82, 0x7b, 0xf6, 0x4a, 0x9f, 0x28, 0xa3, 0x97,
-	0x8a, 0x4b, 0x9c, 0xe1, 0x00, 0x85, 0xc9, 0x33, 0xf7, 0x7c, 0x7d, 0x25, 0xf7, 0x80, 0xd2, 0x63,
-	0x1f, 0x85, 0xbd, 0x52, 0xfc, 0xc4, 0xad, 0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xa9, 0xb8, 0x93,
-	0xa8, 0x64, 0x05, 0x00, 0x00,
+	// 830 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xdd, 0x6e, 0xe3, 0x44,
+	0x14, 0x8e, 0x1d, 0x27, 0x4d, 0x4f, 0xfa, 0x93, 0x1d, 0x2a, 0xd6, 0xa4, 0xc2, 0x35, 0x5d, 0xa4,
+	0x0d, 0xa0, 0x8d, 0x69, 0x2b, 0x21, 0x04, 0x2b, 0xa4, 0x6d, 0x9a, 0x95, 0x2c, 0x20, 0x44, 0x4e,
+	0xba, 0x12, 0xe5, 0xc2, 0x72, 0xec, 0x69, 0x76, 0xb4, 0xb6, 0xc7, 0xd8, 0x93, 0x90, 0xbe, 0xc1,
+	0xd2, 0x2b, 0x6e, 0x41, 0x2a, 0x42, 0xe2, 0x01, 0xb8, 0xe2, 0x1d, 0xf6, 0x72, 0x2f, 0xb9, 0x42,
+	0xa8, 0x7d, 0x11, 0xe4, 0xf1, 0x24, 0x71, 0x76, 0x49, 0xb5, 0xec, 0xf6, 0xca, 0x73, 0xbe, 0xf3,
+	0x7d, 0xc7, 0x33, 0x73, 0xbe, 0x99, 0x81, 0xbb, 0x64, 0xe0, 0x1a, 0x2e, 0x8d, 0xb1, 0xe1, 0xd2,
+	0x30, 0xc4, 0x2e, 0x23, 0x34, 0x34, 0xc6, 0x7b, 0xb9, 0xa8, 0x19, 0xc5, 0x94, 0x51, 0xf4, 0x36,
+	0x19, 0xb8, 0xcd, 0x94, 0xd8, 0xcc, 0xa5, 0xc6, 0x7b, 0xf5, 0xad, 0x21, 0x1d, 0x52, 0x4e, 0x31,
+	0xd2, 0x51, 0xc6, 0xae, 0xe7, 0xcb, 0x06, 0x01, 0x61, 0x01, 0x0e, 0x59, 0x56, 0x76, 0x1a, 0x65,
+	0xc4, 0xdd, 0x5f, 0x8b, 0xb0, 0xde, 0x9a, 0x15, 0x6c, 0x87, 0x1e, 0xda, 0x86, 0x55, 0xd7, 0x27,
+	0x38, 0x64, 0x36, 0xf1, 0x54, 0x49, 0x97, 0x1a, 0xab, 0x56, 0x25, 0x03, 0x4c, 0x0f, 0x7d, 0x0e,
+	0x95, 0x31, 0x8e, 0x13, 0x42, 0xc3, 0x44, 0x95, 0xf5, 0x62, 0xa3, 0xba, 0xbf, 0xd3, 0xfc, 0xef,
+	0x89, 0x35, 0x1f, 0x65, 0x3c, 0x6b, 0x26, 0x40, 0x07, 0x50, 0x4a, 0x98, 0xc3, 0xb0, 0x5a, 0xd4,
+	0xa5, 0xc6, 0xc6, 0xfe, 0xbb, 0xcb, 0x94, 0xbd, 0x94, 0x64, 0x65, 0x5c, 0xd4, 0x81, 0x35, 0x97,
+	0x8e, 0x42, 0x86, 0xe3, 0xc8, 0x89, 0xd9, 0x99, 0xaa, 0xe8, 0x52, 0xa3, 0xba, 0xff, 0xfe, 0x32,
+	0x6d, 0x2b, 0xc7, 0x3d, 0x54, 0x9e, 0xfd, 0xbd, 0x53, 0xb0, 0x16, 0xf4, 0xe8, 0x3d, 0x58, 0xf3,
+	0xb0, 0xef, 0x9c, 0xd9, 0x11, 0x8e, 0x09, 0xf5, 0xd4, 0x92, 0x2e, 0x35, 0x14, 0xab, 0xca, 0xb1,
+	0x2e, 0x87, 0xd0, 0x07, 0x50, 0x1b, 0x45, 0xc3, 0xd8, 0xf1, 0xb0, 0x9d, 0xe0, 0xef, 0x47, 0x38,
+	0x74, 0xb1, 0x5a, 0xe6, 0xb4, 0x4d, 0x81, 0xf7, 0x04, 0x8c, 0x4e, 0xa0, 0x16, 0xc5, 0x34, 0xa2,
+	0x09, 0xf6, 0x6c, 0x91, 0x53, 0x57, 0xf8, 0x0c, 0x8d, 0xe5, 0x33, 0x9c, 0x46, 0xc7, 0x99, 0xe0,
+	0x21, 0xc1, 0xbe, 0x97, 0x58, 0x9b, 0xd3, 0x42, 0x02, 0xfe, 0x4c, 0x79, 0xfa, 0xdb, 0x4e, 0x61,
+	0xf7, 0x47, 0x19, 0x6e, 0x2f, 0x91, 0x2c, 0x74, 0x43, 0xfa, 0xbf, 0xdd, 0x78, 0x71, 0x23, 0xe4,
+	0x97, 0x37, 0xe2, 0x3b, 0x78, 0x2b, 0xbf, 0x77, 0x76, 0x14, 0xe3, 0x53, 0x32, 0x51, 0x8b, 0x2f,
+	0xb7, 0x60, 0xe6, 0xaa, 0xf1, 0x5e, 0xf3, 0x6b, 0x1c, 0x3f, 0xf1, 0x71, 0x97, 0x73, 0x45, 0x0b,
+	0x50, 0xbe, 0x4c, 0x96, 0x41, 0x1f, 0xc1, 0x2d, 0x46, 0x02, 0x4c, 0x47, 0xcc, 0x4e, 0xbf, 0x09,
+	0x73, 0x82, 0x88, 0x77, 0x57, 0xb1, 0x6a, 0x22, 0xd1, 0x9f, 0xe2, 0x62, 0x2f, 0xfe, 0x2c, 0xc2,
+	0x96, 0xe9, 0xe1, 0x90, 0x91, 0x53, 0x82, 0xbd, 0xf9, 0xae, 0xa0, 0x0d, 0x90, 0x67, 0x66, 0x95,
+	0xc9, 0x0b, 0x1e, 0x96, 0xaf, 0xf1, 0x70, 0xf1, 0xb5, 0x3d, 0xac, 0xbc, 0x81, 0x87, 0x4b, 0x37,
+	0xec, 0xe1, 0xf2, 0xab, 0x79, 0x78, 0xe5, 0xd5, 0x3d, 0x5c, 0xb9, 0x51, 0x0f, 0xff, 0x22, 0xc1,
+	0x5a, 0x7e, 0x51, 0xd7, 0xdf, 0x31, 0x77, 0x60, 0x7d, 0xfe, 0xb7, 0x79, 0x03, 0xd7, 0xe6, 0xa0,
+	0xe9, 0xa1, 0x43, 0x28, 0xbf, 0xb6, 0x1b, 0x85, 0x52, 0x4c, 0xee, 0x0e, 0x54, 0x5b, 0xfc, 0xd7,
+	0x5d, 0x87, 0x3d, 0x4e, 0xd0, 0x16, 0x94, 0xa2, 0x74, 0xc0, 0x0f, 0xd4, 0xaa, 0x95, 0x05, 0xbb,
+	0x47, 0xb0, 0x39, 0x5f, 0x73, 0x46, 0xbc, 0x76, 0x0d, 0xb3, 0x2a, 0x72, 0xbe, 0xca, 0x97, 0xb0,
+	0x22, 0x1c, 0x85, 0x34, 0x00, 0x32, 0x75, 0x72, 0x2c, 0xe4, 0x39, 0x04, 0xd5, 0xa1, 0x72, 0x8a,
+	0x1d, 0x36, 0x8a, 0xf1, 0xb4, 0xc6, 0x2c, 0x16, 0xf3, 0xfe, 0x43, 0x82, 0x72, 0xd7, 0x89, 0x9d,
+	0x20, 0x41, 0xf7, 0x61, 0x3b, 0x70, 0x26, 0x36, 0x9e, 0x44, 0xd8, 0x65, 0xd8, 0xe3, 0xe7, 0x29,
+	0xf5, 0x86, 0x3d, 0xf0, 0xa9, 0xfb, 0x84, 0x57, 0x57, 0xac, 0xdb, 0x81, 0x33, 0x69, 0x0b, 0x46,
+	0x7a, 0xb0, 0xba, 0x38, 0x3e, 0x4c, 0xd3, 0xe8, 0x2e, 0x4c, 0x2d, 0x61, 0x8b, 0x73, 0x27, 0xee,
+	0x82, 0x0d, 0x01, 0xf7, 0x33, 0x14, 0x7d, 0x01, 0xdb, 0x8e, 0xef, 0xd3, 0x1f, 0xec, 0x85, 0x4b,
+	0x41, 0x90, 0x12, 0xde, 0x88, 0x8a, 0xf5, 0x0e, 0xa7, 0xe4, 0xbb, 0x2d, 0xbc, 0x90, 0x7c, 0xf8,
+	0xb3, 0x04, 0x25, 0x7e, 0x2e, 0xd0, 0x27, 0xb0, 0xd3, 0xeb, 0x3f, 0xe8, 0xb7, 0xed, 0xe3, 0x8e,
+	0xd9, 0x31, 0xfb, 0xe6, 0x83, 0xaf, 0xcc, 0x93, 0xf6, 0x91, 0x7d, 0xdc, 0xe9, 0x75, 0xdb, 0x2d,
+	0xf3, 0xa1, 0xd9, 0x3e, 0xaa, 0x15, 0xea, 0xb7, 0xce, 0x2f, 0xf4, 0xf5, 0x05, 0x02, 0x52, 0x01,
+	0x32, 0x5d, 0x0a, 0xd6, 0xa4, 0x7a, 0xe5, 0xfc, 0x42, 0x57, 0xd2, 0x31, 0xd2, 0x60, 0x3d, 0xcb,
+	0xf4, 0xad, 0x6f, 0xbf, 0xe9, 0xb6, 0x3b, 0x35, 0xb9, 0x5e, 0x3d, 0xbf, 0xd0, 0x57, 0x44, 0x38,
+	0x57, 0xf2, 0x64, 0x31, 0x53, 0xa6, 0xe3, 0xba, 0xf2, 0xf4, 0x77, 0xad, 0x70, 0xf8, 0xe8, 0xd9,
+	0xa5, 0x26, 0x3d, 0xbf, 0xd4, 0xa4, 0x7f, 0x2e, 0x35, 0xe9, 0xa7, 0x2b, 0xad, 0xf0, 0xfc, 0x4a,
+	0x2b, 0xfc, 0x75, 0xa5, 0x15, 0x4e, 0xee, 0x0f, 0x09, 0x7b, 0x3c, 0x1a, 0xa4, 0xb6, 0x32, 0x5c,
+	0x9a, 0x04, 0x34, 0x31, 0xc8, 0xc0, 0xbd, 0x37, 0xa4, 0xc6, 0xf8, 0x53, 0x23, 0xa0, 0xde, 0xc8,
+	0xc7, 0x49, 0xf6, 0xd4, 0x7e, 0x7c, 0x70, 0x2f, 0xf7, 0x88, 0xb3, 0xb3, 0x08, 0x27, 0x83, 0x32,
+	0x7f, 0x66, 0x0f, 0xfe, 0x1d, 0x00, 0x18, 0xe1, 0x13, 0x13, 0xe8, 0x07, 0x00, 0x00,
 }
 
 func (m *ConnectionEnd) Marshal() (dAtA []byte, err error) {
@@ -483,6 +572,23 @@ func (m *ConnectionEnd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ProposedUpgrade != nil {
+		{
+			size, err := m.ProposedUpgrade.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintConnection(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.UpgradeSequence != 0 {
+		i = encodeVarintConnection(dAtA, i, uint64(m.UpgradeSequence))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.DelayPeriod != 0 {
 		i = encodeVarintConnection(dAtA, i, uint64(m.DelayPeriod))
 		i--
@@ -527,6 +633,63 @@ func (m *ConnectionEnd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ConnectionUpgradeFields) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConnectionUpgradeFields) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConnectionUpgradeFields) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintConnection(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.CounterpartyPrefix.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintConnection(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.DelayPeriod != 0 {
+		i = encodeVarintConnection(dAtA, i, uint64(m.DelayPeriod))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Versions) > 0 {
+		for iNdEx := len(m.Versions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Versions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintConnection(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *IdentifiedConnection) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -547,6 +710,23 @@ func (m *IdentifiedConnection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ProposedUpgrade != nil {
+		{
+			size, err := m.ProposedUpgrade.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintConnection(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.UpgradeSequence != 0 {
+		i = encodeVarintConnection(dAtA, i, uint64(m.UpgradeSequence))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.DelayPeriod != 0 {
 		i = encodeVarintConnection(dAtA, i, uint64(m.DelayPeriod))
 		i--
@@ -775,6 +955,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AllowCounterpartyUpgrades {
+		i--
+		if m.AllowCounterpartyUpgrades {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.UpgradeTimeout != 0 {
+		i = encodeVarintConnection(dAtA, i, uint64(m.UpgradeTimeout))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.MaxExpectedTimePerBlock != 0 {
 		i = encodeVarintConnection(dAtA, i, uint64(m.MaxExpectedTimePerBlock))
 		i--
@@ -818,6 +1013,36 @@ func (m *ConnectionEnd) Size() (n int) {
 	if m.DelayPeriod != 0 {
 		n += 1 + sovConnection(uint64(m.DelayPeriod))
 	}
+	if m.UpgradeSequence != 0 {
+		n += 1 + sovConnection(uint64(m.UpgradeSequence))
+	}
+	if m.ProposedUpgrade != nil {
+		l = m.ProposedUpgrade.Size()
+		n += 1 + l + sovConnection(uint64(l))
+	}
+	return n
+}
+
+func (m *ConnectionUpgradeFields) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Versions) > 0 {
+		for _, e := range m.Versions {
+			l = e.Size()
+			n += 1 + l + sovConnection(uint64(l))
+		}
+	}
+	if m.DelayPeriod != 0 {
+		n += 1 + sovConnection(uint64(m.DelayPeriod))
+	}
+	l = m.CounterpartyPrefix.Size()
+	n += 1 + l + sovConnection(uint64(l))
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovConnection(uint64(m.TimeoutTimestamp))
+	}
 	return n
 }
 
@@ -849,6 +1074,13 @@ func (m *IdentifiedConnection) Size() (n int) {
 	if m.DelayPeriod != 0 {
 		n += 1 + sovConnection(uint64(m.DelayPeriod))
 	}
+	if m.UpgradeSequence != 0 {
+		n += 1 + sovConnection(uint64(m.UpgradeSequence))
+	}
+	if m.ProposedUpgrade != nil {
+		l = m.ProposedUpgrade.Size()
+		n += 1 + l + sovConnection(uint64(l))
+	}
 	return n
 }
 
@@ -933,6 +1165,12 @@ func (m *Params) Size() (n int) {
 	if m.MaxExpectedTimePerBlock != 0 {
 		n += 1 + sovConnection(uint64(m.MaxExpectedTimePerBlock))
 	}
+	if m.UpgradeTimeout != 0 {
+		n += 1 + sovConnection(uint64(m.UpgradeTimeout))
+	}
+	if m.AllowCounterpartyUpgrades {
+		n += 2
+	}
 	return n
 }
 
@@ -1108,6 +1346,216 @@ func (m *ConnectionEnd) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeSequence", wireType)
+			}
+			m.UpgradeSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConnection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpgradeSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedUpgrade", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConnection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConnection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConnection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProposedUpgrade == nil {
+				m.ProposedUpgrade = &ConnectionUpgradeFields{}
+			}
+			if err := m.ProposedUpgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConnection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConnection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConnectionUpgradeFields) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConnection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConnectionUpgradeFields: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConnectionUpgradeFields: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConnection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConnection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConnection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Versions = append(m.Versions, &Version{})
+			if err := m.Versions[len(m.Versions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayPeriod", wireType)
+			}
+			m.DelayPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConnection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelayPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyPrefix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConnection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConnection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConnection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CounterpartyPrefix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConnection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipConnection(dAtA[iNdEx:])
@@ -1327,6 +1775,61 @@ func (m *IdentifiedConnection) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeSequence", wireType)
+			}
+			m.UpgradeSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConnection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpgradeSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedUpgrade", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConnection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConnection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConnection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProposedUpgrade == nil {
+				m.ProposedUpgrade = &ConnectionUpgradeFields{}
+			}
+			if err := m.ProposedUpgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipConnection(dAtA[iNdEx:])
@@ -1853,6 +2356,45 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeTimeout", wireType)
+			}
+			m.UpgradeTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConnection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpgradeTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowCounterpartyUpgrades", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConnection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowCounterpartyUpgrades = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipConnection(dAtA[iNdEx:])
@@ -16,4 +16,11 @@ var (
 	ErrInvalidVersion                = errorsmod.Register(SubModuleName, 9, "invalid connection version")
 	ErrVersionNegotiationFailed      = errorsmod.Register(SubModuleName, 10, "connection version negotiation failed")
 	ErrInvalidConnectionIdentifier   = errorsmod.Register(SubModuleName, 11, "invalid connection identifier")
+	ErrInvalidUpgrade                = errorsmod.Register(SubModuleName, 12, "invalid connection upgrade")
+	ErrUpgradeNotFound               = errorsmod.Register(SubModuleName, 13, "connection upgrade not found")
+	ErrInvalidUpgradeSequence        = errorsmod.Register(SubModuleName, 14, "invalid connection upgrade sequence")
+	ErrIncompatibleUpgrade           = errorsmod.Register(SubModuleName, 15, "incompatible connection upgrade")
+	ErrUpgradeTimeout                = errorsmod.Register(SubModuleName, 16, "connection upgrade timed out")
+	ErrUpgradeTimeoutFailed          = errorsmod.Register(SubModuleName, 17, "connection upgrade timeout failed")
+	ErrUpgradeNotPermitted           = errorsmod.Register(SubModuleName, 18, "connection upgrade not permitted")
 )
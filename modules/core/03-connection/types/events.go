@@ -12,6 +12,9 @@ const (
 	AttributeKeyClientID                 = "client_id"
 	AttributeKeyCounterpartyClientID     = "counterparty_client_id"
 	AttributeKeyCounterpartyConnectionID = "counterparty_connection_id"
+	AttributeKeyUpgradeSequence          = "upgrade_sequence"
+	AttributeKeyUpgradeVersions          = "upgrade_versions"
+	AttributeKeyUpgradeDelayPeriod       = "upgrade_delay_period"
 )
 
 // IBC connection events vars
@@ -21,5 +24,12 @@ var (
 	EventTypeConnectionOpenAck     = "connection_open_ack"
 	EventTypeConnectionOpenConfirm = "connection_open_confirm"
 
+	EventTypeConnectionUpgradeInit    = "connection_upgrade_init"
+	EventTypeConnectionUpgradeTry     = "connection_upgrade_try"
+	EventTypeConnectionUpgradeAck     = "connection_upgrade_ack"
+	EventTypeConnectionUpgradeConfirm = "connection_upgrade_confirm"
+	EventTypeConnectionUpgradeCancel  = "connection_upgrade_cancel"
+	EventTypeConnectionUpgradeTimeout = "connection_upgrade_timeout"
+
 	AttributeValueCategory = fmt.Sprintf("%s_%s", ibcexported.ModuleName, SubModuleName)
 )
@@ -7,6 +7,7 @@ import (
 
 	"github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 )
 
@@ -35,6 +36,18 @@ func TestValidateGenesis(t *testing.T) {
 			),
 			expPass: true,
 		},
+		{
+			name: "valid genesis with localhost connection",
+			genState: types.NewGenesisState(
+				[]types.IdentifiedConnection{
+					types.NewIdentifiedConnection(exported.LocalhostConnectionID, types.NewConnectionEnd(types.OPEN, exported.LocalhostClientID, types.Counterparty{exported.LocalhostClientID, exported.LocalhostConnectionID, commitmenttypes.NewMerklePrefix([]byte("prefix"))}, []*types.Version{ibctesting.ConnectionVersion}, 0)),
+				},
+				[]types.ConnectionPaths{},
+				0,
+				types.DefaultParams(),
+			),
+			expPass: true,
+		},
 		{
 			name: "invalid connection",
 			genState: types.NewGenesisState(
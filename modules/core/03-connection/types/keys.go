@@ -31,8 +31,18 @@ const (
 
 	// ParamsKey is the store key for the IBC connection parameters
 	ParamsKey = "connectionParams"
+
+	// KeyCounterpartyUpgradePrefix is the prefix of the keys storing the upgrade fields proposed by the
+	// counterparty connection end during a connection upgrade handshake.
+	KeyCounterpartyUpgradePrefix = "counterpartyConnectionUpgrade"
 )
 
+// CounterpartyUpgradeKey returns the store key of the upgrade fields proposed by the counterparty of the
+// given connection.
+func CounterpartyUpgradeKey(connectionID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", KeyCounterpartyUpgradePrefix, connectionID))
+}
+
 // FormatConnectionIdentifier returns the connection identifier with the sequence appended.
 // This is a SDK specific format not enforced by IBC protocol.
 func FormatConnectionIdentifier(sequence uint64) string {
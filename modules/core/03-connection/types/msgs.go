@@ -18,12 +18,24 @@ var (
 	_ sdk.Msg = (*MsgConnectionOpenConfirm)(nil)
 	_ sdk.Msg = (*MsgConnectionOpenAck)(nil)
 	_ sdk.Msg = (*MsgConnectionOpenTry)(nil)
+	_ sdk.Msg = (*MsgConnectionUpgradeInit)(nil)
+	_ sdk.Msg = (*MsgConnectionUpgradeTry)(nil)
+	_ sdk.Msg = (*MsgConnectionUpgradeAck)(nil)
+	_ sdk.Msg = (*MsgConnectionUpgradeConfirm)(nil)
+	_ sdk.Msg = (*MsgConnectionUpgradeCancel)(nil)
+	_ sdk.Msg = (*MsgConnectionUpgradeTimeout)(nil)
 	_ sdk.Msg = (*MsgUpdateParams)(nil)
 
 	_ sdk.HasValidateBasic = (*MsgConnectionOpenInit)(nil)
 	_ sdk.HasValidateBasic = (*MsgConnectionOpenConfirm)(nil)
 	_ sdk.HasValidateBasic = (*MsgConnectionOpenAck)(nil)
 	_ sdk.HasValidateBasic = (*MsgConnectionOpenTry)(nil)
+	_ sdk.HasValidateBasic = (*MsgConnectionUpgradeInit)(nil)
+	_ sdk.HasValidateBasic = (*MsgConnectionUpgradeTry)(nil)
+	_ sdk.HasValidateBasic = (*MsgConnectionUpgradeAck)(nil)
+	_ sdk.HasValidateBasic = (*MsgConnectionUpgradeConfirm)(nil)
+	_ sdk.HasValidateBasic = (*MsgConnectionUpgradeCancel)(nil)
+	_ sdk.HasValidateBasic = (*MsgConnectionUpgradeTimeout)(nil)
 	_ sdk.HasValidateBasic = (*MsgUpdateParams)(nil)
 
 	_ codectypes.UnpackInterfacesMessage = (*MsgConnectionOpenTry)(nil)
@@ -254,6 +266,159 @@ func (msg MsgConnectionOpenConfirm) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgConnectionUpgradeInit creates a new MsgConnectionUpgradeInit instance
+func NewMsgConnectionUpgradeInit(connectionID string, upgradeFields ConnectionUpgradeFields, signer string) *MsgConnectionUpgradeInit {
+	return &MsgConnectionUpgradeInit{
+		ConnectionId: connectionID,
+		Fields:       upgradeFields,
+		Signer:       signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgConnectionUpgradeInit) ValidateBasic() error {
+	if !IsValidConnectionID(msg.ConnectionId) {
+		return ErrInvalidConnectionIdentifier
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return msg.Fields.ValidateBasic()
+}
+
+// NewMsgConnectionUpgradeTry creates a new MsgConnectionUpgradeTry instance
+func NewMsgConnectionUpgradeTry(
+	connectionID string, counterpartyUpgradeFields ConnectionUpgradeFields, counterpartyUpgradeSequence uint64,
+	connectionProof []byte, proofHeight clienttypes.Height, signer string,
+) *MsgConnectionUpgradeTry {
+	return &MsgConnectionUpgradeTry{
+		ConnectionId:                connectionID,
+		CounterpartyUpgradeFields:   counterpartyUpgradeFields,
+		CounterpartyUpgradeSequence: counterpartyUpgradeSequence,
+		ProofConnection:             connectionProof,
+		ProofHeight:                 proofHeight,
+		Signer:                      signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgConnectionUpgradeTry) ValidateBasic() error {
+	if !IsValidConnectionID(msg.ConnectionId) {
+		return ErrInvalidConnectionIdentifier
+	}
+	if msg.CounterpartyUpgradeSequence == 0 {
+		return errorsmod.Wrap(ErrInvalidUpgradeSequence, "counterparty upgrade sequence cannot be 0")
+	}
+	if len(msg.ProofConnection) == 0 {
+		return errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty connection proof")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return msg.CounterpartyUpgradeFields.ValidateBasic()
+}
+
+// NewMsgConnectionUpgradeAck creates a new MsgConnectionUpgradeAck instance
+func NewMsgConnectionUpgradeAck(
+	connectionID string, counterpartyUpgradeFields ConnectionUpgradeFields,
+	connectionProof []byte, proofHeight clienttypes.Height, signer string,
+) *MsgConnectionUpgradeAck {
+	return &MsgConnectionUpgradeAck{
+		ConnectionId:              connectionID,
+		CounterpartyUpgradeFields: counterpartyUpgradeFields,
+		ProofConnection:           connectionProof,
+		ProofHeight:               proofHeight,
+		Signer:                    signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgConnectionUpgradeAck) ValidateBasic() error {
+	if !IsValidConnectionID(msg.ConnectionId) {
+		return ErrInvalidConnectionIdentifier
+	}
+	if len(msg.ProofConnection) == 0 {
+		return errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty connection proof")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return msg.CounterpartyUpgradeFields.ValidateBasic()
+}
+
+// NewMsgConnectionUpgradeConfirm creates a new MsgConnectionUpgradeConfirm instance
+func NewMsgConnectionUpgradeConfirm(
+	connectionID string, connectionProof []byte, proofHeight clienttypes.Height, signer string,
+) *MsgConnectionUpgradeConfirm {
+	return &MsgConnectionUpgradeConfirm{
+		ConnectionId:    connectionID,
+		ProofConnection: connectionProof,
+		ProofHeight:     proofHeight,
+		Signer:          signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgConnectionUpgradeConfirm) ValidateBasic() error {
+	if !IsValidConnectionID(msg.ConnectionId) {
+		return ErrInvalidConnectionIdentifier
+	}
+	if len(msg.ProofConnection) == 0 {
+		return errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty connection proof")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// NewMsgConnectionUpgradeCancel creates a new MsgConnectionUpgradeCancel instance
+func NewMsgConnectionUpgradeCancel(connectionID string, signer string) *MsgConnectionUpgradeCancel {
+	return &MsgConnectionUpgradeCancel{
+		ConnectionId: connectionID,
+		Signer:       signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgConnectionUpgradeCancel) ValidateBasic() error {
+	if !IsValidConnectionID(msg.ConnectionId) {
+		return ErrInvalidConnectionIdentifier
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// NewMsgConnectionUpgradeTimeout creates a new MsgConnectionUpgradeTimeout instance
+func NewMsgConnectionUpgradeTimeout(
+	connectionID string, counterpartyConnection ConnectionEnd,
+	connectionProof []byte, proofHeight clienttypes.Height, signer string,
+) *MsgConnectionUpgradeTimeout {
+	return &MsgConnectionUpgradeTimeout{
+		ConnectionId:           connectionID,
+		CounterpartyConnection: counterpartyConnection,
+		ProofConnection:        connectionProof,
+		ProofHeight:            proofHeight,
+		Signer:                 signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgConnectionUpgradeTimeout) ValidateBasic() error {
+	if !IsValidConnectionID(msg.ConnectionId) {
+		return ErrInvalidConnectionIdentifier
+	}
+	if len(msg.ProofConnection) == 0 {
+		return errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty connection proof")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
 // NewMsgUpdateParams creates a new MsgUpdateParams instance
 func NewMsgUpdateParams(signer string, params Params) *MsgUpdateParams {
 	return &MsgUpdateParams{
@@ -246,6 +246,165 @@ func (suite *MsgTestSuite) TestNewMsgConnectionOpenConfirm() {
 	}
 }
 
+func (suite *MsgTestSuite) TestNewMsgConnectionUpgradeInit() {
+	upgradeFields := types.NewConnectionUpgradeFields(types.GetCompatibleVersions(), 500, suite.chainA.GetPrefix())
+	emptyVersions := types.NewConnectionUpgradeFields(nil, 500, suite.chainA.GetPrefix())
+	emptyPrefixFields := types.NewConnectionUpgradeFields(types.GetCompatibleVersions(), 500, emptyPrefix)
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgConnectionUpgradeInit
+		expPass bool
+	}{
+		{"invalid connection ID", types.NewMsgConnectionUpgradeInit("test/conn1", upgradeFields, signer), false},
+		{"empty versions", types.NewMsgConnectionUpgradeInit(connectionID, emptyVersions, signer), false},
+		{"empty counterparty prefix", types.NewMsgConnectionUpgradeInit(connectionID, emptyPrefixFields, signer), false},
+		{"empty signer", types.NewMsgConnectionUpgradeInit(connectionID, upgradeFields, ""), false},
+		{"success", types.NewMsgConnectionUpgradeInit(connectionID, upgradeFields, signer), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *MsgTestSuite) TestNewMsgConnectionUpgradeTry() {
+	upgradeFields := types.NewConnectionUpgradeFields(types.GetCompatibleVersions(), 500, suite.chainA.GetPrefix())
+	emptyVersions := types.NewConnectionUpgradeFields(nil, 500, suite.chainA.GetPrefix())
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgConnectionUpgradeTry
+		expPass bool
+	}{
+		{"invalid connection ID", types.NewMsgConnectionUpgradeTry("test/conn1", upgradeFields, 1, suite.proof, clientHeight, signer), false},
+		{"empty versions", types.NewMsgConnectionUpgradeTry(connectionID, emptyVersions, 1, suite.proof, clientHeight, signer), false},
+		{"zero upgrade sequence", types.NewMsgConnectionUpgradeTry(connectionID, upgradeFields, 0, suite.proof, clientHeight, signer), false},
+		{"empty proof", types.NewMsgConnectionUpgradeTry(connectionID, upgradeFields, 1, emptyProof, clientHeight, signer), false},
+		{"empty signer", types.NewMsgConnectionUpgradeTry(connectionID, upgradeFields, 1, suite.proof, clientHeight, ""), false},
+		{"success", types.NewMsgConnectionUpgradeTry(connectionID, upgradeFields, 1, suite.proof, clientHeight, signer), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *MsgTestSuite) TestNewMsgConnectionUpgradeAck() {
+	upgradeFields := types.NewConnectionUpgradeFields(types.GetCompatibleVersions(), 500, suite.chainA.GetPrefix())
+	emptyVersions := types.NewConnectionUpgradeFields(nil, 500, suite.chainA.GetPrefix())
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgConnectionUpgradeAck
+		expPass bool
+	}{
+		{"invalid connection ID", types.NewMsgConnectionUpgradeAck("test/conn1", upgradeFields, suite.proof, clientHeight, signer), false},
+		{"empty versions", types.NewMsgConnectionUpgradeAck(connectionID, emptyVersions, suite.proof, clientHeight, signer), false},
+		{"empty proof", types.NewMsgConnectionUpgradeAck(connectionID, upgradeFields, emptyProof, clientHeight, signer), false},
+		{"empty signer", types.NewMsgConnectionUpgradeAck(connectionID, upgradeFields, suite.proof, clientHeight, ""), false},
+		{"success", types.NewMsgConnectionUpgradeAck(connectionID, upgradeFields, suite.proof, clientHeight, signer), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *MsgTestSuite) TestNewMsgConnectionUpgradeConfirm() {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgConnectionUpgradeConfirm
+		expPass bool
+	}{
+		{"invalid connection ID", types.NewMsgConnectionUpgradeConfirm("test/conn1", suite.proof, clientHeight, signer), false},
+		{"empty proof", types.NewMsgConnectionUpgradeConfirm(connectionID, emptyProof, clientHeight, signer), false},
+		{"empty signer", types.NewMsgConnectionUpgradeConfirm(connectionID, suite.proof, clientHeight, ""), false},
+		{"success", types.NewMsgConnectionUpgradeConfirm(connectionID, suite.proof, clientHeight, signer), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *MsgTestSuite) TestNewMsgConnectionUpgradeCancel() {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgConnectionUpgradeCancel
+		expPass bool
+	}{
+		{"invalid connection ID", types.NewMsgConnectionUpgradeCancel("test/conn1", signer), false},
+		{"empty signer", types.NewMsgConnectionUpgradeCancel(connectionID, ""), false},
+		{"success", types.NewMsgConnectionUpgradeCancel(connectionID, signer), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *MsgTestSuite) TestNewMsgConnectionUpgradeTimeout() {
+	counterpartyConnection := types.NewConnectionEnd(types.OPEN, clientID, types.NewCounterparty(clientID, connectionID, commitmenttypes.NewMerklePrefix([]byte("storePrefixKey"))), []*types.Version{ibctesting.ConnectionVersion}, 500)
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgConnectionUpgradeTimeout
+		expPass bool
+	}{
+		{"invalid connection ID", types.NewMsgConnectionUpgradeTimeout("test/conn1", counterpartyConnection, suite.proof, clientHeight, signer), false},
+		{"empty proof", types.NewMsgConnectionUpgradeTimeout(connectionID, counterpartyConnection, emptyProof, clientHeight, signer), false},
+		{"empty signer", types.NewMsgConnectionUpgradeTimeout(connectionID, counterpartyConnection, suite.proof, clientHeight, ""), false},
+		{"success", types.NewMsgConnectionUpgradeTimeout(connectionID, counterpartyConnection, suite.proof, clientHeight, signer), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
 // TestMsgUpdateParamsValidateBasic tests ValidateBasic for MsgUpdateParams
 func (suite *MsgTestSuite) TestMsgUpdateParamsValidateBasic() {
 	signer := suite.chainA.App.GetIBCKeeper().GetAuthority()
@@ -266,7 +425,7 @@ func (suite *MsgTestSuite) TestMsgUpdateParamsValidateBasic() {
 		},
 		{
 			"failure: invalid time per block",
-			types.NewMsgUpdateParams(signer, types.NewParams(0)),
+			types.NewMsgUpdateParams(signer, types.NewParams(0, uint64(types.DefaultUpgradeTimeout), false)),
 			false,
 		},
 	}
@@ -5,25 +5,37 @@ import (
 	"time"
 )
 
-// DefaultTimePerBlock is the default value for maximum expected time per block (in nanoseconds).
-const DefaultTimePerBlock = 30 * time.Second
+const (
+	// DefaultTimePerBlock is the default value for maximum expected time per block (in nanoseconds).
+	DefaultTimePerBlock = 30 * time.Second
+
+	// DefaultUpgradeTimeout is the default duration after which a connection upgrade agreed to in
+	// ConnUpgradeTry times out.
+	DefaultUpgradeTimeout = 10 * time.Minute
+)
 
 // NewParams creates a new parameter configuration for the ibc connection module
-func NewParams(timePerBlock uint64) Params {
+func NewParams(timePerBlock, upgradeTimeout uint64, allowCounterpartyUpgrades bool) Params {
 	return Params{
-		MaxExpectedTimePerBlock: timePerBlock,
+		MaxExpectedTimePerBlock:   timePerBlock,
+		UpgradeTimeout:            upgradeTimeout,
+		AllowCounterpartyUpgrades: allowCounterpartyUpgrades,
 	}
 }
 
-// DefaultParams is the default parameter configuration for the ibc connection module
+// DefaultParams is the default parameter configuration for the ibc connection module.
+// Connection upgrades initiated by the counterparty are not agreed to by default.
 func DefaultParams() Params {
-	return NewParams(uint64(DefaultTimePerBlock))
+	return NewParams(uint64(DefaultTimePerBlock), uint64(DefaultUpgradeTimeout), false)
 }
 
-// Validate ensures MaxExpectedTimePerBlock is non-zero
+// Validate ensures MaxExpectedTimePerBlock and UpgradeTimeout are non-zero
 func (p Params) Validate() error {
 	if p.MaxExpectedTimePerBlock == 0 {
 		return fmt.Errorf("MaxExpectedTimePerBlock cannot be zero")
 	}
+	if p.UpgradeTimeout == 0 {
+		return fmt.Errorf("UpgradeTimeout cannot be zero")
+	}
 	return nil
 }
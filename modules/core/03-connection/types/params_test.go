@@ -15,8 +15,9 @@ func TestValidateParams(t *testing.T) {
 		expPass bool
 	}{
 		{"default params", types.DefaultParams(), true},
-		{"custom params", types.NewParams(10), true},
-		{"blank client", types.NewParams(0), false},
+		{"custom params", types.NewParams(10, 20, true), true},
+		{"blank client", types.NewParams(0, 20, true), false},
+		{"blank upgrade timeout", types.NewParams(10, 0, true), false},
 	}
 
 	for _, tc := range testCases {
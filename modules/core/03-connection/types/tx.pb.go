@@ -378,28 +378,26 @@ func (m *MsgConnectionOpenConfirmResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgConnectionOpenConfirmResponse proto.InternalMessageInfo
 
-// MsgUpdateParams defines the sdk.Msg type to update the connection parameters.
-type MsgUpdateParams struct {
-	// signer address
-	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
-	// params defines the connection parameters to update.
-	//
-	// NOTE: All parameters must be supplied.
-	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+// MsgConnectionUpgradeInit defines a msg sent by the authority to Chain A to
+// propose an upgrade of an OPEN connection end.
+type MsgConnectionUpgradeInit struct {
+	ConnectionId string                  `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	Fields       ConnectionUpgradeFields `protobuf:"bytes,2,opt,name=fields,proto3" json:"fields"`
+	Signer       string                  `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
-func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParams) ProtoMessage()    {}
-func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+func (m *MsgConnectionUpgradeInit) Reset()         { *m = MsgConnectionUpgradeInit{} }
+func (m *MsgConnectionUpgradeInit) String() string { return proto.CompactTextString(m) }
+func (*MsgConnectionUpgradeInit) ProtoMessage()    {}
+func (*MsgConnectionUpgradeInit) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5d00fde5fc97399e, []int{8}
 }
-func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+func (m *MsgConnectionUpgradeInit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgConnectionUpgradeInit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgConnectionUpgradeInit.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -409,34 +407,36 @@ func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+func (m *MsgConnectionUpgradeInit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConnectionUpgradeInit.Merge(m, src)
 }
-func (m *MsgUpdateParams) XXX_Size() int {
+func (m *MsgConnectionUpgradeInit) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+func (m *MsgConnectionUpgradeInit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConnectionUpgradeInit.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+var xxx_messageInfo_MsgConnectionUpgradeInit proto.InternalMessageInfo
 
-// MsgUpdateParamsResponse defines the MsgUpdateParams response type.
-type MsgUpdateParamsResponse struct {
+// MsgConnectionUpgradeInitResponse defines the Msg/ConnectionUpgradeInit
+// response type.
+type MsgConnectionUpgradeInitResponse struct {
+	UpgradeSequence uint64 `protobuf:"varint,1,opt,name=upgrade_sequence,json=upgradeSequence,proto3" json:"upgrade_sequence,omitempty"`
 }
 
-func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
-func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParamsResponse) ProtoMessage()    {}
-func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+func (m *MsgConnectionUpgradeInitResponse) Reset()         { *m = MsgConnectionUpgradeInitResponse{} }
+func (m *MsgConnectionUpgradeInitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgConnectionUpgradeInitResponse) ProtoMessage()    {}
+func (*MsgConnectionUpgradeInitResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5d00fde5fc97399e, []int{9}
 }
-func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgConnectionUpgradeInitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgConnectionUpgradeInitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgConnectionUpgradeInitResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -446,814 +446,1145 @@ func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+func (m *MsgConnectionUpgradeInitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConnectionUpgradeInitResponse.Merge(m, src)
 }
-func (m *MsgUpdateParamsResponse) XXX_Size() int {
+func (m *MsgConnectionUpgradeInitResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+func (m *MsgConnectionUpgradeInitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConnectionUpgradeInitResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgConnectionUpgradeInitResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgConnectionOpenInit)(nil), "ibc.core.connection.v1.MsgConnectionOpenInit")
-	proto.RegisterType((*MsgConnectionOpenInitResponse)(nil), "ibc.core.connection.v1.MsgConnectionOpenInitResponse")
-	proto.RegisterType((*MsgConnectionOpenTry)(nil), "ibc.core.connection.v1.MsgConnectionOpenTry")
-	proto.RegisterType((*MsgConnectionOpenTryResponse)(nil), "ibc.core.connection.v1.MsgConnectionOpenTryResponse")
-	proto.RegisterType((*MsgConnectionOpenAck)(nil), "ibc.core.connection.v1.MsgConnectionOpenAck")
-	proto.RegisterType((*MsgConnectionOpenAckResponse)(nil), "ibc.core.connection.v1.MsgConnectionOpenAckResponse")
-	proto.RegisterType((*MsgConnectionOpenConfirm)(nil), "ibc.core.connection.v1.MsgConnectionOpenConfirm")
-	proto.RegisterType((*MsgConnectionOpenConfirmResponse)(nil), "ibc.core.connection.v1.MsgConnectionOpenConfirmResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "ibc.core.connection.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ibc.core.connection.v1.MsgUpdateParamsResponse")
+// MsgConnectionUpgradeTry defines a msg sent by a Relayer to Chain B to agree to
+// the connection upgrade proposed on Chain A.
+type MsgConnectionUpgradeTry struct {
+	ConnectionId                string                  `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	CounterpartyUpgradeFields   ConnectionUpgradeFields `protobuf:"bytes,2,opt,name=counterparty_upgrade_fields,json=counterpartyUpgradeFields,proto3" json:"counterparty_upgrade_fields"`
+	CounterpartyUpgradeSequence uint64                  `protobuf:"varint,3,opt,name=counterparty_upgrade_sequence,json=counterpartyUpgradeSequence,proto3" json:"counterparty_upgrade_sequence,omitempty"`
+	// proof of the connection end on Chain A containing the proposed upgrade
+	ProofConnection []byte        `protobuf:"bytes,4,opt,name=proof_connection,json=proofConnection,proto3" json:"proof_connection,omitempty"`
+	ProofHeight     types1.Height `protobuf:"bytes,5,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
+	Signer          string        `protobuf:"bytes,6,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func init() { proto.RegisterFile("ibc/core/connection/v1/tx.proto", fileDescriptor_5d00fde5fc97399e) }
+func (m *MsgConnectionUpgradeTry) Reset()         { *m = MsgConnectionUpgradeTry{} }
+func (m *MsgConnectionUpgradeTry) String() string { return proto.CompactTextString(m) }
+func (*MsgConnectionUpgradeTry) ProtoMessage()    {}
+func (*MsgConnectionUpgradeTry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d00fde5fc97399e, []int{10}
+}
+func (m *MsgConnectionUpgradeTry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConnectionUpgradeTry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConnectionUpgradeTry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConnectionUpgradeTry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConnectionUpgradeTry.Merge(m, src)
+}
+func (m *MsgConnectionUpgradeTry) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConnectionUpgradeTry) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConnectionUpgradeTry.DiscardUnknown(m)
+}
 
-var fileDescriptor_5d00fde5fc97399e = []byte{
-	// 927 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x41, 0x6f, 0xe3, 0x44,
-	0x14, 0xc7, 0xe3, 0xd6, 0x49, 0x9b, 0x97, 0x2c, 0x85, 0x51, 0xda, 0x7a, 0xbd, 0xac, 0x93, 0x2d,
-	0x48, 0xad, 0x56, 0xd4, 0xde, 0xee, 0x82, 0x28, 0xd0, 0x4b, 0x9b, 0x0b, 0x15, 0x2a, 0xac, 0x4c,
-	0xd9, 0x03, 0x97, 0x28, 0x71, 0xa6, 0xee, 0xa8, 0x8d, 0xc7, 0xf2, 0x38, 0x61, 0xcd, 0x09, 0xc1,
-	0x05, 0x89, 0x0b, 0x1f, 0x81, 0x8f, 0xb0, 0x1f, 0x63, 0xc5, 0x01, 0xad, 0x38, 0x71, 0x42, 0xa8,
-	0x3d, 0xec, 0x17, 0xe0, 0x03, 0x20, 0xcf, 0x8c, 0x1d, 0x27, 0x71, 0x2a, 0x67, 0xcb, 0x2d, 0x79,
-	0xfe, 0xbf, 0x37, 0xff, 0x79, 0xef, 0x37, 0xf6, 0x40, 0x93, 0xf4, 0x1c, 0xcb, 0xa1, 0x01, 0xb6,
-	0x1c, 0xea, 0x79, 0xd8, 0x09, 0x09, 0xf5, 0xac, 0xd1, 0x9e, 0x15, 0x3e, 0x37, 0xfd, 0x80, 0x86,
-	0x14, 0x6d, 0x90, 0x9e, 0x63, 0xc6, 0x02, 0x73, 0x2c, 0x30, 0x47, 0x7b, 0x7a, 0xc3, 0xa5, 0x2e,
-	0xe5, 0x12, 0x2b, 0xfe, 0x25, 0xd4, 0xfa, 0xa6, 0x43, 0xd9, 0x80, 0x32, 0x6b, 0xc0, 0xdc, 0xb8,
-	0xca, 0x80, 0xb9, 0xf2, 0xc1, 0x5d, 0x97, 0x52, 0xf7, 0x12, 0x5b, 0xfc, 0x5f, 0x6f, 0x78, 0x66,
-	0x75, 0xbd, 0x48, 0x3e, 0xca, 0x58, 0xb8, 0x24, 0xd8, 0x0b, 0xe3, 0x44, 0xf1, 0x4b, 0x0a, 0xb6,
-	0xe7, 0x78, 0xcc, 0x18, 0xe2, 0xc2, 0xad, 0x5f, 0x96, 0x60, 0xfd, 0x84, 0xb9, 0xed, 0x34, 0xfe,
-	0x95, 0x8f, 0xbd, 0x63, 0x8f, 0x84, 0xe8, 0x1e, 0x54, 0x45, 0xc9, 0x0e, 0xe9, 0x6b, 0x4a, 0x4b,
-	0xd9, 0xa9, 0xda, 0xab, 0x22, 0x70, 0xdc, 0x47, 0x5f, 0x42, 0xdd, 0xa1, 0x43, 0x2f, 0xc4, 0x81,
-	0xdf, 0x0d, 0xc2, 0x48, 0x5b, 0x6a, 0x29, 0x3b, 0xb5, 0xc7, 0xef, 0x9b, 0xf9, 0x3b, 0x37, 0xdb,
-	0x19, 0xed, 0x91, 0xfa, 0xf2, 0xef, 0x66, 0xc9, 0x9e, 0xc8, 0x47, 0x9f, 0xc0, 0xca, 0x08, 0x07,
-	0x8c, 0x50, 0x4f, 0x5b, 0xe6, 0xa5, 0x9a, 0xf3, 0x4a, 0x3d, 0x13, 0x32, 0x3b, 0xd1, 0xa3, 0x07,
-	0x50, 0xef, 0xe3, 0xcb, 0x6e, 0xd4, 0xf1, 0x71, 0x40, 0x68, 0x5f, 0x53, 0x5b, 0xca, 0x8e, 0x6a,
-	0xd7, 0x78, 0xec, 0x29, 0x0f, 0xa1, 0x0d, 0xa8, 0x30, 0xe2, 0x7a, 0x38, 0xd0, 0xca, 0x7c, 0x1f,
-	0xf2, 0xdf, 0xa7, 0x6b, 0x3f, 0xff, 0xd6, 0x2c, 0xfd, 0xf8, 0xfa, 0xc5, 0x43, 0x19, 0xd8, 0x6a,
-	0xc2, 0xfd, 0xdc, 0x66, 0xd8, 0x98, 0xf9, 0xd4, 0x63, 0x78, 0xeb, 0xcf, 0x32, 0x34, 0x66, 0x14,
-	0xa7, 0x41, 0x74, 0x73, 0xb7, 0xf6, 0x61, 0xc3, 0x0f, 0xf0, 0x88, 0xd0, 0x21, 0xeb, 0x8c, 0x77,
-	0x13, 0x2b, 0xe3, 0xbe, 0x55, 0x8f, 0x96, 0x34, 0xc5, 0x6e, 0x24, 0x8a, 0x71, 0xed, 0xe3, 0x3e,
-	0xfa, 0x18, 0xea, 0xb2, 0x2c, 0x0b, 0xbb, 0x21, 0x96, 0xcd, 0x69, 0x98, 0x02, 0x0d, 0x33, 0x41,
-	0xc3, 0x3c, 0xf4, 0x22, 0xbb, 0x26, 0x94, 0x5f, 0xc7, 0xc2, 0x99, 0x01, 0xa9, 0xb7, 0x1c, 0xd0,
-	0x74, 0x97, 0xcb, 0xb3, 0x5d, 0x3e, 0x85, 0xf5, 0x6c, 0x4a, 0x47, 0x0e, 0x88, 0x69, 0x95, 0xd6,
-	0x72, 0x91, 0x89, 0x36, 0xb2, 0xd9, 0x32, 0xc8, 0x50, 0x1b, 0xea, 0x7e, 0x40, 0xe9, 0x59, 0xe7,
-	0x1c, 0x13, 0xf7, 0x3c, 0xd4, 0x56, 0xf8, 0x46, 0xf4, 0x4c, 0x31, 0xc1, 0xfd, 0x68, 0xcf, 0xfc,
-	0x9c, 0x2b, 0xa4, 0xfd, 0x1a, 0xcf, 0x12, 0x21, 0x74, 0x1f, 0x40, 0x14, 0x21, 0x1e, 0x09, 0xb5,
-	0xd5, 0x96, 0xb2, 0x53, 0xb7, 0xab, 0x3c, 0xc2, 0x51, 0x7f, 0x90, 0xac, 0x21, 0x6a, 0x69, 0x55,
-	0x2e, 0x10, 0x15, 0xda, 0x3c, 0x84, 0xb6, 0x61, 0x4d, 0x4a, 0x62, 0x0e, 0x3c, 0x36, 0x64, 0x1a,
-	0x70, 0xd5, 0x5b, 0x42, 0x95, 0x44, 0xd1, 0x17, 0xf0, 0x76, 0x2a, 0x49, 0x3c, 0xd7, 0x0a, 0x7a,
-	0x5e, 0x4b, 0x33, 0xa5, 0xef, 0x31, 0xb8, 0xf5, 0x2c, 0xb8, 0xe8, 0x33, 0xd0, 0xcf, 0x29, 0x0b,
-	0xc7, 0x66, 0x04, 0x1e, 0x1d, 0xee, 0x45, 0xbb, 0xc3, 0x8d, 0x6d, 0xc6, 0x8a, 0xd4, 0x17, 0xa7,
-	0xe2, 0x69, 0xfc, 0x78, 0x96, 0x7a, 0x03, 0xde, 0xcd, 0x63, 0x3a, 0x85, 0xfe, 0x0f, 0x35, 0x07,
-	0xfa, 0x43, 0xe7, 0x02, 0xbd, 0x07, 0x77, 0x26, 0x71, 0x16, 0xe0, 0xd7, 0x9d, 0x2c, 0xc2, 0x07,
-	0xa0, 0x4f, 0x60, 0x91, 0x73, 0x00, 0x6c, 0x2d, 0xab, 0x98, 0x38, 0x00, 0xb7, 0x78, 0x31, 0x4c,
-	0x9f, 0x1d, 0xb5, 0xe8, 0xd9, 0x99, 0x46, 0xae, 0xfc, 0x26, 0xc8, 0xdd, 0x03, 0x01, 0x58, 0x27,
-	0x0c, 0x22, 0xad, 0xc2, 0x27, 0xb2, 0xca, 0x03, 0xf1, 0xdb, 0x62, 0x1a, 0xb8, 0x95, 0x42, 0xc0,
-	0xad, 0x16, 0x06, 0xae, 0x7a, 0x7b, 0xe0, 0x60, 0x01, 0xe0, 0x6a, 0xff, 0x03, 0x70, 0x87, 0xce,
-	0x45, 0x0a, 0xdc, 0xef, 0x0a, 0x68, 0x33, 0x82, 0x36, 0xf5, 0xce, 0x48, 0x30, 0x28, 0x06, 0x5d,
-	0xda, 0xfd, 0xae, 0x73, 0xc1, 0x19, 0x4b, 0xba, 0x1f, 0x63, 0x3b, 0x3d, 0xdf, 0xe5, 0x37, 0x99,
-	0xef, 0xb8, 0x53, 0xea, 0xcd, 0xdf, 0x94, 0x2d, 0x68, 0xcd, 0xdb, 0x4b, 0xba, 0xe1, 0xe7, 0xb0,
-	0x76, 0xc2, 0xdc, 0x6f, 0xfc, 0x7e, 0xdc, 0xb3, 0x6e, 0xd0, 0x1d, 0xb0, 0x4c, 0x7d, 0x65, 0x62,
-	0x12, 0x07, 0x50, 0xf1, 0xb9, 0x42, 0x7e, 0x73, 0x8d, 0x79, 0xe7, 0x41, 0xd4, 0x91, 0xd6, 0x65,
-	0xce, 0xac, 0xbb, 0xbb, 0xb0, 0x39, 0xb5, 0x72, 0x62, 0xea, 0xf1, 0xbf, 0x2a, 0x2c, 0x9f, 0x30,
-	0x17, 0x7d, 0x0f, 0x28, 0xe7, 0x7a, 0xb0, 0x3b, 0x6f, 0xdd, 0xdc, 0x0f, 0xa8, 0xfe, 0xd1, 0x42,
-	0xf2, 0xc4, 0x03, 0xfa, 0x0e, 0xde, 0x99, 0xfd, 0xd6, 0x7e, 0x50, 0xb8, 0xd6, 0x69, 0x10, 0xe9,
-	0x1f, 0x2e, 0xa2, 0x9e, 0xbf, 0x70, 0x0c, 0x4e, 0xf1, 0x85, 0x0f, 0x9d, 0x8b, 0x05, 0x16, 0xce,
-	0xb0, 0x8f, 0x7e, 0x52, 0x60, 0x3d, 0x1f, 0xfc, 0x47, 0x85, 0xeb, 0xc9, 0x0c, 0x7d, 0x7f, 0xd1,
-	0x8c, 0xd4, 0x45, 0x00, 0x1b, 0x82, 0x89, 0xb1, 0x4c, 0x72, 0xb9, 0x7d, 0x43, 0xcd, 0x2c, 0x46,
-	0xba, 0x55, 0x50, 0x98, 0xac, 0xa9, 0x97, 0x7f, 0x78, 0xfd, 0xe2, 0xa1, 0x72, 0xf4, 0xec, 0xe5,
-	0x95, 0xa1, 0xbc, 0xba, 0x32, 0x94, 0x7f, 0xae, 0x0c, 0xe5, 0xd7, 0x6b, 0xa3, 0xf4, 0xea, 0xda,
-	0x28, 0xfd, 0x75, 0x6d, 0x94, 0xbe, 0x3d, 0x70, 0x49, 0x78, 0x3e, 0xec, 0x99, 0x0e, 0x1d, 0x58,
-	0xf2, 0xd2, 0x4c, 0x7a, 0xce, 0xae, 0x4b, 0xad, 0xd1, 0xbe, 0x35, 0xa0, 0xfd, 0xe1, 0x25, 0x66,
-	0xe2, 0xd2, 0xfb, 0xe8, 0xc9, 0x6e, 0xe6, 0xde, 0x1b, 0x46, 0x3e, 0x66, 0xbd, 0x0a, 0x7f, 0xe1,
-	0x3f, 0xf9, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x92, 0xe1, 0xc5, 0x47, 0xbf, 0x0b, 0x00, 0x00,
+var xxx_messageInfo_MsgConnectionUpgradeTry proto.InternalMessageInfo
+
+// MsgConnectionUpgradeTryResponse defines the Msg/ConnectionUpgradeTry response
+// type.
+type MsgConnectionUpgradeTryResponse struct {
+	UpgradeSequence uint64 `protobuf:"varint,1,opt,name=upgrade_sequence,json=upgradeSequence,proto3" json:"upgrade_sequence,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *MsgConnectionUpgradeTryResponse) Reset()         { *m = MsgConnectionUpgradeTryResponse{} }
+func (m *MsgConnectionUpgradeTryResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgConnectionUpgradeTryResponse) ProtoMessage()    {}
+func (*MsgConnectionUpgradeTryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d00fde5fc97399e, []int{11}
+}
+func (m *MsgConnectionUpgradeTryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConnectionUpgradeTryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConnectionUpgradeTryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConnectionUpgradeTryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConnectionUpgradeTryResponse.Merge(m, src)
+}
+func (m *MsgConnectionUpgradeTryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConnectionUpgradeTryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConnectionUpgradeTryResponse.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgConnectionUpgradeTryResponse proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// ConnectionOpenInit defines a rpc handler method for MsgConnectionOpenInit.
-	ConnectionOpenInit(ctx context.Context, in *MsgConnectionOpenInit, opts ...grpc.CallOption) (*MsgConnectionOpenInitResponse, error)
-	// ConnectionOpenTry defines a rpc handler method for MsgConnectionOpenTry.
-	ConnectionOpenTry(ctx context.Context, in *MsgConnectionOpenTry, opts ...grpc.CallOption) (*MsgConnectionOpenTryResponse, error)
-	// ConnectionOpenAck defines a rpc handler method for MsgConnectionOpenAck.
-	ConnectionOpenAck(ctx context.Context, in *MsgConnectionOpenAck, opts ...grpc.CallOption) (*MsgConnectionOpenAckResponse, error)
-	// ConnectionOpenConfirm defines a rpc handler method for
-	// MsgConnectionOpenConfirm.
-	ConnectionOpenConfirm(ctx context.Context, in *MsgConnectionOpenConfirm, opts ...grpc.CallOption) (*MsgConnectionOpenConfirmResponse, error)
-	// UpdateConnectionParams defines a rpc handler method for
-	// MsgUpdateParams.
-	UpdateConnectionParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+// MsgConnectionUpgradeAck defines a msg sent by a Relayer to Chain A to
+// acknowledge that Chain B agreed to the connection upgrade. The upgrade is
+// applied to the connection end on Chain A.
+type MsgConnectionUpgradeAck struct {
+	ConnectionId              string                  `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	CounterpartyUpgradeFields ConnectionUpgradeFields `protobuf:"bytes,2,opt,name=counterparty_upgrade_fields,json=counterpartyUpgradeFields,proto3" json:"counterparty_upgrade_fields"`
+	// proof of the connection end on Chain B containing the agreed upgrade
+	ProofConnection []byte        `protobuf:"bytes,3,opt,name=proof_connection,json=proofConnection,proto3" json:"proof_connection,omitempty"`
+	ProofHeight     types1.Height `protobuf:"bytes,4,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
+	Signer          string        `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgConnectionUpgradeAck) Reset()         { *m = MsgConnectionUpgradeAck{} }
+func (m *MsgConnectionUpgradeAck) String() string { return proto.CompactTextString(m) }
+func (*MsgConnectionUpgradeAck) ProtoMessage()    {}
+func (*MsgConnectionUpgradeAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d00fde5fc97399e, []int{12}
+}
+func (m *MsgConnectionUpgradeAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConnectionUpgradeAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConnectionUpgradeAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConnectionUpgradeAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConnectionUpgradeAck.Merge(m, src)
+}
+func (m *MsgConnectionUpgradeAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConnectionUpgradeAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConnectionUpgradeAck.DiscardUnknown(m)
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+var xxx_messageInfo_MsgConnectionUpgradeAck proto.InternalMessageInfo
+
+// MsgConnectionUpgradeAckResponse defines the Msg/ConnectionUpgradeAck response
+// type.
+type MsgConnectionUpgradeAckResponse struct {
 }
 
-func (c *msgClient) ConnectionOpenInit(ctx context.Context, in *MsgConnectionOpenInit, opts ...grpc.CallOption) (*MsgConnectionOpenInitResponse, error) {
-	out := new(MsgConnectionOpenInitResponse)
-	err := c.cc.Invoke(ctx, "/ibc.core.connection.v1.Msg/ConnectionOpenInit", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgConnectionUpgradeAckResponse) Reset()         { *m = MsgConnectionUpgradeAckResponse{} }
+func (m *MsgConnectionUpgradeAckResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgConnectionUpgradeAckResponse) ProtoMessage()    {}
+func (*MsgConnectionUpgradeAckResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d00fde5fc97399e, []int{13}
+}
+func (m *MsgConnectionUpgradeAckResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConnectionUpgradeAckResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConnectionUpgradeAckResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgConnectionUpgradeAckResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConnectionUpgradeAckResponse.Merge(m, src)
+}
+func (m *MsgConnectionUpgradeAckResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConnectionUpgradeAckResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConnectionUpgradeAckResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) ConnectionOpenTry(ctx context.Context, in *MsgConnectionOpenTry, opts ...grpc.CallOption) (*MsgConnectionOpenTryResponse, error) {
-	out := new(MsgConnectionOpenTryResponse)
-	err := c.cc.Invoke(ctx, "/ibc.core.connection.v1.Msg/ConnectionOpenTry", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgConnectionUpgradeAckResponse proto.InternalMessageInfo
+
+// MsgConnectionUpgradeConfirm defines a msg sent by a Relayer to Chain B to
+// confirm that the connection upgrade has been applied on Chain A. The upgrade
+// is applied to the connection end on Chain B.
+type MsgConnectionUpgradeConfirm struct {
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// proof of the upgraded connection end on Chain A
+	ProofConnection []byte        `protobuf:"bytes,2,opt,name=proof_connection,json=proofConnection,proto3" json:"proof_connection,omitempty"`
+	ProofHeight     types1.Height `protobuf:"bytes,3,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
+	Signer          string        `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func (c *msgClient) ConnectionOpenAck(ctx context.Context, in *MsgConnectionOpenAck, opts ...grpc.CallOption) (*MsgConnectionOpenAckResponse, error) {
-	out := new(MsgConnectionOpenAckResponse)
-	err := c.cc.Invoke(ctx, "/ibc.core.connection.v1.Msg/ConnectionOpenAck", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgConnectionUpgradeConfirm) Reset()         { *m = MsgConnectionUpgradeConfirm{} }
+func (m *MsgConnectionUpgradeConfirm) String() string { return proto.CompactTextString(m) }
+func (*MsgConnectionUpgradeConfirm) ProtoMessage()    {}
+func (*MsgConnectionUpgradeConfirm) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d00fde5fc97399e, []int{14}
+}
+func (m *MsgConnectionUpgradeConfirm) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConnectionUpgradeConfirm) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConnectionUpgradeConfirm.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgConnectionUpgradeConfirm) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConnectionUpgradeConfirm.Merge(m, src)
+}
+func (m *MsgConnectionUpgradeConfirm) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConnectionUpgradeConfirm) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConnectionUpgradeConfirm.DiscardUnknown(m)
 }
 
-func (c *msgClient) ConnectionOpenConfirm(ctx context.Context, in *MsgConnectionOpenConfirm, opts ...grpc.CallOption) (*MsgConnectionOpenConfirmResponse, error) {
-	out := new(MsgConnectionOpenConfirmResponse)
-	err := c.cc.Invoke(ctx, "/ibc.core.connection.v1.Msg/ConnectionOpenConfirm", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgConnectionUpgradeConfirm proto.InternalMessageInfo
+
+// MsgConnectionUpgradeConfirmResponse defines the Msg/ConnectionUpgradeConfirm
+// response type.
+type MsgConnectionUpgradeConfirmResponse struct {
 }
 
-func (c *msgClient) UpdateConnectionParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/ibc.core.connection.v1.Msg/UpdateConnectionParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgConnectionUpgradeConfirmResponse) Reset()         { *m = MsgConnectionUpgradeConfirmResponse{} }
+func (m *MsgConnectionUpgradeConfirmResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgConnectionUpgradeConfirmResponse) ProtoMessage()    {}
+func (*MsgConnectionUpgradeConfirmResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d00fde5fc97399e, []int{15}
+}
+func (m *MsgConnectionUpgradeConfirmResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConnectionUpgradeConfirmResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConnectionUpgradeConfirmResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// ConnectionOpenInit defines a rpc handler method for MsgConnectionOpenInit.
-	ConnectionOpenInit(context.Context, *MsgConnectionOpenInit) (*MsgConnectionOpenInitResponse, error)
-	// ConnectionOpenTry defines a rpc handler method for MsgConnectionOpenTry.
-	ConnectionOpenTry(context.Context, *MsgConnectionOpenTry) (*MsgConnectionOpenTryResponse, error)
-	// ConnectionOpenAck defines a rpc handler method for MsgConnectionOpenAck.
-	ConnectionOpenAck(context.Context, *MsgConnectionOpenAck) (*MsgConnectionOpenAckResponse, error)
-	// ConnectionOpenConfirm defines a rpc handler method for
-	// MsgConnectionOpenConfirm.
-	ConnectionOpenConfirm(context.Context, *MsgConnectionOpenConfirm) (*MsgConnectionOpenConfirmResponse, error)
-	// UpdateConnectionParams defines a rpc handler method for
-	// MsgUpdateParams.
-	UpdateConnectionParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+func (m *MsgConnectionUpgradeConfirmResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConnectionUpgradeConfirmResponse.Merge(m, src)
+}
+func (m *MsgConnectionUpgradeConfirmResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConnectionUpgradeConfirmResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConnectionUpgradeConfirmResponse.DiscardUnknown(m)
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+var xxx_messageInfo_MsgConnectionUpgradeConfirmResponse proto.InternalMessageInfo
+
+// MsgConnectionUpgradeCancel defines a msg sent by the authority to abandon the
+// connection upgrade in progress on a connection end.
+type MsgConnectionUpgradeCancel struct {
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	Signer       string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func (*UnimplementedMsgServer) ConnectionOpenInit(ctx context.Context, req *MsgConnectionOpenInit) (*MsgConnectionOpenInitResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConnectionOpenInit not implemented")
+func (m *MsgConnectionUpgradeCancel) Reset()         { *m = MsgConnectionUpgradeCancel{} }
+func (m *MsgConnectionUpgradeCancel) String() string { return proto.CompactTextString(m) }
+func (*MsgConnectionUpgradeCancel) ProtoMessage()    {}
+func (*MsgConnectionUpgradeCancel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d00fde5fc97399e, []int{16}
 }
-func (*UnimplementedMsgServer) ConnectionOpenTry(ctx context.Context, req *MsgConnectionOpenTry) (*MsgConnectionOpenTryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConnectionOpenTry not implemented")
+func (m *MsgConnectionUpgradeCancel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) ConnectionOpenAck(ctx context.Context, req *MsgConnectionOpenAck) (*MsgConnectionOpenAckResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConnectionOpenAck not implemented")
+func (m *MsgConnectionUpgradeCancel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConnectionUpgradeCancel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) ConnectionOpenConfirm(ctx context.Context, req *MsgConnectionOpenConfirm) (*MsgConnectionOpenConfirmResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConnectionOpenConfirm not implemented")
+func (m *MsgConnectionUpgradeCancel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConnectionUpgradeCancel.Merge(m, src)
 }
-func (*UnimplementedMsgServer) UpdateConnectionParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateConnectionParams not implemented")
+func (m *MsgConnectionUpgradeCancel) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConnectionUpgradeCancel) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConnectionUpgradeCancel.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgConnectionUpgradeCancel proto.InternalMessageInfo
+
+// MsgConnectionUpgradeCancelResponse defines the Msg/ConnectionUpgradeCancel
+// response type.
+type MsgConnectionUpgradeCancelResponse struct {
 }
 
-func _Msg_ConnectionOpenInit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgConnectionOpenInit)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ConnectionOpenInit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.core.connection.v1.Msg/ConnectionOpenInit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ConnectionOpenInit(ctx, req.(*MsgConnectionOpenInit))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgConnectionUpgradeCancelResponse) Reset()         { *m = MsgConnectionUpgradeCancelResponse{} }
+func (m *MsgConnectionUpgradeCancelResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgConnectionUpgradeCancelResponse) ProtoMessage()    {}
+func (*MsgConnectionUpgradeCancelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d00fde5fc97399e, []int{17}
 }
-
-func _Msg_ConnectionOpenTry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgConnectionOpenTry)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ConnectionOpenTry(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.core.connection.v1.Msg/ConnectionOpenTry",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ConnectionOpenTry(ctx, req.(*MsgConnectionOpenTry))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgConnectionUpgradeCancelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Msg_ConnectionOpenAck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgConnectionOpenAck)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ConnectionOpenAck(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.core.connection.v1.Msg/ConnectionOpenAck",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ConnectionOpenAck(ctx, req.(*MsgConnectionOpenAck))
+func (m *MsgConnectionUpgradeCancelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConnectionUpgradeCancelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-func _Msg_ConnectionOpenConfirm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgConnectionOpenConfirm)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ConnectionOpenConfirm(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.core.connection.v1.Msg/ConnectionOpenConfirm",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ConnectionOpenConfirm(ctx, req.(*MsgConnectionOpenConfirm))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgConnectionUpgradeCancelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConnectionUpgradeCancelResponse.Merge(m, src)
 }
-
-func _Msg_UpdateConnectionParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateConnectionParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.core.connection.v1.Msg/UpdateConnectionParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateConnectionParams(ctx, req.(*MsgUpdateParams))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgConnectionUpgradeCancelResponse) XXX_Size() int {
+	return m.Size()
 }
-
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ibc.core.connection.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ConnectionOpenInit",
-			Handler:    _Msg_ConnectionOpenInit_Handler,
-		},
-		{
-			MethodName: "ConnectionOpenTry",
-			Handler:    _Msg_ConnectionOpenTry_Handler,
-		},
-		{
-			MethodName: "ConnectionOpenAck",
-			Handler:    _Msg_ConnectionOpenAck_Handler,
-		},
-		{
-			MethodName: "ConnectionOpenConfirm",
-			Handler:    _Msg_ConnectionOpenConfirm_Handler,
-		},
-		{
-			MethodName: "UpdateConnectionParams",
-			Handler:    _Msg_UpdateConnectionParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "ibc/core/connection/v1/tx.proto",
+func (m *MsgConnectionUpgradeCancelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConnectionUpgradeCancelResponse.DiscardUnknown(m)
 }
 
-func (m *MsgConnectionOpenInit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_MsgConnectionUpgradeCancelResponse proto.InternalMessageInfo
 
-func (m *MsgConnectionOpenInit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgConnectionUpgradeTimeout defines a msg sent by a Relayer to Chain B to
+// abandon a connection upgrade agreed to in ConnectionUpgradeTry once its
+// timeout has elapsed without the upgrade being applied on Chain A.
+type MsgConnectionUpgradeTimeout struct {
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// connection end on Chain A which has not applied the upgrade
+	CounterpartyConnection ConnectionEnd `protobuf:"bytes,2,opt,name=counterparty_connection,json=counterpartyConnection,proto3" json:"counterparty_connection"`
+	// proof of the connection end on Chain A at a height past the upgrade timeout
+	ProofConnection []byte        `protobuf:"bytes,3,opt,name=proof_connection,json=proofConnection,proto3" json:"proof_connection,omitempty"`
+	ProofHeight     types1.Height `protobuf:"bytes,4,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
+	Signer          string        `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func (m *MsgConnectionOpenInit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.DelayPeriod != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.DelayPeriod))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Version != nil {
-		{
-			size, err := m.Version.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	{
-		size, err := m.Counterparty.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgConnectionUpgradeTimeout) Reset()         { *m = MsgConnectionUpgradeTimeout{} }
+func (m *MsgConnectionUpgradeTimeout) String() string { return proto.CompactTextString(m) }
+func (*MsgConnectionUpgradeTimeout) ProtoMessage()    {}
+func (*MsgConnectionUpgradeTimeout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d00fde5fc97399e, []int{18}
+}
+func (m *MsgConnectionUpgradeTimeout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConnectionUpgradeTimeout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConnectionUpgradeTimeout.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.ClientId) > 0 {
-		i -= len(m.ClientId)
-		copy(dAtA[i:], m.ClientId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientId)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgConnectionOpenInitResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgConnectionUpgradeTimeout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConnectionUpgradeTimeout.Merge(m, src)
 }
-
-func (m *MsgConnectionOpenInitResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgConnectionUpgradeTimeout) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgConnectionOpenInitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgConnectionUpgradeTimeout) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConnectionUpgradeTimeout.DiscardUnknown(m)
 }
 
-func (m *MsgConnectionOpenTry) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_MsgConnectionUpgradeTimeout proto.InternalMessageInfo
 
-func (m *MsgConnectionOpenTry) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgConnectionUpgradeTimeoutResponse defines the Msg/ConnectionUpgradeTimeout
+// response type.
+type MsgConnectionUpgradeTimeoutResponse struct {
 }
 
-func (m *MsgConnectionOpenTry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.HostConsensusStateProof) > 0 {
-		i -= len(m.HostConsensusStateProof)
-		copy(dAtA[i:], m.HostConsensusStateProof)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.HostConsensusStateProof)))
-		i--
-		dAtA[i] = 0x6a
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x62
-	}
-	{
-		size, err := m.ConsensusHeight.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgConnectionUpgradeTimeoutResponse) Reset()         { *m = MsgConnectionUpgradeTimeoutResponse{} }
+func (m *MsgConnectionUpgradeTimeoutResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgConnectionUpgradeTimeoutResponse) ProtoMessage()    {}
+func (*MsgConnectionUpgradeTimeoutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d00fde5fc97399e, []int{19}
+}
+func (m *MsgConnectionUpgradeTimeoutResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConnectionUpgradeTimeoutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConnectionUpgradeTimeoutResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x5a
-	if len(m.ProofConsensus) > 0 {
-		i -= len(m.ProofConsensus)
-		copy(dAtA[i:], m.ProofConsensus)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofConsensus)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if len(m.ProofClient) > 0 {
-		i -= len(m.ProofClient)
-		copy(dAtA[i:], m.ProofClient)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofClient)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.ProofInit) > 0 {
-		i -= len(m.ProofInit)
-		copy(dAtA[i:], m.ProofInit)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofInit)))
-		i--
-		dAtA[i] = 0x42
-	}
-	{
-		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+}
+func (m *MsgConnectionUpgradeTimeoutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConnectionUpgradeTimeoutResponse.Merge(m, src)
+}
+func (m *MsgConnectionUpgradeTimeoutResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConnectionUpgradeTimeoutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConnectionUpgradeTimeoutResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConnectionUpgradeTimeoutResponse proto.InternalMessageInfo
+
+// MsgUpdateParams defines the sdk.Msg type to update the connection parameters.
+type MsgUpdateParams struct {
+	// signer address
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// params defines the connection parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d00fde5fc97399e, []int{20}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x3a
-	if len(m.CounterpartyVersions) > 0 {
-		for iNdEx := len(m.CounterpartyVersions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CounterpartyVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.DelayPeriod != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.DelayPeriod))
-		i--
-		dAtA[i] = 0x28
-	}
-	{
-		size, err := m.Counterparty.MarshalToSizedBuffer(dAtA[:i])
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+// MsgUpdateParamsResponse defines the MsgUpdateParams response type.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d00fde5fc97399e, []int{21}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if m.ClientState != nil {
-		{
-			size, err := m.ClientState.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.PreviousConnectionId) > 0 {
-		i -= len(m.PreviousConnectionId)
-		copy(dAtA[i:], m.PreviousConnectionId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PreviousConnectionId)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.ClientId) > 0 {
-		i -= len(m.ClientId)
-		copy(dAtA[i:], m.ClientId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientId)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgConnectionOpenInit)(nil), "ibc.core.connection.v1.MsgConnectionOpenInit")
+	proto.RegisterType((*MsgConnectionOpenInitResponse)(nil), "ibc.core.connection.v1.MsgConnectionOpenInitResponse")
+	proto.RegisterType((*MsgConnectionOpenTry)(nil), "ibc.core.connection.v1.MsgConnectionOpenTry")
+	proto.RegisterType((*MsgConnectionOpenTryResponse)(nil), "ibc.core.connection.v1.MsgConnectionOpenTryResponse")
+	proto.RegisterType((*MsgConnectionOpenAck)(nil), "ibc.core.connection.v1.MsgConnectionOpenAck")
+	proto.RegisterType((*MsgConnectionOpenAckResponse)(nil), "ibc.core.connection.v1.MsgConnectionOpenAckResponse")
+	proto.RegisterType((*MsgConnectionOpenConfirm)(nil), "ibc.core.connection.v1.MsgConnectionOpenConfirm")
+	proto.RegisterType((*MsgConnectionOpenConfirmResponse)(nil), "ibc.core.connection.v1.MsgConnectionOpenConfirmResponse")
+	proto.RegisterType((*MsgConnectionUpgradeInit)(nil), "ibc.core.connection.v1.MsgConnectionUpgradeInit")
+	proto.RegisterType((*MsgConnectionUpgradeInitResponse)(nil), "ibc.core.connection.v1.MsgConnectionUpgradeInitResponse")
+	proto.RegisterType((*MsgConnectionUpgradeTry)(nil), "ibc.core.connection.v1.MsgConnectionUpgradeTry")
+	proto.RegisterType((*MsgConnectionUpgradeTryResponse)(nil), "ibc.core.connection.v1.MsgConnectionUpgradeTryResponse")
+	proto.RegisterType((*MsgConnectionUpgradeAck)(nil), "ibc.core.connection.v1.MsgConnectionUpgradeAck")
+	proto.RegisterType((*MsgConnectionUpgradeAckResponse)(nil), "ibc.core.connection.v1.MsgConnectionUpgradeAckResponse")
+	proto.RegisterType((*MsgConnectionUpgradeConfirm)(nil), "ibc.core.connection.v1.MsgConnectionUpgradeConfirm")
+	proto.RegisterType((*MsgConnectionUpgradeConfirmResponse)(nil), "ibc.core.connection.v1.MsgConnectionUpgradeConfirmResponse")
+	proto.RegisterType((*MsgConnectionUpgradeCancel)(nil), "ibc.core.connection.v1.MsgConnectionUpgradeCancel")
+	proto.RegisterType((*MsgConnectionUpgradeCancelResponse)(nil), "ibc.core.connection.v1.MsgConnectionUpgradeCancelResponse")
+	proto.RegisterType((*MsgConnectionUpgradeTimeout)(nil), "ibc.core.connection.v1.MsgConnectionUpgradeTimeout")
+	proto.RegisterType((*MsgConnectionUpgradeTimeoutResponse)(nil), "ibc.core.connection.v1.MsgConnectionUpgradeTimeoutResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "ibc.core.connection.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ibc.core.connection.v1.MsgUpdateParamsResponse")
+}
+
+func init() { proto.RegisterFile("ibc/core/connection/v1/tx.proto", fileDescriptor_5d00fde5fc97399e) }
+
+var fileDescriptor_5d00fde5fc97399e = []byte{
+	// 1294 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xc1, 0x6f, 0xe3, 0xc4,
+	0x17, 0xae, 0x93, 0x34, 0xdb, 0xbc, 0x64, 0x7f, 0xd9, 0x9f, 0x95, 0x6d, 0x5c, 0x97, 0x26, 0x69,
+	0x76, 0x57, 0x2d, 0x2b, 0x1a, 0x6f, 0x5b, 0x50, 0x4b, 0xdb, 0x4b, 0x5b, 0x81, 0xa8, 0x50, 0x61,
+	0xe5, 0x76, 0xf7, 0xc0, 0x25, 0x4a, 0x9c, 0xa9, 0x6b, 0x9a, 0x78, 0x8c, 0xed, 0x84, 0x0d, 0xa7,
+	0x15, 0x5c, 0x40, 0x70, 0x40, 0xfc, 0x05, 0x9c, 0x39, 0xed, 0x8d, 0x33, 0xb7, 0x15, 0x87, 0xd5,
+	0x8a, 0x13, 0x12, 0x12, 0x42, 0xed, 0x61, 0xff, 0x0d, 0xe4, 0x99, 0xb1, 0xe3, 0x24, 0x76, 0xd6,
+	0x6e, 0x8b, 0xe0, 0x96, 0x3c, 0x7f, 0xef, 0xcd, 0xf7, 0xde, 0xfb, 0xe6, 0xcd, 0xd8, 0x50, 0xd6,
+	0x9a, 0x8a, 0xa4, 0x60, 0x13, 0x49, 0x0a, 0xd6, 0x75, 0xa4, 0xd8, 0x1a, 0xd6, 0xa5, 0xde, 0xaa,
+	0x64, 0x3f, 0xa9, 0x19, 0x26, 0xb6, 0x31, 0x3f, 0xab, 0x35, 0x95, 0x9a, 0x03, 0xa8, 0x0d, 0x00,
+	0xb5, 0xde, 0xaa, 0x58, 0x50, 0xb1, 0x8a, 0x09, 0x44, 0x72, 0x7e, 0x51, 0xb4, 0x58, 0x54, 0xb0,
+	0xd5, 0xc1, 0x96, 0xd4, 0xb1, 0x54, 0x27, 0x4a, 0xc7, 0x52, 0xd9, 0x83, 0x39, 0x15, 0x63, 0xb5,
+	0x8d, 0x24, 0xf2, 0xaf, 0xd9, 0x3d, 0x91, 0x1a, 0x7a, 0x9f, 0x3d, 0xf2, 0x51, 0x68, 0x6b, 0x48,
+	0xb7, 0x1d, 0x47, 0xfa, 0x8b, 0x01, 0x96, 0x42, 0x38, 0x0e, 0xfe, 0x51, 0x60, 0xf5, 0xdb, 0x04,
+	0xdc, 0x3e, 0xb4, 0xd4, 0x7d, 0xcf, 0xfe, 0xb1, 0x81, 0xf4, 0x03, 0x5d, 0xb3, 0xf9, 0x79, 0xc8,
+	0xd0, 0x90, 0x75, 0xad, 0x25, 0x70, 0x15, 0x6e, 0x39, 0x23, 0xcf, 0x50, 0xc3, 0x41, 0x8b, 0xff,
+	0x08, 0x72, 0x0a, 0xee, 0xea, 0x36, 0x32, 0x8d, 0x86, 0x69, 0xf7, 0x85, 0x44, 0x85, 0x5b, 0xce,
+	0xae, 0xdd, 0xad, 0x05, 0x67, 0x5e, 0xdb, 0xf7, 0x61, 0xf7, 0x52, 0xcf, 0xff, 0x2c, 0x4f, 0xc9,
+	0x43, 0xfe, 0xfc, 0xbb, 0x70, 0xa3, 0x87, 0x4c, 0x4b, 0xc3, 0xba, 0x90, 0x24, 0xa1, 0xca, 0x61,
+	0xa1, 0x1e, 0x53, 0x98, 0xec, 0xe2, 0xf9, 0x45, 0xc8, 0xb5, 0x50, 0xbb, 0xd1, 0xaf, 0x1b, 0xc8,
+	0xd4, 0x70, 0x4b, 0x48, 0x55, 0xb8, 0xe5, 0x94, 0x9c, 0x25, 0xb6, 0x87, 0xc4, 0xc4, 0xcf, 0x42,
+	0xda, 0xd2, 0x54, 0x1d, 0x99, 0xc2, 0x34, 0xc9, 0x83, 0xfd, 0xdb, 0xca, 0x7f, 0xfd, 0x63, 0x79,
+	0xea, 0xcb, 0x57, 0xcf, 0xee, 0x33, 0x43, 0xb5, 0x0c, 0x0b, 0x81, 0xc5, 0x90, 0x91, 0x65, 0x60,
+	0xdd, 0x42, 0xd5, 0xdf, 0xa6, 0xa1, 0x30, 0x86, 0x38, 0x36, 0xfb, 0x93, 0xab, 0xb5, 0x09, 0xb3,
+	0x86, 0x89, 0x7a, 0x1a, 0xee, 0x5a, 0xf5, 0x41, 0x36, 0x0e, 0xd2, 0xa9, 0x5b, 0x66, 0x2f, 0x21,
+	0x70, 0x72, 0xc1, 0x45, 0x0c, 0x62, 0x1f, 0xb4, 0xf8, 0x0d, 0xc8, 0xb1, 0xb0, 0x96, 0xdd, 0xb0,
+	0x11, 0x2b, 0x4e, 0xa1, 0x46, 0xa5, 0x51, 0x73, 0xa5, 0x51, 0xdb, 0xd5, 0xfb, 0x72, 0x96, 0x22,
+	0x8f, 0x1c, 0xe0, 0x58, 0x83, 0x52, 0x57, 0x6c, 0xd0, 0x68, 0x95, 0xa7, 0xc7, 0xab, 0x7c, 0x0c,
+	0xb7, 0xfd, 0x2e, 0x75, 0xd6, 0x20, 0x4b, 0x48, 0x57, 0x92, 0x51, 0x3a, 0x5a, 0xf0, 0x7b, 0x33,
+	0xa3, 0xc5, 0xef, 0x43, 0xce, 0x30, 0x31, 0x3e, 0xa9, 0x9f, 0x22, 0x4d, 0x3d, 0xb5, 0x85, 0x1b,
+	0x24, 0x11, 0xd1, 0x17, 0x8c, 0xea, 0xbe, 0xb7, 0x5a, 0xfb, 0x80, 0x20, 0x18, 0xfd, 0x2c, 0xf1,
+	0xa2, 0x26, 0x7e, 0x01, 0x80, 0x06, 0xd1, 0x74, 0xcd, 0x16, 0x66, 0x2a, 0xdc, 0x72, 0x4e, 0xce,
+	0x10, 0x0b, 0x91, 0xfa, 0xa2, 0xbb, 0x06, 0x8d, 0x25, 0x64, 0x08, 0x80, 0x46, 0xd8, 0x27, 0x26,
+	0x7e, 0x09, 0xf2, 0x0c, 0xe2, 0xe8, 0x40, 0xb7, 0xba, 0x96, 0x00, 0x04, 0xf5, 0x3f, 0x8a, 0x72,
+	0xad, 0xfc, 0x87, 0x70, 0xcb, 0x83, 0xb8, 0x9c, 0xb3, 0x11, 0x39, 0xe7, 0x3d, 0x4f, 0xc6, 0x7b,
+	0x20, 0xdc, 0x9c, 0x5f, 0xb8, 0xfc, 0x36, 0x88, 0xa7, 0xd8, 0xb2, 0x07, 0x64, 0xa8, 0x3c, 0xea,
+	0x84, 0x8b, 0x70, 0x93, 0x10, 0x2b, 0x3a, 0x08, 0x8f, 0x17, 0x51, 0xc5, 0x43, 0xe7, 0xf1, 0xb8,
+	0xea, 0x4b, 0xf0, 0x46, 0x90, 0xa6, 0x3d, 0xd1, 0xbf, 0x48, 0x05, 0x88, 0x7e, 0x57, 0x39, 0xe3,
+	0xef, 0xc0, 0xcd, 0x61, 0x39, 0x53, 0xe1, 0xe7, 0x14, 0xbf, 0x84, 0x77, 0x40, 0x1c, 0x92, 0x45,
+	0xc0, 0x06, 0x90, 0x05, 0x3f, 0x62, 0x68, 0x03, 0x5c, 0x61, 0x30, 0x8c, 0xee, 0x9d, 0x54, 0xd4,
+	0xbd, 0x33, 0x2a, 0xb9, 0xe9, 0xcb, 0x48, 0x6e, 0x1e, 0xa8, 0xc0, 0xea, 0xb6, 0xd9, 0x17, 0xd2,
+	0xa4, 0x23, 0x33, 0xc4, 0xe0, 0x4c, 0x8b, 0x51, 0xc1, 0xdd, 0x88, 0x24, 0xb8, 0x99, 0xc8, 0x82,
+	0xcb, 0x5c, 0x5d, 0x70, 0x10, 0x43, 0x70, 0xd9, 0x6b, 0x10, 0xdc, 0xae, 0x72, 0xe6, 0x09, 0xee,
+	0x57, 0x0e, 0x84, 0x31, 0xc0, 0x3e, 0xd6, 0x4f, 0x34, 0xb3, 0x13, 0x4d, 0x74, 0x5e, 0xf5, 0x1b,
+	0xca, 0x99, 0x90, 0xf0, 0x55, 0xdf, 0x91, 0xed, 0x68, 0x7f, 0x93, 0x97, 0xe9, 0xef, 0xa0, 0x52,
+	0xa9, 0xc9, 0x67, 0x4a, 0x15, 0x2a, 0x61, 0xb9, 0x78, 0x09, 0xff, 0x3c, 0x9a, 0xf0, 0x23, 0x43,
+	0x35, 0x1b, 0x2d, 0x44, 0xa6, 0x53, 0xa4, 0x84, 0x0f, 0x21, 0x7d, 0xa2, 0xa1, 0x76, 0xcb, 0x62,
+	0x47, 0xb1, 0x14, 0x3e, 0xe9, 0x47, 0xd6, 0x78, 0x9f, 0xb8, 0xb1, 0x14, 0x59, 0x10, 0x5f, 0x76,
+	0xc9, 0xc9, 0xd9, 0x1d, 0x8d, 0x64, 0xe7, 0x23, 0xee, 0x66, 0xc7, 0xbf, 0x09, 0xb7, 0xba, 0xd4,
+	0x5c, 0xb7, 0xd0, 0x67, 0x5d, 0xa4, 0x2b, 0x88, 0xe4, 0x90, 0x92, 0xf3, 0xcc, 0x7e, 0xc4, 0xcc,
+	0x5b, 0x29, 0x27, 0x7e, 0xf5, 0x87, 0x24, 0x14, 0x83, 0xa2, 0x3a, 0x5b, 0x27, 0x52, 0x35, 0xba,
+	0x30, 0x3f, 0x34, 0x73, 0xdc, 0xe5, 0xaf, 0xa3, 0x44, 0x73, 0xfe, 0xc8, 0x43, 0x00, 0x7e, 0x0f,
+	0x16, 0x02, 0x97, 0xf5, 0xb2, 0x4e, 0x92, 0xac, 0xe7, 0x03, 0x22, 0xb8, 0x15, 0x70, 0x8a, 0xe5,
+	0xed, 0x7b, 0xc6, 0x82, 0x28, 0x2c, 0x27, 0xe7, 0xdd, 0x8d, 0xcf, 0xcc, 0xd7, 0x33, 0xa7, 0x06,
+	0x9d, 0x4e, 0x4f, 0xee, 0xb4, 0x0c, 0xe5, 0x90, 0x9e, 0x5c, 0xbe, 0xd1, 0x2f, 0x12, 0xc1, 0x8d,
+	0x8e, 0x7c, 0xb8, 0xfc, 0x4b, 0x8d, 0x0e, 0x6a, 0x52, 0x32, 0x5a, 0x93, 0x52, 0x57, 0x6b, 0xd2,
+	0x6b, 0x2e, 0xb0, 0x8b, 0xc1, 0x4d, 0xf2, 0x0f, 0xd7, 0x3f, 0x38, 0x98, 0x0f, 0xc2, 0xc4, 0x9a,
+	0xaf, 0x41, 0x05, 0x48, 0x44, 0x2b, 0xc0, 0x3f, 0x3b, 0x6d, 0xef, 0xc1, 0x9d, 0x09, 0xc9, 0x79,
+	0x45, 0xf8, 0x14, 0xc4, 0x40, 0x58, 0x43, 0x57, 0x50, 0x3b, 0x5a, 0x09, 0x06, 0x94, 0x12, 0x93,
+	0x29, 0xdd, 0x85, 0x6a, 0xf8, 0x5a, 0x1e, 0xa3, 0x5f, 0x12, 0xc1, 0x6d, 0x39, 0xd6, 0x3a, 0x08,
+	0x77, 0x23, 0x9e, 0x02, 0x2d, 0x28, 0x86, 0xdc, 0xb5, 0xd8, 0x56, 0xb8, 0xf7, 0xfa, 0xad, 0xf0,
+	0x9e, 0xde, 0x62, 0x1d, 0x98, 0x0d, 0xbe, 0x95, 0xfd, 0x77, 0xd5, 0x1f, 0xd2, 0x7c, 0x56, 0x42,
+	0xaf, 0xd4, 0x4f, 0x20, 0x7f, 0x68, 0xa9, 0x8f, 0x8c, 0x96, 0x73, 0x43, 0x69, 0x98, 0x8d, 0x8e,
+	0xff, 0xbc, 0xe3, 0x86, 0xee, 0x3d, 0x3b, 0x90, 0x36, 0x08, 0x82, 0xd5, 0xaf, 0x14, 0x56, 0x3f,
+	0x1a, 0xc7, 0x3d, 0x45, 0xa9, 0xcf, 0x38, 0xc1, 0x39, 0x28, 0x8e, 0xac, 0xec, 0x92, 0x5a, 0xfb,
+	0x29, 0x0b, 0xc9, 0x43, 0x4b, 0xe5, 0xbf, 0x00, 0x3e, 0xe0, 0x65, 0x7c, 0x25, 0x6c, 0xdd, 0xc0,
+	0xd7, 0x55, 0xf1, 0x9d, 0x58, 0x70, 0x6f, 0x7e, 0x7f, 0x0e, 0xff, 0x1f, 0x7f, 0xb3, 0x7d, 0x2b,
+	0x72, 0xac, 0x63, 0xb3, 0x2f, 0xbe, 0x1d, 0x07, 0x1d, 0xbe, 0xb0, 0x73, 0x00, 0x44, 0x5f, 0x78,
+	0x57, 0x39, 0x8b, 0xb1, 0xb0, 0x6f, 0x18, 0xf2, 0x5f, 0x71, 0x70, 0x3b, 0xf8, 0x9a, 0xf9, 0x20,
+	0x72, 0x3c, 0xe6, 0x21, 0x6e, 0xc6, 0xf5, 0x08, 0x61, 0xe1, 0xbf, 0xfb, 0x45, 0x63, 0xe1, 0xf3,
+	0x10, 0x37, 0xe3, 0x7a, 0x78, 0x2c, 0x9e, 0x72, 0x50, 0x08, 0xbc, 0x72, 0x49, 0x71, 0x42, 0x3a,
+	0x22, 0xd8, 0x88, 0xe9, 0x30, 0x99, 0x82, 0xa3, 0x85, 0x58, 0x14, 0x1c, 0x39, 0x6c, 0xc4, 0x74,
+	0xf0, 0x28, 0x7c, 0xc7, 0x81, 0x10, 0x7a, 0x36, 0xae, 0xc7, 0x89, 0xea, 0xea, 0x62, 0xfb, 0x12,
+	0x4e, 0x1e, 0x9d, 0x6f, 0x38, 0x28, 0x86, 0x1d, 0x53, 0x6b, 0xb1, 0x02, 0x13, 0x1f, 0x71, 0x2b,
+	0xbe, 0xcf, 0xe4, 0xd2, 0xb8, 0xe7, 0x53, 0xac, 0xd2, 0x30, 0x27, 0x71, 0xfb, 0x12, 0x4e, 0x1e,
+	0x1d, 0x13, 0x66, 0xe9, 0x24, 0x1d, 0x20, 0xd9, 0x34, 0x5f, 0x9a, 0x10, 0xd6, 0x3f, 0x7c, 0x45,
+	0x29, 0x22, 0xd0, 0x5d, 0x53, 0x9c, 0x7e, 0xfa, 0xea, 0xd9, 0x7d, 0x6e, 0xef, 0xf1, 0xf3, 0xf3,
+	0x12, 0xf7, 0xf2, 0xbc, 0xc4, 0xfd, 0x75, 0x5e, 0xe2, 0xbe, 0xbf, 0x28, 0x4d, 0xbd, 0xbc, 0x28,
+	0x4d, 0xfd, 0x7e, 0x51, 0x9a, 0xfa, 0x64, 0x47, 0xd5, 0xec, 0xd3, 0x6e, 0xb3, 0xa6, 0xe0, 0x8e,
+	0xc4, 0x3e, 0xec, 0x6a, 0x4d, 0x65, 0x45, 0xc5, 0x52, 0x6f, 0x53, 0xea, 0xe0, 0x56, 0xb7, 0x8d,
+	0x2c, 0xfa, 0x61, 0xf6, 0xc1, 0xfa, 0x8a, 0xef, 0xdb, 0xac, 0xdd, 0x37, 0x90, 0xd5, 0x4c, 0x93,
+	0x8f, 0x12, 0xeb, 0x7f, 0x0f, 0x00, 0x19, 0x3b, 0x3e, 0x7b, 0x63, 0x16, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// ConnectionOpenInit defines a rpc handler method for MsgConnectionOpenInit.
+	ConnectionOpenInit(ctx context.Context, in *MsgConnectionOpenInit, opts ...grpc.CallOption) (*MsgConnectionOpenInitResponse, error)
+	// ConnectionOpenTry defines a rpc handler method for MsgConnectionOpenTry.
+	ConnectionOpenTry(ctx context.Context, in *MsgConnectionOpenTry, opts ...grpc.CallOption) (*MsgConnectionOpenTryResponse, error)
+	// ConnectionOpenAck defines a rpc handler method for MsgConnectionOpenAck.
+	ConnectionOpenAck(ctx context.Context, in *MsgConnectionOpenAck, opts ...grpc.CallOption) (*MsgConnectionOpenAckResponse, error)
+	// ConnectionOpenConfirm defines a rpc handler method for
+	// MsgConnectionOpenConfirm.
+	ConnectionOpenConfirm(ctx context.Context, in *MsgConnectionOpenConfirm, opts ...grpc.CallOption) (*MsgConnectionOpenConfirmResponse, error)
+	// ConnectionUpgradeInit defines a rpc handler method for
+	// MsgConnectionUpgradeInit.
+	ConnectionUpgradeInit(ctx context.Context, in *MsgConnectionUpgradeInit, opts ...grpc.CallOption) (*MsgConnectionUpgradeInitResponse, error)
+	// ConnectionUpgradeTry defines a rpc handler method for
+	// MsgConnectionUpgradeTry.
+	ConnectionUpgradeTry(ctx context.Context, in *MsgConnectionUpgradeTry, opts ...grpc.CallOption) (*MsgConnectionUpgradeTryResponse, error)
+	// ConnectionUpgradeAck defines a rpc handler method for
+	// MsgConnectionUpgradeAck.
+	ConnectionUpgradeAck(ctx context.Context, in *MsgConnectionUpgradeAck, opts ...grpc.CallOption) (*MsgConnectionUpgradeAckResponse, error)
+	// ConnectionUpgradeConfirm defines a rpc handler method for
+	// MsgConnectionUpgradeConfirm.
+	ConnectionUpgradeConfirm(ctx context.Context, in *MsgConnectionUpgradeConfirm, opts ...grpc.CallOption) (*MsgConnectionUpgradeConfirmResponse, error)
+	// ConnectionUpgradeCancel defines a rpc handler method for
+	// MsgConnectionUpgradeCancel.
+	ConnectionUpgradeCancel(ctx context.Context, in *MsgConnectionUpgradeCancel, opts ...grpc.CallOption) (*MsgConnectionUpgradeCancelResponse, error)
+	// ConnectionUpgradeTimeout defines a rpc handler method for
+	// MsgConnectionUpgradeTimeout.
+	ConnectionUpgradeTimeout(ctx context.Context, in *MsgConnectionUpgradeTimeout, opts ...grpc.CallOption) (*MsgConnectionUpgradeTimeoutResponse, error)
+	// UpdateConnectionParams defines a rpc handler method for
+	// MsgUpdateParams.
+	UpdateConnectionParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) ConnectionOpenInit(ctx context.Context, in *MsgConnectionOpenInit, opts ...grpc.CallOption) (*MsgConnectionOpenInitResponse, error) {
+	out := new(MsgConnectionOpenInitResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.connection.v1.Msg/ConnectionOpenInit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgConnectionOpenTryResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ConnectionOpenTry(ctx context.Context, in *MsgConnectionOpenTry, opts ...grpc.CallOption) (*MsgConnectionOpenTryResponse, error) {
+	out := new(MsgConnectionOpenTryResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.connection.v1.Msg/ConnectionOpenTry", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgConnectionOpenTryResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ConnectionOpenAck(ctx context.Context, in *MsgConnectionOpenAck, opts ...grpc.CallOption) (*MsgConnectionOpenAckResponse, error) {
+	out := new(MsgConnectionOpenAckResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.connection.v1.Msg/ConnectionOpenAck", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgConnectionOpenTryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) ConnectionOpenConfirm(ctx context.Context, in *MsgConnectionOpenConfirm, opts ...grpc.CallOption) (*MsgConnectionOpenConfirmResponse, error) {
+	out := new(MsgConnectionOpenConfirmResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.connection.v1.Msg/ConnectionOpenConfirm", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgConnectionOpenAck) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ConnectionUpgradeInit(ctx context.Context, in *MsgConnectionUpgradeInit, opts ...grpc.CallOption) (*MsgConnectionUpgradeInitResponse, error) {
+	out := new(MsgConnectionUpgradeInitResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.connection.v1.Msg/ConnectionUpgradeInit", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgConnectionOpenAck) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ConnectionUpgradeTry(ctx context.Context, in *MsgConnectionUpgradeTry, opts ...grpc.CallOption) (*MsgConnectionUpgradeTryResponse, error) {
+	out := new(MsgConnectionUpgradeTryResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.connection.v1.Msg/ConnectionUpgradeTry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgConnectionOpenAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.HostConsensusStateProof) > 0 {
-		i -= len(m.HostConsensusStateProof)
-		copy(dAtA[i:], m.HostConsensusStateProof)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.HostConsensusStateProof)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x52
-	}
-	{
-		size, err := m.ConsensusHeight.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x4a
-	if len(m.ProofConsensus) > 0 {
-		i -= len(m.ProofConsensus)
-		copy(dAtA[i:], m.ProofConsensus)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofConsensus)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.ProofClient) > 0 {
-		i -= len(m.ProofClient)
-		copy(dAtA[i:], m.ProofClient)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofClient)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.ProofTry) > 0 {
-		i -= len(m.ProofTry)
-		copy(dAtA[i:], m.ProofTry)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofTry)))
-		i--
-		dAtA[i] = 0x32
-	}
-	{
-		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if m.ClientState != nil {
-		{
-			size, err := m.ClientState.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Version != nil {
-		{
-			size, err := m.Version.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.CounterpartyConnectionId) > 0 {
-		i -= len(m.CounterpartyConnectionId)
-		copy(dAtA[i:], m.CounterpartyConnectionId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CounterpartyConnectionId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ConnectionId) > 0 {
-		i -= len(m.ConnectionId)
-		copy(dAtA[i:], m.ConnectionId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) ConnectionUpgradeAck(ctx context.Context, in *MsgConnectionUpgradeAck, opts ...grpc.CallOption) (*MsgConnectionUpgradeAckResponse, error) {
+	out := new(MsgConnectionUpgradeAckResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.connection.v1.Msg/ConnectionUpgradeAck", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgConnectionOpenAckResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ConnectionUpgradeConfirm(ctx context.Context, in *MsgConnectionUpgradeConfirm, opts ...grpc.CallOption) (*MsgConnectionUpgradeConfirmResponse, error) {
+	out := new(MsgConnectionUpgradeConfirmResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.connection.v1.Msg/ConnectionUpgradeConfirm", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgConnectionOpenAckResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ConnectionUpgradeCancel(ctx context.Context, in *MsgConnectionUpgradeCancel, opts ...grpc.CallOption) (*MsgConnectionUpgradeCancelResponse, error) {
+	out := new(MsgConnectionUpgradeCancelResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.connection.v1.Msg/ConnectionUpgradeCancel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgConnectionOpenAckResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) ConnectionUpgradeTimeout(ctx context.Context, in *MsgConnectionUpgradeTimeout, opts ...grpc.CallOption) (*MsgConnectionUpgradeTimeoutResponse, error) {
+	out := new(MsgConnectionUpgradeTimeoutResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.connection.v1.Msg/ConnectionUpgradeTimeout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgConnectionOpenConfirm) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateConnectionParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.connection.v1.Msg/UpdateConnectionParams", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgConnectionOpenConfirm) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// ConnectionOpenInit defines a rpc handler method for MsgConnectionOpenInit.
+	ConnectionOpenInit(context.Context, *MsgConnectionOpenInit) (*MsgConnectionOpenInitResponse, error)
+	// ConnectionOpenTry defines a rpc handler method for MsgConnectionOpenTry.
+	ConnectionOpenTry(context.Context, *MsgConnectionOpenTry) (*MsgConnectionOpenTryResponse, error)
+	// ConnectionOpenAck defines a rpc handler method for MsgConnectionOpenAck.
+	ConnectionOpenAck(context.Context, *MsgConnectionOpenAck) (*MsgConnectionOpenAckResponse, error)
+	// ConnectionOpenConfirm defines a rpc handler method for
+	// MsgConnectionOpenConfirm.
+	ConnectionOpenConfirm(context.Context, *MsgConnectionOpenConfirm) (*MsgConnectionOpenConfirmResponse, error)
+	// ConnectionUpgradeInit defines a rpc handler method for
+	// MsgConnectionUpgradeInit.
+	ConnectionUpgradeInit(context.Context, *MsgConnectionUpgradeInit) (*MsgConnectionUpgradeInitResponse, error)
+	// ConnectionUpgradeTry defines a rpc handler method for
+	// MsgConnectionUpgradeTry.
+	ConnectionUpgradeTry(context.Context, *MsgConnectionUpgradeTry) (*MsgConnectionUpgradeTryResponse, error)
+	// ConnectionUpgradeAck defines a rpc handler method for
+	// MsgConnectionUpgradeAck.
+	ConnectionUpgradeAck(context.Context, *MsgConnectionUpgradeAck) (*MsgConnectionUpgradeAckResponse, error)
+	// ConnectionUpgradeConfirm defines a rpc handler method for
+	// MsgConnectionUpgradeConfirm.
+	ConnectionUpgradeConfirm(context.Context, *MsgConnectionUpgradeConfirm) (*MsgConnectionUpgradeConfirmResponse, error)
+	// ConnectionUpgradeCancel defines a rpc handler method for
+	// MsgConnectionUpgradeCancel.
+	ConnectionUpgradeCancel(context.Context, *MsgConnectionUpgradeCancel) (*MsgConnectionUpgradeCancelResponse, error)
+	// ConnectionUpgradeTimeout defines a rpc handler method for
+	// MsgConnectionUpgradeTimeout.
+	ConnectionUpgradeTimeout(context.Context, *MsgConnectionUpgradeTimeout) (*MsgConnectionUpgradeTimeoutResponse, error)
+	// UpdateConnectionParams defines a rpc handler method for
+	// MsgUpdateParams.
+	UpdateConnectionParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
-func (m *MsgConnectionOpenConfirm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x22
-	}
-	{
-		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.ProofAck) > 0 {
-		i -= len(m.ProofAck)
-		copy(dAtA[i:], m.ProofAck)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofAck)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ConnectionId) > 0 {
-		i -= len(m.ConnectionId)
-		copy(dAtA[i:], m.ConnectionId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgConnectionOpenConfirmResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) ConnectionOpenInit(ctx context.Context, req *MsgConnectionOpenInit) (*MsgConnectionOpenInitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectionOpenInit not implemented")
 }
-
-func (m *MsgConnectionOpenConfirmResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) ConnectionOpenTry(ctx context.Context, req *MsgConnectionOpenTry) (*MsgConnectionOpenTryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectionOpenTry not implemented")
+}
+func (*UnimplementedMsgServer) ConnectionOpenAck(ctx context.Context, req *MsgConnectionOpenAck) (*MsgConnectionOpenAckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectionOpenAck not implemented")
+}
+func (*UnimplementedMsgServer) ConnectionOpenConfirm(ctx context.Context, req *MsgConnectionOpenConfirm) (*MsgConnectionOpenConfirmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectionOpenConfirm not implemented")
+}
+func (*UnimplementedMsgServer) ConnectionUpgradeInit(ctx context.Context, req *MsgConnectionUpgradeInit) (*MsgConnectionUpgradeInitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectionUpgradeInit not implemented")
+}
+func (*UnimplementedMsgServer) ConnectionUpgradeTry(ctx context.Context, req *MsgConnectionUpgradeTry) (*MsgConnectionUpgradeTryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectionUpgradeTry not implemented")
+}
+func (*UnimplementedMsgServer) ConnectionUpgradeAck(ctx context.Context, req *MsgConnectionUpgradeAck) (*MsgConnectionUpgradeAckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectionUpgradeAck not implemented")
+}
+func (*UnimplementedMsgServer) ConnectionUpgradeConfirm(ctx context.Context, req *MsgConnectionUpgradeConfirm) (*MsgConnectionUpgradeConfirmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectionUpgradeConfirm not implemented")
+}
+func (*UnimplementedMsgServer) ConnectionUpgradeCancel(ctx context.Context, req *MsgConnectionUpgradeCancel) (*MsgConnectionUpgradeCancelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectionUpgradeCancel not implemented")
+}
+func (*UnimplementedMsgServer) ConnectionUpgradeTimeout(ctx context.Context, req *MsgConnectionUpgradeTimeout) (*MsgConnectionUpgradeTimeoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectionUpgradeTimeout not implemented")
+}
+func (*UnimplementedMsgServer) UpdateConnectionParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateConnectionParams not implemented")
 }
 
-func (m *MsgConnectionOpenConfirmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_ConnectionOpenInit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConnectionOpenInit)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).ConnectionOpenInit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.connection.v1.Msg/ConnectionOpenInit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConnectionOpenInit(ctx, req.(*MsgConnectionOpenInit))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_ConnectionOpenTry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConnectionOpenTry)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConnectionOpenTry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.connection.v1.Msg/ConnectionOpenTry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConnectionOpenTry(ctx, req.(*MsgConnectionOpenTry))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func _Msg_ConnectionOpenAck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConnectionOpenAck)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConnectionOpenAck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.connection.v1.Msg/ConnectionOpenAck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConnectionOpenAck(ctx, req.(*MsgConnectionOpenAck))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ConnectionOpenConfirm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConnectionOpenConfirm)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConnectionOpenConfirm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.connection.v1.Msg/ConnectionOpenConfirm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConnectionOpenConfirm(ctx, req.(*MsgConnectionOpenConfirm))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ConnectionUpgradeInit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConnectionUpgradeInit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConnectionUpgradeInit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.connection.v1.Msg/ConnectionUpgradeInit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConnectionUpgradeInit(ctx, req.(*MsgConnectionUpgradeInit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ConnectionUpgradeTry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConnectionUpgradeTry)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConnectionUpgradeTry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.connection.v1.Msg/ConnectionUpgradeTry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConnectionUpgradeTry(ctx, req.(*MsgConnectionUpgradeTry))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ConnectionUpgradeAck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConnectionUpgradeAck)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConnectionUpgradeAck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.connection.v1.Msg/ConnectionUpgradeAck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConnectionUpgradeAck(ctx, req.(*MsgConnectionUpgradeAck))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ConnectionUpgradeConfirm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConnectionUpgradeConfirm)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConnectionUpgradeConfirm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.connection.v1.Msg/ConnectionUpgradeConfirm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConnectionUpgradeConfirm(ctx, req.(*MsgConnectionUpgradeConfirm))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ConnectionUpgradeCancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConnectionUpgradeCancel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConnectionUpgradeCancel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.connection.v1.Msg/ConnectionUpgradeCancel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConnectionUpgradeCancel(ctx, req.(*MsgConnectionUpgradeCancel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ConnectionUpgradeTimeout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConnectionUpgradeTimeout)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConnectionUpgradeTimeout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.connection.v1.Msg/ConnectionUpgradeTimeout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConnectionUpgradeTimeout(ctx, req.(*MsgConnectionUpgradeTimeout))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateConnectionParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateConnectionParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.connection.v1.Msg/UpdateConnectionParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateConnectionParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ibc.core.connection.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ConnectionOpenInit",
+			Handler:    _Msg_ConnectionOpenInit_Handler,
+		},
+		{
+			MethodName: "ConnectionOpenTry",
+			Handler:    _Msg_ConnectionOpenTry_Handler,
+		},
+		{
+			MethodName: "ConnectionOpenAck",
+			Handler:    _Msg_ConnectionOpenAck_Handler,
+		},
+		{
+			MethodName: "ConnectionOpenConfirm",
+			Handler:    _Msg_ConnectionOpenConfirm_Handler,
+		},
+		{
+			MethodName: "ConnectionUpgradeInit",
+			Handler:    _Msg_ConnectionUpgradeInit_Handler,
+		},
+		{
+			MethodName: "ConnectionUpgradeTry",
+			Handler:    _Msg_ConnectionUpgradeTry_Handler,
+		},
+		{
+			MethodName: "ConnectionUpgradeAck",
+			Handler:    _Msg_ConnectionUpgradeAck_Handler,
+		},
+		{
+			MethodName: "ConnectionUpgradeConfirm",
+			Handler:    _Msg_ConnectionUpgradeConfirm_Handler,
+		},
+		{
+			MethodName: "ConnectionUpgradeCancel",
+			Handler:    _Msg_ConnectionUpgradeCancel_Handler,
+		},
+		{
+			MethodName: "ConnectionUpgradeTimeout",
+			Handler:    _Msg_ConnectionUpgradeTimeout_Handler,
+		},
+		{
+			MethodName: "UpdateConnectionParams",
+			Handler:    _Msg_UpdateConnectionParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ibc/core/connection/v1/tx.proto",
+}
+
+func (m *MsgConnectionOpenInit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConnectionOpenInit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConnectionOpenInit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.DelayPeriod != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DelayPeriod))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Version != nil {
+		{
+			size, err := m.Version.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Counterparty.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -1262,17 +1593,17 @@ func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x12
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgConnectionOpenInitResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1282,12 +1613,12 @@ func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgConnectionOpenInitResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgConnectionOpenInitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1295,238 +1626,2595 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgConnectionOpenInit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Counterparty.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.Version != nil {
-		l = m.Version.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.DelayPeriod != 0 {
-		n += 1 + sovTx(uint64(m.DelayPeriod))
-	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgConnectionOpenTry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgConnectionOpenInitResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgConnectionOpenTry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgConnectionOpenTry) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgConnectionOpenTry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.HostConsensusStateProof) > 0 {
+		i -= len(m.HostConsensusStateProof)
+		copy(dAtA[i:], m.HostConsensusStateProof)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HostConsensusStateProof)))
+		i--
+		dAtA[i] = 0x6a
 	}
-	l = len(m.PreviousConnectionId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x62
 	}
-	if m.ClientState != nil {
-		l = m.ClientState.Size()
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.ConsensusHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = m.Counterparty.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.DelayPeriod != 0 {
-		n += 1 + sovTx(uint64(m.DelayPeriod))
+	i--
+	dAtA[i] = 0x5a
+	if len(m.ProofConsensus) > 0 {
+		i -= len(m.ProofConsensus)
+		copy(dAtA[i:], m.ProofConsensus)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofConsensus)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.ProofClient) > 0 {
+		i -= len(m.ProofClient)
+		copy(dAtA[i:], m.ProofClient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofClient)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ProofInit) > 0 {
+		i -= len(m.ProofInit)
+		copy(dAtA[i:], m.ProofInit)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofInit)))
+		i--
+		dAtA[i] = 0x42
+	}
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x3a
 	if len(m.CounterpartyVersions) > 0 {
-		for _, e := range m.CounterpartyVersions {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.CounterpartyVersions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CounterpartyVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
 		}
 	}
-	l = m.ProofHeight.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.ProofInit)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.DelayPeriod != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DelayPeriod))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.ProofClient)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Counterparty.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.ProofConsensus)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	if m.ClientState != nil {
+		{
+			size, err := m.ClientState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = m.ConsensusHeight.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.PreviousConnectionId) > 0 {
+		i -= len(m.PreviousConnectionId)
+		copy(dAtA[i:], m.PreviousConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PreviousConnectionId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.HostConsensusStateProof)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgConnectionOpenTryResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgConnectionOpenTryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConnectionOpenTryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConnectionOpenTryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgConnectionOpenAck) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgConnectionOpenAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConnectionOpenAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConnectionOpenAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ConnectionId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.HostConsensusStateProof) > 0 {
+		i -= len(m.HostConsensusStateProof)
+		copy(dAtA[i:], m.HostConsensusStateProof)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HostConsensusStateProof)))
+		i--
+		dAtA[i] = 0x5a
 	}
-	l = len(m.CounterpartyConnectionId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x52
 	}
-	if m.Version != nil {
-		l = m.Version.Size()
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.ConsensusHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if m.ClientState != nil {
-		l = m.ClientState.Size()
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x4a
+	if len(m.ProofConsensus) > 0 {
+		i -= len(m.ProofConsensus)
+		copy(dAtA[i:], m.ProofConsensus)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofConsensus)))
+		i--
+		dAtA[i] = 0x42
 	}
-	l = m.ProofHeight.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.ProofTry)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ProofClient) > 0 {
+		i -= len(m.ProofClient)
+		copy(dAtA[i:], m.ProofClient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofClient)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	l = len(m.ProofClient)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ProofTry) > 0 {
+		i -= len(m.ProofTry)
+		copy(dAtA[i:], m.ProofTry)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofTry)))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.ProofConsensus)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = m.ConsensusHeight.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x2a
+	if m.ClientState != nil {
+		{
+			size, err := m.ClientState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.HostConsensusStateProof)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Version != nil {
+		{
+			size, err := m.Version.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.CounterpartyConnectionId) > 0 {
+		i -= len(m.CounterpartyConnectionId)
+		copy(dAtA[i:], m.CounterpartyConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CounterpartyConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgConnectionOpenAckResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgConnectionOpenAckResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConnectionOpenAckResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConnectionOpenAckResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgConnectionOpenConfirm) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgConnectionOpenConfirm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConnectionOpenConfirm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConnectionOpenConfirm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ConnectionId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.ProofAck)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = m.ProofHeight.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ProofAck) > 0 {
+		i -= len(m.ProofAck)
+		copy(dAtA[i:], m.ProofAck)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofAck)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgConnectionOpenConfirmResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgConnectionOpenConfirmResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConnectionOpenConfirmResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConnectionOpenConfirmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgConnectionUpgradeInit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConnectionUpgradeInit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConnectionUpgradeInit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	{
+		size, err := m.Fields.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgConnectionUpgradeInitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConnectionUpgradeInitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConnectionUpgradeInitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
-}
+	if m.UpgradeSequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UpgradeSequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConnectionUpgradeTry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConnectionUpgradeTry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConnectionUpgradeTry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.ProofConnection) > 0 {
+		i -= len(m.ProofConnection)
+		copy(dAtA[i:], m.ProofConnection)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofConnection)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CounterpartyUpgradeSequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CounterpartyUpgradeSequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.CounterpartyUpgradeFields.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConnectionUpgradeTryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConnectionUpgradeTryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConnectionUpgradeTryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpgradeSequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UpgradeSequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConnectionUpgradeAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConnectionUpgradeAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConnectionUpgradeAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ProofConnection) > 0 {
+		i -= len(m.ProofConnection)
+		copy(dAtA[i:], m.ProofConnection)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofConnection)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.CounterpartyUpgradeFields.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConnectionUpgradeAckResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConnectionUpgradeAckResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConnectionUpgradeAckResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConnectionUpgradeConfirm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConnectionUpgradeConfirm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConnectionUpgradeConfirm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ProofConnection) > 0 {
+		i -= len(m.ProofConnection)
+		copy(dAtA[i:], m.ProofConnection)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofConnection)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConnectionUpgradeConfirmResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConnectionUpgradeConfirmResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConnectionUpgradeConfirmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConnectionUpgradeCancel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConnectionUpgradeCancel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConnectionUpgradeCancel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConnectionUpgradeCancelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConnectionUpgradeCancelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConnectionUpgradeCancelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConnectionUpgradeTimeout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConnectionUpgradeTimeout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConnectionUpgradeTimeout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ProofConnection) > 0 {
+		i -= len(m.ProofConnection)
+		copy(dAtA[i:], m.ProofConnection)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofConnection)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.CounterpartyConnection.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConnectionUpgradeTimeoutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConnectionUpgradeTimeoutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConnectionUpgradeTimeoutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgConnectionOpenInit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Counterparty.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Version != nil {
+		l = m.Version.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DelayPeriod != 0 {
+		n += 1 + sovTx(uint64(m.DelayPeriod))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConnectionOpenInitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgConnectionOpenTry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PreviousConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ClientState != nil {
+		l = m.ClientState.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Counterparty.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.DelayPeriod != 0 {
+		n += 1 + sovTx(uint64(m.DelayPeriod))
+	}
+	if len(m.CounterpartyVersions) > 0 {
+		for _, e := range m.CounterpartyVersions {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ProofInit)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProofClient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProofConsensus)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ConsensusHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.HostConsensusStateProof)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConnectionOpenTryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgConnectionOpenAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CounterpartyConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Version != nil {
+		l = m.Version.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ClientState != nil {
+		l = m.ClientState.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ProofTry)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProofClient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProofConsensus)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ConsensusHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.HostConsensusStateProof)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConnectionOpenAckResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgConnectionOpenConfirm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProofAck)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConnectionOpenConfirmResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgConnectionUpgradeInit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Fields.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConnectionUpgradeInitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UpgradeSequence != 0 {
+		n += 1 + sovTx(uint64(m.UpgradeSequence))
+	}
+	return n
+}
+
+func (m *MsgConnectionUpgradeTry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.CounterpartyUpgradeFields.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.CounterpartyUpgradeSequence != 0 {
+		n += 1 + sovTx(uint64(m.CounterpartyUpgradeSequence))
+	}
+	l = len(m.ProofConnection)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConnectionUpgradeTryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UpgradeSequence != 0 {
+		n += 1 + sovTx(uint64(m.UpgradeSequence))
+	}
+	return n
+}
+
+func (m *MsgConnectionUpgradeAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.CounterpartyUpgradeFields.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ProofConnection)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConnectionUpgradeAckResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgConnectionUpgradeConfirm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProofConnection)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConnectionUpgradeConfirmResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgConnectionUpgradeCancel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConnectionUpgradeCancelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgConnectionUpgradeTimeout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.CounterpartyConnection.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ProofConnection)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConnectionUpgradeTimeoutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgConnectionOpenInit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConnectionOpenInit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConnectionOpenInit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counterparty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Counterparty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Version == nil {
+				m.Version = &Version{}
+			}
+			if err := m.Version.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayPeriod", wireType)
+			}
+			m.DelayPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelayPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConnectionOpenInitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConnectionOpenInitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConnectionOpenInitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConnectionOpenTry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConnectionOpenTry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConnectionOpenTry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClientState == nil {
+				m.ClientState = &types.Any{}
+			}
+			if err := m.ClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counterparty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Counterparty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayPeriod", wireType)
+			}
+			m.DelayPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelayPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyVersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyVersions = append(m.CounterpartyVersions, &Version{})
+			if err := m.CounterpartyVersions[len(m.CounterpartyVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofInit", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofInit = append(m.ProofInit[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofInit == nil {
+				m.ProofInit = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofClient", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofClient = append(m.ProofClient[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofClient == nil {
+				m.ProofClient = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofConsensus", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofConsensus = append(m.ProofConsensus[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofConsensus == nil {
+				m.ProofConsensus = []byte{}
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConsensusHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostConsensusStateProof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostConsensusStateProof = append(m.HostConsensusStateProof[:0], dAtA[iNdEx:postIndex]...)
+			if m.HostConsensusStateProof == nil {
+				m.HostConsensusStateProof = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConnectionOpenTryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConnectionOpenTryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConnectionOpenTryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConnectionOpenAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConnectionOpenAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConnectionOpenAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Version == nil {
+				m.Version = &Version{}
+			}
+			if err := m.Version.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClientState == nil {
+				m.ClientState = &types.Any{}
+			}
+			if err := m.ClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofTry", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofTry = append(m.ProofTry[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofTry == nil {
+				m.ProofTry = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofClient", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofClient = append(m.ProofClient[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofClient == nil {
+				m.ProofClient = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofConsensus", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofConsensus = append(m.ProofConsensus[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofConsensus == nil {
+				m.ProofConsensus = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConsensusHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostConsensusStateProof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostConsensusStateProof = append(m.HostConsensusStateProof[:0], dAtA[iNdEx:postIndex]...)
+			if m.HostConsensusStateProof == nil {
+				m.HostConsensusStateProof = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConnectionOpenAckResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConnectionOpenAckResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConnectionOpenAckResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgConnectionOpenInit) Unmarshal(dAtA []byte) error {
+func (m *MsgConnectionOpenConfirm) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1549,15 +4237,15 @@ func (m *MsgConnectionOpenInit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConnectionOpenInit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConnectionOpenConfirm: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConnectionOpenInit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConnectionOpenConfirm: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1585,13 +4273,13 @@ func (m *MsgConnectionOpenInit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientId = string(dAtA[iNdEx:postIndex])
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Counterparty", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofAck", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1601,28 +4289,29 @@ func (m *MsgConnectionOpenInit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Counterparty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ProofAck = append(m.ProofAck[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofAck == nil {
+				m.ProofAck = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1649,33 +4338,11 @@ func (m *MsgConnectionOpenInit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Version == nil {
-				m.Version = &Version{}
-			}
-			if err := m.Version.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelayPeriod", wireType)
-			}
-			m.DelayPeriod = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DelayPeriod |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -1728,7 +4395,7 @@ func (m *MsgConnectionOpenInit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConnectionOpenInitResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgConnectionOpenConfirmResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1751,10 +4418,10 @@ func (m *MsgConnectionOpenInitResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConnectionOpenInitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConnectionOpenConfirmResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConnectionOpenInitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConnectionOpenConfirmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1778,7 +4445,7 @@ func (m *MsgConnectionOpenInitResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConnectionOpenTry) Unmarshal(dAtA []byte) error {
+func (m *MsgConnectionUpgradeInit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1801,15 +4468,15 @@ func (m *MsgConnectionOpenTry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConnectionOpenTry: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConnectionUpgradeInit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConnectionOpenTry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConnectionUpgradeInit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1837,13 +4504,13 @@ func (m *MsgConnectionOpenTry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientId = string(dAtA[iNdEx:postIndex])
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousConnectionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1853,29 +4520,30 @@ func (m *MsgConnectionOpenTry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviousConnectionId = string(dAtA[iNdEx:postIndex])
+			if err := m.Fields.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1885,66 +4553,79 @@ func (m *MsgConnectionOpenTry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClientState == nil {
-				m.ClientState = &types.Any{}
-			}
-			if err := m.ClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Counterparty", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConnectionUpgradeInitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Counterparty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConnectionUpgradeInitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConnectionUpgradeInitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelayPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeSequence", wireType)
 			}
-			m.DelayPeriod = 0
+			m.UpgradeSequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1954,50 +4635,66 @@ func (m *MsgConnectionOpenTry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DelayPeriod |= uint64(b&0x7F) << shift
+				m.UpgradeSequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyVersions", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConnectionUpgradeTry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CounterpartyVersions = append(m.CounterpartyVersions, &Version{})
-			if err := m.CounterpartyVersions[len(m.CounterpartyVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 7:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConnectionUpgradeTry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConnectionUpgradeTry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2007,30 +4704,29 @@ func (m *MsgConnectionOpenTry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofInit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyUpgradeFields", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2040,31 +4736,30 @@ func (m *MsgConnectionOpenTry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofInit = append(m.ProofInit[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofInit == nil {
-				m.ProofInit = []byte{}
+			if err := m.CounterpartyUpgradeFields.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofClient", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyUpgradeSequence", wireType)
 			}
-			var byteLen int
+			m.CounterpartyUpgradeSequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2074,29 +4769,14 @@ func (m *MsgConnectionOpenTry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.CounterpartyUpgradeSequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProofClient = append(m.ProofClient[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofClient == nil {
-				m.ProofClient = []byte{}
-			}
-			iNdEx = postIndex
-		case 10:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofConsensus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofConnection", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2123,14 +4803,14 @@ func (m *MsgConnectionOpenTry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofConsensus = append(m.ProofConsensus[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofConsensus == nil {
-				m.ProofConsensus = []byte{}
+			m.ProofConnection = append(m.ProofConnection[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofConnection == nil {
+				m.ProofConnection = []byte{}
 			}
 			iNdEx = postIndex
-		case 11:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2157,11 +4837,11 @@ func (m *MsgConnectionOpenTry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ConsensusHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -2193,40 +4873,6 @@ func (m *MsgConnectionOpenTry) Unmarshal(dAtA []byte) error {
 			}
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostConsensusStateProof", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.HostConsensusStateProof = append(m.HostConsensusStateProof[:0], dAtA[iNdEx:postIndex]...)
-			if m.HostConsensusStateProof == nil {
-				m.HostConsensusStateProof = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2248,7 +4894,7 @@ func (m *MsgConnectionOpenTry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConnectionOpenTryResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgConnectionUpgradeTryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2271,12 +4917,31 @@ func (m *MsgConnectionOpenTryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConnectionOpenTryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConnectionUpgradeTryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConnectionOpenTryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConnectionUpgradeTryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeSequence", wireType)
+			}
+			m.UpgradeSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpgradeSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2298,7 +4963,7 @@ func (m *MsgConnectionOpenTryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConnectionOpenAck) Unmarshal(dAtA []byte) error {
+func (m *MsgConnectionUpgradeAck) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2321,10 +4986,10 @@ func (m *MsgConnectionOpenAck) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConnectionOpenAck: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConnectionUpgradeAck: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConnectionOpenAck: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConnectionUpgradeAck: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2361,9 +5026,9 @@ func (m *MsgConnectionOpenAck) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyConnectionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyUpgradeFields", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2373,29 +5038,30 @@ func (m *MsgConnectionOpenAck) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CounterpartyConnectionId = string(dAtA[iNdEx:postIndex])
+			if err := m.CounterpartyUpgradeFields.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofConnection", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2405,31 +5071,29 @@ func (m *MsgConnectionOpenAck) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Version == nil {
-				m.Version = &Version{}
-			}
-			if err := m.Version.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ProofConnection = append(m.ProofConnection[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofConnection == nil {
+				m.ProofConnection = []byte{}
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2456,18 +5120,147 @@ func (m *MsgConnectionOpenAck) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClientState == nil {
-				m.ClientState = &types.Any{}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.ClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConnectionUpgradeAckResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConnectionUpgradeAckResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConnectionUpgradeAckResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 5:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConnectionUpgradeConfirm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConnectionUpgradeConfirm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConnectionUpgradeConfirm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2477,28 +5270,27 @@ func (m *MsgConnectionOpenAck) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofTry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofConnection", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2525,16 +5317,16 @@ func (m *MsgConnectionOpenAck) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofTry = append(m.ProofTry[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofTry == nil {
-				m.ProofTry = []byte{}
+			m.ProofConnection = append(m.ProofConnection[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofConnection == nil {
+				m.ProofConnection = []byte{}
 			}
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofClient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2544,31 +5336,30 @@ func (m *MsgConnectionOpenAck) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofClient = append(m.ProofClient[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofClient == nil {
-				m.ProofClient = []byte{}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofConsensus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2578,62 +5369,127 @@ func (m *MsgConnectionOpenAck) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofConsensus = append(m.ProofConsensus[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofConsensus == nil {
-				m.ProofConsensus = []byte{}
-			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusHeight", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConnectionUpgradeConfirmResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ConsensusHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConnectionUpgradeConfirmResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConnectionUpgradeConfirmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 10:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConnectionUpgradeCancel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConnectionUpgradeCancel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConnectionUpgradeCancel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2661,13 +5517,13 @@ func (m *MsgConnectionOpenAck) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostConsensusStateProof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2677,25 +5533,23 @@ func (m *MsgConnectionOpenAck) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostConsensusStateProof = append(m.HostConsensusStateProof[:0], dAtA[iNdEx:postIndex]...)
-			if m.HostConsensusStateProof == nil {
-				m.HostConsensusStateProof = []byte{}
-			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2718,7 +5572,7 @@ func (m *MsgConnectionOpenAck) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConnectionOpenAckResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgConnectionUpgradeCancelResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2741,10 +5595,10 @@ func (m *MsgConnectionOpenAckResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConnectionOpenAckResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConnectionUpgradeCancelResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConnectionOpenAckResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConnectionUpgradeCancelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2768,7 +5622,7 @@ func (m *MsgConnectionOpenAckResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConnectionOpenConfirm) Unmarshal(dAtA []byte) error {
+func (m *MsgConnectionUpgradeTimeout) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2791,10 +5645,10 @@ func (m *MsgConnectionOpenConfirm) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConnectionOpenConfirm: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConnectionUpgradeTimeout: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConnectionOpenConfirm: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConnectionUpgradeTimeout: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2831,7 +5685,40 @@ func (m *MsgConnectionOpenConfirm) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofAck", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyConnection", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CounterpartyConnection.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofConnection", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2858,12 +5745,12 @@ func (m *MsgConnectionOpenConfirm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofAck = append(m.ProofAck[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProofAck == nil {
-				m.ProofAck = []byte{}
+			m.ProofConnection = append(m.ProofConnection[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofConnection == nil {
+				m.ProofConnection = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
 			}
@@ -2896,7 +5783,7 @@ func (m *MsgConnectionOpenConfirm) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -2949,7 +5836,7 @@ func (m *MsgConnectionOpenConfirm) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConnectionOpenConfirmResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgConnectionUpgradeTimeoutResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2972,10 +5859,10 @@ func (m *MsgConnectionOpenConfirmResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConnectionOpenConfirmResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConnectionUpgradeTimeoutResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConnectionOpenConfirmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConnectionUpgradeTimeoutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+)
+
+// NewConnectionUpgradeFields returns a new ConnectionUpgradeFields instance.
+func NewConnectionUpgradeFields(versions []*Version, delayPeriod uint64, counterpartyPrefix commitmenttypes.MerklePrefix) ConnectionUpgradeFields {
+	return ConnectionUpgradeFields{
+		Versions:           versions,
+		DelayPeriod:        delayPeriod,
+		CounterpartyPrefix: counterpartyPrefix,
+	}
+}
+
+// ValidateBasic performs a basic validation of the proposed upgrade fields.
+func (uf ConnectionUpgradeFields) ValidateBasic() error {
+	if len(uf.Versions) == 0 {
+		return errorsmod.Wrap(ErrInvalidVersion, "empty connection versions")
+	}
+
+	for _, version := range uf.Versions {
+		if err := ValidateVersion(version); err != nil {
+			return err
+		}
+	}
+
+	if uf.CounterpartyPrefix.Empty() {
+		return errorsmod.Wrap(ErrInvalidCounterparty, "counterparty prefix cannot be empty")
+	}
+
+	return nil
+}
+
+// IsCompatible returns true if both connection ends have proposed the same versions and delay period.
+// The counterparty prefix is not compared as each connection end stores the prefix of the other chain.
+func (uf ConnectionUpgradeFields) IsCompatible(counterpartyFields ConnectionUpgradeFields) bool {
+	if uf.DelayPeriod != counterpartyFields.DelayPeriod {
+		return false
+	}
+
+	return slices.EqualFunc(uf.Versions, counterpartyFields.Versions, func(a, b *Version) bool {
+		return a.Identifier == b.Identifier && slices.Equal(a.Features, b.Features)
+	})
+}
+
+// HasUpgrade returns true if a connection upgrade is currently in progress on the connection end.
+func (c ConnectionEnd) HasUpgrade() bool {
+	return c.ProposedUpgrade != nil
+}
+
+// ApplyUpgrade sets the proposed upgrade fields on the connection end and clears the proposed upgrade.
+func (c *ConnectionEnd) ApplyUpgrade() {
+	if c.ProposedUpgrade == nil {
+		return
+	}
+
+	c.Versions = c.ProposedUpgrade.Versions
+	c.DelayPeriod = c.ProposedUpgrade.DelayPeriod
+	c.Counterparty.Prefix = c.ProposedUpgrade.CounterpartyPrefix
+	c.ProposedUpgrade = nil
+}
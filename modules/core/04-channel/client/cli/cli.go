@@ -33,7 +33,13 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryNextSequenceSend(),
 		GetCmdQueryUpgradeError(),
 		GetCmdQueryUpgrade(),
+		GetCmdQueryUpgradeBatch(),
+		GetCmdQueryUpgradeStatus(),
 		GetCmdChannelParams(),
+		GetCmdQueryPacketsBySender(),
+		GetCmdQueryPacketsByReceiver(),
+		GetCmdQueryInvariants(),
+		GetCmdQueryPruningProgress(),
 	)
 
 	return queryCmd
@@ -51,7 +57,9 @@ func NewTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(
 		newUpgradeChannelsTxCmd(),
+		newUpgradeChannelsBatchTxCmd(),
 		newPruneAcknowledgementsTxCmd(),
+		newEnableAcknowledgementPruningTxCmd(),
 	)
 
 	return txCmd
@@ -1,10 +1,17 @@
 package cli
 
 import (
+	"context"
 	"fmt"
+	"io"
+	"slices"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -16,7 +23,9 @@ import (
 )
 
 const (
-	flagSequences = "sequences"
+	flagSequences        = "sequences"
+	flagPacketStatus     = "status"
+	flagCounterpartyNode = "counterparty-node"
 )
 
 // GetCmdQueryChannels defines the command to query all the channels ends
@@ -560,6 +569,94 @@ func GetCmdQueryUpgrade() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryUpgradeBatch defines the command to query the status of the channel upgrades started by an upgrade batch
+func GetCmdQueryUpgradeBatch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "upgrade-batch [batch-id]",
+		Short:   "Query an upgrade batch",
+		Long:    "Query the status of the channel upgrades started by an upgrade batch, along with the error receipts of the failed upgrades",
+		Example: fmt.Sprintf("%s query %s %s upgrade-batch 1", version.AppName, ibcexported.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			batchID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.UpgradeBatch(cmd.Context(), &types.QueryUpgradeBatchRequest{BatchId: batchID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryUpgradeStatus defines the command to print the upgrade handshake state of a channel on both chains
+func GetCmdQueryUpgradeStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade-status [port-id] [channel-id]",
+		Short: "Query the upgrade handshake state of a channel",
+		Long: `Query the upgrade handshake state of a channel end, including the in-flight packets which must be flushed,
+the flush timeouts and the latest error receipt. If the node of the counterparty chain is provided, the state of the
+counterparty channel end and the next expected handshake step are also printed.`,
+		Example: fmt.Sprintf(
+			"%s query %s %s upgrade-status transfer channel-0 --%s http://localhost:26657", version.AppName, ibcexported.ModuleName, types.SubModuleName, flagCounterpartyNode,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			self, err := queryUpgradeEndStatus(cmd.Context(), clientCtx, args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			printUpgradeEndStatus(cmd.OutOrStdout(), "this chain", self)
+
+			counterpartyNode, err := cmd.Flags().GetString(flagCounterpartyNode)
+			if err != nil || counterpartyNode == "" {
+				return err
+			}
+
+			rpcClient, err := client.NewClientFromNode(counterpartyNode)
+			if err != nil {
+				return err
+			}
+
+			counterpartyCtx := clientCtx.WithNodeURI(counterpartyNode).WithClient(rpcClient).WithHeight(0)
+			counterparty, err := queryUpgradeEndStatus(cmd.Context(), counterpartyCtx, self.channel.Counterparty.PortId, self.channel.Counterparty.ChannelId)
+			if err != nil {
+				return err
+			}
+
+			printUpgradeEndStatus(cmd.OutOrStdout(), "counterparty chain", counterparty)
+			fmt.Fprintf(cmd.OutOrStdout(), "next step: %s\n", nextUpgradeStep(self, counterparty))
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagCounterpartyNode, "", "<host>:<port> to the CometBFT RPC interface of the counterparty chain")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdChannelParams returns the command handler for ibc channel parameter querying.
 func GetCmdChannelParams() *cobra.Command {
 	cmd := &cobra.Command{
@@ -584,3 +681,338 @@ func GetCmdChannelParams() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryPacketsBySender defines the command to query the packets in the packet lifecycle index sent by a sender.
+func GetCmdQueryPacketsBySender() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "packets-by-sender [sender]",
+		Short:   "Query the indexed packets sent by a sender",
+		Long:    "Query the packets in the packet lifecycle index sent by a sender, along with their status and acknowledgement",
+		Example: fmt.Sprintf("%s query %s %s packets-by-sender [sender] --%s pending", version.AppName, ibcexported.ModuleName, types.SubModuleName, flagPacketStatus),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			status, err := parsePacketStatusFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPacketsBySenderRequest{
+				Sender:     args[0],
+				Status:     status,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.PacketsBySender(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagPacketStatus, "", "filter packets by status (pending, acknowledged or timed-out)")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "packets sent by a sender")
+
+	return cmd
+}
+
+// GetCmdQueryPacketsByReceiver defines the command to query the packets in the packet lifecycle index sent to a receiver.
+func GetCmdQueryPacketsByReceiver() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "packets-by-receiver [receiver]",
+		Short:   "Query the indexed packets sent to a receiver",
+		Long:    "Query the packets in the packet lifecycle index sent to a receiver, along with their status and acknowledgement",
+		Example: fmt.Sprintf("%s query %s %s packets-by-receiver [receiver] --%s pending", version.AppName, ibcexported.ModuleName, types.SubModuleName, flagPacketStatus),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			status, err := parsePacketStatusFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPacketsByReceiverRequest{
+				Receiver:   args[0],
+				Status:     status,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.PacketsByReceiver(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagPacketStatus, "", "filter packets by status (pending, acknowledged or timed-out)")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "packets sent to a receiver")
+
+	return cmd
+}
+
+// parsePacketStatusFlag parses the packet status flag, e.g. "timed-out" is parsed as PACKET_STATUS_TIMED_OUT.
+// An empty flag returns the unspecified packet status.
+func parsePacketStatusFlag(cmd *cobra.Command) (types.PacketStatus, error) {
+	status, err := cmd.Flags().GetString(flagPacketStatus)
+	if err != nil || status == "" {
+		return types.UNKNOWN, err
+	}
+
+	name := "PACKET_STATUS_" + strings.ToUpper(strings.ReplaceAll(status, "-", "_"))
+	value, ok := types.PacketStatus_value[name]
+	if !ok {
+		return types.UNKNOWN, fmt.Errorf("invalid packet status %s, expected one of pending, acknowledged or timed-out", status)
+	}
+
+	return types.PacketStatus(value), nil
+}
+
+// upgradeEndStatus defines the upgrade handshake state of a channel end.
+type upgradeEndStatus struct {
+	portID       string
+	channelID    string
+	channel      types.Channel
+	upgrade      *types.Upgrade
+	errorReceipt *types.ErrorReceipt
+	flushStatus  *types.QueryUpgradeFlushStatusResponse
+}
+
+// hasCounterpartyUpgrade returns true if the channel end has stored the upgrade of the counterparty,
+// which happens in the ACK and CONFIRM steps of the handshake.
+func (s upgradeEndStatus) hasCounterpartyUpgrade() bool {
+	return s.flushStatus != nil && s.flushStatus.CounterpartyUpgradeTimeout.IsValid()
+}
+
+// hasInflightPackets returns true if the channel end is flushing and has in-flight packets.
+func (s upgradeEndStatus) hasInflightPackets() bool {
+	return s.channel.State == types.FLUSHING && s.flushStatus != nil && len(s.flushStatus.InflightSequences) > 0
+}
+
+// queryUpgradeEndStatus queries the upgrade handshake state of the given channel end.
+// The upgrade, error receipt and flush status are left unset if they do not exist.
+func queryUpgradeEndStatus(ctx context.Context, clientCtx client.Context, portID, channelID string) (upgradeEndStatus, error) {
+	queryClient := types.NewQueryClient(clientCtx)
+
+	channelRes, err := queryClient.Channel(ctx, &types.QueryChannelRequest{PortId: portID, ChannelId: channelID})
+	if err != nil {
+		return upgradeEndStatus{}, err
+	}
+
+	endStatus := upgradeEndStatus{
+		portID:    portID,
+		channelID: channelID,
+		channel:   *channelRes.Channel,
+	}
+
+	upgradeRes, err := queryClient.Upgrade(ctx, &types.QueryUpgradeRequest{PortId: portID, ChannelId: channelID})
+	switch {
+	case err == nil:
+		endStatus.upgrade = &upgradeRes.Upgrade
+	case status.Code(err) != codes.NotFound:
+		return upgradeEndStatus{}, err
+	}
+
+	errorRes, err := queryClient.UpgradeError(ctx, &types.QueryUpgradeErrorRequest{PortId: portID, ChannelId: channelID})
+	switch {
+	case err == nil:
+		endStatus.errorReceipt = &errorRes.ErrorReceipt
+	case status.Code(err) != codes.NotFound:
+		return upgradeEndStatus{}, err
+	}
+
+	if endStatus.upgrade == nil {
+		return endStatus, nil
+	}
+
+	endStatus.flushStatus, err = queryClient.UpgradeFlushStatus(ctx, &types.QueryUpgradeFlushStatusRequest{PortId: portID, ChannelId: channelID})
+	if err != nil {
+		return upgradeEndStatus{}, err
+	}
+
+	return endStatus, nil
+}
+
+// printUpgradeEndStatus prints the upgrade handshake state of a channel end.
+func printUpgradeEndStatus(w io.Writer, name string, endStatus upgradeEndStatus) {
+	fmt.Fprintf(w, "%s: port %s, channel %s\n", name, endStatus.portID, endStatus.channelID)
+	fmt.Fprintf(w, "  state: %s\n", endStatus.channel.State)
+	fmt.Fprintf(w, "  upgrade sequence: %d\n", endStatus.channel.UpgradeSequence)
+	fmt.Fprintf(w, "  ordering: %s, version: %s, connection hops: %s\n", endStatus.channel.Ordering, endStatus.channel.Version, strings.Join(endStatus.channel.ConnectionHops, ","))
+
+	if endStatus.upgrade == nil {
+		fmt.Fprintln(w, "  upgrade: none")
+	} else {
+		fields := endStatus.upgrade.Fields
+		fmt.Fprintf(w, "  upgrade: ordering: %s, version: %s, connection hops: %s\n", fields.Ordering, fields.Version, strings.Join(fields.ConnectionHops, ","))
+	}
+
+	if endStatus.flushStatus != nil {
+		fmt.Fprintf(w, "  in-flight packets: %v\n", endStatus.flushStatus.InflightSequences)
+		fmt.Fprintf(w, "  upgrade timeout: %s\n", formatUpgradeTimeout(endStatus.flushStatus.UpgradeTimeout))
+		fmt.Fprintf(w, "  counterparty upgrade timeout: %s\n", formatUpgradeTimeout(endStatus.flushStatus.CounterpartyUpgradeTimeout))
+		if endStatus.flushStatus.FlushDeadline.IsValid() {
+			fmt.Fprintf(w, "  flush deadline: %s\n", formatUpgradeTimeout(endStatus.flushStatus.FlushDeadline))
+		}
+	}
+
+	if endStatus.errorReceipt != nil {
+		fmt.Fprintf(w, "  error receipt: sequence %d: %s\n", endStatus.errorReceipt.Sequence, endStatus.errorReceipt.Message)
+	}
+}
+
+// formatUpgradeTimeout formats an upgrade timeout for printing.
+func formatUpgradeTimeout(timeout types.Timeout) string {
+	if !timeout.IsValid() {
+		return "unset"
+	}
+
+	if timeout.Timestamp == 0 {
+		return fmt.Sprintf("height %s", timeout.Height)
+	}
+
+	return fmt.Sprintf("height %s, timestamp %s", timeout.Height, time.Unix(0, int64(timeout.Timestamp)).UTC())
+}
+
+// nextUpgradeStep returns a description of the next expected step of the upgrade handshake between the given channel ends.
+func nextUpgradeStep(self, counterparty upgradeEndStatus) string {
+	ends := []struct {
+		name, counterpartyName string
+		self, counterparty     upgradeEndStatus
+	}{
+		{"this chain", "counterparty chain", self, counterparty},
+		{"counterparty chain", "this chain", counterparty, self},
+	}
+
+	if self.upgrade == nil && counterparty.upgrade == nil {
+		return "no upgrade in progress"
+	}
+
+	for _, end := range ends {
+		receipt := end.counterparty.errorReceipt
+		if end.self.upgrade != nil && receipt != nil && receipt.Sequence >= end.self.channel.UpgradeSequence {
+			return fmt.Sprintf("MsgChannelUpgradeCancel on the %s using the error receipt of the %s", end.name, end.counterpartyName)
+		}
+	}
+
+	for _, end := range ends {
+		switch {
+		case end.self.channel.State == types.OPEN && end.self.upgrade == nil && end.counterparty.channel.State == types.OPEN:
+			return fmt.Sprintf("MsgChannelUpgradeTry on the %s", end.name)
+		case end.self.channel.State == types.OPEN && end.self.upgrade != nil && end.counterparty.channel.State == types.FLUSHING:
+			return fmt.Sprintf("MsgChannelUpgradeAck on the %s", end.name)
+		case end.self.channel.State == types.FLUSHING && !end.self.hasCounterpartyUpgrade() && end.counterparty.hasCounterpartyUpgrade():
+			return fmt.Sprintf("MsgChannelUpgradeConfirm on the %s", end.name)
+		}
+	}
+
+	for _, end := range ends {
+		if end.self.hasInflightPackets() {
+			return fmt.Sprintf("acknowledge or time out the in-flight packets %v sent by the %s", end.self.flushStatus.InflightSequences, end.name)
+		}
+	}
+
+	for _, end := range ends {
+		if end.self.channel.State == types.FLUSHCOMPLETE && slices.Contains([]types.State{types.FLUSHCOMPLETE, types.OPEN}, end.counterparty.channel.State) {
+			return fmt.Sprintf("MsgChannelUpgradeOpen on the %s", end.name)
+		}
+	}
+
+	return "waiting for the upgrade to progress"
+}
+
+// GetCmdQueryPruningProgress defines the command to query the acknowledgement pruning progress of a channel
+func GetCmdQueryPruningProgress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pruning-progress [port-id] [channel-id]",
+		Short:   "Query the acknowledgement pruning progress of a channel",
+		Long:    "Query the range of packet sequences whose acknowledgements and receipts remain to be pruned on a channel",
+		Example: fmt.Sprintf("%s query %s %s pruning-progress transfer channel-0", version.AppName, ibcexported.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PruningProgress(cmd.Context(), &types.QueryPruningProgressRequest{PortId: args[0], ChannelId: args[1]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryInvariants defines the command to run a channel invariant against a page of the current state.
+func GetCmdQueryInvariants() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "invariants [invariant]",
+		Short: "Query the result of running a channel invariant",
+		Long: `Query the result of running a channel invariant against a page of the current state. The packet-commitment-sequences
+invariant is run against a page of packet commitments, the channel-upgrades invariant against a page of channels.
+A broken invariant is reported with a description of the inconsistency.`,
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query %s %s invariants channel-upgrades --limit 100", version.AppName, ibcexported.ModuleName, types.SubModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryInvariantsRequest{
+				Invariant:  args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.Invariants(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "invariants")
+
+	return cmd
+}
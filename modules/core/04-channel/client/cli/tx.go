@@ -13,18 +13,25 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	"github.com/cosmos/cosmos-sdk/version"
 	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
 )
 
 const (
-	flagJSON        = "json"
-	flagPortPattern = "port-pattern"
-	flagExpedited   = "expedited"
-	flagChannelIDs  = "channel-ids"
+	flagJSON                = "json"
+	flagPortPattern         = "port-pattern"
+	flagExpedited           = "expedited"
+	flagChannelIDs          = "channel-ids"
+	flagAuthority           = "authority"
+	flagConnectionID        = "connection-id"
+	flagCounterpartyChainID = "counterparty-chain-id"
+	flagOrdering            = "ordering"
+	flagMaxRetries          = "max-retries"
 )
 
 // newPruneAcknowledgementsTxCmd returns the command to create a new MsgPruneAcknowledgements transaction
@@ -59,6 +66,64 @@ func newPruneAcknowledgementsTxCmd() *cobra.Command {
 	return cmd
 }
 
+// newEnableAcknowledgementPruningTxCmd returns the command to submit a governance proposal enabling the pruning
+// of the packet acknowledgements and receipts of a channel which has never been upgraded.
+func newEnableAcknowledgementPruningTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "enable-acknowledgement-pruning [port-id] [channel-id] [recv-start-sequence]",
+		Short: "Enable the pruning of packet acknowledgements and receipts of a channel",
+		Long: `Submit a governance proposal to enable the pruning of the packet acknowledgements and receipts of a channel
+which has never been upgraded. Packets with a sequence lower than the recv start sequence are rejected as already
+received once the proposal passes, every packet sent by the counterparty with a lower sequence must therefore be
+received and acknowledged, or timed out.`,
+		Args:    cobra.ExactArgs(3),
+		Example: fmt.Sprintf("%s tx %s %s enable-acknowledgement-pruning transfer channel-0 1000 --deposit 10stake", version.AppName, ibcexported.ModuleName, types.SubModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := govcli.ReadGovPropFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			authority, _ := cmd.Flags().GetString(flagAuthority)
+			if authority != "" {
+				if _, err = sdk.AccAddressFromBech32(authority); err != nil {
+					return fmt.Errorf("invalid authority address: %w", err)
+				}
+			} else {
+				authority = sdk.AccAddress(address.Module(govtypes.ModuleName)).String()
+			}
+
+			recvStartSequence, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgEnableAcknowledgementPruning(authority, args[0], args[1], recvStartSequence)
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("error validating %T: %w", types.MsgEnableAcknowledgementPruning{}, err)
+			}
+
+			if err := proposal.SetMsgs([]sdk.Msg{msg}); err != nil {
+				return fmt.Errorf("failed to create enable acknowledgement pruning proposal message: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+		},
+	}
+
+	cmd.Flags().String(flagAuthority, "", "The address of the ibc module authority (defaults to gov)")
+
+	flags.AddTxFlagsToCmd(cmd)
+	govcli.AddGovPropFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func newUpgradeChannelsTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "upgrade-channels [version]",
@@ -149,6 +214,81 @@ func newUpgradeChannelsTxCmd() *cobra.Command {
 	return cmd
 }
 
+// newUpgradeChannelsBatchTxCmd returns the command to submit a governance proposal containing a MsgChannelUpgradeInitBatch.
+func newUpgradeChannelsBatchTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade-channels-batch [port-id] [version]",
+		Short: "Upgrade IBC channels as a batch",
+		Long: `Submit a governance proposal to upgrade all open channels bound to the given port, optionally only those
+built upon the given connection or connected to the given counterparty chain. The progress of the upgrades is
+tracked on chain and may be queried using the identifier of the batch. Failed upgrades are retried up to the
+given maximum number of retries.`,
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf(`%s tx %s %s upgrade-channels-batch transfer "{\"fee_version\":\"ics29-1\",\"app_version\":\"ics20-1\"}" --%s 3 --deposit 10stake`, version.AppName, ibcexported.ModuleName, types.SubModuleName, flagMaxRetries),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := govcli.ReadGovPropFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			authority, _ := cmd.Flags().GetString(flagAuthority)
+			if authority != "" {
+				if _, err = sdk.AccAddressFromBech32(authority); err != nil {
+					return fmt.Errorf("invalid authority address: %w", err)
+				}
+			} else {
+				authority = sdk.AccAddress(address.Module(govtypes.ModuleName)).String()
+			}
+
+			connectionID, _ := cmd.Flags().GetString(flagConnectionID)
+			counterpartyChainID, _ := cmd.Flags().GetString(flagCounterpartyChainID)
+
+			ordering := types.NONE
+			if orderingStr, _ := cmd.Flags().GetString(flagOrdering); orderingStr != "" {
+				order, ok := types.Order_value[orderingStr]
+				if !ok {
+					return fmt.Errorf("invalid channel ordering %s", orderingStr)
+				}
+				ordering = types.Order(order)
+			}
+
+			maxRetries, err := cmd.Flags().GetUint64(flagMaxRetries)
+			if err != nil {
+				return err
+			}
+
+			filter := types.NewUpgradeBatchFilter(args[0], connectionID, counterpartyChainID)
+			msg := types.NewMsgChannelUpgradeInitBatch(authority, filter, ordering, args[1], types.NewUpgradeBatchRetryPolicy(maxRetries))
+
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("error validating %T: %w", types.MsgChannelUpgradeInitBatch{}, err)
+			}
+
+			if err := proposal.SetMsgs([]sdk.Msg{msg}); err != nil {
+				return fmt.Errorf("failed to create upgrade channels batch proposal message: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+		},
+	}
+
+	cmd.Flags().String(flagAuthority, "", "The address of the ibc module authority (defaults to gov)")
+	cmd.Flags().String(flagConnectionID, "", "Only upgrade the channels built upon the given connection.")
+	cmd.Flags().String(flagCounterpartyChainID, "", "Only upgrade the channels connected to the given counterparty chain.")
+	cmd.Flags().String(flagOrdering, "", "The proposed channel ordering, e.g. ORDER_UNORDERED. The current ordering of each channel is kept if unset.")
+	cmd.Flags().Uint64(flagMaxRetries, 0, "The maximum number of times a failed channel upgrade is retried.")
+
+	flags.AddTxFlagsToCmd(cmd)
+	govcli.AddGovPropFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // getChannelIDs returns a slice of channel IDs based on a comma separated string of channel IDs.
 func getChannelIDs(commaSeparatedList string) []string {
 	if strings.TrimSpace(commaSeparatedList) == "" {
@@ -1,12 +1,14 @@
 package channel
 
 import (
+	"bytes"
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/keeper"
 	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 )
 
 // InitGenesis initializes the ibc channel submodule's state from a provided genesis
@@ -27,7 +29,7 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
 		k.SetPacketCommitment(ctx, commitment.PortId, commitment.ChannelId, commitment.Sequence, commitment.Data)
 	}
 	for _, receipt := range gs.Receipts {
-		k.SetPacketReceipt(ctx, receipt.PortId, receipt.ChannelId, receipt.Sequence)
+		setPacketReceipt(ctx, k, receipt)
 	}
 	for _, ss := range gs.SendSequences {
 		k.SetNextSequenceSend(ctx, ss.PortId, ss.ChannelId, ss.Sequence)
@@ -38,20 +40,121 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
 	for _, as := range gs.AckSequences {
 		k.SetNextSequenceAck(ctx, as.PortId, as.ChannelId, as.Sequence)
 	}
+	for _, rs := range gs.RecvStartSequences {
+		k.SetRecvStartSequence(ctx, rs.PortId, rs.ChannelId, rs.Sequence)
+	}
+	for _, ps := range gs.PruningSequenceStarts {
+		k.SetPruningSequenceStart(ctx, ps.PortId, ps.ChannelId, ps.Sequence)
+	}
 	k.SetNextChannelSequence(ctx, gs.NextChannelSequence)
 }
 
+// setPacketReceipt sets the packet receipt of the provided packet state, preserving the timeout receipts
+// written for packets skipped on ORDERED_ALLOW_TIMEOUT channels.
+func setPacketReceipt(ctx sdk.Context, k keeper.Keeper, receipt types.PacketState) {
+	if bytes.Equal(receipt.Data, types.TimeoutReceipt) {
+		k.SetPacketTimeoutReceipt(ctx, receipt.PortId, receipt.ChannelId, receipt.Sequence)
+		return
+	}
+
+	k.SetPacketReceipt(ctx, receipt.PortId, receipt.ChannelId, receipt.Sequence)
+}
+
 // ExportGenesis returns the ibc channel submodule's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
+	return ExportGenesisWithOptions(ctx, k, types.ExportOptions{})
+}
+
+// ExportGenesisWithOptions returns the ibc channel submodule's exported genesis, omitting
+// the pruneable state selected by the provided export options.
+func ExportGenesisWithOptions(ctx sdk.Context, k keeper.Keeper, opts types.ExportOptions) types.GenesisState {
+	filter := newExportFilter(ctx, k, opts)
+
+	var acks []types.PacketState
+	k.IteratePacketAcknowledgement(ctx, func(portID, channelID string, sequence uint64, ack []byte) bool {
+		if !filter.omitAcknowledgement(portID, channelID, sequence) {
+			acks = append(acks, types.NewPacketState(portID, channelID, sequence, ack))
+		}
+		return false
+	})
+
+	var receipts []types.PacketState
+	k.IteratePacketReceipt(ctx, func(portID, channelID string, sequence uint64, receipt []byte) bool {
+		if !filter.omitReceipt(portID, channelID, sequence, receipt) {
+			receipts = append(receipts, types.NewPacketState(portID, channelID, sequence, receipt))
+		}
+		return false
+	})
+
+	pruningSequenceStarts := k.GetAllPruningSequenceStarts(ctx)
+	for i, ps := range pruningSequenceStarts {
+		pruningSequenceStarts[i] = filter.pruningSequenceStart(ps)
+	}
+
 	return types.GenesisState{
-		Channels:            k.GetAllChannels(ctx),
-		Acknowledgements:    k.GetAllPacketAcks(ctx),
-		Commitments:         k.GetAllPacketCommitments(ctx),
-		Receipts:            k.GetAllPacketReceipts(ctx),
-		SendSequences:       k.GetAllPacketSendSeqs(ctx),
-		RecvSequences:       k.GetAllPacketRecvSeqs(ctx),
-		AckSequences:        k.GetAllPacketAckSeqs(ctx),
-		NextChannelSequence: k.GetNextChannelSequence(ctx),
-		Params:              k.GetParams(ctx),
+		Channels:              k.GetAllChannels(ctx),
+		Acknowledgements:      acks,
+		Commitments:           k.GetAllPacketCommitments(ctx),
+		Receipts:              receipts,
+		SendSequences:         k.GetAllPacketSendSeqs(ctx),
+		RecvSequences:         k.GetAllPacketRecvSeqs(ctx),
+		AckSequences:          k.GetAllPacketAckSeqs(ctx),
+		NextChannelSequence:   k.GetNextChannelSequence(ctx),
+		Params:                k.GetParams(ctx),
+		RecvStartSequences:    k.GetAllRecvStartSequences(ctx),
+		PruningSequenceStarts: pruningSequenceStarts,
 	}
 }
+
+// exportFilter selects the channel state which is omitted from an export with the given options.
+type exportFilter struct {
+	opts               types.ExportOptions
+	recvStartSequences map[string]uint64
+}
+
+// newExportFilter returns an exportFilter for the provided export options.
+func newExportFilter(ctx sdk.Context, k keeper.Keeper, opts types.ExportOptions) exportFilter {
+	filter := exportFilter{
+		opts:               opts,
+		recvStartSequences: make(map[string]uint64),
+	}
+
+	for _, rs := range k.GetAllRecvStartSequences(ctx) {
+		filter.recvStartSequences[host.ChannelPath(rs.PortId, rs.ChannelId)] = rs.Sequence
+	}
+
+	return filter
+}
+
+// omitAcknowledgement returns true if the packet acknowledgement is omitted.
+func (f exportFilter) omitAcknowledgement(portID, channelID string, sequence uint64) bool {
+	return f.opts.OmitPruneableAcknowledgements && f.isPruneable(portID, channelID, sequence)
+}
+
+// omitReceipt returns true if the packet receipt is omitted. Timeout receipts are never pruned and are
+// therefore always exported, see Keeper.PruneAcknowledgements.
+func (f exportFilter) omitReceipt(portID, channelID string, sequence uint64, receipt []byte) bool {
+	return f.opts.OmitPruneableReceipts && !bytes.Equal(receipt, types.TimeoutReceipt) && f.isPruneable(portID, channelID, sequence)
+}
+
+// isPruneable returns true if the packet acknowledgement or receipt may be pruned. Acknowledgements
+// and receipts with a sequence lower than the recv start sequence of an upgraded channel may be
+// pruned, see Keeper.PruneAcknowledgements.
+func (f exportFilter) isPruneable(portID, channelID string, sequence uint64) bool {
+	recvStartSequence, found := f.recvStartSequences[host.ChannelPath(portID, channelID)]
+	return found && sequence < recvStartSequence
+}
+
+// pruningSequenceStart returns the exported pruning sequence start. If both pruneable acknowledgements
+// and receipts are omitted nothing is left to be pruned, and pruning resumes from the recv start sequence.
+func (f exportFilter) pruningSequenceStart(ps types.PacketSequence) types.PacketSequence {
+	if !f.opts.OmitPruneableAcknowledgements || !f.opts.OmitPruneableReceipts {
+		return ps
+	}
+
+	if recvStartSequence, found := f.recvStartSequences[host.ChannelPath(ps.PortId, ps.ChannelId)]; found && ps.Sequence < recvStartSequence {
+		ps.Sequence = recvStartSequence
+	}
+
+	return ps
+}
@@ -0,0 +1,253 @@
+package channel
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/internal/genesis"
+	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/keeper"
+	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// StreamInitGenesis initializes the ibc channel submodule's state from the genesis JSON
+// opened by the provided function. Channels, packet state and sequences are decoded and
+// stored one at a time in a single pass over the genesis JSON.
+func StreamInitGenesis(ctx sdk.Context, k keeper.Keeper, cdc codec.JSONCodec, open func() (io.ReadCloser, error)) error {
+	var gs types.GenesisState
+
+	if err := genesis.Decode(open, cdc, func(d *genesis.Decoder) error {
+		decodePacketStates := func(set func(types.PacketState)) func() error {
+			return func() error {
+				return genesis.DecodeMessages(d, func(ps *types.PacketState) error {
+					set(*ps)
+					return nil
+				})
+			}
+		}
+
+		decodePacketSequences := func(set func(types.PacketSequence)) func() error {
+			return func() error {
+				return genesis.DecodeMessages(d, func(ps *types.PacketSequence) error {
+					set(*ps)
+					return nil
+				})
+			}
+		}
+
+		return d.Object(map[string]func() error{
+			"channels": func() error {
+				return genesis.DecodeMessages(d, func(channel *types.IdentifiedChannel) error {
+					ch := types.NewChannel(channel.State, channel.Ordering, channel.Counterparty, channel.ConnectionHops, channel.Version)
+					k.SetChannel(ctx, channel.PortId, channel.ChannelId, ch)
+					return nil
+				})
+			},
+			"acknowledgements": decodePacketStates(func(ack types.PacketState) {
+				k.SetPacketAcknowledgement(ctx, ack.PortId, ack.ChannelId, ack.Sequence, ack.Data)
+			}),
+			"commitments": decodePacketStates(func(commitment types.PacketState) {
+				k.SetPacketCommitment(ctx, commitment.PortId, commitment.ChannelId, commitment.Sequence, commitment.Data)
+			}),
+			"receipts": decodePacketStates(func(receipt types.PacketState) {
+				setPacketReceipt(ctx, k, receipt)
+			}),
+			"send_sequences": decodePacketSequences(func(ss types.PacketSequence) {
+				k.SetNextSequenceSend(ctx, ss.PortId, ss.ChannelId, ss.Sequence)
+			}),
+			"recv_sequences": decodePacketSequences(func(rs types.PacketSequence) {
+				k.SetNextSequenceRecv(ctx, rs.PortId, rs.ChannelId, rs.Sequence)
+			}),
+			"ack_sequences": decodePacketSequences(func(as types.PacketSequence) {
+				k.SetNextSequenceAck(ctx, as.PortId, as.ChannelId, as.Sequence)
+			}),
+			"recv_start_sequences": decodePacketSequences(func(rs types.PacketSequence) {
+				k.SetRecvStartSequence(ctx, rs.PortId, rs.ChannelId, rs.Sequence)
+			}),
+			"pruning_sequence_starts": decodePacketSequences(func(ps types.PacketSequence) {
+				k.SetPruningSequenceStart(ctx, ps.PortId, ps.ChannelId, ps.Sequence)
+			}),
+			"next_channel_sequence": func() (err error) {
+				gs.NextChannelSequence, err = d.Uint64()
+				return err
+			},
+			"params": func() error {
+				return d.Message(&gs.Params)
+			},
+		})
+	}); err != nil {
+		return err
+	}
+
+	if err := gs.Params.Validate(); err != nil {
+		return fmt.Errorf("invalid ibc channel genesis state parameters: %w", err)
+	}
+	k.SetParams(ctx, gs.Params)
+	k.SetNextChannelSequence(ctx, gs.NextChannelSequence)
+
+	return nil
+}
+
+// StreamExportGenesis writes the ibc channel submodule's exported genesis to the writer opened
+// by the provided function, omitting the pruneable state selected by the provided export options.
+// Channels and packet state are written one at a time as they are iterated.
+func StreamExportGenesis(ctx sdk.Context, k keeper.Keeper, cdc codec.JSONCodec, opts types.ExportOptions, open func() (io.WriteCloser, error)) error {
+	filter := newExportFilter(ctx, k, opts)
+
+	writeSequences := func(enc *genesis.Encoder, seqs []types.PacketSequence) {
+		enc.Array(func(write func(proto.Message)) {
+			for _, ps := range seqs {
+				write(&ps)
+			}
+		})
+	}
+
+	return genesis.Encode(open, cdc, func(enc *genesis.Encoder) {
+		enc.BeginObject()
+
+		enc.Field("channels")
+		enc.Array(func(write func(proto.Message)) {
+			k.IterateChannels(ctx, func(channel types.IdentifiedChannel) bool {
+				write(&channel)
+				return false
+			})
+		})
+
+		enc.Field("acknowledgements")
+		enc.Array(func(write func(proto.Message)) {
+			k.IteratePacketAcknowledgement(ctx, func(portID, channelID string, sequence uint64, ack []byte) bool {
+				if !filter.omitAcknowledgement(portID, channelID, sequence) {
+					packetAck := types.NewPacketState(portID, channelID, sequence, ack)
+					write(&packetAck)
+				}
+				return false
+			})
+		})
+
+		enc.Field("commitments")
+		enc.Array(func(write func(proto.Message)) {
+			k.IteratePacketCommitment(ctx, func(portID, channelID string, sequence uint64, hash []byte) bool {
+				packetCommitment := types.NewPacketState(portID, channelID, sequence, hash)
+				write(&packetCommitment)
+				return false
+			})
+		})
+
+		enc.Field("receipts")
+		enc.Array(func(write func(proto.Message)) {
+			k.IteratePacketReceipt(ctx, func(portID, channelID string, sequence uint64, receipt []byte) bool {
+				if !filter.omitReceipt(portID, channelID, sequence, receipt) {
+					packetReceipt := types.NewPacketState(portID, channelID, sequence, receipt)
+					write(&packetReceipt)
+				}
+				return false
+			})
+		})
+
+		enc.Field("send_sequences")
+		writeSequences(enc, k.GetAllPacketSendSeqs(ctx))
+
+		enc.Field("recv_sequences")
+		writeSequences(enc, k.GetAllPacketRecvSeqs(ctx))
+
+		enc.Field("ack_sequences")
+		writeSequences(enc, k.GetAllPacketAckSeqs(ctx))
+
+		enc.Field("next_channel_sequence")
+		enc.Uint64(k.GetNextChannelSequence(ctx))
+
+		params := k.GetParams(ctx)
+		enc.Field("params")
+		enc.Message(&params)
+
+		enc.Field("recv_start_sequences")
+		writeSequences(enc, k.GetAllRecvStartSequences(ctx))
+
+		pruningSequenceStarts := k.GetAllPruningSequenceStarts(ctx)
+		for i, ps := range pruningSequenceStarts {
+			pruningSequenceStarts[i] = filter.pruningSequenceStart(ps)
+		}
+
+		enc.Field("pruning_sequence_starts")
+		writeSequences(enc, pruningSequenceStarts)
+
+		enc.EndObject()
+	})
+}
+
+// StreamValidateGenesis performs the basic genesis state validation of GenesisState.Validate
+// on the genesis JSON opened by the provided function, decoding channels, packet state and
+// sequences one at a time.
+func StreamValidateGenesis(cdc codec.JSONCodec, open func() (io.ReadCloser, error)) error {
+	var gs types.GenesisState
+
+	// the next channel sequence is required to validate the channels and is decoded first
+	if err := genesis.DecodeField(open, cdc, "next_channel_sequence", func(d *genesis.Decoder) (err error) {
+		gs.NextChannelSequence, err = d.Uint64()
+		return err
+	}); err != nil {
+		return err
+	}
+
+	// every element is validated as part of a genesis state containing only that element,
+	// all checks of GenesisState.Validate apply to the elements individually.
+	validate := func(set func(*types.GenesisState)) error {
+		elementGenesis := types.GenesisState{NextChannelSequence: gs.NextChannelSequence}
+		set(&elementGenesis)
+		return elementGenesis.Validate()
+	}
+
+	return genesis.Decode(open, cdc, func(d *genesis.Decoder) error {
+		validatePacketStates := func(set func(*types.GenesisState, types.PacketState)) func() error {
+			return func() error {
+				return genesis.DecodeMessages(d, func(ps *types.PacketState) error {
+					return validate(func(gs *types.GenesisState) { set(gs, *ps) })
+				})
+			}
+		}
+
+		validatePacketSequences := func(set func(*types.GenesisState, types.PacketSequence)) func() error {
+			return func() error {
+				return genesis.DecodeMessages(d, func(ps *types.PacketSequence) error {
+					return validate(func(gs *types.GenesisState) { set(gs, *ps) })
+				})
+			}
+		}
+
+		return d.Object(map[string]func() error{
+			"channels": func() error {
+				return genesis.DecodeMessages(d, func(channel *types.IdentifiedChannel) error {
+					return validate(func(gs *types.GenesisState) { gs.Channels = []types.IdentifiedChannel{*channel} })
+				})
+			},
+			"acknowledgements": validatePacketStates(func(gs *types.GenesisState, ack types.PacketState) {
+				gs.Acknowledgements = []types.PacketState{ack}
+			}),
+			"commitments": validatePacketStates(func(gs *types.GenesisState, commitment types.PacketState) {
+				gs.Commitments = []types.PacketState{commitment}
+			}),
+			"receipts": validatePacketStates(func(gs *types.GenesisState, receipt types.PacketState) {
+				gs.Receipts = []types.PacketState{receipt}
+			}),
+			"send_sequences": validatePacketSequences(func(gs *types.GenesisState, ss types.PacketSequence) {
+				gs.SendSequences = []types.PacketSequence{ss}
+			}),
+			"recv_sequences": validatePacketSequences(func(gs *types.GenesisState, rs types.PacketSequence) {
+				gs.RecvSequences = []types.PacketSequence{rs}
+			}),
+			"ack_sequences": validatePacketSequences(func(gs *types.GenesisState, as types.PacketSequence) {
+				gs.AckSequences = []types.PacketSequence{as}
+			}),
+			"recv_start_sequences": validatePacketSequences(func(gs *types.GenesisState, rs types.PacketSequence) {
+				gs.RecvStartSequences = []types.PacketSequence{rs}
+			}),
+			"pruning_sequence_starts": validatePacketSequences(func(gs *types.GenesisState, ps types.PacketSequence) {
+				gs.PruningSequenceStarts = []types.PacketSequence{ps}
+			}),
+		})
+	})
+}
@@ -252,6 +252,29 @@ func emitTimeoutPacketEvent(ctx sdk.Context, packet exported.PacketI, channel ty
 	})
 }
 
+// emitPacketSkippedEvent emits an event when a timed out packet is skipped by the receiving end
+// of an ORDERED_ALLOW_TIMEOUT channel.
+func emitPacketSkippedEvent(ctx sdk.Context, packet exported.PacketI, channel types.Channel) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSkipPacket,
+			sdk.NewAttribute(types.AttributeKeyTimeoutHeight, packet.GetTimeoutHeight().String()),
+			sdk.NewAttribute(types.AttributeKeyTimeoutTimestamp, fmt.Sprintf("%d", packet.GetTimeoutTimestamp())),
+			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", packet.GetSequence())),
+			sdk.NewAttribute(types.AttributeKeySrcPort, packet.GetSourcePort()),
+			sdk.NewAttribute(types.AttributeKeySrcChannel, packet.GetSourceChannel()),
+			sdk.NewAttribute(types.AttributeKeyDstPort, packet.GetDestPort()),
+			sdk.NewAttribute(types.AttributeKeyDstChannel, packet.GetDestChannel()),
+			sdk.NewAttribute(types.AttributeKeyConnectionID, channel.ConnectionHops[0]),
+			sdk.NewAttribute(types.AttributeKeyChannelOrdering, channel.Ordering.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
 // emitChannelClosedEvent emits a channel closed event.
 func emitChannelClosedEvent(ctx sdk.Context, packet exported.PacketI, channel types.Channel) {
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -455,3 +478,124 @@ func emitChannelFlushCompleteEvent(ctx sdk.Context, portID string, channelID str
 		),
 	})
 }
+
+// emitChannelFlushPacketEvent emits an event when an in-flight packet is acknowledged or timed out while the channel is flushing.
+func emitChannelFlushPacketEvent(ctx sdk.Context, packet exported.PacketI, channel types.Channel) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeChannelFlushPacket,
+			sdk.NewAttribute(types.AttributeKeyPortID, packet.GetSourcePort()),
+			sdk.NewAttribute(types.AttributeKeyChannelID, packet.GetSourceChannel()),
+			sdk.NewAttribute(types.AttributeCounterpartyPortID, channel.Counterparty.PortId),
+			sdk.NewAttribute(types.AttributeCounterpartyChannelID, channel.Counterparty.ChannelId),
+			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", packet.GetSequence())),
+			sdk.NewAttribute(types.AttributeKeyUpgradeSequence, fmt.Sprintf("%d", channel.UpgradeSequence)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// EmitLocalhostRelayFailedEvent emits an event when a packet or acknowledgement queued for automatic relay over
+// the 09-localhost connection could not be relayed.
+func EmitLocalhostRelayFailedEvent(ctx sdk.Context, packet exported.PacketI, acknowledgement []byte, err error) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeLocalhostRelayFailed,
+			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", packet.GetSequence())),
+			sdk.NewAttribute(types.AttributeKeySrcPort, packet.GetSourcePort()),
+			sdk.NewAttribute(types.AttributeKeySrcChannel, packet.GetSourceChannel()),
+			sdk.NewAttribute(types.AttributeKeyDstPort, packet.GetDestPort()),
+			sdk.NewAttribute(types.AttributeKeyDstChannel, packet.GetDestChannel()),
+			sdk.NewAttribute(types.AttributeKeyAckHex, hex.EncodeToString(acknowledgement)),
+			sdk.NewAttribute(types.AttributeKeyLocalhostRelayError, err.Error()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// EmitChannelUpgradeBatchEvent emits an event when the upgrades of the channels of an upgrade batch are initialized.
+func EmitChannelUpgradeBatchEvent(ctx sdk.Context, batch types.UpgradeBatch) {
+	var failed int
+	for _, channel := range batch.Channels {
+		if channel.InitError != "" {
+			failed++
+		}
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeChannelUpgradeBatch,
+			sdk.NewAttribute(types.AttributeKeyUpgradeBatchID, fmt.Sprintf("%d", batch.Id)),
+			sdk.NewAttribute(types.AttributeKeyPortID, batch.Filter.PortId),
+			sdk.NewAttribute(types.AttributeKeyConnectionID, batch.Filter.ConnectionId),
+			sdk.NewAttribute(types.AttributeKeyVersion, batch.Version),
+			sdk.NewAttribute(types.AttributeKeyOrdering, batch.Ordering.String()),
+			sdk.NewAttribute(types.AttributeKeyUpgradeBatchChannels, fmt.Sprintf("%d", len(batch.Channels))),
+			sdk.NewAttribute(types.AttributeKeyUpgradeBatchFailedChannels, fmt.Sprintf("%d", failed)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// EmitChannelUpgradeRetryEvent emits an event when the failed upgrade of a channel of an upgrade batch is retried.
+func EmitChannelUpgradeRetryEvent(ctx sdk.Context, batchID uint64, channel types.UpgradeBatchChannel) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeChannelUpgradeRetry,
+			sdk.NewAttribute(types.AttributeKeyUpgradeBatchID, fmt.Sprintf("%d", batchID)),
+			sdk.NewAttribute(types.AttributeKeyPortID, channel.PortId),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channel.ChannelId),
+			sdk.NewAttribute(types.AttributeKeyUpgradeSequence, fmt.Sprintf("%d", channel.UpgradeSequence)),
+			sdk.NewAttribute(types.AttributeKeyUpgradeBatchRetries, fmt.Sprintf("%d", channel.Retries)),
+			sdk.NewAttribute(types.AttributeKeyUpgradeBatchError, channel.InitError),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// emitAcknowledgementsPrunedEvent emits an event when the packet acknowledgements and receipts of a channel are
+// pruned automatically.
+func emitAcknowledgementsPrunedEvent(ctx sdk.Context, portID, channelID string, pruned, remaining uint64) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAcknowledgementsPruned,
+			sdk.NewAttribute(types.AttributeKeyPortID, portID),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeKeyPrunedSequences, fmt.Sprintf("%d", pruned)),
+			sdk.NewAttribute(types.AttributeKeyRemainingSequences, fmt.Sprintf("%d", remaining)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// emitAcknowledgementPruningEnabledEvent emits an event when the pruning of the packet acknowledgements and
+// receipts of a channel which has never been upgraded is enabled.
+func emitAcknowledgementPruningEnabledEvent(ctx sdk.Context, portID, channelID string, recvStartSequence uint64) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePruningEnabled,
+			sdk.NewAttribute(types.AttributeKeyPortID, portID),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeKeyRecvStartSequence, fmt.Sprintf("%d", recvStartSequence)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"strconv"
 	"strings"
@@ -11,13 +12,17 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/store/prefix"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 )
 
 var _ types.QueryServer = (*Keeper)(nil)
@@ -422,7 +427,7 @@ func (k Keeper) UnreceivedPackets(c context.Context, req *types.QueryUnreceivedP
 				unreceivedSequences = append(unreceivedSequences, seq)
 			}
 		}
-	case types.ORDERED:
+	case types.ORDERED, types.ORDERED_ALLOW_TIMEOUT:
 		nextSequenceRecv, found := k.GetNextSequenceRecv(ctx, req.PortId, req.ChannelId)
 		if !found {
 			return nil, status.Error(
@@ -628,6 +633,57 @@ func (k Keeper) Upgrade(c context.Context, req *types.QueryUpgradeRequest) (*typ
 	return types.NewQueryUpgradeResponse(upgrade, nil, selfHeight), nil
 }
 
+// UpgradeFlushStatus implements the Query/UpgradeFlushStatus gRPC method
+func (k Keeper) UpgradeFlushStatus(c context.Context, req *types.QueryUpgradeFlushStatusRequest) (*types.QueryUpgradeFlushStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validategRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	channel, found := k.GetChannel(ctx, req.PortId, req.ChannelId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrChannelNotFound, "port-id: %s, channel-id %s", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	upgrade, found := k.GetUpgrade(ctx, req.PortId, req.ChannelId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrUpgradeNotFound, "port-id: %s, channel-id %s", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	res := &types.QueryUpgradeFlushStatusResponse{
+		State:             channel.State,
+		UpgradeSequence:   channel.UpgradeSequence,
+		InflightSequences: k.GetInflightPacketSequences(ctx, req.PortId, req.ChannelId),
+		UpgradeTimeout:    upgrade.Timeout,
+	}
+
+	if counterpartyUpgrade, found := k.GetCounterpartyUpgrade(ctx, req.PortId, req.ChannelId); found {
+		res.CounterpartyUpgradeTimeout = counterpartyUpgrade.Timeout
+	}
+
+	// flushing must complete before the counterparty upgrade timeout once it is known, otherwise the
+	// upgrade of this channel end may be timed out once its own upgrade timeout has elapsed.
+	if channel.State == types.OPEN || channel.State == types.FLUSHING {
+		if res.CounterpartyUpgradeTimeout.IsValid() {
+			res.FlushDeadline = res.CounterpartyUpgradeTimeout
+		} else {
+			res.FlushDeadline = upgrade.Timeout
+		}
+	}
+
+	return res, nil
+}
+
 // ChannelParams implements the Query/ChannelParams gRPC method.
 func (k Keeper) ChannelParams(c context.Context, req *types.QueryChannelParamsRequest) (*types.QueryChannelParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -638,6 +694,263 @@ func (k Keeper) ChannelParams(c context.Context, req *types.QueryChannelParamsRe
 	}, nil
 }
 
+// UpgradeBatch implements the Query/UpgradeBatch gRPC method
+func (k Keeper) UpgradeBatch(c context.Context, req *types.QueryUpgradeBatchRequest) (*types.QueryUpgradeBatchResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	batch, found := k.GetUpgradeBatch(ctx, req.BatchId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrUpgradeBatchNotFound, "batch-id %d", req.BatchId).Error(),
+		)
+	}
+
+	channels, done := k.GetUpgradeBatchStatus(ctx, batch)
+	return &types.QueryUpgradeBatchResponse{
+		Batch:    batch,
+		Channels: channels,
+		Done:     done,
+	}, nil
+}
+
+// PruningProgress implements the Query/PruningProgress gRPC method
+func (k Keeper) PruningProgress(c context.Context, req *types.QueryPruningProgressRequest) (*types.QueryPruningProgressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validategRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	pruningSequenceStart, found := k.GetPruningSequenceStart(ctx, req.PortId, req.ChannelId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrPruningSequenceStartNotFound, "port-id: %s, channel-id %s", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	pruningSequenceEnd, found := k.GetRecvStartSequence(ctx, req.PortId, req.ChannelId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrRecvStartSequenceNotFound, "port-id: %s, channel-id %s", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	var totalRemaining uint64
+	if pruningSequenceStart < pruningSequenceEnd {
+		totalRemaining = pruningSequenceEnd - pruningSequenceStart
+	}
+
+	return &types.QueryPruningProgressResponse{
+		PruningSequenceStart:    pruningSequenceStart,
+		PruningSequenceEnd:      pruningSequenceEnd,
+		TotalRemainingSequences: totalRemaining,
+		AutoPruningEnabled:      k.GetParams(ctx).AutoPruning.Enabled,
+	}, nil
+}
+
+// PacketsBySender implements the Query/PacketsBySender gRPC method
+func (k Keeper) PacketsBySender(c context.Context, req *types.QueryPacketsBySenderRequest) (*types.QueryPacketsBySenderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if strings.TrimSpace(req.Sender) == "" {
+		return nil, status.Error(codes.InvalidArgument, "sender cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	packets, pageRes, err := k.queryIndexedPackets(ctx, types.PacketIndexSenderPrefix(req.Sender), req.Status, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPacketsBySenderResponse{
+		Packets:    packets,
+		Pagination: pageRes,
+	}, nil
+}
+
+// PacketsByReceiver implements the Query/PacketsByReceiver gRPC method
+func (k Keeper) PacketsByReceiver(c context.Context, req *types.QueryPacketsByReceiverRequest) (*types.QueryPacketsByReceiverResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if strings.TrimSpace(req.Receiver) == "" {
+		return nil, status.Error(codes.InvalidArgument, "receiver cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	packets, pageRes, err := k.queryIndexedPackets(ctx, types.PacketIndexReceiverPrefix(req.Receiver), req.Status, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPacketsByReceiverResponse{
+		Packets:    packets,
+		Pagination: pageRes,
+	}, nil
+}
+
+// queryIndexedPackets paginates over the packet lifecycle index stored under the given prefix and returns
+// the indexed packets, filtered by the packet status if it is specified.
+func (k Keeper) queryIndexedPackets(ctx sdk.Context, indexPrefix []byte, packetStatus types.PacketStatus, pageReq *query.PageRequest) ([]types.IndexedPacket, *query.PageResponse, error) {
+	if _, ok := types.PacketStatus_name[int32(packetStatus)]; !ok {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "invalid packet status %d", packetStatus)
+	}
+
+	var packets []types.IndexedPacket
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), indexPrefix)
+
+	pageRes, err := query.FilteredPaginate(store, pageReq, func(_, value []byte, accumulate bool) (bool, error) {
+		indexedPacket, found := k.getIndexedPacketByKey(ctx, value)
+		if !found {
+			return false, nil
+		}
+
+		if packetStatus != types.UNKNOWN && indexedPacket.Status != packetStatus {
+			return false, nil
+		}
+
+		if accumulate {
+			packets = append(packets, indexedPacket)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return packets, pageRes, nil
+}
+
+// DecodePacketData implements the Query/DecodePacketData gRPC method
+func (k Keeper) DecodePacketData(c context.Context, req *types.QueryDecodePacketDataRequest) (*types.QueryDecodePacketDataResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if k.packetDataRegistry == nil {
+		return nil, status.Error(codes.Unimplemented, "packet data registry is not set")
+	}
+
+	cdc, ok := k.cdc.(codec.JSONCodec)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "codec %T does not support JSON encoding", k.cdc)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	packet, packetEnd, err := k.getDecodePacketDataPacket(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	portID, channelID := packet.GetSourcePort(), packet.GetSourceChannel()
+	if packetEnd == types.PACKET_END_DESTINATION {
+		portID, channelID = packet.GetDestPort(), packet.GetDestChannel()
+	}
+
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return nil, status.Error(codes.NotFound, errorsmod.Wrapf(types.ErrChannelNotFound, "port-id: %s, channel-id: %s", portID, channelID).Error())
+	}
+
+	// packet commitments are only written by the source channel end
+	var committed bool
+	if packetEnd == types.PACKET_END_SOURCE {
+		if commitment := k.GetPacketCommitment(ctx, portID, channelID, packet.GetSequence()); len(commitment) != 0 {
+			if !bytes.Equal(commitment, types.CommitPacket(k.cdc, packet)) {
+				return nil, status.Error(
+					codes.InvalidArgument,
+					errorsmod.Wrapf(types.ErrInvalidPacket, "packet commitment bytes are not equal: got (%v), expected (%v)", types.CommitPacket(k.cdc, packet), commitment).Error(),
+				)
+			}
+			committed = true
+		}
+	}
+
+	schema, version, found := k.packetDataRegistry.GetSchema(portID, channel.Version)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(porttypes.ErrPacketDataSchemaNotFound, "port-id: %s, version: %s", portID, channel.Version).Error(),
+		)
+	}
+
+	msg, jsonBz, err := schema.Decode(cdc, packet.GetData())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var packetData *codectypes.Any
+	if msg != nil {
+		packetData, err = codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &types.QueryDecodePacketDataResponse{
+		PortId:     portID,
+		ChannelId:  channelID,
+		Version:    version,
+		PacketData: packetData,
+		Json:       string(jsonBz),
+		JsonSchema: schema.JSONSchema,
+		Committed:  committed,
+	}, nil
+}
+
+// getDecodePacketDataPacket returns the packet to decode and its packet end on this chain. The packet is either
+// provided in the request together with its packet end, or retrieved from the packet lifecycle index.
+func (k Keeper) getDecodePacketDataPacket(ctx sdk.Context, req *types.QueryDecodePacketDataRequest) (types.Packet, types.PacketEnd, error) {
+	if req.Packet != nil {
+		if req.PortId != "" || req.ChannelId != "" || req.Sequence != 0 {
+			return types.Packet{}, 0, status.Error(codes.InvalidArgument, "port identifier, channel identifier and sequence must be empty if a packet is provided")
+		}
+
+		if req.PacketEnd != types.PACKET_END_SOURCE && req.PacketEnd != types.PACKET_END_DESTINATION {
+			return types.Packet{}, 0, status.Errorf(codes.InvalidArgument, "invalid packet end %s", req.PacketEnd)
+		}
+
+		if err := req.Packet.ValidateBasic(); err != nil {
+			return types.Packet{}, 0, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return *req.Packet, req.PacketEnd, nil
+	}
+
+	if err := validategRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return types.Packet{}, 0, err
+	}
+
+	if req.Sequence == 0 {
+		return types.Packet{}, 0, status.Error(codes.InvalidArgument, "packet sequence cannot be 0")
+	}
+
+	if req.PacketEnd == types.PACKET_END_DESTINATION {
+		return types.Packet{}, 0, status.Error(codes.InvalidArgument, "only packets sent from this chain are stored in the packet lifecycle index")
+	}
+
+	indexedPacket, found := k.GetIndexedPacket(ctx, req.PortId, req.ChannelId, req.Sequence)
+	if !found {
+		return types.Packet{}, 0, status.Errorf(codes.NotFound, "packet not found in the packet lifecycle index: port-id: %s, channel-id: %s, sequence: %d", req.PortId, req.ChannelId, req.Sequence)
+	}
+
+	return indexedPacket.Packet, types.PACKET_END_SOURCE, nil
+}
+
 func validategRPCRequest(portID, channelID string) error {
 	if err := host.PortIdentifierValidator(portID); err != nil {
 		return status.Error(codes.InvalidArgument, err.Error())
@@ -649,3 +962,69 @@ func validategRPCRequest(portID, channelID string) error {
 
 	return nil
 }
+
+// Invariants implements the Query/Invariants gRPC method
+func (k Keeper) Invariants(c context.Context, req *types.QueryInvariantsRequest) (*types.QueryInvariantsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var (
+		msg     strings.Builder
+		name    string
+		pageRes *query.PageResponse
+		err     error
+	)
+
+	switch req.Invariant {
+	case PacketCommitmentSequencesInvariantRoute:
+		name = "packet commitment sequences"
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(host.KeyPacketCommitmentPrefix+"/"))
+		pageRes, err = query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+			keySplit := strings.Split(string(key), "/")
+			if len(keySplit) != 6 {
+				return status.Errorf(codes.Internal, "invalid packet commitment key %s", key)
+			}
+
+			sequence, err := strconv.ParseUint(keySplit[len(keySplit)-1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg.WriteString(k.checkPacketCommitmentSequence(ctx, keySplit[1], keySplit[3], sequence))
+			return nil
+		})
+	case ChannelUpgradesInvariantRoute:
+		name = "channel upgrades"
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(host.KeyChannelEndPrefix))
+		pageRes, err = query.Paginate(store, req.Pagination, func(key, value []byte) error {
+			var channel types.Channel
+			if err := k.cdc.Unmarshal(value, &channel); err != nil {
+				return err
+			}
+
+			portID, channelID, err := host.ParseChannelPath(string(key))
+			if err != nil {
+				return err
+			}
+
+			msg.WriteString(k.checkChannelUpgrade(ctx, types.NewIdentifiedChannel(portID, channelID, channel)))
+			return nil
+		})
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown invariant %s, expected %s or %s", req.Invariant, PacketCommitmentSequencesInvariantRoute, ChannelUpgradesInvariantRoute)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.QueryInvariantsResponse{Pagination: pageRes}
+	if msg.Len() > 0 {
+		res.Broken = true
+		res.Message = sdk.FormatInvariant(exported.ModuleName, name, msg.String())
+	}
+
+	return res, nil
+}
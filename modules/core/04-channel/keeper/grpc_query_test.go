@@ -3,10 +3,18 @@ package keeper_test
 import (
 	"fmt"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	errorsmod "cosmossdk.io/errors"
+
 	"github.com/cosmos/cosmos-sdk/types/query"
 
+	ibcfeetypes "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/keeper"
 	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
@@ -1889,9 +1897,784 @@ func (suite *KeeperTestSuite) TestQueryUpgrade() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryUpgradeFlushStatus() {
+	var (
+		path        *ibctesting.Path
+		req         *types.QueryUpgradeFlushStatusRequest
+		expResponse *types.QueryUpgradeFlushStatusResponse
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid port ID",
+			func() {
+				req.PortId = ""
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				req.ChannelId = ""
+			},
+			false,
+		},
+		{
+			"channel not found",
+			func() {
+				req.ChannelId = ibctesting.InvalidID
+			},
+			false,
+		},
+		{
+			"upgrade not found",
+			func() {
+				storeKey := suite.chainA.GetSimApp().GetKey(exported.StoreKey)
+				kvStore := suite.chainA.GetContext().KVStore(storeKey)
+				kvStore.Delete(host.ChannelUpgradeKey(req.PortId, req.ChannelId))
+			},
+			false,
+		},
+		{
+			"success: initialized upgrade",
+			func() {},
+			true,
+		},
+		{
+			"success: flushing with in-flight packets",
+			func() {
+				sequence, err := path.EndpointA.SendPacket(suite.chainA.GetTimeoutHeight(), 0, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				secondSequence, err := path.EndpointA.SendPacket(suite.chainA.GetTimeoutHeight(), 0, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				suite.Require().NoError(path.EndpointB.ChanUpgradeTry())
+				suite.Require().NoError(path.EndpointA.ChanUpgradeAck())
+
+				counterpartyUpgrade := path.EndpointB.GetChannelUpgrade()
+
+				expResponse.State = types.FLUSHING
+				expResponse.InflightSequences = []uint64{sequence, secondSequence}
+				expResponse.UpgradeTimeout = path.EndpointA.GetChannelUpgrade().Timeout
+				expResponse.CounterpartyUpgradeTimeout = counterpartyUpgrade.Timeout
+				expResponse.FlushDeadline = counterpartyUpgrade.Timeout
+			},
+			true,
+		},
+		{
+			"success: flush complete",
+			func() {
+				suite.Require().NoError(path.EndpointB.ChanUpgradeTry())
+				suite.Require().NoError(path.EndpointA.ChanUpgradeAck())
+
+				expResponse.State = types.FLUSHCOMPLETE
+				expResponse.InflightSequences = nil
+				expResponse.UpgradeTimeout = path.EndpointA.GetChannelUpgrade().Timeout
+				expResponse.CounterpartyUpgradeTimeout = path.EndpointB.GetChannelUpgrade().Timeout
+				expResponse.FlushDeadline = types.Timeout{}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			path.EndpointA.ChannelConfig.ProposedUpgrade.Fields.Version = mock.UpgradeVersion
+			path.EndpointB.ChannelConfig.ProposedUpgrade.Fields.Version = mock.UpgradeVersion
+
+			suite.Require().NoError(path.EndpointA.ChanUpgradeInit())
+
+			upgrade := path.EndpointA.GetChannelUpgrade()
+			expResponse = &types.QueryUpgradeFlushStatusResponse{
+				State:           types.OPEN,
+				UpgradeSequence: 1,
+				UpgradeTimeout:  upgrade.Timeout,
+				FlushDeadline:   upgrade.Timeout,
+			}
+
+			req = &types.QueryUpgradeFlushStatusRequest{
+				PortId:    path.EndpointA.ChannelConfig.PortID,
+				ChannelId: path.EndpointA.ChannelID,
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.QueryServer.UpgradeFlushStatus(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expResponse, res)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryChannelParams() {
 	ctx := suite.chainA.GetContext()
 	expParams := types.DefaultParams()
 	res, _ := suite.chainA.QueryServer.ChannelParams(ctx, &types.QueryChannelParamsRequest{})
 	suite.Require().Equal(&expParams, res.Params)
 }
+
+func (suite *KeeperTestSuite) TestQueryPruningProgress() {
+	var (
+		path *ibctesting.Path
+		req  *types.QueryPruningProgressRequest
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expRes   *types.QueryPruningProgressResponse
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			&types.QueryPruningProgressResponse{PruningSequenceStart: 1, PruningSequenceEnd: 6, TotalRemainingSequences: 5},
+			nil,
+		},
+		{
+			"success: partially pruned with auto pruning enabled",
+			func() {
+				_, _, err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.PruneAcknowledgements(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 3)
+				suite.Require().NoError(err)
+
+				params := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetParams(suite.chainA.GetContext())
+				params.AutoPruning.Enabled = true
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainA.GetContext(), params)
+			},
+			&types.QueryPruningProgressResponse{PruningSequenceStart: 4, PruningSequenceEnd: 6, TotalRemainingSequences: 2, AutoPruningEnabled: true},
+			nil,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			nil,
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+		{
+			"invalid port ID",
+			func() {
+				req.PortId = ""
+			},
+			nil,
+			status.Error(codes.InvalidArgument, "identifier cannot be blank: invalid identifier"),
+		},
+		{
+			"pruning not enabled",
+			func() {
+				req.ChannelId = ibctesting.InvalidID
+			},
+			nil,
+			status.Error(codes.NotFound, errorsmod.Wrapf(types.ErrPruningSequenceStartNotFound, "port-id: %s, channel-id %s", ibctesting.MockPort, ibctesting.InvalidID).Error()),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			// Send 5 packets from B -> A, creating 5 packet receipts and 5 packet acks on A.
+			suite.sendMockPackets(path, 5, true)
+
+			err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.EnableAcknowledgementPruning(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 6)
+			suite.Require().NoError(err)
+
+			req = &types.QueryPruningProgressRequest{
+				PortId:    path.EndpointA.ChannelConfig.PortID,
+				ChannelId: path.EndpointA.ChannelID,
+			}
+
+			tc.malleate()
+
+			res, err := suite.chainA.QueryServer.PruningProgress(suite.chainA.GetContext(), req)
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expRes, res)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryUpgradeBatch() {
+	var (
+		req         *types.QueryUpgradeBatchRequest
+		expBatch    types.UpgradeBatch
+		expChannels []types.UpgradeBatchChannelState
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"upgrade batch not found",
+			func() {
+				req.BatchId = 2
+			},
+			false,
+		},
+		{
+			"success",
+			func() {},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			upgradeErr := types.NewUpgradeError(1, fmt.Errorf("test error"))
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.WriteErrorReceipt(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, upgradeErr)
+
+			batchChannel := types.UpgradeBatchChannel{PortId: path.EndpointA.ChannelConfig.PortID, ChannelId: path.EndpointA.ChannelID, UpgradeSequence: 1}
+			expBatch = suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetUpgradeBatch(suite.chainA.GetContext(), types.UpgradeBatch{
+				Filter:   types.NewUpgradeBatchFilter(path.EndpointA.ChannelConfig.PortID, "", ""),
+				Version:  mock.UpgradeVersion,
+				Channels: []types.UpgradeBatchChannel{batchChannel},
+			})
+
+			errorReceipt := upgradeErr.GetErrorReceipt()
+			expChannels = []types.UpgradeBatchChannelState{{Channel: batchChannel, Status: types.UPGRADE_STATUS_FAILED, ErrorReceipt: &errorReceipt}}
+
+			req = &types.QueryUpgradeBatchRequest{BatchId: 1}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.QueryServer.UpgradeBatch(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(uint64(1), res.Batch.Id)
+				suite.Require().Equal(expBatch, res.Batch)
+				suite.Require().Equal(expChannels, res.Channels)
+				suite.Require().True(res.Done)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryPacketsBySender() {
+	var (
+		req        *types.QueryPacketsBySenderRequest
+		expPackets []types.IndexedPacket
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"empty sender",
+			func() {
+				req.Sender = ""
+			},
+			false,
+		},
+		{
+			"invalid packet status",
+			func() {
+				req.Status = types.PacketStatus(100)
+			},
+			false,
+		},
+		{
+			"success: all packets",
+			func() {},
+			true,
+		},
+		{
+			"success: filtered by status",
+			func() {
+				req.Status = types.ACKNOWLEDGED
+				expPackets = expPackets[:1]
+			},
+			true,
+		},
+		{
+			"success: unknown sender",
+			func() {
+				req.Sender = indexSender + "1"
+				expPackets = nil
+			},
+			true,
+		},
+		{
+			"success: receiver is not indexed as sender",
+			func() {
+				req.Sender = indexReceiver
+				expPackets = nil
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+			enablePacketIndex(suite.chainA)
+
+			ackedPacket := suite.sendIndexedPacket(path, defaultTimeoutHeight)
+			suite.Require().NoError(path.RelayPacket(ackedPacket))
+			pendingPacket := suite.sendIndexedPacket(path, defaultTimeoutHeight)
+
+			expPackets = []types.IndexedPacket{
+				{Packet: ackedPacket, Sender: indexSender, Receiver: indexReceiver, Status: types.ACKNOWLEDGED, Acknowledgement: ibctesting.MockAcknowledgement},
+				{Packet: pendingPacket, Sender: indexSender, Receiver: indexReceiver, Status: types.PENDING},
+			}
+
+			req = &types.QueryPacketsBySenderRequest{
+				Sender:     indexSender,
+				Pagination: &query.PageRequest{CountTotal: true},
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.QueryServer.PacketsBySender(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expPackets, res.Packets)
+				suite.Require().Equal(uint64(len(expPackets)), res.Pagination.Total)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryPacketsByReceiver() {
+	var req *types.QueryPacketsByReceiverRequest
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"empty receiver",
+			func() {
+				req.Receiver = ""
+			},
+			false,
+		},
+		{
+			"success",
+			func() {},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+			enablePacketIndex(suite.chainA)
+
+			packet := suite.sendIndexedPacket(path, defaultTimeoutHeight)
+
+			req = &types.QueryPacketsByReceiverRequest{
+				Receiver: indexReceiver,
+				Status:   types.PENDING,
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.QueryServer.PacketsByReceiver(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal([]types.IndexedPacket{{Packet: packet, Sender: indexSender, Receiver: indexReceiver, Status: types.PENDING}}, res.Packets)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryDecodePacketData() {
+	var (
+		req          *types.QueryDecodePacketDataRequest
+		queryChain   *ibctesting.TestChain
+		expCommitted bool
+		packetData   transfertypes.FungibleTokenPacketData
+	)
+
+	// sendPacket opens a channel over the provided path and returns a request to decode a packet sent over it.
+	sendPacket := func(path *ibctesting.Path) *types.QueryDecodePacketDataRequest {
+		path.Setup()
+
+		timeoutHeight := suite.chainB.GetTimeoutHeight()
+		sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, packetData.GetBytes())
+		suite.Require().NoError(err)
+
+		packet := types.NewPacket(packetData.GetBytes(), sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+		return &types.QueryDecodePacketDataRequest{Packet: &packet, PacketEnd: types.PACKET_END_SOURCE}
+	}
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: packet commitment exists",
+			func() {},
+			true,
+		},
+		{
+			"success: fee enabled channel",
+			func() {
+				feeVersion := string(ibcfeetypes.ModuleCdc.MustMarshalJSON(&ibcfeetypes.Metadata{FeeVersion: ibcfeetypes.Version, AppVersion: transfertypes.Version}))
+
+				path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+				path.EndpointA.ChannelConfig.Version = feeVersion
+				path.EndpointB.ChannelConfig.Version = feeVersion
+
+				req = sendPacket(path)
+			},
+			true,
+		},
+		{
+			"success: received packet on symmetric channel identifiers",
+			func() {
+				path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+				req = sendPacket(path)
+				suite.Require().Equal(path.EndpointA.ChannelID, path.EndpointB.ChannelID)
+
+				// chainB commits to a different packet with the same port, channel and sequence
+				_, err := path.EndpointB.SendPacket(suite.chainA.GetTimeoutHeight(), 0, []byte("packet sent from chainB"))
+				suite.Require().NoError(err)
+
+				req.PacketEnd = types.PACKET_END_DESTINATION
+				queryChain = suite.chainB
+				expCommitted = false
+			},
+			true,
+		},
+		{
+			"success: packet identified by port, channel and sequence",
+			func() {
+				channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+				params := channelKeeper.GetParams(suite.chainA.GetContext())
+				params.PacketIndexEnabled = true
+				channelKeeper.SetParams(suite.chainA.GetContext(), params)
+				channelKeeper.IndexPacket(suite.chainA.GetContext(), *req.Packet, packetData.Sender, packetData.Receiver)
+
+				req = &types.QueryDecodePacketDataRequest{PortId: req.Packet.SourcePort, ChannelId: req.Packet.SourceChannel, Sequence: req.Packet.Sequence}
+			},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid packet",
+			func() {
+				req.Packet.Sequence = 0
+			},
+			false,
+		},
+		{
+			"packet end not specified",
+			func() {
+				req.PacketEnd = types.PACKET_END_UNSPECIFIED
+			},
+			false,
+		},
+		{
+			"both packet and sequence are set",
+			func() {
+				req.Sequence = req.Packet.Sequence
+			},
+			false,
+		},
+		{
+			"packet not found in the packet lifecycle index",
+			func() {
+				req = &types.QueryDecodePacketDataRequest{PortId: req.Packet.SourcePort, ChannelId: req.Packet.SourceChannel, Sequence: req.Packet.Sequence}
+			},
+			false,
+		},
+		{
+			"destination packet end for packet identified by sequence",
+			func() {
+				req = &types.QueryDecodePacketDataRequest{PortId: req.Packet.SourcePort, ChannelId: req.Packet.SourceChannel, Sequence: req.Packet.Sequence, PacketEnd: types.PACKET_END_DESTINATION}
+			},
+			false,
+		},
+		{
+			"channel not found",
+			func() {
+				req.Packet.SourceChannel = ibctesting.InvalidID
+			},
+			false,
+		},
+		{
+			"packet does not match packet commitment",
+			func() {
+				req.Packet.TimeoutHeight = req.Packet.TimeoutHeight.Increment().(clienttypes.Height)
+			},
+			false,
+		},
+		{
+			"packet data schema not found",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				req = sendPacket(path)
+			},
+			false,
+		},
+		{
+			"packet data cannot be decoded",
+			func() {
+				req.PacketEnd = types.PACKET_END_DESTINATION
+				req.Packet.Data = []byte("invalid packet data")
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			queryChain = suite.chainA
+			expCommitted = true
+			packetData = transfertypes.NewFungibleTokenPacketData(ibctesting.TestCoin.Denom, ibctesting.TestCoin.Amount.String(), suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), "")
+
+			path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			req = sendPacket(path)
+
+			tc.malleate()
+
+			ctx := queryChain.GetContext()
+			res, err := queryChain.QueryServer.DecodePacketData(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(ibctesting.TransferPort, res.PortId)
+				suite.Require().Equal(transfertypes.Version, res.Version)
+				suite.Require().Equal(expCommitted, res.Committed)
+				suite.Require().Equal(&packetData, res.PacketData.GetCachedValue())
+
+				expJSON, err := suite.chainA.App.AppCodec().MarshalJSON(&packetData)
+				suite.Require().NoError(err)
+				suite.Require().JSONEq(string(expJSON), res.Json)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryInvariants() {
+	var req *types.QueryInvariantsRequest
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		expBroken  bool
+		expNextKey bool
+		expPass    bool
+	}{
+		{
+			"success: packet commitment sequences",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.Setup()
+
+				_, err := path.EndpointA.SendPacket(suite.chainB.GetTimeoutHeight(), 0, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+			},
+			false,
+			false,
+			true,
+		},
+		{
+			"success: channel upgrades",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.Setup()
+
+				req.Invariant = keeper.ChannelUpgradesInvariantRoute
+			},
+			false,
+			false,
+			true,
+		},
+		{
+			"success: broken packet commitment sequences invariant",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.Setup()
+
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketCommitment(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1, []byte("commitment"))
+			},
+			true,
+			false,
+			true,
+		},
+		{
+			"success: broken channel upgrades invariant",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.Setup()
+
+				suite.Require().NoError(path.EndpointA.SetChannelState(types.FLUSHING))
+
+				req.Invariant = keeper.ChannelUpgradesInvariantRoute
+			},
+			true,
+			false,
+			true,
+		},
+		{
+			"success: broken invariant outside of the requested page",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.Setup()
+
+				_, err := path.EndpointA.SendPacket(suite.chainB.GetTimeoutHeight(), 0, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				brokenPath := ibctesting.NewPath(suite.chainA, suite.chainB)
+				brokenPath.Setup()
+
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketCommitment(suite.chainA.GetContext(), brokenPath.EndpointA.ChannelConfig.PortID, brokenPath.EndpointA.ChannelID, 1, []byte("commitment"))
+
+				req.Pagination = &query.PageRequest{Limit: 1}
+			},
+			false,
+			true,
+			true,
+		},
+		{
+			"unknown invariant",
+			func() {
+				req.Invariant = "unknown"
+			},
+			false,
+			false,
+			false,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			req = &types.QueryInvariantsRequest{
+				Invariant: keeper.PacketCommitmentSequencesInvariantRoute,
+			}
+
+			tc.malleate()
+
+			res, err := suite.chainA.QueryServer.Invariants(suite.chainA.GetContext(), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expBroken, res.Broken)
+				suite.Require().Equal(tc.expBroken, res.Message != "")
+				suite.Require().Equal(tc.expNextKey, len(res.Pagination.NextKey) != 0)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+const (
+	// PacketCommitmentSequencesInvariantRoute defines the route of the packet commitment sequences invariant.
+	PacketCommitmentSequencesInvariantRoute = "packet-commitment-sequences"
+	// ChannelUpgradesInvariantRoute defines the route of the channel upgrades invariant.
+	ChannelUpgradesInvariantRoute = "channel-upgrades"
+)
+
+// RegisterInvariants registers all channel invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
+	ir.RegisterRoute(exported.ModuleName, PacketCommitmentSequencesInvariantRoute,
+		PacketCommitmentSequencesInvariant(k))
+	ir.RegisterRoute(exported.ModuleName, ChannelUpgradesInvariantRoute,
+		ChannelUpgradesInvariant(k))
+}
+
+// AllInvariants runs all invariants of the channel submodule.
+func AllInvariants(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := PacketCommitmentSequencesInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return ChannelUpgradesInvariant(k)(ctx)
+	}
+}
+
+// PacketCommitmentSequencesInvariant checks that the sequence of every stored packet
+// commitment is lower than the next sequence send of the channel the packet was sent on.
+func PacketCommitmentSequencesInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg strings.Builder
+
+		k.IteratePacketCommitment(ctx, func(portID, channelID string, sequence uint64, _ []byte) bool {
+			msg.WriteString(k.checkPacketCommitmentSequence(ctx, portID, channelID, sequence))
+			return false
+		})
+
+		if msg.Len() > 0 {
+			return sdk.FormatInvariant(exported.ModuleName, "packet commitment sequences", msg.String()), true
+		}
+
+		return "", false
+	}
+}
+
+// ChannelUpgradesInvariant checks that the stored upgrade information of every channel
+// is consistent with the state of the channel:
+//   - an upgrade may only be stored for channels in the OPEN, FLUSHING or FLUSHCOMPLETE state
+//   - a counterparty upgrade may only be stored for channels in the FLUSHING or FLUSHCOMPLETE state
+//   - channels in the FLUSHING state must have an upgrade stored
+//   - channels in the FLUSHCOMPLETE state must have both an upgrade and a counterparty upgrade stored
+//
+// Closed channels are not checked as channels closed during an upgrade retain the upgrade information.
+func ChannelUpgradesInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg strings.Builder
+
+		k.IterateChannels(ctx, func(channel types.IdentifiedChannel) bool {
+			msg.WriteString(k.checkChannelUpgrade(ctx, channel))
+			return false
+		})
+
+		if msg.Len() > 0 {
+			return sdk.FormatInvariant(exported.ModuleName, "channel upgrades", msg.String()), true
+		}
+
+		return "", false
+	}
+}
+
+// checkPacketCommitmentSequence returns a description of the inconsistency if the sequence of the packet commitment
+// is not lower than the next sequence send of its channel, or an empty string otherwise.
+func (k *Keeper) checkPacketCommitmentSequence(ctx sdk.Context, portID, channelID string, sequence uint64) string {
+	nextSequenceSend, found := k.GetNextSequenceSend(ctx, portID, channelID)
+	if !found {
+		return fmt.Sprintf("packet commitment with sequence %d exists for port %s and channel %s without a next sequence send\n", sequence, portID, channelID)
+	}
+
+	if sequence >= nextSequenceSend {
+		return fmt.Sprintf("packet commitment with sequence %d exists for port %s and channel %s with next sequence send %d\n", sequence, portID, channelID, nextSequenceSend)
+	}
+
+	return ""
+}
+
+// checkChannelUpgrade returns a description of the inconsistency if the stored upgrade information of the channel
+// is inconsistent with its state, or an empty string otherwise. See ChannelUpgradesInvariant.
+func (k *Keeper) checkChannelUpgrade(ctx sdk.Context, channel types.IdentifiedChannel) string {
+	hasUpgrade := k.hasUpgrade(ctx, channel.PortId, channel.ChannelId)
+	_, hasCounterpartyUpgrade := k.GetCounterpartyUpgrade(ctx, channel.PortId, channel.ChannelId)
+
+	switch channel.State {
+	case types.CLOSED:
+		return ""
+	case types.OPEN:
+		if hasCounterpartyUpgrade {
+			return fmt.Sprintf("channel %s on port %s in state %s has a counterparty upgrade\n", channel.ChannelId, channel.PortId, channel.State)
+		}
+	case types.FLUSHING:
+		if !hasUpgrade {
+			return fmt.Sprintf("channel %s on port %s in state %s has no upgrade\n", channel.ChannelId, channel.PortId, channel.State)
+		}
+	case types.FLUSHCOMPLETE:
+		if !hasUpgrade || !hasCounterpartyUpgrade {
+			return fmt.Sprintf("channel %s on port %s in state %s is missing its upgrade or counterparty upgrade\n", channel.ChannelId, channel.PortId, channel.State)
+		}
+	default:
+		if hasUpgrade || hasCounterpartyUpgrade {
+			return fmt.Sprintf("channel %s on port %s in state %s has upgrade information\n", channel.ChannelId, channel.PortId, channel.State)
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,151 @@
+package keeper_test
+
+import (
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/keeper"
+	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	"github.com/cosmos/ibc-go/v8/testing/mock"
+)
+
+func (suite *KeeperTestSuite) TestPacketCommitmentSequencesInvariant() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expBroken bool
+	}{
+		{
+			"success",
+			func() {},
+			false,
+		},
+		{
+			"packet commitment sequence equal to next sequence send",
+			func() {
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketCommitment(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 2, []byte("commitment"))
+			},
+			true,
+		},
+		{
+			"packet commitment without next sequence send",
+			func() {
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketCommitment(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, ibctesting.InvalidID, 1, []byte("commitment"))
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			_, err := path.EndpointA.SendPacket(clienttypes.NewHeight(1, 100), 0, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+			msg, broken := keeper.PacketCommitmentSequencesInvariant(&channelKeeper)(suite.chainA.GetContext())
+			suite.Require().Equal(tc.expBroken, broken)
+			suite.Require().Equal(tc.expBroken, msg != "")
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestChannelUpgradesInvariant() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expBroken bool
+	}{
+		{
+			"success",
+			func() {},
+			false,
+		},
+		{
+			"success: upgrade initialized",
+			func() {
+				suite.Require().NoError(path.EndpointA.ChanUpgradeInit())
+			},
+			false,
+		},
+		{
+			"success: channels flushing",
+			func() {
+				suite.Require().NoError(path.EndpointA.ChanUpgradeInit())
+				suite.Require().NoError(path.EndpointB.ChanUpgradeTry())
+				suite.Require().NoError(path.EndpointA.ChanUpgradeAck())
+			},
+			false,
+		},
+		{
+			"success: channel closed during upgrade",
+			func() {
+				suite.Require().NoError(path.EndpointA.ChanUpgradeInit())
+				suite.Require().NoError(path.EndpointA.SetChannelState(types.CLOSED))
+			},
+			false,
+		},
+		{
+			"open channel with counterparty upgrade",
+			func() {
+				path.EndpointA.SetChannelCounterpartyUpgrade(types.Upgrade{})
+			},
+			true,
+		},
+		{
+			"flushing channel without upgrade",
+			func() {
+				suite.Require().NoError(path.EndpointA.SetChannelState(types.FLUSHING))
+			},
+			true,
+		},
+		{
+			"flush complete channel without counterparty upgrade",
+			func() {
+				suite.Require().NoError(path.EndpointA.ChanUpgradeInit())
+				suite.Require().NoError(path.EndpointA.SetChannelState(types.FLUSHCOMPLETE))
+			},
+			true,
+		},
+		{
+			"initialized channel with upgrade",
+			func() {
+				suite.Require().NoError(path.EndpointA.ChanUpgradeInit())
+				suite.Require().NoError(path.EndpointA.SetChannelState(types.INIT))
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			path.EndpointA.ChannelConfig.ProposedUpgrade.Fields.Version = mock.UpgradeVersion
+			path.EndpointB.ChannelConfig.ProposedUpgrade.Fields.Version = mock.UpgradeVersion
+
+			tc.malleate()
+
+			channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+			msg, broken := keeper.ChannelUpgradesInvariant(&channelKeeper)(suite.chainA.GetContext())
+			suite.Require().Equal(tc.expBroken, broken)
+			suite.Require().Equal(tc.expBroken, msg != "")
+		})
+	}
+}
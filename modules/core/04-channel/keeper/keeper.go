@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"errors"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -36,6 +37,8 @@ type Keeper struct {
 	connectionKeeper types.ConnectionKeeper
 	portKeeper       types.PortKeeper
 	scopedKeeper     exported.ScopedKeeper
+
+	packetDataRegistry *porttypes.PacketDataRegistry
 }
 
 // NewKeeper creates a new IBC channel Keeper instance
@@ -59,6 +62,11 @@ func (Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+exported.ModuleName+"/"+types.SubModuleName)
 }
 
+// SetPacketDataRegistry sets the registry of packet data schemas used to decode packet data.
+func (k *Keeper) SetPacketDataRegistry(registry *porttypes.PacketDataRegistry) {
+	k.packetDataRegistry = registry
+}
+
 // GenerateChannelIdentifier returns the next channel identifier.
 func (k Keeper) GenerateChannelIdentifier(ctx sdk.Context) string {
 	nextChannelSeq := k.GetNextChannelSequence(ctx)
@@ -194,6 +202,12 @@ func (k Keeper) SetPacketReceipt(ctx sdk.Context, portID, channelID string, sequ
 	store.Set(host.PacketReceiptKey(portID, channelID, sequence), []byte{byte(1)})
 }
 
+// SetPacketTimeoutReceipt sets the packet receipt marking a packet as timed out to the store
+func (k Keeper) SetPacketTimeoutReceipt(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(host.PacketReceiptKey(portID, channelID, sequence), types.TimeoutReceipt)
+}
+
 // deletePacketReceipt deletes a packet receipt from the store
 func (k Keeper) deletePacketReceipt(ctx sdk.Context, portID, channelID string, sequence uint64) {
 	store := ctx.KVStore(k.storeKey)
@@ -216,14 +230,46 @@ func (k Keeper) HasPacketCommitment(ctx sdk.Context, portID, channelID string, s
 // SetPacketCommitment sets the packet commitment hash to the store
 func (k Keeper) SetPacketCommitment(ctx sdk.Context, portID, channelID string, sequence uint64, commitmentHash []byte) {
 	store := ctx.KVStore(k.storeKey)
+	if !store.Has(host.PacketCommitmentKey(portID, channelID, sequence)) {
+		k.setInflightPacketCount(ctx, portID, channelID, k.GetInflightPacketCount(ctx, portID, channelID)+1)
+	}
+
 	store.Set(host.PacketCommitmentKey(portID, channelID, sequence), commitmentHash)
 }
 
 func (k Keeper) deletePacketCommitment(ctx sdk.Context, portID, channelID string, sequence uint64) {
 	store := ctx.KVStore(k.storeKey)
+	if store.Has(host.PacketCommitmentKey(portID, channelID, sequence)) {
+		k.setInflightPacketCount(ctx, portID, channelID, k.GetInflightPacketCount(ctx, portID, channelID)-1)
+	}
+
 	store.Delete(host.PacketCommitmentKey(portID, channelID, sequence))
 }
 
+// GetInflightPacketCount returns the number of packets in flight on the given channel, that is the number of
+// packet commitments stored for the channel.
+func (k Keeper) GetInflightPacketCount(ctx sdk.Context, portID, channelID string) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.InflightPacketsKey(portID, channelID))
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setInflightPacketCount sets the number of packets in flight on the given channel. The entry is removed
+// once no packets are in flight.
+func (k Keeper) setInflightPacketCount(ctx sdk.Context, portID, channelID string, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	if count == 0 {
+		store.Delete(types.InflightPacketsKey(portID, channelID))
+		return
+	}
+
+	store.Set(types.InflightPacketsKey(portID, channelID), sdk.Uint64ToBigEndian(count))
+}
+
 // SetPacketAcknowledgement sets the packet ack hash to the store
 func (k Keeper) SetPacketAcknowledgement(ctx sdk.Context, portID, channelID string, sequence uint64, ackHash []byte) {
 	store := ctx.KVStore(k.storeKey)
@@ -308,6 +354,30 @@ func (k Keeper) GetAllPacketAckSeqs(ctx sdk.Context) (seqs []types.PacketSequenc
 	return seqs
 }
 
+// GetAllRecvStartSequences returns all stored recv start sequences.
+func (k Keeper) GetAllRecvStartSequences(ctx sdk.Context) (seqs []types.PacketSequence) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(host.KeyRecvStartSequence))
+	k.IteratePacketSequence(ctx, iterator, func(portID, channelID string, recvStartSeq uint64) bool {
+		ps := types.NewPacketSequence(portID, channelID, recvStartSeq)
+		seqs = append(seqs, ps)
+		return false
+	})
+	return seqs
+}
+
+// GetAllPruningSequenceStarts returns all stored pruning sequence starts.
+func (k Keeper) GetAllPruningSequenceStarts(ctx sdk.Context) (seqs []types.PacketSequence) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(host.KeyPruningSequenceStart))
+	k.IteratePacketSequence(ctx, iterator, func(portID, channelID string, pruningSeqStart uint64) bool {
+		ps := types.NewPacketSequence(portID, channelID, pruningSeqStart)
+		seqs = append(seqs, ps)
+		return false
+	})
+	return seqs
+}
+
 // IteratePacketCommitment provides an iterator over all PacketCommitment objects. For each
 // packet commitment, cb will be called. If the cb returns true, the iterator will close
 // and stop.
@@ -547,6 +617,24 @@ func (k Keeper) deleteUpgrade(ctx sdk.Context, portID, channelID string) {
 	store.Delete(host.ChannelUpgradeKey(portID, channelID))
 }
 
+// setSelfInitiatedUpgrade marks the upgrade of the given channel as initiated by this chain.
+func (k Keeper) setSelfInitiatedUpgrade(ctx sdk.Context, portID, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.SelfInitiatedUpgradeKey(portID, channelID), []byte{byte(1)})
+}
+
+// isSelfInitiatedUpgrade returns true if the upgrade of the given channel was initiated by this chain.
+func (k Keeper) isSelfInitiatedUpgrade(ctx sdk.Context, portID, channelID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.SelfInitiatedUpgradeKey(portID, channelID))
+}
+
+// deleteSelfInitiatedUpgrade removes the marker of an upgrade initiated by this chain for the given channel.
+func (k Keeper) deleteSelfInitiatedUpgrade(ctx sdk.Context, portID, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.SelfInitiatedUpgradeKey(portID, channelID))
+}
+
 // GetCounterpartyUpgrade gets the counterparty upgrade from the store.
 func (k Keeper) GetCounterpartyUpgrade(ctx sdk.Context, portID, channelID string) (types.Upgrade, bool) {
 	store := ctx.KVStore(k.storeKey)
@@ -578,6 +666,7 @@ func (k Keeper) deleteCounterpartyUpgrade(ctx sdk.Context, portID, channelID str
 func (k Keeper) deleteUpgradeInfo(ctx sdk.Context, portID, channelID string) {
 	k.deleteUpgrade(ctx, portID, channelID)
 	k.deleteCounterpartyUpgrade(ctx, portID, channelID)
+	k.deleteSelfInitiatedUpgrade(ctx, portID, channelID)
 }
 
 // SetParams sets the channel parameters.
@@ -629,11 +718,26 @@ func (k Keeper) HasInflightPackets(ctx sdk.Context, portID, channelID string) bo
 	return iterator.Valid()
 }
 
-// setRecvStartSequence sets the channel's recv start sequence to the store.
-func (k Keeper) setRecvStartSequence(ctx sdk.Context, portID, channelID string, sequence uint64) {
+// GetInflightPacketSequences returns the sequences, in ascending order, of the packets sent on the specified
+// port and channel whose packet commitments are stored.
+func (k Keeper) GetInflightPacketSequences(ctx sdk.Context, portID, channelID string) []uint64 {
+	var sequences []uint64
+	k.IteratePacketCommitmentAtChannel(ctx, portID, channelID, func(_, _ string, sequence uint64, _ []byte) bool {
+		sequences = append(sequences, sequence)
+		return false
+	})
+
+	// packet commitment keys are ordered lexicographically by their string encoded sequence
+	slices.Sort(sequences)
+	return sequences
+}
+
+// SetRecvStartSequence sets the channel's recv start sequence to the store.
+func (k Keeper) SetRecvStartSequence(ctx sdk.Context, portID, channelID string, sequence uint64) {
 	store := ctx.KVStore(k.storeKey)
 	bz := sdk.Uint64ToBigEndian(sequence)
 	store.Set(host.RecvStartSequenceKey(portID, channelID), bz)
+	k.updatePruneableChannelIndex(ctx, portID, channelID)
 }
 
 // GetRecvStartSequence gets a channel's recv start sequence from the store.
@@ -655,6 +759,7 @@ func (k Keeper) SetPruningSequenceStart(ctx sdk.Context, portID, channelID strin
 	store := ctx.KVStore(k.storeKey)
 	bz := sdk.Uint64ToBigEndian(sequence)
 	store.Set(host.PruningSequenceStartKey(portID, channelID), bz)
+	k.updatePruneableChannelIndex(ctx, portID, channelID)
 }
 
 // GetPruningSequenceStart gets a channel's pruning sequence start from the store.
@@ -675,6 +780,7 @@ func (k Keeper) HasPruningSequenceStart(ctx sdk.Context, portID, channelID strin
 }
 
 // PruneAcknowledgements prunes packet acknowledgements and receipts that have a sequence number less than pruning sequence end.
+// Timeout receipts written for packets skipped on ORDERED_ALLOW_TIMEOUT channels are not pruned.
 // The number of packet acks/receipts pruned is bounded by the limit. Pruning can only occur after a channel has been upgraded.
 //
 // Pruning sequence start keeps track of the packet ack/receipt that can be pruned next. When it reaches pruningSequenceEnd,
@@ -700,8 +806,12 @@ func (k Keeper) PruneAcknowledgements(ctx sdk.Context, portID, channelID string,
 
 		k.deletePacketAcknowledgement(ctx, portID, channelID, start)
 
-		// NOTE: packet receipts are only relevant for unordered channels.
-		k.deletePacketReceipt(ctx, portID, channelID, start)
+		// NOTE: packet receipts are only relevant for unordered and ORDERED_ALLOW_TIMEOUT channels. Timeout
+		// receipts are never pruned, the counterparty requires a proof of the timeout receipt to time out the
+		// skipped packet, see verifyPacketUnreceivedAllowTimeout.
+		if receipt, _ := k.GetPacketReceipt(ctx, portID, channelID, start); receipt != string(types.TimeoutReceipt) {
+			k.deletePacketReceipt(ctx, portID, channelID, start)
+		}
 	}
 
 	// set pruning sequence start to the updated value
@@ -510,6 +510,10 @@ func (suite *KeeperTestSuite) TestParams() {
 		{"success: zero timeout height", types.NewParams(types.NewTimeout(clienttypes.ZeroHeight(), 10000)), true},
 		{"fail: zero timeout timestamp", types.NewParams(types.NewTimeout(clienttypes.NewHeight(1, 1000), 0)), false},
 		{"fail: zero timeout", types.NewParams(types.NewTimeout(clienttypes.ZeroHeight(), 0)), false},
+		{"success: localhost auto relay enabled", types.Params{UpgradeTimeout: types.DefaultTimeout, LocalhostAutoRelay: types.NewLocalhostAutoRelay(true, 10, 100_000)}, true},
+		{"success: localhost auto relay disabled with zero limits", types.Params{UpgradeTimeout: types.DefaultTimeout, LocalhostAutoRelay: types.NewLocalhostAutoRelay(false, 0, 0)}, true},
+		{"fail: localhost auto relay enabled with zero max packets per block", types.Params{UpgradeTimeout: types.DefaultTimeout, LocalhostAutoRelay: types.NewLocalhostAutoRelay(true, 0, 100_000)}, false},
+		{"fail: localhost auto relay enabled with zero max gas per packet", types.Params{UpgradeTimeout: types.DefaultTimeout, LocalhostAutoRelay: types.NewLocalhostAutoRelay(true, 10, 0)}, false},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// isLocalhostAutoRelayEnabled returns true if the channel is built upon the 09-localhost connection
+// and automatic relaying of localhost packets is enabled in the channel params.
+func (k Keeper) isLocalhostAutoRelayEnabled(ctx sdk.Context, channel types.Channel) bool {
+	if channel.ConnectionHops[0] != exported.LocalhostConnectionID {
+		return false
+	}
+
+	return k.GetParams(ctx).LocalhostAutoRelay.Enabled
+}
+
+// getNextLocalhostRelayIndex returns the index of the next entry to be appended to the localhost relay queue.
+func (k Keeper) getNextLocalhostRelayIndex(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get([]byte(types.KeyNextLocalhostRelayIndex))
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setNextLocalhostRelayIndex sets the index of the next entry to be appended to the localhost relay queue.
+func (k Keeper) setNextLocalhostRelayIndex(ctx sdk.Context, index uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(types.KeyNextLocalhostRelayIndex), sdk.Uint64ToBigEndian(index))
+}
+
+// enqueueLocalhostRelay appends the packet and its acknowledgement, if any, to the localhost relay queue.
+func (k Keeper) enqueueLocalhostRelay(ctx sdk.Context, packet types.Packet, acknowledgement []byte) {
+	index := k.getNextLocalhostRelayIndex(ctx)
+
+	entry := types.LocalhostRelayEntry{
+		Packet:          packet,
+		Acknowledgement: acknowledgement,
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LocalhostRelayQueueKey(index), k.cdc.MustMarshal(&entry))
+
+	k.setNextLocalhostRelayIndex(ctx, index+1)
+}
+
+// GetNextLocalhostRelayEntry returns the oldest entry in the localhost relay queue along with its index.
+// False is returned if the queue is empty.
+func (k Keeper) GetNextLocalhostRelayEntry(ctx sdk.Context) (uint64, types.LocalhostRelayEntry, bool) {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.LocalhostRelayQueuePrefix())
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	if !iterator.Valid() {
+		return 0, types.LocalhostRelayEntry{}, false
+	}
+
+	var entry types.LocalhostRelayEntry
+	k.cdc.MustUnmarshal(iterator.Value(), &entry)
+
+	index := sdk.BigEndianToUint64(iterator.Key()[len(types.LocalhostRelayQueuePrefix()):])
+	return index, entry, true
+}
+
+// DeleteLocalhostRelayEntry removes the entry at the given index from the localhost relay queue.
+func (k Keeper) DeleteLocalhostRelayEntry(ctx sdk.Context, index uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.LocalhostRelayQueueKey(index))
+}
+
+// GetAllLocalhostRelayEntries returns all entries in the localhost relay queue in the order in which they were queued.
+func (k Keeper) GetAllLocalhostRelayEntries(ctx sdk.Context) []types.LocalhostRelayEntry {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.LocalhostRelayQueuePrefix())
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	var entries []types.LocalhostRelayEntry
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.LocalhostRelayEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &entry)
+
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
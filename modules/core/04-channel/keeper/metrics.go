@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibcmetrics "github.com/cosmos/ibc-go/v8/modules/core/metrics"
+)
+
+// ReportChannelMetrics reports the number of packets in flight on every channel, that is the number of packets
+// sent on the channel which have been neither acknowledged nor timed out. The number of packets in flight is
+// maintained in state as packet commitments are set and deleted, see GetInflightPacketCount. It is a no-op if
+// the ibc metrics are not enabled.
+//
+// Channels whose port or channel identifier exceeds the maximum number of label values share the OtherLabelValue
+// label, the packets in flight on such channels are therefore summed before being reported.
+func (k Keeper) ReportChannelMetrics(ctx sdk.Context) {
+	if !ibcmetrics.Enabled() {
+		return
+	}
+
+	type channelLabels struct {
+		portID    string
+		channelID string
+	}
+
+	// channels without packets in flight are reported as well, such that their gauges are reset to zero
+	var labelOrder []channelLabels
+	inflightPackets := make(map[channelLabels]uint64)
+	k.IterateChannels(ctx, func(channel types.IdentifiedChannel) bool {
+		labels := channelLabels{
+			portID:    ibcmetrics.Label(types.LabelPortID, channel.PortId).Value,
+			channelID: ibcmetrics.Label(types.LabelChannelID, channel.ChannelId).Value,
+		}
+
+		if _, ok := inflightPackets[labels]; !ok {
+			labelOrder = append(labelOrder, labels)
+		}
+
+		inflightPackets[labels] += k.GetInflightPacketCount(ctx, channel.PortId, channel.ChannelId)
+
+		return false
+	})
+
+	for _, labels := range labelOrder {
+		ibcmetrics.SetGauge(
+			[]string{"ibc", "channel", "inflight_packets"},
+			float32(inflightPackets[labels]),
+			telemetry.NewLabel(types.LabelPortID, labels.portID),
+			telemetry.NewLabel(types.LabelChannelID, labels.channelID),
+		)
+	}
+}
+
+// setPacketSendTime stores the current block height and time as the send time of the packet if recording
+// packet send times is enabled in the channel params.
+func (k Keeper) setPacketSendTime(ctx sdk.Context, packet exported.PacketI) {
+	if !k.GetParams(ctx).PacketSendTimeEnabled {
+		return
+	}
+
+	sendTime := types.PacketSendTime{
+		Height:    uint64(ctx.BlockHeight()),
+		Timestamp: uint64(ctx.BlockTime().UnixNano()),
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PacketSendTimeKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()), k.cdc.MustMarshal(&sendTime))
+}
+
+// GetPacketSendTime returns the send time of the packet with the given source port, source channel and sequence.
+// The send time is only stored while the packet is in flight, if recording packet send times was enabled in the
+// channel params when the packet was sent.
+func (k Keeper) GetPacketSendTime(ctx sdk.Context, portID, channelID string, sequence uint64) (types.PacketSendTime, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PacketSendTimeKey(portID, channelID, sequence))
+	if len(bz) == 0 {
+		return types.PacketSendTime{}, false
+	}
+
+	var sendTime types.PacketSendTime
+	k.cdc.MustUnmarshal(bz, &sendTime)
+
+	return sendTime, true
+}
+
+// deletePacketSendTime deletes the send time of the packet once the packet is no longer in flight. If the packet
+// has been acknowledged, the number of blocks and seconds elapsed since the packet was sent are reported.
+func (k Keeper) deletePacketSendTime(ctx sdk.Context, packet exported.PacketI, acknowledged bool) {
+	sendTime, found := k.GetPacketSendTime(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PacketSendTimeKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+
+	if !acknowledged || !ibcmetrics.Enabled() {
+		return
+	}
+
+	sourcePortLabel := ibcmetrics.Label(types.LabelSourcePort, packet.GetSourcePort())
+	sourceChannelLabel := ibcmetrics.Label(types.LabelSourceChannel, packet.GetSourceChannel())
+
+	blocks := uint64(ctx.BlockHeight()) - sendTime.Height
+	ibcmetrics.AddSample([]string{"ibc", "packet", "ack_latency_blocks"}, float32(blocks), sourcePortLabel, sourceChannelLabel)
+
+	seconds := float64(uint64(ctx.BlockTime().UnixNano())-sendTime.Timestamp) / 1e9
+	ibcmetrics.AddSample([]string{"ibc", "packet", "ack_latency_seconds"}, float32(seconds), sourcePortLabel, sourceChannelLabel)
+}
@@ -0,0 +1,160 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcmetrics "github.com/cosmos/ibc-go/v8/modules/core/metrics"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func (suite *KeeperTestSuite) TestPacketSendTime() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		name                  string
+		packetSendTimeEnabled bool
+		acknowledge           bool
+	}{
+		{
+			"success: packet acknowledged",
+			true,
+			true,
+		},
+		{
+			"success: packet timed out",
+			true,
+			false,
+		},
+		{
+			"success: packet send time disabled",
+			false,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			sink := ibctesting.EnableMetrics(suite.T())
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			params := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetParams(suite.chainA.GetContext())
+			params.PacketSendTimeEnabled = tc.packetSendTimeEnabled
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			sendCtx := suite.chainA.GetContext()
+
+			timeoutHeight := clienttypes.NewHeight(1, 1000)
+			var timeoutTimestamp uint64
+			if !tc.acknowledge {
+				timeoutHeight = clienttypes.ZeroHeight()
+				timeoutTimestamp = uint64(sendCtx.BlockTime().UnixNano()) + 1
+			}
+
+			sequence, err := path.EndpointA.SendPacket(timeoutHeight, timeoutTimestamp, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+
+			sendTime, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketSendTime(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence)
+			suite.Require().Equal(tc.packetSendTimeEnabled, found)
+			if found {
+				suite.Require().Equal(types.PacketSendTime{Height: uint64(sendCtx.BlockHeight()), Timestamp: uint64(sendCtx.BlockTime().UnixNano())}, sendTime)
+			}
+
+			packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, timeoutTimestamp)
+			if tc.acknowledge {
+				suite.Require().NoError(path.RelayPacket(packet))
+			} else {
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+				suite.Require().NoError(path.EndpointA.TimeoutPacket(packet))
+			}
+
+			_, found = suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketSendTime(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence)
+			suite.Require().False(found)
+
+			labels := fmt.Sprintf("source_port=%s;source_channel=%s", path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			latencyBlocks, reported := sink.Data()[0].Samples["ibc.packet.ack_latency_blocks;"+labels]
+			suite.Require().Equal(tc.packetSendTimeEnabled && tc.acknowledge, reported)
+			if reported {
+				suite.Require().Equal(1, latencyBlocks.Count)
+				suite.Require().Positive(latencyBlocks.Sum)
+
+				latencySeconds := sink.Data()[0].Samples["ibc.packet.ack_latency_seconds;"+labels]
+				suite.Require().Equal(1, latencySeconds.Count)
+				suite.Require().Positive(latencySeconds.Sum)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestReportChannelMetrics() {
+	sink := ibctesting.EnableMetrics(suite.T())
+
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	inflightPackets := func() float32 {
+		key := fmt.Sprintf("ibc.channel.inflight_packets;port_id=%s;channel_id=%s", path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+		gauge, found := sink.Data()[0].Gauges[key]
+		suite.Require().True(found)
+		return gauge.Value
+	}
+
+	suite.chainA.App.GetIBCKeeper().ChannelKeeper.ReportChannelMetrics(suite.chainA.GetContext())
+	suite.Require().Zero(inflightPackets())
+
+	var packets []types.Packet
+	for i := 0; i < 3; i++ {
+		sequence, err := path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+		suite.Require().NoError(err)
+
+		packets = append(packets, types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp))
+	}
+
+	suite.chainA.App.GetIBCKeeper().ChannelKeeper.ReportChannelMetrics(suite.chainA.GetContext())
+	suite.Require().Equal(float32(3), inflightPackets())
+
+	suite.Require().NoError(path.RelayPacket(packets[0]))
+
+	suite.chainA.App.GetIBCKeeper().ChannelKeeper.ReportChannelMetrics(suite.chainA.GetContext())
+	suite.Require().Equal(float32(2), inflightPackets())
+}
+
+func (suite *KeeperTestSuite) TestReportChannelMetricsOtherLabel() {
+	var paths []*ibctesting.Path
+	for i := 0; i < 3; i++ {
+		path := ibctesting.NewPath(suite.chainA, suite.chainB)
+		path.Setup()
+		paths = append(paths, path)
+	}
+
+	sink := ibctesting.EnableMetrics(suite.T())
+
+	// only the first channel is reported with its own identifier
+	ibcmetrics.Configure(ibcmetrics.Config{Enabled: true, MaxLabelValues: 1})
+
+	inflightPackets := func(channelID string) float32 {
+		key := fmt.Sprintf("ibc.channel.inflight_packets;port_id=%s;channel_id=%s", ibctesting.MockPort, channelID)
+		gauge, found := sink.Data()[0].Gauges[key]
+		suite.Require().True(found)
+		return gauge.Value
+	}
+
+	// send one packet on the first channel and two packets on each of the other channels
+	for i, path := range paths {
+		for j := 0; j < min(i+1, 2); j++ {
+			_, err := path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+		}
+	}
+
+	suite.chainA.App.GetIBCKeeper().ChannelKeeper.ReportChannelMetrics(suite.chainA.GetContext())
+
+	suite.Require().Equal(float32(1), inflightPackets(paths[0].EndpointA.ChannelID))
+	suite.Require().Equal(float32(4), inflightPackets(ibcmetrics.OtherLabelValue))
+}
@@ -23,3 +23,36 @@ func (m Migrator) MigrateParams(ctx sdk.Context) error {
 	m.keeper.Logger(ctx).Info("successfully migrated ibc channel params")
 	return nil
 }
+
+// Migrate6to7 migrates the ibc channel state from consensus version 6 to 7. The pruneable channel index is
+// populated with the channels with packet acknowledgements and receipts remaining to be pruned, and the
+// automatic pruning cursor, which referenced a pruning sequence start key, is reset. The number of packets
+// in flight of every channel is set to the number of its packet commitments.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	for _, ps := range m.keeper.GetAllPruningSequenceStarts(ctx) {
+		m.keeper.updatePruneableChannelIndex(ctx, ps.PortId, ps.ChannelId)
+	}
+
+	store := ctx.KVStore(m.keeper.storeKey)
+	store.Delete([]byte(channeltypes.KeyAutoPruningCursor))
+
+	// packet commitments are iterated in key order, the commitments of a channel are therefore adjacent.
+	// The sequence of every entry holds the number of packets in flight of the channel.
+	var inflightPackets []channeltypes.PacketSequence
+	m.keeper.IteratePacketCommitment(ctx, func(portID, channelID string, _ uint64, _ []byte) bool {
+		if n := len(inflightPackets); n > 0 && inflightPackets[n-1].PortId == portID && inflightPackets[n-1].ChannelId == channelID {
+			inflightPackets[n-1].Sequence++
+			return false
+		}
+
+		inflightPackets = append(inflightPackets, channeltypes.NewPacketSequence(portID, channelID, 1))
+		return false
+	})
+
+	for _, ps := range inflightPackets {
+		m.keeper.setInflightPacketCount(ctx, ps.PortId, ps.ChannelId, ps.Sequence)
+	}
+
+	m.keeper.Logger(ctx).Info("successfully migrated ibc channel state to consensus version 7")
+	return nil
+}
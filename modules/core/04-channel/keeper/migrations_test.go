@@ -3,6 +3,9 @@ package keeper_test
 import (
 	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/keeper"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 )
 
 // TestMigrateDefaultParams tests the migration for the channel params
@@ -32,3 +35,47 @@ func (suite *KeeperTestSuite) TestMigrateDefaultParams() {
 		})
 	}
 }
+
+// TestMigrate6to7 tests the migration populating the pruneable channel index and the number of packets in flight
+func (suite *KeeperTestSuite) TestMigrate6to7() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	prunedPath := ibctesting.NewPath(suite.chainA, suite.chainB)
+	prunedPath.Setup()
+
+	ctx := suite.chainA.GetContext()
+	channelKeeper := suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper
+	channelKeeper.SetRecvStartSequence(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 10)
+	channelKeeper.SetPruningSequenceStart(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1)
+	channelKeeper.SetRecvStartSequence(ctx, prunedPath.EndpointA.ChannelConfig.PortID, prunedPath.EndpointA.ChannelID, 10)
+	channelKeeper.SetPruningSequenceStart(ctx, prunedPath.EndpointA.ChannelConfig.PortID, prunedPath.EndpointA.ChannelID, 10)
+
+	// remove the index entries and set a cursor referencing a pruning sequence start key as stored by consensus version 6
+	store := ctx.KVStore(suite.chainA.GetSimApp().GetKey(exported.StoreKey))
+	store.Delete(channeltypes.PruneableChannelKey(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+	store.Set([]byte(channeltypes.KeyAutoPruningCursor), host.PruningSequenceStartKey(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+
+	for i := 0; i < 2; i++ {
+		_, err := path.EndpointA.SendPacket(suite.chainB.GetTimeoutHeight(), 0, ibctesting.MockPacketData)
+		suite.Require().NoError(err)
+	}
+
+	_, err := prunedPath.EndpointA.SendPacket(suite.chainB.GetTimeoutHeight(), 0, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+
+	// remove the number of packets in flight, which is not stored by consensus version 6
+	store.Delete(channeltypes.InflightPacketsKey(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+	store.Delete(channeltypes.InflightPacketsKey(prunedPath.EndpointA.ChannelConfig.PortID, prunedPath.EndpointA.ChannelID))
+
+	migrator := keeper.NewMigrator(channelKeeper)
+	err = migrator.Migrate6to7(ctx)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(uint64(2), channelKeeper.GetInflightPacketCount(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+	suite.Require().Equal(uint64(1), channelKeeper.GetInflightPacketCount(ctx, prunedPath.EndpointA.ChannelConfig.PortID, prunedPath.EndpointA.ChannelID))
+
+	suite.Require().True(store.Has(channeltypes.PruneableChannelKey(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)))
+	suite.Require().False(store.Has(channeltypes.PruneableChannelKey(prunedPath.EndpointA.ChannelConfig.PortID, prunedPath.EndpointA.ChannelID)))
+	suite.Require().False(store.Has([]byte(channeltypes.KeyAutoPruningCursor)))
+}
@@ -89,6 +89,12 @@ func (k Keeper) SendPacket(
 
 	k.SetNextSequenceSend(ctx, sourcePort, sourceChannel, sequence+1)
 	k.SetPacketCommitment(ctx, sourcePort, sourceChannel, packet.GetSequence(), commitment)
+	k.setPacketSendTime(ctx, packet)
+
+	// queue the packet to be received within the current block if it is sent over the localhost connection
+	if k.isLocalhostAutoRelayEnabled(ctx, channel) {
+		k.enqueueLocalhostRelay(ctx, packet, nil)
+	}
 
 	emitSendPacketEvent(ctx, packet, channel, timeoutHeight)
 
@@ -174,10 +180,12 @@ func (k Keeper) RecvPacket(
 		)
 	}
 
-	// check if packet timed out by comparing it with the latest height of the chain
+	// check if packet timed out by comparing it with the latest height of the chain.
+	// Timed out packets on ORDERED_ALLOW_TIMEOUT channels are skipped once the packet commitment has been verified.
 	selfHeight, selfTimestamp := clienttypes.GetSelfHeight(ctx), uint64(ctx.BlockTime().UnixNano())
 	timeout := types.NewTimeout(packet.GetTimeoutHeight().(clienttypes.Height), packet.GetTimeoutTimestamp())
-	if timeout.Elapsed(selfHeight, selfTimestamp) {
+	timedOut := timeout.Elapsed(selfHeight, selfTimestamp)
+	if timedOut && channel.Ordering != types.ORDERED_ALLOW_TIMEOUT {
 		return errorsmod.Wrap(timeout.ErrTimeoutElapsed(selfHeight, selfTimestamp), "packet timeout elapsed")
 	}
 
@@ -221,7 +229,7 @@ func (k Keeper) RecvPacket(
 		// it's just a single store key set to a single byte to indicate that the packet has been received
 		k.SetPacketReceipt(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
 
-	case types.ORDERED:
+	case types.ORDERED, types.ORDERED_ALLOW_TIMEOUT:
 		// check if the packet is being received in order
 		nextSequenceRecv, found := k.GetNextSequenceRecv(ctx, packet.GetDestPort(), packet.GetDestChannel())
 		if !found {
@@ -255,6 +263,25 @@ func (k Keeper) RecvPacket(
 		// incrementing nextSequenceRecv and storing under this chain's channelEnd identifiers
 		// Since this is the receiving chain, our channelEnd is packet's destination port and channel
 		k.SetNextSequenceRecv(ctx, packet.GetDestPort(), packet.GetDestChannel(), nextSequenceRecv)
+
+		if timedOut {
+			// the timed out packet is skipped: a timeout receipt is written so that the sending chain
+			// can prove the packet will never be received and the application callback is not executed.
+			k.SetPacketTimeoutReceipt(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+
+			k.Logger(ctx).Info(
+				"packet skipped",
+				"sequence", strconv.FormatUint(packet.GetSequence(), 10),
+				"src_port", packet.GetSourcePort(),
+				"src_channel", packet.GetSourceChannel(),
+				"dst_port", packet.GetDestPort(),
+				"dst_channel", packet.GetDestChannel(),
+			)
+
+			emitPacketSkippedEvent(ctx, packet, channel)
+
+			return types.ErrPacketSkipped
+		}
 	}
 
 	// log that a packet has been received & executed
@@ -330,6 +357,14 @@ func (k Keeper) WriteAcknowledgement(
 		types.CommitAcknowledgement(bz),
 	)
 
+	// queue the acknowledgement to be processed within the current block if the packet was sent over the localhost connection
+	if k.isLocalhostAutoRelayEnabled(ctx, channel) {
+		k.enqueueLocalhostRelay(ctx, types.NewPacket(
+			packet.GetData(), packet.GetSequence(), packet.GetSourcePort(), packet.GetSourceChannel(),
+			packet.GetDestPort(), packet.GetDestChannel(), packet.GetTimeoutHeight().(clienttypes.Height), packet.GetTimeoutTimestamp(),
+		), bz)
+	}
+
 	// log that a packet acknowledgement has been written
 	k.Logger(ctx).Info(
 		"acknowledgement written",
@@ -433,7 +468,7 @@ func (k Keeper) AcknowledgePacket(
 	}
 
 	// assert packets acknowledged in order
-	if channel.Ordering == types.ORDERED {
+	if channel.Ordering == types.ORDERED || channel.Ordering == types.ORDERED_ALLOW_TIMEOUT {
 		nextSequenceAck, found := k.GetNextSequenceAck(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
 		if !found {
 			return errorsmod.Wrapf(
@@ -460,6 +495,8 @@ func (k Keeper) AcknowledgePacket(
 
 	// Delete packet commitment, since the packet has been acknowledged, the commitement is no longer necessary
 	k.deletePacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	k.updateIndexedPacketStatus(ctx, packet, types.ACKNOWLEDGED, acknowledgement)
+	k.deletePacketSendTime(ctx, packet, true)
 
 	// log that a packet has been acknowledged
 	k.Logger(ctx).Info(
@@ -476,6 +513,8 @@ func (k Keeper) AcknowledgePacket(
 
 	// if an upgrade is in progress, handling packet flushing and update channel state appropriately
 	if channel.State == types.FLUSHING {
+		emitChannelFlushPacketEvent(ctx, packet, channel)
+
 		// counterparty upgrade is written in the OnChanUpgradeAck step.
 		counterpartyUpgrade, found := k.GetCounterpartyUpgrade(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
 		if found {
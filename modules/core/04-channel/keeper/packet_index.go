@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// IndexPacket adds a packet sent from this chain to the packet lifecycle index with the pending status.
+// Applications may call IndexPacket after sending a packet in order to allow the packet to be queried
+// by the provided sender and receiver. An empty sender or receiver is not indexed. IndexPacket is a
+// no-op if the packet index is not enabled in the channel params.
+func (k Keeper) IndexPacket(ctx sdk.Context, packet types.Packet, sender, receiver string) {
+	if !k.GetParams(ctx).PacketIndexEnabled {
+		return
+	}
+
+	indexedPacket := types.IndexedPacket{
+		Packet:   packet,
+		Sender:   sender,
+		Receiver: receiver,
+		Status:   types.PENDING,
+	}
+
+	key := types.PacketIndexKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(key, k.cdc.MustMarshal(&indexedPacket))
+
+	if sender != "" {
+		store.Set(append(types.PacketIndexSenderPrefix(sender), key...), key)
+	}
+
+	if receiver != "" {
+		store.Set(append(types.PacketIndexReceiverPrefix(receiver), key...), key)
+	}
+}
+
+// GetIndexedPacket returns the packet stored in the packet lifecycle index for the given source port,
+// source channel and sequence.
+func (k Keeper) GetIndexedPacket(ctx sdk.Context, portID, channelID string, sequence uint64) (types.IndexedPacket, bool) {
+	return k.getIndexedPacketByKey(ctx, types.PacketIndexKey(portID, channelID, sequence))
+}
+
+// getIndexedPacketByKey returns the packet stored in the packet lifecycle index under the given key.
+func (k Keeper) getIndexedPacketByKey(ctx sdk.Context, key []byte) (types.IndexedPacket, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(key)
+	if len(bz) == 0 {
+		return types.IndexedPacket{}, false
+	}
+
+	var indexedPacket types.IndexedPacket
+	k.cdc.MustUnmarshal(bz, &indexedPacket)
+
+	return indexedPacket, true
+}
+
+// updateIndexedPacketStatus sets the status and acknowledgement of the packet in the packet lifecycle index and
+// schedules its removal from the index once the retention period set in the channel params has elapsed. The packet
+// is removed immediately if the retention period is zero. It is a no-op if the packet has not been indexed.
+func (k Keeper) updateIndexedPacketStatus(ctx sdk.Context, packet exported.PacketI, status types.PacketStatus, acknowledgement []byte) {
+	key := types.PacketIndexKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
+	indexedPacket, found := k.getIndexedPacketByKey(ctx, key)
+	if !found {
+		return
+	}
+
+	retentionBlocks := k.GetParams(ctx).PacketIndexRetentionBlocks
+	if retentionBlocks == 0 {
+		k.deleteIndexedPacket(ctx, key, indexedPacket)
+		return
+	}
+
+	indexedPacket.Status = status
+	indexedPacket.Acknowledgement = acknowledgement
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(key, k.cdc.MustMarshal(&indexedPacket))
+
+	expiryHeight := uint64(ctx.BlockHeight()) + retentionBlocks
+	store.Set(types.PacketIndexExpiryQueueKey(expiryHeight, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()), key)
+}
+
+// PruneExpiredIndexedPackets removes up to MaxIndexedPacketsPrunedPerBlock acknowledged and timed out packets
+// whose retention period has elapsed from the packet lifecycle index. Expired packets beyond the limit are
+// pruned in the following blocks.
+func (k Keeper) PruneExpiredIndexedPackets(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	for _, entry := range k.getExpiredIndexedPackets(ctx) {
+		store.Delete(entry.queueKey)
+
+		if indexedPacket, found := k.getIndexedPacketByKey(ctx, entry.indexKey); found {
+			k.deleteIndexedPacket(ctx, entry.indexKey, indexedPacket)
+		}
+	}
+}
+
+// expiredIndexedPacket is an entry of the packet lifecycle index expiry queue along with the index key of the
+// expired packet.
+type expiredIndexedPacket struct {
+	queueKey []byte
+	indexKey []byte
+}
+
+// getExpiredIndexedPackets returns up to MaxIndexedPacketsPrunedPerBlock entries of the packet lifecycle index
+// expiry queue whose expiry height has been reached, in the order in which they expired.
+func (k Keeper) getExpiredIndexedPackets(ctx sdk.Context) []expiredIndexedPacket {
+	// entries expiring at the current height or earlier sort before the prefix of the next height
+	end := append(types.PacketIndexExpiryQueuePrefix(), sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())+1)...)
+
+	iterator := ctx.KVStore(k.storeKey).Iterator(types.PacketIndexExpiryQueuePrefix(), end)
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	var expired []expiredIndexedPacket
+	for ; iterator.Valid() && len(expired) < types.MaxIndexedPacketsPrunedPerBlock; iterator.Next() {
+		expired = append(expired, expiredIndexedPacket{queueKey: iterator.Key(), indexKey: iterator.Value()})
+	}
+
+	return expired
+}
+
+// deleteIndexedPacket removes the packet stored under the given key from the packet lifecycle index along with
+// its sender and receiver index entries.
+func (k Keeper) deleteIndexedPacket(ctx sdk.Context, key []byte, indexedPacket types.IndexedPacket) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(key)
+
+	if indexedPacket.Sender != "" {
+		store.Delete(append(types.PacketIndexSenderPrefix(indexedPacket.Sender), key...))
+	}
+
+	if indexedPacket.Receiver != "" {
+		store.Delete(append(types.PacketIndexReceiverPrefix(indexedPacket.Receiver), key...))
+	}
+}
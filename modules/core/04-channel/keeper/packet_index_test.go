@@ -0,0 +1,181 @@
+package keeper_test
+
+import (
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+const (
+	indexSender   = "sender"
+	indexReceiver = "receiver"
+)
+
+// enablePacketIndex enables the packet lifecycle index in the channel params of the given chain.
+func enablePacketIndex(chain *ibctesting.TestChain) {
+	channelKeeper := chain.App.GetIBCKeeper().ChannelKeeper
+	params := channelKeeper.GetParams(chain.GetContext())
+	params.PacketIndexEnabled = true
+	channelKeeper.SetParams(chain.GetContext(), params)
+}
+
+// sendIndexedPacket sends a packet from endpoint A of the path and adds it to the packet lifecycle index.
+func (suite *KeeperTestSuite) sendIndexedPacket(path *ibctesting.Path, timeoutHeight clienttypes.Height) types.Packet {
+	sequence, err := path.EndpointA.SendPacket(timeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+
+	packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+	suite.chainA.App.GetIBCKeeper().ChannelKeeper.IndexPacket(suite.chainA.GetContext(), packet, indexSender, indexReceiver)
+
+	return packet
+}
+
+func (suite *KeeperTestSuite) TestIndexPacket() {
+	var (
+		path            *ibctesting.Path
+		packet          types.Packet
+		expFound        bool
+		expStatus       types.PacketStatus
+		expAcknowledged []byte
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+	}{
+		{
+			"success: packet pending",
+			func() {
+				packet = suite.sendIndexedPacket(path, defaultTimeoutHeight)
+			},
+		},
+		{
+			"success: packet acknowledged",
+			func() {
+				packet = suite.sendIndexedPacket(path, defaultTimeoutHeight)
+
+				err := path.RelayPacket(packet)
+				suite.Require().NoError(err)
+
+				expStatus = types.ACKNOWLEDGED
+				expAcknowledged = ibctesting.MockAcknowledgement
+			},
+		},
+		{
+			"success: packet timed out",
+			func() {
+				timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+				packet = suite.sendIndexedPacket(path, timeoutHeight)
+
+				err := path.EndpointA.UpdateClient()
+				suite.Require().NoError(err)
+
+				err = path.EndpointA.TimeoutPacket(packet)
+				suite.Require().NoError(err)
+
+				expStatus = types.TIMEDOUT
+			},
+		},
+		{
+			"packet not indexed when the packet index is disabled",
+			func() {
+				params := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetParams(suite.chainA.GetContext())
+				params.PacketIndexEnabled = false
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainA.GetContext(), params)
+
+				packet = suite.sendIndexedPacket(path, defaultTimeoutHeight)
+
+				expFound = false
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+			expFound = true
+			expStatus = types.PENDING
+			expAcknowledged = nil
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+			enablePacketIndex(suite.chainA)
+
+			tc.malleate()
+
+			indexedPacket, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetIndexedPacket(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+			suite.Require().Equal(expFound, found)
+
+			if expFound {
+				suite.Require().Equal(packet, indexedPacket.Packet)
+				suite.Require().Equal(indexSender, indexedPacket.Sender)
+				suite.Require().Equal(indexReceiver, indexedPacket.Receiver)
+				suite.Require().Equal(expStatus, indexedPacket.Status)
+				suite.Require().Equal(expAcknowledged, indexedPacket.Acknowledgement)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPruneExpiredIndexedPackets() {
+	testCases := []struct {
+		name            string
+		retentionBlocks uint64
+		acknowledge     bool
+	}{
+		{"acknowledged packet pruned after the retention period", 3, true},
+		{"timed out packet pruned after the retention period", 3, false},
+		{"completed packet removed immediately without a retention period", 0, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+			enablePacketIndex(suite.chainA)
+
+			channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+			params := channelKeeper.GetParams(suite.chainA.GetContext())
+			params.PacketIndexRetentionBlocks = tc.retentionBlocks
+			channelKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			var packet types.Packet
+			if tc.acknowledge {
+				packet = suite.sendIndexedPacket(path, defaultTimeoutHeight)
+				suite.Require().NoError(path.RelayPacket(packet))
+			} else {
+				packet = suite.sendIndexedPacket(path, clienttypes.GetSelfHeight(suite.chainB.GetContext()))
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+				suite.Require().NoError(path.EndpointA.TimeoutPacket(packet))
+			}
+
+			isIndexed := func() bool {
+				_, found := channelKeeper.GetIndexedPacket(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
+				res, err := channelKeeper.PacketsBySender(suite.chainA.GetContext(), &types.QueryPacketsBySenderRequest{Sender: indexSender})
+				suite.Require().NoError(err)
+				suite.Require().Equal(found, len(res.Packets) == 1)
+
+				return found
+			}
+
+			// the packet was completed in the latest committed block
+			completionHeight := suite.chainA.GetContext().BlockHeight() - 1
+			for suite.chainA.GetContext().BlockHeight() <= completionHeight+int64(tc.retentionBlocks) {
+				suite.Require().True(isIndexed())
+
+				channelKeeper.PruneExpiredIndexedPackets(suite.chainA.GetContext())
+				suite.coordinator.CommitBlock(suite.chainA)
+			}
+
+			channelKeeper.PruneExpiredIndexedPackets(suite.chainA.GetContext())
+			suite.Require().False(isIndexed())
+		})
+	}
+}
@@ -311,6 +311,19 @@ func (suite *KeeperTestSuite) TestRecvPacket() {
 			},
 			nil,
 		},
+		{
+			"success: ORDERED_ALLOW_TIMEOUT channel",
+			func() {
+				path.SetChannelOrderedAllowTimeout()
+				path.Setup()
+
+				sequence, err := path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+				packet = types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+				channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			},
+			nil,
+		},
 		{
 			"success UNORDERED channel",
 			func() {
@@ -591,6 +604,20 @@ func (suite *KeeperTestSuite) TestRecvPacket() {
 			},
 			types.ErrTimeoutElapsed,
 		},
+		{
+			"packet skipped: timeout height passed on ORDERED_ALLOW_TIMEOUT channel",
+			func() {
+				path.SetChannelOrderedAllowTimeout()
+				path.Setup()
+
+				timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+				sequence, err := path.EndpointA.SendPacket(timeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+				packet = types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+				channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			},
+			types.ErrPacketSkipped,
+		},
 		{
 			"next receive sequence is not found",
 			func() {
@@ -684,7 +711,7 @@ func (suite *KeeperTestSuite) TestRecvPacket() {
 				suite.Require().True(found)
 				receipt, receiptStored := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketReceipt(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
 
-				if channelB.Ordering == types.ORDERED {
+				if channelB.Ordering != types.UNORDERED {
 					suite.Require().Equal(packet.GetSequence()+1, nextSeqRecv, "sequence not incremented in ordered channel")
 					suite.Require().False(receiptStored, "packet receipt stored on ORDERED channel")
 				} else {
@@ -976,6 +1003,14 @@ func (suite *KeeperTestSuite) TestAcknowledgePacket() {
 			},
 			expEvents: func(path *ibctesting.Path) map[string]map[string]string {
 				return ibctesting.EventsMap{
+					types.EventTypeChannelFlushPacket: {
+						types.AttributeKeyPortID:             path.EndpointA.ChannelConfig.PortID,
+						types.AttributeKeyChannelID:          path.EndpointA.ChannelID,
+						types.AttributeCounterpartyPortID:    path.EndpointB.ChannelConfig.PortID,
+						types.AttributeCounterpartyChannelID: path.EndpointB.ChannelID,
+						types.AttributeKeySequence:           "1",
+						types.AttributeKeyUpgradeSequence:    "0",
+					},
 					types.EventTypeChannelFlushComplete: {
 						types.AttributeKeyPortID:             path.EndpointA.ChannelConfig.PortID,
 						types.AttributeKeyChannelID:          path.EndpointA.ChannelID,
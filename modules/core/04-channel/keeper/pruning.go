@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+)
+
+// pruneableChannel identifies a channel with packet acknowledgements and receipts remaining to be pruned.
+type pruneableChannel struct {
+	portID    string
+	channelID string
+}
+
+// AutoPruneAcknowledgements prunes stale packet acknowledgements and receipts when automatic pruning is enabled
+// in the channel params. The channels of the pruneable channel index are pruned in turn, in the order of their
+// index keys. Each block resumes with the channel following the last channel pruned in the previous block, such
+// that every channel is eventually pruned regardless of the number of sequences remaining on other channels. At
+// most MaxPrunedPerChannel sequences of a single channel and MaxPrunedPerBlock sequences in total are pruned per
+// block.
+func (k Keeper) AutoPruneAcknowledgements(ctx sdk.Context) {
+	params := k.GetParams(ctx).AutoPruning
+	if !params.Enabled {
+		return
+	}
+
+	budget := params.MaxPrunedPerBlock
+	for _, channel := range k.getPruneableChannels(ctx, budget) {
+		pruned, remaining, err := k.PruneAcknowledgements(ctx, channel.portID, channel.channelID, min(params.MaxPrunedPerChannel, budget))
+		if err != nil {
+			ctx.Logger().Error("automatic acknowledgement pruning failed", "port-id", channel.portID, "channel-id", channel.channelID, "error", err)
+			continue
+		}
+
+		k.setAutoPruningCursor(ctx, channel.portID, channel.channelID)
+		emitAcknowledgementsPrunedEvent(ctx, channel.portID, channel.channelID, pruned, remaining)
+
+		budget -= pruned
+		if budget == 0 {
+			break
+		}
+	}
+}
+
+// getPruneableChannels returns up to limit channels of the pruneable channel index. The channels following the
+// automatic pruning cursor are returned first, followed by the channels preceding it and the channel of the cursor
+// itself.
+func (k Keeper) getPruneableChannels(ctx sdk.Context, limit uint64) []pruneableChannel {
+	var channels []pruneableChannel
+	collect := func(portID, channelID string, _ uint64) bool {
+		if uint64(len(channels)) >= limit {
+			return true
+		}
+
+		channels = append(channels, pruneableChannel{portID: portID, channelID: channelID})
+		return false
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.PruneableChannelPrefix()
+
+	cursor := store.Get([]byte(types.KeyAutoPruningCursor))
+	if len(cursor) == 0 {
+		k.IteratePacketSequence(ctx, storetypes.KVStorePrefixIterator(store, prefix), collect)
+		return channels
+	}
+
+	// the first key following the cursor key in lexicographic order
+	next := append(slices.Clone(cursor), 0x00)
+	k.IteratePacketSequence(ctx, store.Iterator(next, storetypes.PrefixEndBytes(prefix)), collect)
+	k.IteratePacketSequence(ctx, store.Iterator(prefix, next), collect)
+
+	return channels
+}
+
+// setAutoPruningCursor stores the pruneable channel key of the given channel as the automatic pruning cursor.
+func (k Keeper) setAutoPruningCursor(ctx sdk.Context, portID, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(types.KeyAutoPruningCursor), types.PruneableChannelKey(portID, channelID))
+}
+
+// updatePruneableChannelIndex adds the channel to the pruneable channel index, along with its pruning sequence
+// start, if its pruning sequence start is below its recv start sequence. Otherwise the channel is removed from
+// the index.
+func (k Keeper) updatePruneableChannelIndex(ctx sdk.Context, portID, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+
+	pruningSequenceStart, found := k.GetPruningSequenceStart(ctx, portID, channelID)
+	if recvStartSequence, recvFound := k.GetRecvStartSequence(ctx, portID, channelID); found && recvFound && pruningSequenceStart < recvStartSequence {
+		store.Set(types.PruneableChannelKey(portID, channelID), sdk.Uint64ToBigEndian(pruningSequenceStart))
+		return
+	}
+
+	store.Delete(types.PruneableChannelKey(portID, channelID))
+}
+
+// EnableAcknowledgementPruning enables the pruning of the packet acknowledgements and receipts of a channel which has
+// never been upgraded by setting its recv start sequence to the provided sequence and its pruning sequence start to 1.
+// Packets with a sequence lower than the recv start sequence are rejected as already received. The caller must
+// therefore ensure that every packet sent by the counterparty with a lower sequence has been received and
+// acknowledged on the counterparty, or timed out. For ORDERED and ORDERED_ALLOW_TIMEOUT channels the recv start
+// sequence cannot exceed the next sequence receive.
+func (k Keeper) EnableAcknowledgementPruning(ctx sdk.Context, portID, channelID string, recvStartSequence uint64) error {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if _, found := k.GetRecvStartSequence(ctx, portID, channelID); found {
+		return errorsmod.Wrapf(types.ErrPruningAlreadyEnabled, "recv start sequence already set for port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if channel.Ordering == types.ORDERED || channel.Ordering == types.ORDERED_ALLOW_TIMEOUT {
+		nextSequenceRecv, found := k.GetNextSequenceRecv(ctx, portID, channelID)
+		if !found {
+			return errorsmod.Wrapf(types.ErrSequenceReceiveNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+		}
+
+		if recvStartSequence > nextSequenceRecv {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidSequence, "recv start sequence (%d) cannot exceed next sequence receive (%d) on %s channels", recvStartSequence, nextSequenceRecv, channel.Ordering)
+		}
+	}
+
+	k.SetRecvStartSequence(ctx, portID, channelID, recvStartSequence)
+	if !k.HasPruningSequenceStart(ctx, portID, channelID) {
+		k.SetPruningSequenceStart(ctx, portID, channelID, 1)
+	}
+
+	emitAcknowledgementPruningEnabledEvent(ctx, portID, channelID, recvStartSequence)
+
+	return nil
+}
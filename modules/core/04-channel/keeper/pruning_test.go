@@ -0,0 +1,329 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	ibcmock "github.com/cosmos/ibc-go/v8/testing/mock"
+)
+
+func (suite *KeeperTestSuite) TestAutoPruneAcknowledgements() {
+	const numPaths = 3
+
+	testCases := []struct {
+		name        string
+		autoPruning types.AutoPruning
+		// expPruningStarts holds the expected pruning sequence start of every channel after each block.
+		expPruningStarts [][numPaths]uint64
+	}{
+		{
+			"auto pruning disabled",
+			types.NewAutoPruning(false, 10, 10),
+			[][numPaths]uint64{{1, 1, 1}, {1, 1, 1}},
+		},
+		{
+			"all channels pruned in a single block",
+			types.NewAutoPruning(true, 100, 100),
+			[][numPaths]uint64{{6, 6, 6}, {6, 6, 6}},
+		},
+		{
+			"channels pruned up to the per channel limit",
+			types.NewAutoPruning(true, 100, 2),
+			[][numPaths]uint64{{3, 3, 3}, {5, 5, 5}, {6, 6, 6}},
+		},
+		{
+			"channels pruned up to the per block limit, resuming after the last pruned channel",
+			types.NewAutoPruning(true, 6, 4),
+			[][numPaths]uint64{{5, 3, 1}, {6, 4, 5}, {6, 6, 6}},
+		},
+		{
+			"channels pruned in turn when the per block limit equals the per channel limit",
+			types.NewAutoPruning(true, 4, 4),
+			[][numPaths]uint64{{5, 1, 1}, {5, 5, 1}, {5, 5, 5}, {6, 6, 6}},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			var paths []*ibctesting.Path
+			for i := 0; i < numPaths; i++ {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.Setup()
+
+				// Send 5 packets from B -> A, creating 5 packet receipts and 5 packet acks on A.
+				suite.sendMockPackets(path, 5, true)
+
+				err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.EnableAcknowledgementPruning(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 6)
+				suite.Require().NoError(err)
+
+				paths = append(paths, path)
+			}
+
+			params := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetParams(suite.chainA.GetContext())
+			params.AutoPruning = tc.autoPruning
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			for block, expPruningStarts := range tc.expPruningStarts {
+				ctx := suite.chainA.GetContext()
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.AutoPruneAcknowledgements(ctx)
+
+				var totalPruned uint64
+				for i, path := range paths {
+					pruningStart, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPruningSequenceStart(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+					suite.Require().True(found)
+					suite.Require().Equal(expPruningStarts[i], pruningStart, "block %d, channel %s", block, path.EndpointA.ChannelID)
+
+					for sequence := uint64(1); sequence <= 5; sequence++ {
+						_, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence)
+						suite.Require().Equal(sequence >= pruningStart, found)
+
+						_, found = suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketReceipt(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence)
+						suite.Require().Equal(sequence >= pruningStart, found)
+					}
+
+					var prevPruningStart uint64 = 1
+					if block > 0 {
+						prevPruningStart = tc.expPruningStarts[block-1][i]
+					}
+					totalPruned += pruningStart - prevPruningStart
+				}
+
+				if tc.autoPruning.Enabled {
+					suite.Require().LessOrEqual(totalPruned, tc.autoPruning.MaxPrunedPerBlock)
+				}
+
+				var prunedEvents []sdk.Event
+				for _, event := range ctx.EventManager().Events() {
+					if event.Type == types.EventTypeAcknowledgementsPruned {
+						prunedEvents = append(prunedEvents, event)
+					}
+				}
+
+				if totalPruned == 0 {
+					suite.Require().Empty(prunedEvents)
+				} else {
+					suite.Require().NotEmpty(prunedEvents)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestEnableAcknowledgementPruning() {
+	var (
+		path              *ibctesting.Path
+		recvStartSequence uint64
+	)
+
+	testCases := []struct {
+		name     string
+		order    types.Order
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			types.UNORDERED,
+			func() {},
+			nil,
+		},
+		{
+			"success: recv start sequence below the next sequence receive on an ordered channel",
+			types.ORDERED,
+			func() {
+				recvStartSequence = 5
+			},
+			nil,
+		},
+		{
+			"success: recv start sequence equal to the next sequence receive on an ordered channel",
+			types.ORDERED,
+			func() {},
+			nil,
+		},
+		{
+			"success: recv start sequence equal to the next sequence receive on an ORDERED_ALLOW_TIMEOUT channel",
+			types.ORDERED_ALLOW_TIMEOUT,
+			func() {},
+			nil,
+		},
+		{
+			"failure: recv start sequence exceeds the next sequence receive on an ordered channel",
+			types.ORDERED,
+			func() {
+				recvStartSequence = 7
+			},
+			ibcerrors.ErrInvalidSequence,
+		},
+		{
+			"failure: recv start sequence exceeds the next sequence receive on an ORDERED_ALLOW_TIMEOUT channel",
+			types.ORDERED_ALLOW_TIMEOUT,
+			func() {
+				recvStartSequence = 7
+			},
+			ibcerrors.ErrInvalidSequence,
+		},
+		{
+			"failure: channel not found",
+			types.UNORDERED,
+			func() {
+				path.EndpointA.ChannelID = ibctesting.InvalidID
+			},
+			types.ErrChannelNotFound,
+		},
+		{
+			"failure: channel has been upgraded",
+			types.UNORDERED,
+			func() {
+				suite.UpgradeChannel(path, types.UpgradeFields{Version: ibcmock.UpgradeVersion})
+			},
+			types.ErrPruningAlreadyEnabled,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.EndpointA.ChannelConfig.Order = tc.order
+			path.EndpointB.ChannelConfig.Order = tc.order
+			path.Setup()
+
+			// Send 5 packets from B -> A, creating 5 packet acks on A, the next sequence receive on A is 6.
+			suite.sendMockPackets(path, 5, true)
+
+			recvStartSequence = 6
+
+			tc.malleate()
+
+			err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.EnableAcknowledgementPruning(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, recvStartSequence)
+
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+
+				storedRecvStartSequence, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetRecvStartSequence(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(recvStartSequence, storedRecvStartSequence)
+
+				pruningStart, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPruningSequenceStart(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(uint64(1), pruningStart)
+
+				// the channel is added to the pruneable channel index until pruning is complete
+				store := suite.chainA.GetContext().KVStore(suite.chainA.GetSimApp().GetKey(exported.StoreKey))
+				suite.Require().True(store.Has(types.PruneableChannelKey(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)))
+
+				pruned, remaining, err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.PruneAcknowledgements(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 10)
+				suite.Require().NoError(err)
+				suite.Require().Equal(recvStartSequence-1, pruned)
+				suite.Require().Zero(remaining)
+
+				suite.Require().False(store.Has(types.PruneableChannelKey(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)))
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+// TestPruneAcknowledgementsTimeoutReceipt asserts that the timeout receipts written for packets skipped on an
+// ORDERED_ALLOW_TIMEOUT channel are not pruned, as they are required by the counterparty to time out the packets.
+func (suite *KeeperTestSuite) TestPruneAcknowledgementsTimeoutReceipt() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetChannelOrderedAllowTimeout()
+	path.Setup()
+
+	// send a packet from B -> A which is skipped on A
+	timeoutHeight := clienttypes.GetSelfHeight(suite.chainA.GetContext())
+	sequence, err := path.EndpointB.SendPacket(timeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+
+	packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+	suite.Require().NoError(path.EndpointA.RecvPacket(packet))
+
+	channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+	err = channelKeeper.EnableAcknowledgementPruning(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence+1)
+	suite.Require().NoError(err)
+
+	pruned, remaining, err := channelKeeper.PruneAcknowledgements(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 10)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), pruned)
+	suite.Require().Zero(remaining)
+
+	receipt, found := channelKeeper.GetPacketReceipt(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence)
+	suite.Require().True(found)
+	suite.Require().Equal(string(types.TimeoutReceipt), receipt)
+
+	// the packet can still be timed out on B
+	suite.Require().NoError(path.EndpointB.UpdateClient())
+	suite.Require().NoError(path.EndpointB.TimeoutPacket(packet))
+}
+
+// TestEnableAcknowledgementPruningReplayProtection asserts that packets with a sequence lower than the recv start sequence
+// set by EnableAcknowledgementPruning are rejected as already received.
+func (suite *KeeperTestSuite) TestEnableAcknowledgementPruningReplayProtection() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	timeoutHeight := clienttypes.NewHeight(1, 1000)
+	sequence, err := path.EndpointB.SendPacket(timeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+
+	err = suite.chainA.App.GetIBCKeeper().ChannelKeeper.EnableAcknowledgementPruning(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence+1)
+	suite.Require().NoError(err)
+
+	packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+	proof, proofHeight := path.EndpointB.QueryProof(host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+
+	err = suite.chainA.App.GetIBCKeeper().ChannelKeeper.RecvPacket(suite.chainA.GetContext(), suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID), packet, proof, proofHeight)
+	suite.Require().ErrorIs(err, types.ErrPacketReceived)
+}
+
+// TestEnableAcknowledgementPruningUpgrade asserts that a channel upgrade keeps a recv start sequence set by
+// EnableAcknowledgementPruning which exceeds the counterparty next sequence send, and raises a lower one.
+func (suite *KeeperTestSuite) TestEnableAcknowledgementPruningUpgrade() {
+	testCases := []struct {
+		name                 string
+		recvStartSequence    uint64
+		expRecvStartSequence uint64
+	}{
+		{"recv start sequence above the counterparty next sequence send is kept", 100, 100},
+		{"recv start sequence below the counterparty next sequence send is raised", 2, 6},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			// Send 5 packets from B -> A, the counterparty next sequence send is 6.
+			suite.sendMockPackets(path, 5, true)
+
+			err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.EnableAcknowledgementPruning(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, tc.recvStartSequence)
+			suite.Require().NoError(err)
+
+			suite.UpgradeChannel(path, types.UpgradeFields{Version: fmt.Sprintf("%s-v2", ibcmock.Version)})
+
+			recvStartSequence, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetRecvStartSequence(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.expRecvStartSequence, recvStartSequence)
+		})
+	}
+}
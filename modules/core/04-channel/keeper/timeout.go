@@ -106,6 +106,8 @@ func (k Keeper) TimeoutPacket(
 			ctx, connectionEnd, proofHeight, proof,
 			packet.GetDestPort(), packet.GetDestChannel(), nextSequenceRecv,
 		)
+	case types.ORDERED_ALLOW_TIMEOUT:
+		err = k.verifyPacketUnreceivedAllowTimeout(ctx, connectionEnd, packet, proof, proofHeight, nextSequenceRecv)
 	case types.UNORDERED:
 		err = k.connectionKeeper.VerifyPacketReceiptAbsence(
 			ctx, connectionEnd, proofHeight, proof,
@@ -123,8 +125,52 @@ func (k Keeper) TimeoutPacket(
 	return nil
 }
 
+// verifyPacketUnreceivedAllowTimeout verifies that a packet sent on an ORDERED_ALLOW_TIMEOUT channel will never
+// be received by the counterparty. Packets must be timed out in the same order in which they are acknowledged.
+// If the counterparty has not yet received the packet, the proof of its next sequence receive is verified.
+// Otherwise, the counterparty must have skipped the packet and the proof of its timeout receipt is verified.
+func (k Keeper) verifyPacketUnreceivedAllowTimeout(
+	ctx sdk.Context,
+	connectionEnd connectiontypes.ConnectionEnd,
+	packet exported.PacketI,
+	proof []byte,
+	proofHeight exported.Height,
+	nextSequenceRecv uint64,
+) error {
+	nextSequenceAck, found := k.GetNextSequenceAck(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+	if !found {
+		return errorsmod.Wrapf(
+			types.ErrSequenceAckNotFound,
+			"source port: %s, source channel: %s", packet.GetSourcePort(), packet.GetSourceChannel(),
+		)
+	}
+
+	if packet.GetSequence() != nextSequenceAck {
+		return errorsmod.Wrapf(
+			types.ErrPacketSequenceOutOfOrder,
+			"packet sequence ≠ next ack sequence (%d ≠ %d)", packet.GetSequence(), nextSequenceAck,
+		)
+	}
+
+	if nextSequenceRecv > packet.GetSequence() {
+		// check that the counterparty skipped the packet rather than receiving it
+		return k.connectionKeeper.VerifyPacketReceipt(
+			ctx, connectionEnd, proofHeight, proof,
+			packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(), types.TimeoutReceipt,
+		)
+	}
+
+	// check that the recv sequence is as claimed
+	return k.connectionKeeper.VerifyNextSequenceRecv(
+		ctx, connectionEnd, proofHeight, proof,
+		packet.GetDestPort(), packet.GetDestChannel(), nextSequenceRecv,
+	)
+}
+
 // TimeoutExecuted deletes the commitment send from this chain after it verifies timeout.
 // If the timed-out packet came from an ORDERED channel then this channel will be closed.
+// If the timed-out packet came from an ORDERED_ALLOW_TIMEOUT channel then the next sequence
+// acknowledgement is incremented and the channel remains open.
 // If the channel is in the FLUSHING state and there is a counterparty upgrade, then the
 // upgrade will be aborted if the upgrade has timed out. Otherwise, if there are no more inflight packets,
 // then the channel will be set to the FLUSHCOMPLETE state.
@@ -149,9 +195,13 @@ func (k Keeper) TimeoutExecuted(
 	}
 
 	k.deletePacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	k.updateIndexedPacketStatus(ctx, packet, types.TIMEDOUT, nil)
+	k.deletePacketSendTime(ctx, packet, false)
 
 	// if an upgrade is in progress, handling packet flushing and update channel state appropriately
-	if channel.State == types.FLUSHING && channel.Ordering == types.UNORDERED {
+	if channel.State == types.FLUSHING && channel.Ordering != types.ORDERED {
+		emitChannelFlushPacketEvent(ctx, packet, channel)
+
 		counterpartyUpgrade, found := k.GetCounterpartyUpgrade(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
 		// once we have received the counterparty timeout in the channel UpgradeAck or UpgradeConfirm handshake steps
 		// then we can move to flushing complete if the timeout has not passed and there are no in-flight packets
@@ -172,6 +222,12 @@ func (k Keeper) TimeoutExecuted(
 		}
 	}
 
+	if channel.Ordering == types.ORDERED_ALLOW_TIMEOUT {
+		// timed out packets are acknowledged in order, the next sequence acknowledgement was verified in
+		// TimeoutPacket or TimeoutOnClose to be equal to the sequence of the timed out packet.
+		k.SetNextSequenceAck(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()+1)
+	}
+
 	if channel.Ordering == types.ORDERED {
 		// NOTE: if the channel is ORDERED and a packet is timed out in FLUSHING state then
 		// the upgrade is aborted and the channel is set to CLOSED.
@@ -297,6 +353,8 @@ func (k Keeper) TimeoutOnClose(
 			ctx, connectionEnd, proofHeight, proof,
 			packet.GetDestPort(), packet.GetDestChannel(), nextSequenceRecv,
 		)
+	case types.ORDERED_ALLOW_TIMEOUT:
+		err = k.verifyPacketUnreceivedAllowTimeout(ctx, connectionEnd, packet, proof, proofHeight, nextSequenceRecv)
 	case types.UNORDERED:
 		err = k.connectionKeeper.VerifyPacketReceiptAbsence(
 			ctx, connectionEnd, proofHeight, proof,
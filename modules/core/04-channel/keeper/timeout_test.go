@@ -236,6 +236,143 @@ func (suite *KeeperTestSuite) TestTimeoutPacket() {
 // TestTimeoutExecuted verifies that packet commitments are deleted on chainA after the
 // channel capabilities are verified. In addition, the test verifies that the channel state
 // after a timeout is updated accordingly.
+// TestTimeoutPacketOrderedAllowTimeout tests timing out packets sent on ORDERED_ALLOW_TIMEOUT channels, where the
+// channel remains open and packets sent after a timed out packet are still received in order.
+func (suite *KeeperTestSuite) TestTimeoutPacketOrderedAllowTimeout() {
+	var (
+		path   *ibctesting.Path
+		packet types.Packet
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: packet not yet received by counterparty",
+			func() {},
+			nil,
+		},
+		{
+			"success: packet skipped by counterparty",
+			func() {
+				err := path.EndpointB.UpdateClient()
+				suite.Require().NoError(err)
+
+				err = path.EndpointB.RecvPacket(packet)
+				suite.Require().NoError(err)
+
+				nextSeqRecv, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(packet.GetSequence()+1, nextSeqRecv)
+
+				receipt, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketReceipt(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, packet.GetSequence())
+				suite.Require().True(found)
+				suite.Require().Equal(string(types.TimeoutReceipt), receipt)
+			},
+			nil,
+		},
+		{
+			"failure: previous packet not yet acknowledged or timed out",
+			func() {
+				timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+
+				sequence, err := path.EndpointA.SendPacket(timeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				packet = types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+
+				err = path.EndpointA.UpdateClient()
+				suite.Require().NoError(err)
+			},
+			types.ErrPacketSequenceOutOfOrder,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetChannelOrderedAllowTimeout()
+			path.Setup()
+
+			timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+
+			sequence, err := path.EndpointA.SendPacket(timeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+
+			packet = types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+
+			err = path.EndpointA.UpdateClient()
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			err = path.EndpointA.TimeoutPacket(packet)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+
+				channel := path.EndpointA.GetChannel()
+				suite.Require().Equal(types.OPEN, channel.State, "channel should remain open after a timeout")
+
+				nextSeqAck, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceAck(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(packet.GetSequence()+1, nextSeqAck)
+
+				commitment := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, packet.GetSequence())
+				suite.Require().Nil(commitment)
+			} else {
+				suite.Require().ErrorContains(err, tc.expError.Error())
+			}
+		})
+	}
+}
+
+// TestOrderedAllowTimeoutPacketFlow tests that packets sent after a timed out packet on an ORDERED_ALLOW_TIMEOUT
+// channel are received and acknowledged in order once the timed out packet has been skipped.
+func (suite *KeeperTestSuite) TestOrderedAllowTimeoutPacketFlow() {
+	suite.SetupTest() // reset
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetChannelOrderedAllowTimeout()
+	path.Setup()
+
+	timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+	sequence, err := path.EndpointA.SendPacket(timeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+	timedOutPacket := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+
+	sequence, err = path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+	packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+
+	// the packet which has not timed out cannot be received before the timed out packet is skipped
+	err = path.EndpointB.RecvPacket(packet)
+	suite.Require().ErrorContains(err, types.ErrPacketSequenceOutOfOrder.Error())
+
+	err = path.EndpointB.RecvPacket(timedOutPacket)
+	suite.Require().NoError(err)
+
+	err = path.EndpointA.TimeoutPacket(timedOutPacket)
+	suite.Require().NoError(err)
+
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(types.OPEN, path.EndpointA.GetChannel().State)
+	suite.Require().Equal(types.OPEN, path.EndpointB.GetChannel().State)
+
+	nextSeqRecv, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(packet.GetSequence()+1, nextSeqRecv)
+
+	nextSeqAck, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceAck(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(packet.GetSequence()+1, nextSeqAck)
+}
+
 func (suite *KeeperTestSuite) TestTimeoutExecuted() {
 	var (
 		path    *ibctesting.Path
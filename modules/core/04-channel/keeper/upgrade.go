@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
 	"reflect"
 	"slices"
@@ -38,6 +39,10 @@ func (k Keeper) ChanUpgradeInit(
 		return types.Upgrade{}, err
 	}
 
+	if err := k.validateUpgradePolicy(ctx, portID, channel, upgradeFields); err != nil {
+		return types.Upgrade{}, err
+	}
+
 	// NOTE: the Upgrade returned here is intentionally not fully populated. The Timeout remains unset
 	// until the counterparty calls ChanUpgradeTry.
 	return types.Upgrade{Fields: upgradeFields}, nil
@@ -64,6 +69,7 @@ func (k Keeper) WriteUpgradeInitChannel(ctx sdk.Context, portID, channelID strin
 
 	k.SetChannel(ctx, portID, channelID, channel)
 	k.SetUpgrade(ctx, portID, channelID, upgrade)
+	k.setSelfInitiatedUpgrade(ctx, portID, channelID)
 
 	k.Logger(ctx).Info("channel state updated", "port-id", portID, "channel-id", channelID, "state", channel.State, "upgrade-sequence", fmt.Sprintf("%d", channel.UpgradeSequence))
 
@@ -103,6 +109,27 @@ func (k Keeper) ChanUpgradeTry(
 		)
 	}
 
+	var (
+		err                     error
+		upgrade                 types.Upgrade
+		isCrossingHello         bool
+		expectedUpgradeSequence uint64
+		upgradeConnectionHops   []string
+	)
+
+	// NOTE: the connection hops proposed by the relayer are not trusted for proof verification, the proposed
+	// connection may only be used to verify proofs if it was chosen in ChanUpgradeInit (crossing hellos).
+	// An upgrade written in ChanUpgradeTry without crossing hellos is therefore not marked as self initiated.
+	upgrade, isCrossingHello = k.GetUpgrade(ctx, portID, channelID)
+	if isCrossingHello {
+		upgradeConnectionHops = upgrade.Fields.ConnectionHops
+	}
+
+	proofConnection, err := k.getUpgradeProofConnection(ctx, portID, channelID, connection, upgradeConnectionHops)
+	if err != nil {
+		return types.Channel{}, types.Upgrade{}, err
+	}
+
 	// construct expected counterparty channel from information in state
 	// only the counterpartyUpgradeSequence is provided by the relayer
 	counterpartyConnectionHops := []string{connection.GetCounterparty().GetConnectionID()}
@@ -118,7 +145,7 @@ func (k Keeper) ChanUpgradeTry(
 	// verify the counterparty channel state containing the upgrade sequence
 	if err := k.connectionKeeper.VerifyChannelState(
 		ctx,
-		connection,
+		proofConnection,
 		proofHeight, channelProof,
 		channel.Counterparty.PortId,
 		channel.Counterparty.ChannelId,
@@ -127,14 +154,6 @@ func (k Keeper) ChanUpgradeTry(
 		return types.Channel{}, types.Upgrade{}, errorsmod.Wrap(err, "failed to verify counterparty channel state")
 	}
 
-	var (
-		err                     error
-		upgrade                 types.Upgrade
-		isCrossingHello         bool
-		expectedUpgradeSequence uint64
-	)
-
-	upgrade, isCrossingHello = k.GetUpgrade(ctx, portID, channelID)
 	if isCrossingHello {
 		expectedUpgradeSequence = channel.UpgradeSequence
 	} else {
@@ -174,7 +193,7 @@ func (k Keeper) ChanUpgradeTry(
 	// verifies the proof that a particular proposed upgrade has been stored in the upgrade path of the counterparty
 	if err := k.connectionKeeper.VerifyChannelUpgrade(
 		ctx,
-		connection,
+		proofConnection,
 		proofHeight, upgradeProof,
 		channel.Counterparty.PortId,
 		channel.Counterparty.ChannelId,
@@ -191,6 +210,17 @@ func (k Keeper) ChanUpgradeTry(
 		Version:        counterpartyUpgradeFields.Version,
 	}
 
+	// NOTE: upgrades initiated by the counterparty are checked against the upgrade policy of the port before the
+	// upgrade init sub-protocol is run. The upgrade sequence is fast-forwarded to the counterparty upgrade sequence
+	// so that the error receipt written allows the counterparty to cancel its upgrade.
+	if !isCrossingHello {
+		if err := k.validateCounterpartyUpgradePolicy(ctx, portID, channel, proposedUpgradeFields); err != nil {
+			channel.UpgradeSequence = counterpartyUpgradeSequence
+			k.SetChannel(ctx, portID, channelID, channel)
+			return channel, upgrade, types.NewUpgradeError(counterpartyUpgradeSequence, err)
+		}
+	}
+
 	// NOTE: if an upgrade exists (crossing hellos) then use existing upgrade fields
 	// otherwise, run the upgrade init sub-protocol
 	if isCrossingHello {
@@ -203,6 +233,9 @@ func (k Keeper) ChanUpgradeTry(
 		}
 
 		channel, upgrade = k.WriteUpgradeInitChannel(ctx, portID, channelID, upgrade, upgrade.Fields.Version)
+
+		// the connection hops of the upgrade are proposed by the relayer, the upgrade is not initiated by this chain
+		k.deleteSelfInitiatedUpgrade(ctx, portID, channelID)
 	}
 
 	if err := k.checkForUpgradeCompatibility(ctx, proposedUpgradeFields, counterpartyUpgradeFields); err != nil {
@@ -279,6 +312,16 @@ func (k Keeper) ChanUpgradeAck(
 		return errorsmod.Wrapf(connectiontypes.ErrInvalidConnectionState, "connection state is not OPEN (got %s)", connectiontypes.State(connection.GetState()).String())
 	}
 
+	upgrade, found := k.GetUpgrade(ctx, portID, channelID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrUpgradeNotFound, "failed to retrieve channel upgrade: port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	proofConnection, err := k.getUpgradeProofConnection(ctx, portID, channelID, connection, upgrade.Fields.ConnectionHops)
+	if err != nil {
+		return err
+	}
+
 	counterpartyHops := []string{connection.GetCounterparty().GetConnectionID()}
 	counterpartyChannel := types.Channel{
 		State:           types.FLUSHING,
@@ -292,7 +335,7 @@ func (k Keeper) ChanUpgradeAck(
 	// verify the counterparty channel state containing the upgrade sequence
 	if err := k.connectionKeeper.VerifyChannelState(
 		ctx,
-		connection,
+		proofConnection,
 		proofHeight, channelProof,
 		channel.Counterparty.PortId,
 		channel.Counterparty.ChannelId,
@@ -304,7 +347,7 @@ func (k Keeper) ChanUpgradeAck(
 	// verifies the proof that a particular proposed upgrade has been stored in the upgrade path of the counterparty
 	if err := k.connectionKeeper.VerifyChannelUpgrade(
 		ctx,
-		connection,
+		proofConnection,
 		proofHeight, upgradeProof,
 		channel.Counterparty.PortId,
 		channel.Counterparty.ChannelId,
@@ -313,11 +356,6 @@ func (k Keeper) ChanUpgradeAck(
 		return errorsmod.Wrap(err, "failed to verify counterparty upgrade")
 	}
 
-	upgrade, found := k.GetUpgrade(ctx, portID, channelID)
-	if !found {
-		return errorsmod.Wrapf(types.ErrUpgradeNotFound, "failed to retrieve channel upgrade: port ID (%s) channel ID (%s)", portID, channelID)
-	}
-
 	// optimistically accept version that TRY chain proposes and pass this to callback for confirmation
 	// in the crossing hello case, we do not modify version that our TRY call returned and instead enforce
 	// that both TRY calls returned the same version. It is possible that this will fail in the OnChanUpgradeAck
@@ -326,6 +364,11 @@ func (k Keeper) ChanUpgradeAck(
 		upgrade.Fields.Version = counterpartyUpgrade.Fields.Version
 	}
 
+	// the version selected by the counterparty must also be permitted by the upgrade policy of the port
+	if err := k.validateUpgradePolicy(ctx, portID, channel, upgrade.Fields); err != nil {
+		return types.NewUpgradeError(channel.UpgradeSequence, err)
+	}
+
 	// if upgrades are not compatible by ACK step, then we restore the channel
 	if err := k.checkForUpgradeCompatibility(ctx, upgrade.Fields, counterpartyUpgrade.Fields); err != nil {
 		return types.NewUpgradeError(channel.UpgradeSequence, err)
@@ -412,6 +455,16 @@ func (k Keeper) ChanUpgradeConfirm(
 		return errorsmod.Wrapf(connectiontypes.ErrInvalidConnectionState, "connection state is not OPEN (got %s)", connectiontypes.State(connection.GetState()).String())
 	}
 
+	upgrade, found := k.GetUpgrade(ctx, portID, channelID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrUpgradeNotFound, "failed to retrieve channel upgrade: port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	proofConnection, err := k.getUpgradeProofConnection(ctx, portID, channelID, connection, upgrade.Fields.ConnectionHops)
+	if err != nil {
+		return err
+	}
+
 	counterpartyHops := []string{connection.GetCounterparty().GetConnectionID()}
 	counterpartyChannel := types.Channel{
 		State:           counterpartyChannelState,
@@ -424,7 +477,7 @@ func (k Keeper) ChanUpgradeConfirm(
 
 	if err := k.connectionKeeper.VerifyChannelState(
 		ctx,
-		connection,
+		proofConnection,
 		proofHeight, channelProof,
 		channel.Counterparty.PortId,
 		channel.Counterparty.ChannelId,
@@ -435,7 +488,7 @@ func (k Keeper) ChanUpgradeConfirm(
 
 	if err := k.connectionKeeper.VerifyChannelUpgrade(
 		ctx,
-		connection,
+		proofConnection,
 		proofHeight, upgradeProof,
 		channel.Counterparty.PortId,
 		channel.Counterparty.ChannelId,
@@ -507,13 +560,19 @@ func (k Keeper) ChanUpgradeOpen(
 		return errorsmod.Wrapf(connectiontypes.ErrInvalidConnectionState, "connection state is not OPEN (got %s)", connectiontypes.State(connection.GetState()).String())
 	}
 
+	upgrade, found := k.GetUpgrade(ctx, portID, channelID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrUpgradeNotFound, "failed to retrieve channel upgrade: port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	proofConnection, err := k.getUpgradeProofConnection(ctx, portID, channelID, connection, upgrade.Fields.ConnectionHops)
+	if err != nil {
+		return err
+	}
+
 	var counterpartyChannel types.Channel
 	switch counterpartyChannelState {
 	case types.OPEN:
-		upgrade, found := k.GetUpgrade(ctx, portID, channelID)
-		if !found {
-			return errorsmod.Wrapf(types.ErrUpgradeNotFound, "failed to retrieve channel upgrade: port ID (%s) channel ID (%s)", portID, channelID)
-		}
 		// If counterparty has reached OPEN, we must use the upgraded connection to verify the counterparty channel
 		upgradeConnection, found := k.connectionKeeper.GetConnection(ctx, upgrade.Fields.ConnectionHops[0])
 		if !found {
@@ -557,7 +616,7 @@ func (k Keeper) ChanUpgradeOpen(
 
 	if err := k.connectionKeeper.VerifyChannelState(
 		ctx,
-		connection,
+		proofConnection,
 		proofHeight, channelProof,
 		channel.Counterparty.PortId,
 		channel.Counterparty.ChannelId,
@@ -589,8 +648,9 @@ func (k Keeper) WriteUpgradeOpenChannel(ctx sdk.Context, portID, channelID strin
 	}
 
 	// next seq recv and ack is used for ordered channels to verify the packet has been received/acked in the correct order
-	// this is no longer necessary if the channel is UNORDERED and should be reset to 1
-	if channel.Ordering == types.ORDERED && upgrade.Fields.Ordering == types.UNORDERED {
+	// this is no longer necessary if the channel is UNORDERED and should be reset to 1.
+	// Both ORDERED and ORDERED_ALLOW_TIMEOUT channels track the next seq recv and ack, which are kept when moving between them.
+	if channel.Ordering != types.UNORDERED && upgrade.Fields.Ordering == types.UNORDERED {
 		k.SetNextSequenceRecv(ctx, portID, channelID, 1)
 		k.SetNextSequenceAck(ctx, portID, channelID, 1)
 	}
@@ -598,15 +658,19 @@ func (k Keeper) WriteUpgradeOpenChannel(ctx sdk.Context, portID, channelID strin
 	// next seq recv and ack should updated when moving from UNORDERED to ORDERED using the counterparty NextSequenceSend as set just after blocking new packet sends.
 	// we can be sure that the next packet we are set to receive will be the first packet the counterparty sends after reopening.
 	// we can be sure that our next acknowledgement will be our first packet sent after upgrade, as the counterparty processed all sent packets after flushing completes.
-	if channel.Ordering == types.UNORDERED && upgrade.Fields.Ordering == types.ORDERED {
+	if channel.Ordering == types.UNORDERED && upgrade.Fields.Ordering != types.UNORDERED {
 		k.SetNextSequenceRecv(ctx, portID, channelID, counterpartyUpgrade.NextSequenceSend)
 		k.SetNextSequenceAck(ctx, portID, channelID, upgrade.NextSequenceSend)
 	}
 
 	// Set the counterparty next sequence send as the recv start sequence.
 	// This will be the upper bound for pruning and it will allow for replay
-	// protection of historical packets.
-	k.setRecvStartSequence(ctx, portID, channelID, counterpartyUpgrade.NextSequenceSend)
+	// protection of historical packets. A greater recv start sequence set using
+	// MsgEnableAcknowledgementPruning is kept, since packet receipts below it may
+	// already have been pruned.
+	if recvStartSequence, found := k.GetRecvStartSequence(ctx, portID, channelID); !found || recvStartSequence < counterpartyUpgrade.NextSequenceSend {
+		k.SetRecvStartSequence(ctx, portID, channelID, counterpartyUpgrade.NextSequenceSend)
+	}
 
 	// First upgrade for this channel will set the pruning sequence to 1, the starting sequence for pruning.
 	// Subsequent upgrades will not modify the pruning sequence thereby allowing pruning to continue from the last
@@ -640,7 +704,7 @@ func (k Keeper) ChanUpgradeCancel(ctx sdk.Context, portID, channelID string, err
 		return errorsmod.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
 	}
 
-	_, found = k.GetUpgrade(ctx, portID, channelID)
+	upgrade, found := k.GetUpgrade(ctx, portID, channelID)
 	if !found {
 		return errorsmod.Wrapf(types.ErrUpgradeNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
 	}
@@ -678,9 +742,14 @@ func (k Keeper) ChanUpgradeCancel(ctx sdk.Context, portID, channelID string, err
 		)
 	}
 
+	proofConnection, err := k.getUpgradeProofConnection(ctx, portID, channelID, connection, upgrade.Fields.ConnectionHops)
+	if err != nil {
+		return err
+	}
+
 	if err := k.connectionKeeper.VerifyChannelUpgradeError(
 		ctx,
-		connection,
+		proofConnection,
 		proofHeight,
 		errorReceiptProof,
 		channel.Counterparty.PortId,
@@ -749,7 +818,12 @@ func (k Keeper) ChanUpgradeTimeout(
 		)
 	}
 
-	proofTimestamp, err := k.connectionKeeper.GetTimestampAtHeight(ctx, connection, proofHeight)
+	proofConnection, err := k.getUpgradeProofConnection(ctx, portID, channelID, connection, upgrade.Fields.ConnectionHops)
+	if err != nil {
+		return err
+	}
+
+	proofTimestamp, err := k.connectionKeeper.GetTimestampAtHeight(ctx, proofConnection, proofHeight)
 	if err != nil {
 		return err
 	}
@@ -789,7 +863,7 @@ func (k Keeper) ChanUpgradeTimeout(
 	// verify the counterparty channel state
 	if err := k.connectionKeeper.VerifyChannelState(
 		ctx,
-		connection,
+		proofConnection,
 		proofHeight, counterpartyChannelProof,
 		channel.Counterparty.PortId,
 		channel.Counterparty.ChannelId,
@@ -905,6 +979,7 @@ func (k Keeper) checkForUpgradeCompatibility(ctx sdk.Context, upgradeFields, cou
 // - the proposed connection hops do not exist
 // - the proposed version is non-empty (checked in UpgradeFields.ValidateBasic())
 // - the proposed connection hops are not open
+// - the proposed connection hops do not reach the same counterparty chain as the existing connection hops
 func (k Keeper) validateSelfUpgradeFields(ctx sdk.Context, proposedUpgrade types.UpgradeFields, currentChannel types.Channel) error {
 	currentFields := extractUpgradeFields(currentChannel)
 
@@ -925,6 +1000,17 @@ func (k Keeper) validateSelfUpgradeFields(ctx sdk.Context, proposedUpgrade types
 		)
 	}
 
+	if connectionID != currentChannel.ConnectionHops[0] {
+		currentConnection, found := k.connectionKeeper.GetConnection(ctx, currentChannel.ConnectionHops[0])
+		if !found {
+			return errorsmod.Wrapf(connectiontypes.ErrConnectionNotFound, "failed to retrieve connection: %s", currentChannel.ConnectionHops[0])
+		}
+
+		if err := k.validateUpgradeConnection(ctx, currentConnection, connection); err != nil {
+			return err
+		}
+	}
+
 	getVersions := connection.GetVersions()
 	if len(getVersions) != 1 {
 		return errorsmod.Wrapf(
@@ -945,6 +1031,131 @@ func (k Keeper) validateSelfUpgradeFields(ctx sdk.Context, proposedUpgrade types
 	return nil
 }
 
+// IsAllowedUpgradeSigner returns true if the given signer, other than the authority, may initiate upgrades of channels
+// bound to the given port according to the upgrade policy of the port.
+func (k Keeper) IsAllowedUpgradeSigner(ctx sdk.Context, portID, signer string) bool {
+	policy, found := k.GetParams(ctx).GetUpgradePolicy(portID)
+	return found && policy.IsAllowedSigner(signer)
+}
+
+// validateUpgradePolicy returns an error if upgrading the given channel to the proposed upgrade fields is not permitted
+// by the upgrade policy of the port the channel is bound to. Channels bound to ports without a policy are not restricted.
+func (k Keeper) validateUpgradePolicy(ctx sdk.Context, portID string, channel types.Channel, upgradeFields types.UpgradeFields) error {
+	policy, found := k.GetParams(ctx).GetUpgradePolicy(portID)
+	if !found {
+		return nil
+	}
+
+	return policy.ValidateUpgradeFields(channel, upgradeFields)
+}
+
+// validateCounterpartyUpgradePolicy returns an error if an upgrade of the given channel initiated by the counterparty
+// is not permitted by the upgrade policy of the port the channel is bound to.
+func (k Keeper) validateCounterpartyUpgradePolicy(ctx sdk.Context, portID string, channel types.Channel, upgradeFields types.UpgradeFields) error {
+	policy, found := k.GetParams(ctx).GetUpgradePolicy(portID)
+	if !found {
+		return nil
+	}
+
+	if !policy.AllowCounterpartyInitiated {
+		return errorsmod.Wrapf(types.ErrUpgradeNotPermitted, "upgrades of channels bound to port %s cannot be initiated by the counterparty", portID)
+	}
+
+	return policy.ValidateUpgradeFields(channel, upgradeFields)
+}
+
+// validateUpgradeConnection validates that the connection proposed in a channel upgrade reaches the same counterparty
+// chain as the current connection of the channel. Both connections must use the same counterparty commitment prefix
+// and their clients must be of the same type and track the same counterparty chain identifier. Connections whose
+// clients do not track the chain identifier of the counterparty chain cannot be validated and are therefore rejected.
+// The chain identifier alone does not establish that both clients track the same chain, the new connection is
+// therefore only used to verify proofs once the consensus states of both clients are validated to match, see
+// getUpgradeProofConnection.
+func (k Keeper) validateUpgradeConnection(ctx sdk.Context, connection, upgradeConnection connectiontypes.ConnectionEnd) error {
+	if !connection.GetCounterparty().GetPrefix().Empty() && !bytes.Equal(connection.GetCounterparty().GetPrefix().Bytes(), upgradeConnection.GetCounterparty().GetPrefix().Bytes()) {
+		return errorsmod.Wrapf(
+			types.ErrInvalidUpgradeConnection, "proposed connection counterparty prefix (%s) does not match current connection counterparty prefix (%s)",
+			upgradeConnection.GetCounterparty().GetPrefix().Bytes(), connection.GetCounterparty().GetPrefix().Bytes(),
+		)
+	}
+
+	clientState, err := k.getConnectionChainIDClientState(ctx, connection)
+	if err != nil {
+		return err
+	}
+
+	upgradeClientState, err := k.getConnectionChainIDClientState(ctx, upgradeConnection)
+	if err != nil {
+		return err
+	}
+
+	if clientState.ClientType() != upgradeClientState.ClientType() {
+		return errorsmod.Wrapf(types.ErrInvalidUpgradeConnection, "proposed connection client type (%s) does not match current connection client type (%s)", upgradeClientState.ClientType(), clientState.ClientType())
+	}
+
+	if clientState.GetChainID() != upgradeClientState.GetChainID() {
+		return errorsmod.Wrapf(types.ErrInvalidUpgradeConnection, "proposed connection counterparty chain ID (%s) does not match current connection counterparty chain ID (%s)", upgradeClientState.GetChainID(), clientState.GetChainID())
+	}
+
+	return nil
+}
+
+// getConnectionChainIDClientState returns the client state of the given connection, which must track the chain identifier of the counterparty chain.
+func (k Keeper) getConnectionChainIDClientState(ctx sdk.Context, connection connectiontypes.ConnectionEnd) (chainIDClientState, error) {
+	clientState, found := k.clientKeeper.GetClientState(ctx, connection.ClientId)
+	if !found {
+		return nil, errorsmod.Wrapf(clienttypes.ErrClientNotFound, "client-id: %s", connection.ClientId)
+	}
+
+	chainIDClientState, ok := clientState.(chainIDClientState)
+	if !ok {
+		return nil, errorsmod.Wrapf(types.ErrInvalidUpgradeConnection, "client (%s) does not track the counterparty chain ID", connection.ClientId)
+	}
+
+	return chainIDClientState, nil
+}
+
+// getUpgradeProofConnection returns the connection used to verify proofs of the counterparty channel end during the upgrade handshake.
+// Proofs are verified via the current connection of the channel, unless its client is no longer active and the upgrade migrates the
+// channel to a new connection. The new connection is only used if the upgrade was initiated by this chain, since the connection hops
+// of an upgrade accepted in ChanUpgradeTry are chosen by the relayer. The client of the new connection must be active and must track
+// the same counterparty chain as the client of the current connection, but it is not required to share any consensus state with the
+// latter, as the new connection was authorised by the initiator of the upgrade.
+func (k Keeper) getUpgradeProofConnection(ctx sdk.Context, portID, channelID string, connection connectiontypes.ConnectionEnd, upgradeConnectionHops []string) (connectiontypes.ConnectionEnd, error) {
+	if len(upgradeConnectionHops) == 0 || k.isClientActive(ctx, connection.ClientId) || !k.isSelfInitiatedUpgrade(ctx, portID, channelID) {
+		return connection, nil
+	}
+
+	upgradeConnection, found := k.connectionKeeper.GetConnection(ctx, upgradeConnectionHops[0])
+	if !found {
+		return connectiontypes.ConnectionEnd{}, errorsmod.Wrap(connectiontypes.ErrConnectionNotFound, upgradeConnectionHops[0])
+	}
+
+	if upgradeConnection.GetState() != int32(connectiontypes.OPEN) {
+		return connectiontypes.ConnectionEnd{}, errorsmod.Wrapf(connectiontypes.ErrInvalidConnectionState, "connection state is not OPEN (got %s)", connectiontypes.State(upgradeConnection.GetState()).String())
+	}
+
+	if err := k.validateUpgradeConnection(ctx, connection, upgradeConnection); err != nil {
+		return connectiontypes.ConnectionEnd{}, err
+	}
+
+	if !k.isClientActive(ctx, upgradeConnection.ClientId) {
+		return connectiontypes.ConnectionEnd{}, errorsmod.Wrapf(clienttypes.ErrClientNotActive, "upgrade connection client (%s) is not active", upgradeConnection.ClientId)
+	}
+
+	return upgradeConnection, nil
+}
+
+// isClientActive returns true if the client with the given identifier exists and its status is Active.
+func (k Keeper) isClientActive(ctx sdk.Context, clientID string) bool {
+	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
+	if !found {
+		return false
+	}
+
+	return k.clientKeeper.GetClientStatus(ctx, clientState, clientID) == exported.Active
+}
+
 // extractUpgradeFields returns the upgrade fields from the provided channel.
 func extractUpgradeFields(channel types.Channel) types.UpgradeFields {
 	return types.UpgradeFields{
@@ -0,0 +1,169 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// chainIDClientState is implemented by client states which track the chain identifier of the counterparty chain.
+type chainIDClientState interface {
+	exported.ClientState
+	GetChainID() string
+}
+
+// GetUpgradeBatchChannels returns the open channels matching the given upgrade batch filter. Channels whose client
+// does not track the chain identifier of the counterparty chain never match a filter on the counterparty chain ID.
+func (k Keeper) GetUpgradeBatchChannels(ctx sdk.Context, filter types.UpgradeBatchFilter) []types.IdentifiedChannel {
+	var channels []types.IdentifiedChannel
+	k.IterateChannels(ctx, func(channel types.IdentifiedChannel) bool {
+		if k.matchesUpgradeBatchFilter(ctx, channel, filter) {
+			channels = append(channels, channel)
+		}
+		return false
+	})
+
+	return channels
+}
+
+// matchesUpgradeBatchFilter returns true if the channel is open and matches every criteria set in the filter.
+func (k Keeper) matchesUpgradeBatchFilter(ctx sdk.Context, channel types.IdentifiedChannel, filter types.UpgradeBatchFilter) bool {
+	if channel.State != types.OPEN {
+		return false
+	}
+
+	if filter.PortId != "" && channel.PortId != filter.PortId {
+		return false
+	}
+
+	if filter.ConnectionId != "" && channel.ConnectionHops[0] != filter.ConnectionId {
+		return false
+	}
+
+	if filter.CounterpartyChainId != "" {
+		_, clientState, err := k.GetChannelClientState(ctx, channel.PortId, channel.ChannelId)
+		if err != nil {
+			return false
+		}
+
+		chainIDClientState, ok := clientState.(chainIDClientState)
+		if !ok || chainIDClientState.GetChainID() != filter.CounterpartyChainId {
+			return false
+		}
+	}
+
+	return true
+}
+
+// SetUpgradeBatch stores the given upgrade batch. A new identifier is assigned to the batch if it has none.
+// Batches whose failed upgrades may still be retried are marked as active. The stored batch is returned.
+func (k Keeper) SetUpgradeBatch(ctx sdk.Context, batch types.UpgradeBatch) types.UpgradeBatch {
+	store := ctx.KVStore(k.storeKey)
+
+	if batch.Id == 0 {
+		batch.Id = k.getNextUpgradeBatchSequence(ctx)
+		store.Set([]byte(types.KeyNextUpgradeBatchSequence), sdk.Uint64ToBigEndian(batch.Id+1))
+	}
+
+	store.Set(types.UpgradeBatchKey(batch.Id), k.cdc.MustMarshal(&batch))
+
+	if _, done := k.GetUpgradeBatchStatus(ctx, batch); done || batch.RetryPolicy.MaxRetries == 0 {
+		store.Delete(types.ActiveUpgradeBatchKey(batch.Id))
+	} else {
+		store.Set(types.ActiveUpgradeBatchKey(batch.Id), []byte{byte(1)})
+	}
+
+	return batch
+}
+
+// getNextUpgradeBatchSequence returns the identifier assigned to the next upgrade batch. Identifiers start at 1.
+func (k Keeper) getNextUpgradeBatchSequence(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get([]byte(types.KeyNextUpgradeBatchSequence))
+	if len(bz) == 0 {
+		return 1
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// GetUpgradeBatch returns the upgrade batch with the given identifier.
+func (k Keeper) GetUpgradeBatch(ctx sdk.Context, batchID uint64) (types.UpgradeBatch, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.UpgradeBatchKey(batchID))
+	if len(bz) == 0 {
+		return types.UpgradeBatch{}, false
+	}
+
+	var batch types.UpgradeBatch
+	k.cdc.MustUnmarshal(bz, &batch)
+
+	return batch, true
+}
+
+// IterateActiveUpgradeBatches iterates over the upgrade batches whose failed upgrades may still be retried,
+// in ascending order of their identifiers. Iteration stops when the callback returns true.
+func (k Keeper) IterateActiveUpgradeBatches(ctx sdk.Context, cb func(batch types.UpgradeBatch) (stop bool)) {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ActiveUpgradeBatchPrefix())
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	for ; iterator.Valid(); iterator.Next() {
+		batchID := sdk.BigEndianToUint64(iterator.Key()[len(types.ActiveUpgradeBatchPrefix()):])
+
+		batch, found := k.GetUpgradeBatch(ctx, batchID)
+		if !found {
+			continue
+		}
+
+		if cb(batch) {
+			break
+		}
+	}
+}
+
+// GetUpgradeBatchStatus returns the current status of the upgrade of each channel of the given upgrade batch
+// and whether the upgrade of every channel has completed or failed without remaining retries.
+func (k Keeper) GetUpgradeBatchStatus(ctx sdk.Context, batch types.UpgradeBatch) ([]types.UpgradeBatchChannelState, bool) {
+	done := true
+	states := make([]types.UpgradeBatchChannelState, len(batch.Channels))
+	for i, channel := range batch.Channels {
+		states[i] = k.getUpgradeBatchChannelState(ctx, channel)
+		done = done && states[i].IsTerminal(batch.RetryPolicy)
+	}
+
+	return states, done
+}
+
+// getUpgradeBatchChannelState returns the current status of the upgrade of a channel of an upgrade batch.
+// The upgrade has failed if it could not be initialized, if an error receipt was written for the upgrade
+// sequence of its latest attempt, or a later sequence, or if the channel has been closed. Otherwise, the
+// upgrade is in progress until the channel is open again without a pending upgrade.
+func (k Keeper) getUpgradeBatchChannelState(ctx sdk.Context, batchChannel types.UpgradeBatchChannel) types.UpgradeBatchChannelState {
+	state := types.UpgradeBatchChannelState{Channel: batchChannel}
+
+	if batchChannel.InitError != "" {
+		state.Status = types.UPGRADE_STATUS_FAILED
+		return state
+	}
+
+	if errorReceipt, found := k.GetUpgradeErrorReceipt(ctx, batchChannel.PortId, batchChannel.ChannelId); found && errorReceipt.Sequence >= batchChannel.UpgradeSequence {
+		state.Status = types.UPGRADE_STATUS_FAILED
+		state.ErrorReceipt = &errorReceipt
+		return state
+	}
+
+	channel, found := k.GetChannel(ctx, batchChannel.PortId, batchChannel.ChannelId)
+	switch {
+	case !found || channel.State == types.CLOSED:
+		state.Status = types.UPGRADE_STATUS_FAILED
+	case channel.State != types.OPEN || k.hasUpgrade(ctx, batchChannel.PortId, batchChannel.ChannelId):
+		state.Status = types.UPGRADE_STATUS_IN_PROGRESS
+	default:
+		state.Status = types.UPGRADE_STATUS_COMPLETED
+	}
+
+	return state
+}
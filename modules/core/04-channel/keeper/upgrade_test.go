@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math"
 	"testing"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -13,6 +14,7 @@ import (
 	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 	"github.com/cosmos/ibc-go/v8/testing/mock"
 )
@@ -85,6 +87,28 @@ func (suite *KeeperTestSuite) TestChanUpgradeInit() {
 			},
 			false,
 		},
+		{
+			"success: upgrade policy permits the version transition",
+			func() {
+				suite.setUpgradePolicy(suite.chainA, types.NewUpgradePolicy(ibctesting.MockPort, nil, false, []types.VersionTransition{types.NewVersionTransition(mock.Version, mock.UpgradeVersion)}, false))
+			},
+			true,
+		},
+		{
+			"upgrade policy does not permit the version transition",
+			func() {
+				suite.setUpgradePolicy(suite.chainA, types.NewUpgradePolicy(ibctesting.MockPort, nil, true, []types.VersionTransition{types.NewVersionTransition(mock.Version, "mock-version-v3")}, true))
+			},
+			false,
+		},
+		{
+			"upgrade policy does not permit the ordering change",
+			func() {
+				suite.setUpgradePolicy(suite.chainA, types.NewUpgradePolicy(ibctesting.MockPort, nil, true, nil, false))
+				upgradeFields.Ordering = types.ORDERED
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -146,6 +170,37 @@ func (suite *KeeperTestSuite) TestChanUpgradeTry() {
 			},
 			nil,
 		},
+		{
+			"success: upgrade policy permits counterparty initiated upgrades",
+			func() {
+				suite.setUpgradePolicy(suite.chainB, types.NewUpgradePolicy(ibctesting.MockPort, nil, true, nil, false))
+			},
+			nil,
+		},
+		{
+			"success: upgrade policy does not apply to crossing hellos",
+			func() {
+				err := path.EndpointB.ChanUpgradeInit()
+				suite.Require().NoError(err)
+
+				suite.setUpgradePolicy(suite.chainB, types.NewUpgradePolicy(ibctesting.MockPort, nil, false, nil, false))
+			},
+			nil,
+		},
+		{
+			"upgrade policy does not permit counterparty initiated upgrades",
+			func() {
+				suite.setUpgradePolicy(suite.chainB, types.NewUpgradePolicy(ibctesting.MockPort, nil, false, nil, true))
+			},
+			types.NewUpgradeError(1, types.ErrUpgradeNotPermitted),
+		},
+		{
+			"upgrade policy does not permit the version transition proposed by the counterparty",
+			func() {
+				suite.setUpgradePolicy(suite.chainB, types.NewUpgradePolicy(ibctesting.MockPort, nil, true, []types.VersionTransition{types.NewVersionTransition(mock.Version, "mock-version-v3")}, true))
+			},
+			types.NewUpgradeError(1, types.ErrUpgradeNotPermitted),
+		},
 		{
 			"success: upgrade sequence is fast forwarded to counterparty upgrade sequence",
 			func() {
@@ -735,6 +790,13 @@ func (suite *KeeperTestSuite) TestChanUpgradeAck() {
 			},
 			types.NewUpgradeError(1, types.ErrIncompatibleCounterpartyUpgrade),
 		},
+		{
+			"fails when upgrade policy does not permit the version selected by the counterparty",
+			func() {
+				suite.setUpgradePolicy(suite.chainA, types.NewUpgradePolicy(ibctesting.MockPort, nil, true, []types.VersionTransition{types.NewVersionTransition(mock.Version, "mock-version-v3")}, true))
+			},
+			types.NewUpgradeError(1, types.ErrUpgradeNotPermitted),
+		},
 		{
 			"fails due to proof verification failure, counterparty channel ordering does not match expected ordering",
 			func() {
@@ -1625,6 +1687,47 @@ func (suite *KeeperTestSuite) TestWriteUpgradeOpenChannel_Ordering() {
 				suite.Require().Equal(uint64(2), counterpartySequenceSend)
 			},
 		},
+		{
+			name: "success: ORDERED -> ORDERED_ALLOW_TIMEOUT",
+			malleate: func() {
+				path.EndpointA.ChannelConfig.Order = types.ORDERED
+				path.EndpointB.ChannelConfig.Order = types.ORDERED
+
+				path.EndpointA.ChannelConfig.ProposedUpgrade.Fields.Ordering = types.ORDERED_ALLOW_TIMEOUT
+				path.EndpointB.ChannelConfig.ProposedUpgrade.Fields.Ordering = types.ORDERED_ALLOW_TIMEOUT
+			},
+			preUpgrade: func() {
+				ctx := suite.chainA.GetContext()
+
+				// assert that NextSeqAck is incremented to 2 because channel is ORDERED
+				seq, found := suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.GetNextSequenceAck(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(uint64(2), seq)
+
+				// assert that NextSeqRecv is incremented to 2 because channel is ORDERED
+				seq, found = suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.GetNextSequenceRecv(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(uint64(2), seq)
+			},
+			postUpgrade: func() {
+				channel := path.EndpointA.GetChannel()
+				ctx := suite.chainA.GetContext()
+
+				// Assert that channel state has been updated
+				suite.Require().Equal(types.OPEN, channel.State)
+				suite.Require().Equal(types.ORDERED_ALLOW_TIMEOUT, channel.Ordering)
+
+				// assert that NextSeqRecv is kept, because channel is still ordered
+				seq, found := suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.GetNextSequenceRecv(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(uint64(2), seq)
+
+				// assert that NextSeqAck is kept, because channel is still ordered
+				seq, found = suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.GetNextSequenceAck(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(uint64(2), seq)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -2411,6 +2514,33 @@ func (suite *KeeperTestSuite) TestValidateUpgradeFields() {
 			malleate: func() {},
 			expPass:  false,
 		},
+		{
+			name: "fails when proposed connection reaches a different counterparty chain",
+			malleate: func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.Setup()
+				proposedUpgrade.ConnectionHops = []string{path.EndpointA.ConnectionID}
+
+				clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+				suite.Require().True(ok)
+				clientState.ChainId = "otherchain-1"
+				path.EndpointA.SetClientState(clientState)
+			},
+			expPass: false,
+		},
+		{
+			name: "fails when proposed connection uses a different counterparty commitment prefix",
+			malleate: func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.Setup()
+				proposedUpgrade.ConnectionHops = []string{path.EndpointA.ConnectionID}
+
+				connection := path.EndpointA.GetConnection()
+				connection.Counterparty.Prefix = commitmenttypes.NewMerklePrefix([]byte("other-prefix"))
+				path.EndpointA.SetConnection(connection)
+			},
+			expPass: false,
+		},
 		{
 			name: "fails when connection is not set",
 			malleate: func() {
@@ -2483,6 +2613,13 @@ func (suite *KeeperTestSuite) TestValidateUpgradeFields() {
 	}
 }
 
+// setUpgradePolicy sets the given upgrade policy in the channel params of the given chain.
+func (suite *KeeperTestSuite) setUpgradePolicy(chain *ibctesting.TestChain, policy types.UpgradePolicy) {
+	params := chain.GetSimApp().IBCKeeper.ChannelKeeper.GetParams(chain.GetContext())
+	params.UpgradePolicies = []types.UpgradePolicy{policy}
+	chain.GetSimApp().IBCKeeper.ChannelKeeper.SetParams(chain.GetContext(), params)
+}
+
 func (suite *KeeperTestSuite) assertUpgradeError(actualError, expError error) {
 	suite.Require().Error(actualError)
 
@@ -2778,6 +2915,143 @@ func (suite *KeeperTestSuite) TestChanUpgradeCrossingHelloWithHistoricalProofs()
 	}
 }
 
+// TestChanUpgrade_MigrateConnectionHops tests that a channel can be migrated to a new connection between the same chains,
+// including when the client of the existing connection has expired.
+func (suite *KeeperTestSuite) TestChanUpgrade_MigrateConnectionHops() {
+	testCases := []struct {
+		name           string
+		expireClient   bool
+		expireAfterTry bool
+		crossingHello  bool
+		expError       error
+	}{
+		{
+			"success: client of existing connection is active",
+			false,
+			false,
+			false,
+			nil,
+		},
+		{
+			"success: client of existing connection has expired",
+			true,
+			false,
+			true,
+			nil,
+		},
+		{
+			"failure: client of existing connection has expired and connection hops are proposed by the relayer",
+			true,
+			false,
+			false,
+			clienttypes.ErrClientNotActive,
+		},
+		{
+			"failure: client of existing connection expires after the connection hops are proposed by the relayer",
+			false,
+			true,
+			false,
+			clienttypes.ErrClientNotActive,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			upgradePath := ibctesting.NewPath(suite.chainA, suite.chainB)
+
+			// the clients of the new connection must remain active once the clients of the existing connection
+			// expire, including during the voting periods of the upgrade proposals
+			setTrustingPeriod := func(endpoint *ibctesting.Endpoint, trustingPeriod time.Duration) {
+				tmConfig, ok := endpoint.ClientConfig.(*ibctesting.TendermintConfig)
+				suite.Require().True(ok)
+				tmConfig.TrustingPeriod = trustingPeriod
+			}
+
+			setTrustingPeriod(path.EndpointA, 5*24*time.Hour)
+			setTrustingPeriod(path.EndpointB, 5*24*time.Hour)
+			setTrustingPeriod(upgradePath.EndpointA, ibctesting.UnbondingPeriod-time.Hour)
+			setTrustingPeriod(upgradePath.EndpointB, ibctesting.UnbondingPeriod-time.Hour)
+
+			path.Setup()
+			upgradePath.SetupConnections()
+
+			// the upgrade must not time out while the clients of the existing connection expire
+			params := suite.chainB.GetSimApp().IBCKeeper.ChannelKeeper.GetParams(suite.chainB.GetContext())
+			params.UpgradeTimeout = types.NewTimeout(clienttypes.ZeroHeight(), uint64((ibctesting.UnbondingPeriod).Nanoseconds()))
+			suite.chainB.GetSimApp().IBCKeeper.ChannelKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			path.EndpointA.ChannelConfig.ProposedUpgrade.Fields.ConnectionHops = []string{upgradePath.EndpointA.ConnectionID}
+			path.EndpointB.ChannelConfig.ProposedUpgrade.Fields.ConnectionHops = []string{upgradePath.EndpointB.ConnectionID}
+			path.EndpointA.ChannelConfig.ProposedUpgrade.Fields.Version = mock.UpgradeVersion
+			path.EndpointB.ChannelConfig.ProposedUpgrade.Fields.Version = mock.UpgradeVersion
+
+			// the clients of the existing connection expire, the clients of the new connection are updated independently
+			expireClients := func() {
+				clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+				suite.Require().True(ok)
+				suite.coordinator.IncrementTimeBy(clientState.TrustingPeriod)
+
+				path.EndpointA.ClientID, path.EndpointA.ConnectionID = upgradePath.EndpointA.ClientID, upgradePath.EndpointA.ConnectionID
+				path.EndpointB.ClientID, path.EndpointB.ConnectionID = upgradePath.EndpointB.ClientID, upgradePath.EndpointB.ConnectionID
+			}
+
+			if tc.expireClient {
+				expireClients()
+			}
+
+			suite.Require().NoError(path.EndpointA.ChanUpgradeInit())
+			if tc.crossingHello {
+				suite.Require().NoError(path.EndpointB.ChanUpgradeInit())
+			}
+
+			err := path.EndpointB.ChanUpgradeTry()
+			if tc.expError != nil && !tc.expireAfterTry {
+				// errors returned by message execution are not preserved in the error chain
+				suite.Require().ErrorContains(err, tc.expError.Error())
+				return
+			}
+			suite.Require().NoError(err)
+
+			if tc.expireAfterTry {
+				expireClients()
+			}
+
+			suite.Require().NoError(path.EndpointA.ChanUpgradeAck())
+
+			err = path.EndpointB.ChanUpgradeConfirm()
+			if tc.expError != nil {
+				// the upgrade on chainB was written in ChanUpgradeTry, its connection hops are not trusted for proof verification
+				suite.Require().ErrorContains(err, tc.expError.Error())
+				return
+			}
+			suite.Require().NoError(err)
+
+			suite.Require().NoError(path.EndpointA.ChanUpgradeOpen())
+
+			suite.Require().Equal(types.OPEN, path.EndpointA.GetChannel().State)
+			suite.Require().Equal(types.OPEN, path.EndpointB.GetChannel().State)
+			suite.Require().Equal([]string{upgradePath.EndpointA.ConnectionID}, path.EndpointA.GetChannel().ConnectionHops)
+			suite.Require().Equal([]string{upgradePath.EndpointB.ConnectionID}, path.EndpointB.GetChannel().ConnectionHops)
+
+			if !tc.expireClient {
+				path.EndpointA.ClientID, path.EndpointA.ConnectionID = upgradePath.EndpointA.ClientID, upgradePath.EndpointA.ConnectionID
+				path.EndpointB.ClientID, path.EndpointB.ConnectionID = upgradePath.EndpointB.ClientID, upgradePath.EndpointB.ConnectionID
+			}
+
+			// packets sent after the upgrade are verified via the new connection
+			sequence, err := path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+
+			packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+			suite.Require().NoError(path.RelayPacket(packet))
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestWriteErrorReceipt() {
 	var path *ibctesting.Path
 	var upgradeError *types.UpgradeError
@@ -8,7 +8,11 @@ import (
 	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 )
 
-// GenChannelGenesis returns the default channel genesis state.
-func GenChannelGenesis(_ *rand.Rand, _ []simtypes.Account) types.GenesisState {
-	return types.DefaultGenesisState()
+// GenChannelGenesis returns the default channel genesis state with automatic relaying of packets
+// sent over the 09-localhost connection randomly enabled.
+func GenChannelGenesis(r *rand.Rand, _ []simtypes.Account) types.GenesisState {
+	genesis := types.DefaultGenesisState()
+	genesis.Params.LocalhostAutoRelay.Enabled = r.Intn(2) == 0
+
+	return genesis
 }
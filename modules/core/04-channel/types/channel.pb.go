@@ -75,7 +75,7 @@ func (State) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_c3a07336710636a0, []int{0}
 }
 
-// Order defines if a channel is ORDERED or UNORDERED
+// Order defines if a channel is ORDERED, ORDERED_ALLOW_TIMEOUT or UNORDERED
 type Order int32
 
 const (
@@ -86,18 +86,23 @@ const (
 	UNORDERED Order = 1
 	// packets are delivered exactly in the order which they were sent
 	ORDERED Order = 2
+	// packets are delivered exactly in the order which they were sent, packets which
+	// time out are skipped by the receiver and do not close the channel
+	ORDERED_ALLOW_TIMEOUT Order = 3
 )
 
 var Order_name = map[int32]string{
 	0: "ORDER_NONE_UNSPECIFIED",
 	1: "ORDER_UNORDERED",
 	2: "ORDER_ORDERED",
+	3: "ORDER_ORDERED_ALLOW_TIMEOUT",
 }
 
 var Order_value = map[string]int32{
-	"ORDER_NONE_UNSPECIFIED": 0,
-	"ORDER_UNORDERED":        1,
-	"ORDER_ORDERED":          2,
+	"ORDER_NONE_UNSPECIFIED":      0,
+	"ORDER_UNORDERED":             1,
+	"ORDER_ORDERED":               2,
+	"ORDER_ORDERED_ALLOW_TIMEOUT": 3,
 }
 
 func (x Order) String() string {
@@ -108,6 +113,42 @@ func (Order) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_c3a07336710636a0, []int{1}
 }
 
+// PacketStatus defines the lifecycle status of a packet sent from this chain.
+type PacketStatus int32
+
+const (
+	// zero-value for the packet status
+	UNKNOWN PacketStatus = 0
+	// the packet has been sent and is awaiting its acknowledgement or timeout
+	PENDING PacketStatus = 1
+	// the acknowledgement of the packet has been processed
+	ACKNOWLEDGED PacketStatus = 2
+	// the packet has been timed out
+	TIMEDOUT PacketStatus = 3
+)
+
+var PacketStatus_name = map[int32]string{
+	0: "PACKET_STATUS_UNKNOWN_UNSPECIFIED",
+	1: "PACKET_STATUS_PENDING",
+	2: "PACKET_STATUS_ACKNOWLEDGED",
+	3: "PACKET_STATUS_TIMED_OUT",
+}
+
+var PacketStatus_value = map[string]int32{
+	"PACKET_STATUS_UNKNOWN_UNSPECIFIED": 0,
+	"PACKET_STATUS_PENDING":             1,
+	"PACKET_STATUS_ACKNOWLEDGED":        2,
+	"PACKET_STATUS_TIMED_OUT":           3,
+}
+
+func (x PacketStatus) String() string {
+	return proto.EnumName(PacketStatus_name, int32(x))
+}
+
+func (PacketStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c3a07336710636a0, []int{2}
+}
+
 // Channel defines pipeline for exactly-once packet delivery between specific
 // modules on separate blockchains, which has at least one end capable of
 // sending packets and one end capable of receiving packets.
@@ -561,6 +602,20 @@ func (m *Timeout) GetTimestamp() uint64 {
 type Params struct {
 	// the relative timeout after which channel upgrades will time out.
 	UpgradeTimeout Timeout `protobuf:"bytes,1,opt,name=upgrade_timeout,json=upgradeTimeout,proto3" json:"upgrade_timeout"`
+	// the configuration for relaying packets over the 09-localhost connection without an off-chain relayer.
+	LocalhostAutoRelay LocalhostAutoRelay `protobuf:"bytes,2,opt,name=localhost_auto_relay,json=localhostAutoRelay,proto3" json:"localhost_auto_relay"`
+	// enables the packet lifecycle index which applications may fill when sending packets.
+	PacketIndexEnabled bool `protobuf:"varint,3,opt,name=packet_index_enabled,json=packetIndexEnabled,proto3" json:"packet_index_enabled,omitempty"`
+	// the permission policies restricting the upgrades of channels bound to specific ports.
+	UpgradePolicies []UpgradePolicy `protobuf:"bytes,5,rep,name=upgrade_policies,json=upgradePolicies,proto3" json:"upgrade_policies"`
+	// the configuration for pruning stale packet acknowledgements and receipts without MsgPruneAcknowledgements.
+	AutoPruning AutoPruning `protobuf:"bytes,6,opt,name=auto_pruning,json=autoPruning,proto3" json:"auto_pruning"`
+	// records the block height and time at which packets are sent, such that the latency from send to
+	// acknowledgement is reported by the ibc metrics.
+	PacketSendTimeEnabled bool `protobuf:"varint,7,opt,name=packet_send_time_enabled,json=packetSendTimeEnabled,proto3" json:"packet_send_time_enabled,omitempty"`
+	// the number of blocks for which acknowledged and timed out packets remain in the packet lifecycle index
+	// before being pruned. Completed packets are removed from the index immediately if zero.
+	PacketIndexRetentionBlocks uint64 `protobuf:"varint,8,opt,name=packet_index_retention_blocks,json=packetIndexRetentionBlocks,proto3" json:"packet_index_retention_blocks,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -603,538 +658,645 @@ func (m *Params) GetUpgradeTimeout() Timeout {
 	return Timeout{}
 }
 
-func init() {
-	proto.RegisterEnum("ibc.core.channel.v1.State", State_name, State_value)
-	proto.RegisterEnum("ibc.core.channel.v1.Order", Order_name, Order_value)
-	proto.RegisterType((*Channel)(nil), "ibc.core.channel.v1.Channel")
-	proto.RegisterType((*IdentifiedChannel)(nil), "ibc.core.channel.v1.IdentifiedChannel")
-	proto.RegisterType((*Counterparty)(nil), "ibc.core.channel.v1.Counterparty")
-	proto.RegisterType((*Packet)(nil), "ibc.core.channel.v1.Packet")
-	proto.RegisterType((*PacketState)(nil), "ibc.core.channel.v1.PacketState")
-	proto.RegisterType((*PacketId)(nil), "ibc.core.channel.v1.PacketId")
-	proto.RegisterType((*Acknowledgement)(nil), "ibc.core.channel.v1.Acknowledgement")
-	proto.RegisterType((*Timeout)(nil), "ibc.core.channel.v1.Timeout")
-	proto.RegisterType((*Params)(nil), "ibc.core.channel.v1.Params")
+func (m *Params) GetLocalhostAutoRelay() LocalhostAutoRelay {
+	if m != nil {
+		return m.LocalhostAutoRelay
+	}
+	return LocalhostAutoRelay{}
 }
 
-func init() { proto.RegisterFile("ibc/core/channel/v1/channel.proto", fileDescriptor_c3a07336710636a0) }
-
-var fileDescriptor_c3a07336710636a0 = []byte{
-	// 937 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x4f, 0x6f, 0xe2, 0xc6,
-	0x1b, 0xc6, 0xc4, 0xfc, 0x7b, 0x93, 0x80, 0x33, 0xf9, 0xfd, 0x52, 0xcb, 0x4a, 0xc1, 0x8b, 0x5a,
-	0x95, 0x4d, 0xb5, 0xb0, 0xd9, 0x56, 0xd5, 0xb6, 0xb7, 0x04, 0xbc, 0x8b, 0xb5, 0x14, 0x90, 0x81,
-	0x43, 0xf7, 0x82, 0x8c, 0x3d, 0x05, 0x6b, 0xc1, 0x43, 0xed, 0x81, 0xd5, 0xaa, 0xe7, 0x4a, 0x2b,
-	0x4e, 0xfd, 0x02, 0x48, 0x95, 0xfa, 0x15, 0xfa, 0x21, 0xf6, 0xb8, 0xc7, 0x3d, 0x55, 0x55, 0xf2,
-	0x1d, 0x7a, 0xae, 0x3c, 0x33, 0x0e, 0x10, 0x45, 0x51, 0x55, 0xa9, 0xb7, 0x9e, 0x98, 0xf7, 0x79,
-	0x9f, 0xf7, 0x7d, 0xde, 0x3f, 0xc3, 0xc8, 0xf0, 0xc0, 0x1b, 0x39, 0x35, 0x87, 0x04, 0xb8, 0xe6,
-	0x4c, 0x6c, 0xdf, 0xc7, 0xd3, 0xda, 0xf2, 0x3c, 0x3e, 0x56, 0xe7, 0x01, 0xa1, 0x04, 0x1d, 0x7b,
-	0x23, 0xa7, 0x1a, 0x51, 0xaa, 0x31, 0xbe, 0x3c, 0xd7, 0xfe, 0x37, 0x26, 0x63, 0xc2, 0xfc, 0xb5,
-	0xe8, 0xc4, 0xa9, 0x5a, 0x69, 0x93, 0x6d, 0xea, 0x61, 0x9f, 0xb2, 0x64, 0xec, 0xc4, 0x09, 0xe5,
-	0xdf, 0x92, 0x90, 0xa9, 0xf3, 0x2c, 0xe8, 0x31, 0xa4, 0x42, 0x6a, 0x53, 0xac, 0x4a, 0xba, 0x54,
-	0xc9, 0x3f, 0xd1, 0xaa, 0x77, 0xe8, 0x54, 0x7b, 0x11, 0xc3, 0xe2, 0x44, 0xf4, 0x15, 0x64, 0x49,
-	0xe0, 0xe2, 0xc0, 0xf3, 0xc7, 0x6a, 0xf2, 0x9e, 0xa0, 0x4e, 0x44, 0xb2, 0x6e, 0xb8, 0xe8, 0x05,
-	0x1c, 0x38, 0x64, 0xe1, 0x53, 0x1c, 0xcc, 0xed, 0x80, 0xbe, 0x51, 0xf7, 0x74, 0xa9, 0xb2, 0xff,
-	0xe4, 0xc1, 0x9d, 0xb1, 0xf5, 0x2d, 0xe2, 0xa5, 0xfc, 0xee, 0xf7, 0x52, 0xc2, 0xda, 0x09, 0x46,
-	0x9f, 0x41, 0xc1, 0x21, 0xbe, 0x8f, 0x1d, 0xea, 0x11, 0x7f, 0x38, 0x21, 0xf3, 0x50, 0x95, 0xf5,
-	0xbd, 0x4a, 0xce, 0xca, 0x6f, 0xe0, 0x26, 0x99, 0x87, 0x48, 0x85, 0xcc, 0x12, 0x07, 0xa1, 0x47,
-	0x7c, 0x35, 0xa5, 0x4b, 0x95, 0x9c, 0x15, 0x9b, 0xe8, 0x21, 0x28, 0x8b, 0xf9, 0x38, 0xb0, 0x5d,
-	0x3c, 0x0c, 0xf1, 0x0f, 0x0b, 0xec, 0x3b, 0x58, 0x4d, 0xeb, 0x52, 0x45, 0xb6, 0x0a, 0x02, 0xef,
-	0x09, 0xf8, 0x1b, 0xf9, 0xed, 0x2f, 0xa5, 0x44, 0xf9, 0xcf, 0x24, 0x1c, 0x99, 0x2e, 0xf6, 0xa9,
-	0xf7, 0xbd, 0x87, 0xdd, 0xff, 0x06, 0xf8, 0x11, 0x64, 0xe6, 0x24, 0xa0, 0x43, 0xcf, 0x65, 0x73,
-	0xcb, 0x59, 0xe9, 0xc8, 0x34, 0x5d, 0xf4, 0x31, 0x80, 0x28, 0x25, 0xf2, 0x65, 0x98, 0x2f, 0x27,
-	0x10, 0xd3, 0xbd, 0x73, 0xf0, 0xd9, 0xfb, 0x06, 0xdf, 0x82, 0x83, 0xed, 0x7e, 0xb6, 0x85, 0xa5,
-	0x7b, 0x84, 0x93, 0xb7, 0x84, 0x45, 0xb6, 0x0f, 0x49, 0x48, 0x77, 0x6d, 0xe7, 0x15, 0xa6, 0x48,
-	0x83, 0xec, 0x4d, 0x05, 0x12, 0xab, 0xe0, 0xc6, 0x46, 0x25, 0xd8, 0x0f, 0xc9, 0x22, 0x70, 0xf0,
-	0x30, 0x4a, 0x2e, 0x92, 0x01, 0x87, 0xba, 0x24, 0xa0, 0xe8, 0x53, 0xc8, 0x0b, 0x82, 0x50, 0x60,
-	0x0b, 0xc9, 0x59, 0x87, 0x1c, 0x8d, 0xef, 0xc7, 0x43, 0x50, 0x5c, 0x1c, 0x52, 0xcf, 0xb7, 0xd9,
-	0xa4, 0x59, 0x32, 0x99, 0x11, 0x0b, 0x5b, 0x38, 0xcb, 0x58, 0x83, 0xe3, 0x6d, 0x6a, 0x9c, 0x96,
-	0x8f, 0x1d, 0x6d, 0xb9, 0xe2, 0xdc, 0x08, 0x64, 0xd7, 0xa6, 0x36, 0x1b, 0xff, 0x81, 0xc5, 0xce,
-	0xe8, 0x39, 0xe4, 0xa9, 0x37, 0xc3, 0x64, 0x41, 0x87, 0x13, 0xec, 0x8d, 0x27, 0x94, 0x2d, 0x60,
-	0x7f, 0xe7, 0x8e, 0xf1, 0xc7, 0x60, 0x79, 0x5e, 0x6d, 0x32, 0x86, 0xb8, 0x20, 0x87, 0x22, 0x8e,
-	0x83, 0xe8, 0x73, 0x38, 0x8a, 0x13, 0x45, 0xbf, 0x21, 0xb5, 0x67, 0x73, 0xb1, 0x27, 0x45, 0x38,
-	0xfa, 0x31, 0x2e, 0x46, 0xfb, 0x23, 0xec, 0xf3, 0xc9, 0xb2, 0xfb, 0xfe, 0x4f, 0xf7, 0xb4, 0xb3,
-	0x96, 0xbd, 0x5b, 0x6b, 0x89, 0x5b, 0x96, 0x37, 0x2d, 0x0b, 0x71, 0x17, 0xb2, 0x5c, 0xdc, 0x74,
-	0xff, 0x0d, 0x65, 0xa1, 0xd2, 0x81, 0xc2, 0x85, 0xf3, 0xca, 0x27, 0xaf, 0xa7, 0xd8, 0x1d, 0xe3,
-	0x19, 0xf6, 0x29, 0x52, 0x21, 0x1d, 0xe0, 0x70, 0x31, 0xa5, 0xea, 0xff, 0xa3, 0xa2, 0x9a, 0x09,
-	0x4b, 0xd8, 0xe8, 0x04, 0x52, 0x38, 0x08, 0x48, 0xa0, 0x9e, 0x44, 0x42, 0xcd, 0x84, 0xc5, 0xcd,
-	0x4b, 0x80, 0x6c, 0x80, 0xc3, 0x39, 0xf1, 0x43, 0x5c, 0xb6, 0x21, 0xd3, 0xe7, 0xd3, 0x44, 0x4f,
-	0x21, 0x2d, 0x56, 0x26, 0xfd, 0xcd, 0x95, 0x09, 0x3e, 0x3a, 0x85, 0xdc, 0x66, 0x47, 0x49, 0x56,
-	0xf8, 0x06, 0x28, 0x0f, 0xa2, 0x0b, 0x1f, 0xd8, 0xb3, 0x10, 0xbd, 0x80, 0xf8, 0x2f, 0x36, 0x14,
-	0x2b, 0x14, 0x52, 0xa7, 0x77, 0xbe, 0x22, 0xa2, 0x30, 0x21, 0x96, 0x17, 0xa1, 0x02, 0x3d, 0xfb,
-	0x29, 0x09, 0xa9, 0x9e, 0x78, 0xd1, 0x4a, 0xbd, 0xfe, 0x45, 0xdf, 0x18, 0x0e, 0xda, 0x66, 0xdb,
-	0xec, 0x9b, 0x17, 0x2d, 0xf3, 0xa5, 0xd1, 0x18, 0x0e, 0xda, 0xbd, 0xae, 0x51, 0x37, 0x9f, 0x99,
-	0x46, 0x43, 0x49, 0x68, 0x47, 0xab, 0xb5, 0x7e, 0xb8, 0x43, 0x40, 0x2a, 0x00, 0x8f, 0x8b, 0x40,
-	0x45, 0xd2, 0xb2, 0xab, 0xb5, 0x2e, 0x47, 0x67, 0x54, 0x84, 0x43, 0xee, 0xe9, 0x5b, 0xdf, 0x75,
-	0xba, 0x46, 0x5b, 0x49, 0x6a, 0xfb, 0xab, 0xb5, 0x9e, 0x11, 0xe6, 0x26, 0x92, 0x39, 0xf7, 0x78,
-	0x24, 0xf3, 0x9c, 0xc2, 0x01, 0xf7, 0xd4, 0x5b, 0x9d, 0x9e, 0xd1, 0x50, 0x64, 0x0d, 0x56, 0x6b,
-	0x3d, 0xcd, 0x2d, 0xa4, 0x43, 0x9e, 0x7b, 0x9f, 0xb5, 0x06, 0xbd, 0xa6, 0xd9, 0x7e, 0xae, 0xa4,
-	0xb4, 0x83, 0xd5, 0x5a, 0xcf, 0xc6, 0x36, 0x3a, 0x83, 0xe3, 0x2d, 0x46, 0xbd, 0xf3, 0x6d, 0xb7,
-	0x65, 0xf4, 0x0d, 0x25, 0xcd, 0xeb, 0xdf, 0x01, 0x35, 0xf9, 0xed, 0xaf, 0xc5, 0xc4, 0xd9, 0x6b,
-	0x48, 0xb1, 0xa7, 0x1a, 0x7d, 0x02, 0x27, 0x1d, 0xab, 0x61, 0x58, 0xc3, 0x76, 0xa7, 0x6d, 0xdc,
-	0xea, 0x9e, 0x15, 0x18, 0xe1, 0xa8, 0x0c, 0x05, 0xce, 0x1a, 0xb4, 0xd9, 0xaf, 0xd1, 0x50, 0x24,
-	0xed, 0x70, 0xb5, 0xd6, 0x73, 0x37, 0x40, 0xd4, 0x3e, 0xe7, 0xc4, 0x0c, 0xd1, 0xbe, 0x30, 0xb9,
-	0xf0, 0x65, 0xef, 0xdd, 0x55, 0x51, 0x7a, 0x7f, 0x55, 0x94, 0xfe, 0xb8, 0x2a, 0x4a, 0x3f, 0x5f,
-	0x17, 0x13, 0xef, 0xaf, 0x8b, 0x89, 0x0f, 0xd7, 0xc5, 0xc4, 0xcb, 0xaf, 0xc7, 0x1e, 0x9d, 0x2c,
-	0x46, 0x55, 0x87, 0xcc, 0x6a, 0x0e, 0x09, 0x67, 0x24, 0xac, 0x79, 0x23, 0xe7, 0xd1, 0x98, 0xd4,
-	0x96, 0x4f, 0x6b, 0x33, 0xe2, 0x2e, 0xa6, 0x38, 0xe4, 0x9f, 0x08, 0x8f, 0xbf, 0x7c, 0x14, 0x7f,
-	0x73, 0xd0, 0x37, 0x73, 0x1c, 0x8e, 0xd2, 0xec, 0x1b, 0xe1, 0x8b, 0xbf, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0x90, 0xd1, 0xb4, 0xca, 0x94, 0x08, 0x00, 0x00,
+func (m *Params) GetPacketIndexEnabled() bool {
+	if m != nil {
+		return m.PacketIndexEnabled
+	}
+	return false
 }
 
-func (m *Channel) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Params) GetUpgradePolicies() []UpgradePolicy {
+	if m != nil {
+		return m.UpgradePolicies
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Channel) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Params) GetAutoPruning() AutoPruning {
+	if m != nil {
+		return m.AutoPruning
+	}
+	return AutoPruning{}
 }
 
-func (m *Channel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UpgradeSequence != 0 {
-		i = encodeVarintChannel(dAtA, i, uint64(m.UpgradeSequence))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintChannel(dAtA, i, uint64(len(m.Version)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.ConnectionHops) > 0 {
-		for iNdEx := len(m.ConnectionHops) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ConnectionHops[iNdEx])
-			copy(dAtA[i:], m.ConnectionHops[iNdEx])
-			i = encodeVarintChannel(dAtA, i, uint64(len(m.ConnectionHops[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	{
-		size, err := m.Counterparty.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintChannel(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.Ordering != 0 {
-		i = encodeVarintChannel(dAtA, i, uint64(m.Ordering))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.State != 0 {
-		i = encodeVarintChannel(dAtA, i, uint64(m.State))
-		i--
-		dAtA[i] = 0x8
+func (m *Params) GetPacketSendTimeEnabled() bool {
+	if m != nil {
+		return m.PacketSendTimeEnabled
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *IdentifiedChannel) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Params) GetPacketIndexRetentionBlocks() uint64 {
+	if m != nil {
+		return m.PacketIndexRetentionBlocks
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *IdentifiedChannel) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// UpgradePolicy defines the permission policy for upgrading channels bound to a port. Upgrades of channels
+// bound to ports without a policy may be initiated by the authority or the counterparty chain and may change
+// any of the upgrade fields.
+type UpgradePolicy struct {
+	// the port identifier the policy applies to.
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// the addresses, in addition to the authority, which may initiate upgrades of channels bound to the port.
+	AllowedSigners []string `protobuf:"bytes,2,rep,name=allowed_signers,json=allowedSigners,proto3" json:"allowed_signers,omitempty"`
+	// whether upgrades initiated by the counterparty chain are accepted.
+	AllowCounterpartyInitiated bool `protobuf:"varint,3,opt,name=allow_counterparty_initiated,json=allowCounterpartyInitiated,proto3" json:"allow_counterparty_initiated,omitempty"`
+	// the channel version transitions which are allowed. Any version transition is allowed if empty.
+	AllowedVersionTransitions []VersionTransition `protobuf:"bytes,4,rep,name=allowed_version_transitions,json=allowedVersionTransitions,proto3" json:"allowed_version_transitions"`
+	// whether the ordering of the channel may change.
+	AllowOrderingChange bool `protobuf:"varint,5,opt,name=allow_ordering_change,json=allowOrderingChange,proto3" json:"allow_ordering_change,omitempty"`
 }
 
-func (m *IdentifiedChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UpgradeSequence != 0 {
-		i = encodeVarintChannel(dAtA, i, uint64(m.UpgradeSequence))
-		i--
-		dAtA[i] = 0x40
-	}
-	if len(m.ChannelId) > 0 {
-		i -= len(m.ChannelId)
-		copy(dAtA[i:], m.ChannelId)
-		i = encodeVarintChannel(dAtA, i, uint64(len(m.ChannelId)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.PortId) > 0 {
-		i -= len(m.PortId)
-		copy(dAtA[i:], m.PortId)
-		i = encodeVarintChannel(dAtA, i, uint64(len(m.PortId)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintChannel(dAtA, i, uint64(len(m.Version)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.ConnectionHops) > 0 {
-		for iNdEx := len(m.ConnectionHops) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ConnectionHops[iNdEx])
-			copy(dAtA[i:], m.ConnectionHops[iNdEx])
-			i = encodeVarintChannel(dAtA, i, uint64(len(m.ConnectionHops[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	{
-		size, err := m.Counterparty.MarshalToSizedBuffer(dAtA[:i])
+func (m *UpgradePolicy) Reset()         { *m = UpgradePolicy{} }
+func (m *UpgradePolicy) String() string { return proto.CompactTextString(m) }
+func (*UpgradePolicy) ProtoMessage()    {}
+func (*UpgradePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3a07336710636a0, []int{9}
+}
+func (m *UpgradePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpgradePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpgradePolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintChannel(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.Ordering != 0 {
-		i = encodeVarintChannel(dAtA, i, uint64(m.Ordering))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.State != 0 {
-		i = encodeVarintChannel(dAtA, i, uint64(m.State))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *UpgradePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpgradePolicy.Merge(m, src)
+}
+func (m *UpgradePolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpgradePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpgradePolicy.DiscardUnknown(m)
 }
 
-func (m *Counterparty) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_UpgradePolicy proto.InternalMessageInfo
+
+func (m *UpgradePolicy) GetPortId() string {
+	if m != nil {
+		return m.PortId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Counterparty) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *UpgradePolicy) GetAllowedSigners() []string {
+	if m != nil {
+		return m.AllowedSigners
+	}
+	return nil
 }
 
-func (m *Counterparty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChannelId) > 0 {
-		i -= len(m.ChannelId)
-		copy(dAtA[i:], m.ChannelId)
-		i = encodeVarintChannel(dAtA, i, uint64(len(m.ChannelId)))
-		i--
-		dAtA[i] = 0x12
+func (m *UpgradePolicy) GetAllowCounterpartyInitiated() bool {
+	if m != nil {
+		return m.AllowCounterpartyInitiated
 	}
-	if len(m.PortId) > 0 {
-		i -= len(m.PortId)
-		copy(dAtA[i:], m.PortId)
-		i = encodeVarintChannel(dAtA, i, uint64(len(m.PortId)))
-		i--
-		dAtA[i] = 0xa
+	return false
+}
+
+func (m *UpgradePolicy) GetAllowedVersionTransitions() []VersionTransition {
+	if m != nil {
+		return m.AllowedVersionTransitions
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *Packet) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *UpgradePolicy) GetAllowOrderingChange() bool {
+	if m != nil {
+		return m.AllowOrderingChange
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *Packet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// VersionTransition defines an upgrade of the channel version from one version to another.
+type VersionTransition struct {
+	// the channel version before the upgrade.
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// the channel version after the upgrade.
+	To string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
 }
 
-func (m *Packet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TimeoutTimestamp != 0 {
-		i = encodeVarintChannel(dAtA, i, uint64(m.TimeoutTimestamp))
-		i--
-		dAtA[i] = 0x40
-	}
-	{
-		size, err := m.TimeoutHeight.MarshalToSizedBuffer(dAtA[:i])
+func (m *VersionTransition) Reset()         { *m = VersionTransition{} }
+func (m *VersionTransition) String() string { return proto.CompactTextString(m) }
+func (*VersionTransition) ProtoMessage()    {}
+func (*VersionTransition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3a07336710636a0, []int{10}
+}
+func (m *VersionTransition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VersionTransition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VersionTransition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintChannel(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x3a
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintChannel(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.DestinationChannel) > 0 {
-		i -= len(m.DestinationChannel)
-		copy(dAtA[i:], m.DestinationChannel)
-		i = encodeVarintChannel(dAtA, i, uint64(len(m.DestinationChannel)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.DestinationPort) > 0 {
-		i -= len(m.DestinationPort)
-		copy(dAtA[i:], m.DestinationPort)
-		i = encodeVarintChannel(dAtA, i, uint64(len(m.DestinationPort)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.SourceChannel) > 0 {
-		i -= len(m.SourceChannel)
-		copy(dAtA[i:], m.SourceChannel)
-		i = encodeVarintChannel(dAtA, i, uint64(len(m.SourceChannel)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.SourcePort) > 0 {
-		i -= len(m.SourcePort)
-		copy(dAtA[i:], m.SourcePort)
-		i = encodeVarintChannel(dAtA, i, uint64(len(m.SourcePort)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Sequence != 0 {
-		i = encodeVarintChannel(dAtA, i, uint64(m.Sequence))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *VersionTransition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VersionTransition.Merge(m, src)
+}
+func (m *VersionTransition) XXX_Size() int {
+	return m.Size()
+}
+func (m *VersionTransition) XXX_DiscardUnknown() {
+	xxx_messageInfo_VersionTransition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VersionTransition proto.InternalMessageInfo
+
+func (m *VersionTransition) GetFrom() string {
+	if m != nil {
+		return m.From
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *PacketState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *VersionTransition) GetTo() string {
+	if m != nil {
+		return m.To
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *PacketState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// AutoPruning defines the configuration for pruning stale packet acknowledgements and receipts in EndBlock.
+// The channels with a pruning sequence start, i.e. channels which have been upgraded or for which pruning was
+// enabled using MsgEnableAcknowledgementPruning, are pruned in turn. Each block resumes with the channel
+// following the last channel pruned in the previous block.
+type AutoPruning struct {
+	// enables the automatic pruning of packet acknowledgements and receipts.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// the maximum number of sequences whose acknowledgement and receipt may be pruned in a single block.
+	MaxPrunedPerBlock uint64 `protobuf:"varint,2,opt,name=max_pruned_per_block,json=maxPrunedPerBlock,proto3" json:"max_pruned_per_block,omitempty"`
+	// the maximum number of sequences of a single channel whose acknowledgement and receipt may be pruned in a
+	// single block, before pruning moves on to the next channel.
+	MaxPrunedPerChannel uint64 `protobuf:"varint,3,opt,name=max_pruned_per_channel,json=maxPrunedPerChannel,proto3" json:"max_pruned_per_channel,omitempty"`
 }
 
-func (m *PacketState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintChannel(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Sequence != 0 {
-		i = encodeVarintChannel(dAtA, i, uint64(m.Sequence))
-		i--
-		dAtA[i] = 0x18
+func (m *AutoPruning) Reset()         { *m = AutoPruning{} }
+func (m *AutoPruning) String() string { return proto.CompactTextString(m) }
+func (*AutoPruning) ProtoMessage()    {}
+func (*AutoPruning) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3a07336710636a0, []int{11}
+}
+func (m *AutoPruning) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoPruning) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoPruning.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.ChannelId) > 0 {
-		i -= len(m.ChannelId)
-		copy(dAtA[i:], m.ChannelId)
-		i = encodeVarintChannel(dAtA, i, uint64(len(m.ChannelId)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *AutoPruning) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoPruning.Merge(m, src)
+}
+func (m *AutoPruning) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoPruning) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoPruning.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoPruning proto.InternalMessageInfo
+
+func (m *AutoPruning) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
 	}
-	if len(m.PortId) > 0 {
-		i -= len(m.PortId)
-		copy(dAtA[i:], m.PortId)
-		i = encodeVarintChannel(dAtA, i, uint64(len(m.PortId)))
-		i--
-		dAtA[i] = 0xa
+	return false
+}
+
+func (m *AutoPruning) GetMaxPrunedPerBlock() uint64 {
+	if m != nil {
+		return m.MaxPrunedPerBlock
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *PacketId) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AutoPruning) GetMaxPrunedPerChannel() uint64 {
+	if m != nil {
+		return m.MaxPrunedPerChannel
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *PacketId) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// LocalhostAutoRelay defines the configuration for relaying packets sent on channels built upon
+// the 09-localhost connection. When enabled, packets are received and their acknowledgements are
+// processed in the EndBlock of the block in which they were sent or written.
+type LocalhostAutoRelay struct {
+	// enables the automatic relaying of packets and acknowledgements over the 09-localhost connection.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// the maximum number of packets and acknowledgements which may be relayed in a single block.
+	MaxPacketsPerBlock uint64 `protobuf:"varint,2,opt,name=max_packets_per_block,json=maxPacketsPerBlock,proto3" json:"max_packets_per_block,omitempty"`
+	// the gas limit for relaying a single packet or acknowledgement, including the application callbacks.
+	MaxGasPerPacket uint64 `protobuf:"varint,3,opt,name=max_gas_per_packet,json=maxGasPerPacket,proto3" json:"max_gas_per_packet,omitempty"`
 }
 
-func (m *PacketId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Sequence != 0 {
-		i = encodeVarintChannel(dAtA, i, uint64(m.Sequence))
-		i--
-		dAtA[i] = 0x18
+func (m *LocalhostAutoRelay) Reset()         { *m = LocalhostAutoRelay{} }
+func (m *LocalhostAutoRelay) String() string { return proto.CompactTextString(m) }
+func (*LocalhostAutoRelay) ProtoMessage()    {}
+func (*LocalhostAutoRelay) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3a07336710636a0, []int{12}
+}
+func (m *LocalhostAutoRelay) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LocalhostAutoRelay) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LocalhostAutoRelay.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.ChannelId) > 0 {
-		i -= len(m.ChannelId)
-		copy(dAtA[i:], m.ChannelId)
-		i = encodeVarintChannel(dAtA, i, uint64(len(m.ChannelId)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *LocalhostAutoRelay) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LocalhostAutoRelay.Merge(m, src)
+}
+func (m *LocalhostAutoRelay) XXX_Size() int {
+	return m.Size()
+}
+func (m *LocalhostAutoRelay) XXX_DiscardUnknown() {
+	xxx_messageInfo_LocalhostAutoRelay.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LocalhostAutoRelay proto.InternalMessageInfo
+
+func (m *LocalhostAutoRelay) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
 	}
-	if len(m.PortId) > 0 {
-		i -= len(m.PortId)
-		copy(dAtA[i:], m.PortId)
-		i = encodeVarintChannel(dAtA, i, uint64(len(m.PortId)))
-		i--
-		dAtA[i] = 0xa
+	return false
+}
+
+func (m *LocalhostAutoRelay) GetMaxPacketsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxPacketsPerBlock
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Acknowledgement) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *LocalhostAutoRelay) GetMaxGasPerPacket() uint64 {
+	if m != nil {
+		return m.MaxGasPerPacket
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Acknowledgement) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// IndexedPacket defines a packet sent from this chain which has been added to the packet lifecycle
+// index by the sending application, along with its current status.
+type IndexedPacket struct {
+	// the packet sent
+	Packet Packet `protobuf:"bytes,1,opt,name=packet,proto3" json:"packet"`
+	// the application provided sender of the packet
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// the application provided receiver of the packet
+	Receiver string `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// the lifecycle status of the packet
+	Status PacketStatus `protobuf:"varint,4,opt,name=status,proto3,enum=ibc.core.channel.v1.PacketStatus" json:"status,omitempty"`
+	// the acknowledgement of the packet, set once the packet has been acknowledged
+	Acknowledgement []byte `protobuf:"bytes,5,opt,name=acknowledgement,proto3" json:"acknowledgement,omitempty"`
 }
 
-func (m *Acknowledgement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Response != nil {
-		{
-			size := m.Response.Size()
-			i -= size
-			if _, err := m.Response.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+func (m *IndexedPacket) Reset()         { *m = IndexedPacket{} }
+func (m *IndexedPacket) String() string { return proto.CompactTextString(m) }
+func (*IndexedPacket) ProtoMessage()    {}
+func (*IndexedPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3a07336710636a0, []int{13}
+}
+func (m *IndexedPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IndexedPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IndexedPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *Acknowledgement_Result) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *IndexedPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexedPacket.Merge(m, src)
+}
+func (m *IndexedPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *IndexedPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexedPacket.DiscardUnknown(m)
 }
 
-func (m *Acknowledgement_Result) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Result != nil {
-		i -= len(m.Result)
-		copy(dAtA[i:], m.Result)
-		i = encodeVarintChannel(dAtA, i, uint64(len(m.Result)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xaa
+var xxx_messageInfo_IndexedPacket proto.InternalMessageInfo
+
+func (m *IndexedPacket) GetPacket() Packet {
+	if m != nil {
+		return m.Packet
 	}
-	return len(dAtA) - i, nil
-}
-func (m *Acknowledgement_Error) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return Packet{}
 }
 
-func (m *Acknowledgement_Error) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= len(m.Error)
-	copy(dAtA[i:], m.Error)
-	i = encodeVarintChannel(dAtA, i, uint64(len(m.Error)))
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0xb2
-	return len(dAtA) - i, nil
+func (m *IndexedPacket) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
 }
-func (m *Timeout) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *IndexedPacket) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Timeout) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *IndexedPacket) GetStatus() PacketStatus {
+	if m != nil {
+		return m.Status
+	}
+	return UNKNOWN
 }
 
-func (m *Timeout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Timestamp != 0 {
-		i = encodeVarintChannel(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x10
+func (m *IndexedPacket) GetAcknowledgement() []byte {
+	if m != nil {
+		return m.Acknowledgement
 	}
-	{
-		size, err := m.Height.MarshalToSizedBuffer(dAtA[:i])
+	return nil
+}
+
+// PacketSendTime defines the block height and time at which a packet in flight was sent.
+type PacketSendTime struct {
+	// the block height at which the packet was sent
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// the block time at which the packet was sent, in nanoseconds since the unix epoch
+	Timestamp uint64 `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *PacketSendTime) Reset()         { *m = PacketSendTime{} }
+func (m *PacketSendTime) String() string { return proto.CompactTextString(m) }
+func (*PacketSendTime) ProtoMessage()    {}
+func (*PacketSendTime) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3a07336710636a0, []int{14}
+}
+func (m *PacketSendTime) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketSendTime) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketSendTime.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintChannel(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *PacketSendTime) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketSendTime.Merge(m, src)
+}
+func (m *PacketSendTime) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketSendTime) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketSendTime.DiscardUnknown(m)
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
+var xxx_messageInfo_PacketSendTime proto.InternalMessageInfo
+
+func (m *PacketSendTime) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PacketSendTime) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+// LocalhostRelayEntry defines a packet queued for relaying over the 09-localhost connection.
+// An entry with an empty acknowledgement is awaiting receipt on the destination channel,
+// otherwise the acknowledgement is awaiting delivery on the source channel.
+type LocalhostRelayEntry struct {
+	// the packet to be relayed
+	Packet Packet `protobuf:"bytes,1,opt,name=packet,proto3" json:"packet"`
+	// the acknowledgement written for the packet, if any
+	Acknowledgement []byte `protobuf:"bytes,2,opt,name=acknowledgement,proto3" json:"acknowledgement,omitempty"`
+}
+
+func (m *LocalhostRelayEntry) Reset()         { *m = LocalhostRelayEntry{} }
+func (m *LocalhostRelayEntry) String() string { return proto.CompactTextString(m) }
+func (*LocalhostRelayEntry) ProtoMessage()    {}
+func (*LocalhostRelayEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3a07336710636a0, []int{15}
+}
+func (m *LocalhostRelayEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LocalhostRelayEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LocalhostRelayEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LocalhostRelayEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LocalhostRelayEntry.Merge(m, src)
+}
+func (m *LocalhostRelayEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *LocalhostRelayEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_LocalhostRelayEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LocalhostRelayEntry proto.InternalMessageInfo
+
+func (m *LocalhostRelayEntry) GetPacket() Packet {
+	if m != nil {
+		return m.Packet
+	}
+	return Packet{}
+}
+
+func (m *LocalhostRelayEntry) GetAcknowledgement() []byte {
+	if m != nil {
+		return m.Acknowledgement
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("ibc.core.channel.v1.State", State_name, State_value)
+	proto.RegisterEnum("ibc.core.channel.v1.Order", Order_name, Order_value)
+	proto.RegisterEnum("ibc.core.channel.v1.PacketStatus", PacketStatus_name, PacketStatus_value)
+	proto.RegisterType((*Channel)(nil), "ibc.core.channel.v1.Channel")
+	proto.RegisterType((*IdentifiedChannel)(nil), "ibc.core.channel.v1.IdentifiedChannel")
+	proto.RegisterType((*Counterparty)(nil), "ibc.core.channel.v1.Counterparty")
+	proto.RegisterType((*Packet)(nil), "ibc.core.channel.v1.Packet")
+	proto.RegisterType((*PacketState)(nil), "ibc.core.channel.v1.PacketState")
+	proto.RegisterType((*PacketId)(nil), "ibc.core.channel.v1.PacketId")
+	proto.RegisterType((*Acknowledgement)(nil), "ibc.core.channel.v1.Acknowledgement")
+	proto.RegisterType((*Timeout)(nil), "ibc.core.channel.v1.Timeout")
+	proto.RegisterType((*Params)(nil), "ibc.core.channel.v1.Params")
+	proto.RegisterType((*UpgradePolicy)(nil), "ibc.core.channel.v1.UpgradePolicy")
+	proto.RegisterType((*VersionTransition)(nil), "ibc.core.channel.v1.VersionTransition")
+	proto.RegisterType((*AutoPruning)(nil), "ibc.core.channel.v1.AutoPruning")
+	proto.RegisterType((*LocalhostAutoRelay)(nil), "ibc.core.channel.v1.LocalhostAutoRelay")
+	proto.RegisterType((*IndexedPacket)(nil), "ibc.core.channel.v1.IndexedPacket")
+	proto.RegisterType((*PacketSendTime)(nil), "ibc.core.channel.v1.PacketSendTime")
+	proto.RegisterType((*LocalhostRelayEntry)(nil), "ibc.core.channel.v1.LocalhostRelayEntry")
+}
+
+func init() { proto.RegisterFile("ibc/core/channel/v1/channel.proto", fileDescriptor_c3a07336710636a0) }
+
+var fileDescriptor_c3a07336710636a0 = []byte{
+	// 1588 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0x4d, 0x6f, 0x1a, 0xcf,
+	0x19, 0x67, 0x31, 0x60, 0xfc, 0x80, 0x01, 0x8f, 0x5f, 0xfe, 0xfc, 0x89, 0xff, 0x78, 0x8d, 0xda,
+	0xc4, 0x49, 0x14, 0x63, 0x93, 0xaa, 0x79, 0x39, 0x15, 0xc3, 0xc6, 0xa6, 0x26, 0x80, 0x16, 0x68,
+	0xd4, 0x5c, 0x56, 0xeb, 0xdd, 0x09, 0x5e, 0x05, 0x76, 0xe8, 0xee, 0xe2, 0xd8, 0xed, 0xb9, 0x52,
+	0x84, 0xd4, 0xaa, 0x5f, 0x00, 0xa9, 0x52, 0x3f, 0x42, 0xdb, 0xef, 0x90, 0x4b, 0xa5, 0x1c, 0x73,
+	0xaa, 0xaa, 0xf8, 0x3b, 0xf4, 0x5c, 0xcd, 0xcb, 0xc2, 0x62, 0x53, 0x2b, 0x6a, 0xd5, 0x5b, 0x4f,
+	0xcc, 0x3c, 0xbf, 0xdf, 0xf3, 0x3e, 0xf3, 0x0c, 0x0b, 0xbb, 0xd6, 0x99, 0x51, 0x34, 0x88, 0x83,
+	0x8b, 0xc6, 0xb9, 0x6e, 0xdb, 0xb8, 0x5f, 0xbc, 0x38, 0xf4, 0x97, 0xfb, 0x43, 0x87, 0x78, 0x04,
+	0xad, 0x5b, 0x67, 0xc6, 0x3e, 0xa5, 0xec, 0xfb, 0xf2, 0x8b, 0xc3, 0xdc, 0x46, 0x8f, 0xf4, 0x08,
+	0xc3, 0x8b, 0x74, 0xc5, 0xa9, 0xb9, 0x9d, 0x99, 0xb5, 0xbe, 0x85, 0x6d, 0x8f, 0x19, 0x63, 0x2b,
+	0x4e, 0x28, 0xfc, 0x25, 0x0c, 0xcb, 0x15, 0x6e, 0x05, 0x1d, 0x40, 0xd4, 0xf5, 0x74, 0x0f, 0x67,
+	0x25, 0x59, 0xda, 0x4b, 0x95, 0x72, 0xfb, 0x0b, 0xfc, 0xec, 0xb7, 0x29, 0x43, 0xe5, 0x44, 0xf4,
+	0x53, 0x88, 0x13, 0xc7, 0xc4, 0x8e, 0x65, 0xf7, 0xb2, 0xe1, 0x3b, 0x94, 0x9a, 0x94, 0xa4, 0x4e,
+	0xb9, 0xe8, 0x14, 0x92, 0x06, 0x19, 0xd9, 0x1e, 0x76, 0x86, 0xba, 0xe3, 0x5d, 0x65, 0x97, 0x64,
+	0x69, 0x2f, 0x51, 0xda, 0x5d, 0xa8, 0x5b, 0x09, 0x10, 0x8f, 0x22, 0x9f, 0xfe, 0xbe, 0x13, 0x52,
+	0xe7, 0x94, 0xd1, 0x03, 0x48, 0x1b, 0xc4, 0xb6, 0xb1, 0xe1, 0x59, 0xc4, 0xd6, 0xce, 0xc9, 0xd0,
+	0xcd, 0x46, 0xe4, 0xa5, 0xbd, 0x15, 0x35, 0x35, 0x13, 0x9f, 0x90, 0xa1, 0x8b, 0xb2, 0xb0, 0x7c,
+	0x81, 0x1d, 0xd7, 0x22, 0x76, 0x36, 0x2a, 0x4b, 0x7b, 0x2b, 0xaa, 0xbf, 0x45, 0x0f, 0x21, 0x33,
+	0x1a, 0xf6, 0x1c, 0xdd, 0xc4, 0x9a, 0x8b, 0x7f, 0x35, 0xc2, 0xb6, 0x81, 0xb3, 0x31, 0x59, 0xda,
+	0x8b, 0xa8, 0x69, 0x21, 0x6f, 0x0b, 0xf1, 0xcb, 0xc8, 0xc7, 0x3f, 0xee, 0x84, 0x0a, 0xff, 0x0c,
+	0xc3, 0x5a, 0xcd, 0xc4, 0xb6, 0x67, 0xbd, 0xb3, 0xb0, 0xf9, 0xff, 0x02, 0x7e, 0x07, 0xcb, 0x43,
+	0xe2, 0x78, 0x9a, 0x65, 0xb2, 0xba, 0xad, 0xa8, 0x31, 0xba, 0xad, 0x99, 0xe8, 0x07, 0x00, 0x11,
+	0x0a, 0xc5, 0x96, 0x19, 0xb6, 0x22, 0x24, 0x35, 0x73, 0x61, 0xe1, 0xe3, 0x77, 0x15, 0xbe, 0x0e,
+	0xc9, 0x60, 0x3e, 0x41, 0xc7, 0xd2, 0x1d, 0x8e, 0xc3, 0x37, 0x1c, 0x0b, 0x6b, 0x5f, 0xc2, 0x10,
+	0x6b, 0xe9, 0xc6, 0x7b, 0xec, 0xa1, 0x1c, 0xc4, 0xa7, 0x11, 0x48, 0x2c, 0x82, 0xe9, 0x1e, 0xed,
+	0x40, 0xc2, 0x25, 0x23, 0xc7, 0xc0, 0x1a, 0x35, 0x2e, 0x8c, 0x01, 0x17, 0xb5, 0x88, 0xe3, 0xa1,
+	0x1f, 0x43, 0x4a, 0x10, 0x84, 0x07, 0xd6, 0x90, 0x15, 0x75, 0x95, 0x4b, 0xfd, 0xf3, 0xf1, 0x10,
+	0x32, 0x26, 0x76, 0x3d, 0xcb, 0xd6, 0x59, 0xa5, 0x99, 0xb1, 0x08, 0x23, 0xa6, 0x03, 0x72, 0x66,
+	0xb1, 0x08, 0xeb, 0x41, 0xaa, 0x6f, 0x96, 0x97, 0x1d, 0x05, 0x20, 0xdf, 0x36, 0x82, 0x88, 0xa9,
+	0x7b, 0x3a, 0x2b, 0x7f, 0x52, 0x65, 0x6b, 0x74, 0x0c, 0x29, 0xcf, 0x1a, 0x60, 0x32, 0xf2, 0xb4,
+	0x73, 0x6c, 0xf5, 0xce, 0x3d, 0xd6, 0x80, 0xc4, 0xdc, 0x19, 0xe3, 0xc3, 0xe0, 0xe2, 0x70, 0xff,
+	0x84, 0x31, 0xc4, 0x01, 0x59, 0x15, 0x7a, 0x5c, 0x88, 0x1e, 0xc3, 0x9a, 0x6f, 0x88, 0xfe, 0xba,
+	0x9e, 0x3e, 0x18, 0x8a, 0x3e, 0x65, 0x04, 0xd0, 0xf1, 0xe5, 0xa2, 0xb4, 0xbf, 0x81, 0x04, 0xaf,
+	0x2c, 0x3b, 0xef, 0xff, 0x69, 0x9f, 0xe6, 0xda, 0xb2, 0x74, 0xa3, 0x2d, 0x7e, 0xca, 0x91, 0x59,
+	0xca, 0xc2, 0xb9, 0x09, 0x71, 0xee, 0xbc, 0x66, 0xfe, 0x2f, 0x3c, 0x0b, 0x2f, 0x4d, 0x48, 0x97,
+	0x8d, 0xf7, 0x36, 0xf9, 0xd0, 0xc7, 0x66, 0x0f, 0x0f, 0xb0, 0xed, 0xa1, 0x2c, 0xc4, 0x1c, 0xec,
+	0x8e, 0xfa, 0x5e, 0x76, 0x93, 0x06, 0x75, 0x12, 0x52, 0xc5, 0x1e, 0x6d, 0x41, 0x14, 0x3b, 0x0e,
+	0x71, 0xb2, 0x5b, 0xd4, 0xd1, 0x49, 0x48, 0xe5, 0xdb, 0x23, 0x80, 0xb8, 0x83, 0xdd, 0x21, 0xb1,
+	0x5d, 0x5c, 0xd0, 0x61, 0xb9, 0xc3, 0xab, 0x89, 0x9e, 0x43, 0x4c, 0xb4, 0x4c, 0xfa, 0xc6, 0x96,
+	0x09, 0x3e, 0xda, 0x86, 0x95, 0x59, 0x8f, 0xc2, 0x2c, 0xf0, 0x99, 0xa0, 0x30, 0x8e, 0xd0, 0x13,
+	0xef, 0xe8, 0x03, 0x17, 0x9d, 0x82, 0x7f, 0xc7, 0x34, 0xd1, 0x43, 0xe1, 0x6b, 0x7b, 0xe1, 0x18,
+	0x11, 0x91, 0x09, 0x6f, 0x29, 0xa1, 0xea, 0xc7, 0xab, 0xc1, 0x46, 0x9f, 0x18, 0x7a, 0xff, 0x9c,
+	0xb8, 0x9e, 0xa6, 0x8f, 0x3c, 0xa2, 0x39, 0xb8, 0xaf, 0x5f, 0xb1, 0x00, 0x12, 0xa5, 0x07, 0x0b,
+	0x2d, 0xd6, 0x7d, 0x85, 0xf2, 0xc8, 0x23, 0x2a, 0xa5, 0x0b, 0xe3, 0xa8, 0x7f, 0x0b, 0x41, 0x07,
+	0xb0, 0x31, 0x64, 0x2d, 0xd5, 0x2c, 0xdb, 0xc4, 0x97, 0x1a, 0xb6, 0xf5, 0xb3, 0x3e, 0x36, 0x59,
+	0x6b, 0xe2, 0x2a, 0xe2, 0x58, 0x8d, 0x42, 0x0a, 0x47, 0x50, 0x7b, 0x36, 0x5b, 0x86, 0xa4, 0x6f,
+	0x19, 0x16, 0x76, 0xb3, 0x51, 0x79, 0x69, 0x2f, 0x51, 0x2a, 0x2c, 0x0c, 0xa7, 0xcb, 0xc9, 0x2d,
+	0xca, 0xf5, 0x23, 0x49, 0x8f, 0x02, 0x42, 0x0b, 0xbb, 0xa8, 0x06, 0x49, 0x96, 0xdd, 0xd0, 0x19,
+	0xd9, 0x74, 0x68, 0xc7, 0x58, 0x7e, 0xf2, 0x42, 0x83, 0x34, 0xf8, 0x16, 0xe7, 0x09, 0x73, 0x09,
+	0x7d, 0x26, 0x42, 0xcf, 0x20, 0x2b, 0x32, 0x72, 0xb1, 0x6d, 0xb2, 0x1e, 0x4c, 0xb3, 0x5a, 0x66,
+	0x59, 0x6d, 0x72, 0xbc, 0x8d, 0x6d, 0x93, 0xd6, 0xd9, 0x4f, 0xac, 0x0c, 0x3f, 0xcc, 0x95, 0xc2,
+	0xc1, 0x1e, 0x7d, 0x89, 0x88, 0xad, 0x9d, 0xf5, 0x89, 0xf1, 0xde, 0x15, 0x37, 0x33, 0x17, 0xa8,
+	0x89, 0xea, 0x53, 0x8e, 0x18, 0xe3, 0xe7, 0x91, 0x78, 0x24, 0x13, 0x2d, 0xfc, 0x35, 0x0c, 0xab,
+	0x73, 0x59, 0xff, 0xfb, 0xcb, 0xf2, 0x00, 0xd2, 0x7a, 0xbf, 0x4f, 0x3e, 0x60, 0x53, 0x73, 0xad,
+	0x9e, 0x8d, 0x1d, 0x37, 0x1b, 0xe6, 0x6f, 0x84, 0x10, 0xb7, 0xb9, 0x14, 0xfd, 0x0c, 0xb6, 0x99,
+	0x44, 0x0b, 0x3e, 0x31, 0x9a, 0x65, 0x5b, 0x9e, 0xa5, 0x7b, 0xd3, 0x7e, 0xe5, 0x18, 0x27, 0x38,
+	0xc9, 0x6b, 0x3e, 0x03, 0xf5, 0xe1, 0x9e, 0xef, 0x4a, 0x3c, 0x2f, 0x9a, 0xe7, 0xe8, 0xb6, 0x6b,
+	0xd1, 0xf8, 0xf9, 0xd3, 0x94, 0x28, 0xdd, 0x5f, 0x58, 0xf1, 0x5f, 0x70, 0x7e, 0x67, 0x4a, 0x17,
+	0x75, 0xff, 0x5e, 0x18, 0xbc, 0x85, 0xbb, 0xa8, 0x04, 0x9b, 0x3c, 0x5e, 0xff, 0x6d, 0x65, 0xb3,
+	0xb6, 0x87, 0xd9, 0xa8, 0x8d, 0xab, 0xeb, 0x0c, 0x6c, 0x0a, 0xac, 0xc2, 0xa0, 0xc2, 0x33, 0x58,
+	0xbb, 0x65, 0x89, 0x4e, 0xa3, 0x77, 0x0e, 0x19, 0x88, 0xba, 0xb1, 0x35, 0x4a, 0x41, 0xd8, 0x23,
+	0x62, 0xb4, 0x84, 0x3d, 0x52, 0xf8, 0x9d, 0x04, 0x89, 0xc0, 0xa9, 0xa0, 0x0f, 0xaa, 0xdf, 0x71,
+	0x89, 0xb9, 0xf3, 0xb7, 0xa8, 0x08, 0x1b, 0x03, 0xfd, 0x92, 0x1d, 0x33, 0x6c, 0x6a, 0x43, 0xec,
+	0xf0, 0xde, 0x8a, 0x0b, 0xbd, 0x36, 0xd0, 0x2f, 0x5b, 0x0c, 0x6a, 0x61, 0x87, 0xb5, 0x14, 0x3d,
+	0x85, 0xad, 0x1b, 0x0a, 0xc1, 0xa7, 0x28, 0xa2, 0xae, 0x07, 0x55, 0xc4, 0xa3, 0x51, 0xf8, 0xbd,
+	0x04, 0xe8, 0xf6, 0x2d, 0xbc, 0x23, 0xac, 0x43, 0xd8, 0x64, 0x5e, 0xd8, 0xc9, 0x72, 0x6f, 0xc5,
+	0x85, 0xa8, 0x13, 0x8e, 0x4d, 0x03, 0x7b, 0x0c, 0x54, 0xaa, 0xf5, 0x74, 0x4e, 0xe7, 0xaa, 0x22,
+	0xa8, 0xf4, 0x40, 0xbf, 0x3c, 0xd6, 0x29, 0x97, 0x6b, 0x15, 0xae, 0x25, 0x58, 0x65, 0x07, 0x16,
+	0x9b, 0x5c, 0x82, 0x5e, 0x40, 0x4c, 0xa8, 0xf0, 0xe1, 0x74, 0x6f, 0x61, 0xe3, 0x39, 0xd9, 0x9f,
+	0x84, 0x5c, 0x01, 0x6d, 0x41, 0x8c, 0xde, 0x2c, 0xec, 0x88, 0x0e, 0x88, 0x1d, 0x9d, 0xec, 0x0e,
+	0x36, 0xb0, 0x75, 0x81, 0x1d, 0xf1, 0x4e, 0x4f, 0xf7, 0xd4, 0x1d, 0xfd, 0x67, 0x36, 0x72, 0xd9,
+	0xab, 0x92, 0x2a, 0xed, 0xde, 0xe1, 0xae, 0xcd, 0x88, 0xaa, 0x50, 0x40, 0x7b, 0x90, 0xd6, 0xe7,
+	0x9f, 0x03, 0x76, 0x86, 0x92, 0xea, 0x4d, 0x71, 0xe1, 0x15, 0xa4, 0x5a, 0x73, 0x37, 0x9b, 0x86,
+	0x1a, 0x18, 0xf7, 0x91, 0x6f, 0x1c, 0xe6, 0xbf, 0x86, 0xf5, 0x69, 0xf7, 0x58, 0xe7, 0x14, 0xdb,
+	0x73, 0xae, 0xfe, 0x9b, 0x92, 0x2d, 0xc8, 0x21, 0xbc, 0x30, 0x87, 0x47, 0xbf, 0x0d, 0x43, 0xb4,
+	0x2d, 0xfe, 0xc3, 0xee, 0xb4, 0x3b, 0xe5, 0x8e, 0xa2, 0x75, 0x1b, 0xb5, 0x46, 0xad, 0x53, 0x2b,
+	0xd7, 0x6b, 0x6f, 0x95, 0xaa, 0xd6, 0x6d, 0xb4, 0x5b, 0x4a, 0xa5, 0xf6, 0xaa, 0xa6, 0x54, 0x33,
+	0xa1, 0xdc, 0xda, 0x78, 0x22, 0xaf, 0xce, 0x11, 0x50, 0x16, 0x80, 0xeb, 0x51, 0x61, 0x46, 0xca,
+	0xc5, 0xc7, 0x13, 0x39, 0x42, 0xd7, 0x28, 0x0f, 0xab, 0x1c, 0xe9, 0xa8, 0xbf, 0x6c, 0xb6, 0x94,
+	0x46, 0x26, 0x9c, 0x4b, 0x8c, 0x27, 0xf2, 0xb2, 0xd8, 0xce, 0x34, 0x19, 0xb8, 0xc4, 0x35, 0x19,
+	0xb2, 0x0d, 0x49, 0x8e, 0x54, 0xea, 0xcd, 0xb6, 0x52, 0xcd, 0x44, 0x72, 0x30, 0x9e, 0xc8, 0x31,
+	0xbe, 0x43, 0x32, 0xa4, 0x38, 0xfa, 0xaa, 0xde, 0x6d, 0x9f, 0xd4, 0x1a, 0xc7, 0x99, 0x68, 0x2e,
+	0x39, 0x9e, 0xc8, 0x71, 0x7f, 0x8f, 0x1e, 0xc1, 0x7a, 0x80, 0x51, 0x69, 0xbe, 0x6e, 0xd5, 0x95,
+	0x8e, 0x92, 0x89, 0xf1, 0xf8, 0xe7, 0x84, 0xb9, 0xc8, 0xc7, 0x3f, 0xe5, 0x43, 0x8f, 0xfe, 0x2c,
+	0x41, 0x94, 0x8d, 0x07, 0xf4, 0x23, 0xd8, 0x6a, 0xaa, 0x55, 0x45, 0xd5, 0x1a, 0xcd, 0x86, 0x72,
+	0x23, 0x7d, 0x16, 0x21, 0x95, 0xa3, 0x02, 0xa4, 0x39, 0xab, 0xdb, 0x60, 0xbf, 0x4a, 0x35, 0x23,
+	0xe5, 0x56, 0xc7, 0x13, 0x79, 0x65, 0x2a, 0xa0, 0xf9, 0x73, 0x8e, 0xcf, 0x10, 0xf9, 0xfb, 0xf8,
+	0x4b, 0xb8, 0x37, 0x87, 0x6b, 0xe5, 0x7a, 0xbd, 0xf9, 0x46, 0xeb, 0xd4, 0x5e, 0x2b, 0xcd, 0x6e,
+	0x27, 0xb3, 0x94, 0xfb, 0x7e, 0x3c, 0x91, 0x37, 0x17, 0x82, 0x22, 0xea, 0xbf, 0x49, 0x90, 0x0c,
+	0x1e, 0x62, 0x54, 0x82, 0xdd, 0x56, 0xb9, 0x72, 0xaa, 0x74, 0x34, 0x9a, 0x7f, 0xb7, 0xad, 0x75,
+	0x1b, 0xa7, 0x8d, 0xe6, 0x9b, 0xc6, 0x8d, 0x3c, 0x58, 0x18, 0x02, 0x42, 0xf7, 0x61, 0x73, 0x5e,
+	0xa7, 0xa5, 0x34, 0xaa, 0xb4, 0xaa, 0x12, 0xe7, 0x89, 0x2d, 0x3a, 0x80, 0xdc, 0x3c, 0xaf, 0x5c,
+	0xa1, 0x06, 0xea, 0x4a, 0xf5, 0x98, 0xe5, 0x96, 0x19, 0x4f, 0xe4, 0x64, 0x50, 0x86, 0x1e, 0xc2,
+	0x77, 0xf3, 0x1a, 0x34, 0xfa, 0xaa, 0xc6, 0x93, 0x63, 0x1d, 0x63, 0x82, 0x69, 0x3e, 0x47, 0xed,
+	0x4f, 0x5f, 0xf3, 0xd2, 0xe7, 0xaf, 0x79, 0xe9, 0x1f, 0x5f, 0xf3, 0xd2, 0x1f, 0xae, 0xf3, 0xa1,
+	0xcf, 0xd7, 0xf9, 0xd0, 0x97, 0xeb, 0x7c, 0xe8, 0xed, 0x8b, 0x9e, 0xe5, 0x9d, 0x8f, 0xce, 0xf6,
+	0x0d, 0x32, 0x28, 0x1a, 0xc4, 0x1d, 0x10, 0xb7, 0x68, 0x9d, 0x19, 0x4f, 0x7a, 0xa4, 0x78, 0xf1,
+	0xbc, 0x38, 0x20, 0xe6, 0xa8, 0x8f, 0x5d, 0xfe, 0x85, 0x7c, 0xf0, 0x93, 0x27, 0xfe, 0x27, 0xb7,
+	0x77, 0x35, 0xc4, 0xee, 0x59, 0x8c, 0x7d, 0x22, 0x3f, 0xfd, 0xd7, 0x00, 0x78, 0x98, 0xb9, 0x58,
+	0x93, 0x0f, 0x00, 0x00,
+}
+
+func (m *Channel) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1144,18 +1306,39 @@ func (m *Params) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+func (m *Channel) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Channel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.UpgradeSequence != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.UpgradeSequence))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ConnectionHops) > 0 {
+		for iNdEx := len(m.ConnectionHops) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ConnectionHops[iNdEx])
+			copy(dAtA[i:], m.ConnectionHops[iNdEx])
+			i = encodeVarintChannel(dAtA, i, uint64(len(m.ConnectionHops[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
-		size, err := m.UpgradeTimeout.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Counterparty.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -1163,253 +1346,2266 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintChannel(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
+	dAtA[i] = 0x1a
+	if m.Ordering != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.Ordering))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.State != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintChannel(dAtA []byte, offset int, v uint64) int {
-	offset -= sovChannel(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *IdentifiedChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Channel) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *IdentifiedChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IdentifiedChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.State != 0 {
-		n += 1 + sovChannel(uint64(m.State))
+	if m.UpgradeSequence != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.UpgradeSequence))
+		i--
+		dAtA[i] = 0x40
 	}
-	if m.Ordering != 0 {
-		n += 1 + sovChannel(uint64(m.Ordering))
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = m.Counterparty.Size()
-	n += 1 + l + sovChannel(uint64(l))
 	if len(m.ConnectionHops) > 0 {
-		for _, s := range m.ConnectionHops {
-			l = len(s)
-			n += 1 + l + sovChannel(uint64(l))
+		for iNdEx := len(m.ConnectionHops) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ConnectionHops[iNdEx])
+			copy(dAtA[i:], m.ConnectionHops[iNdEx])
+			i = encodeVarintChannel(dAtA, i, uint64(len(m.ConnectionHops[iNdEx])))
+			i--
+			dAtA[i] = 0x22
 		}
 	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovChannel(uint64(l))
+	{
+		size, err := m.Counterparty.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintChannel(dAtA, i, uint64(size))
 	}
-	if m.UpgradeSequence != 0 {
-		n += 1 + sovChannel(uint64(m.UpgradeSequence))
+	i--
+	dAtA[i] = 0x1a
+	if m.Ordering != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.Ordering))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.State != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *IdentifiedChannel) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Counterparty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Counterparty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Counterparty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.State != 0 {
-		n += 1 + sovChannel(uint64(m.State))
-	}
-	if m.Ordering != 0 {
-		n += 1 + sovChannel(uint64(m.Ordering))
-	}
-	l = m.Counterparty.Size()
-	n += 1 + l + sovChannel(uint64(l))
-	if len(m.ConnectionHops) > 0 {
-		for _, s := range m.ConnectionHops {
-			l = len(s)
-			n += 1 + l + sovChannel(uint64(l))
-		}
-	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovChannel(uint64(l))
-	}
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovChannel(uint64(l))
-	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovChannel(uint64(l))
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.UpgradeSequence != 0 {
-		n += 1 + sovChannel(uint64(m.UpgradeSequence))
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Counterparty) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovChannel(uint64(l))
-	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovChannel(uint64(l))
+func (m *Packet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Packet) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Packet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Packet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Sequence != 0 {
-		n += 1 + sovChannel(uint64(m.Sequence))
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x40
 	}
-	l = len(m.SourcePort)
-	if l > 0 {
-		n += 1 + l + sovChannel(uint64(l))
+	{
+		size, err := m.TimeoutHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintChannel(dAtA, i, uint64(size))
 	}
-	l = len(m.SourceChannel)
-	if l > 0 {
-		n += 1 + l + sovChannel(uint64(l))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.DestinationPort)
-	if l > 0 {
-		n += 1 + l + sovChannel(uint64(l))
+	if len(m.DestinationChannel) > 0 {
+		i -= len(m.DestinationChannel)
+		copy(dAtA[i:], m.DestinationChannel)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.DestinationChannel)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.DestinationChannel)
-	if l > 0 {
-		n += 1 + l + sovChannel(uint64(l))
+	if len(m.DestinationPort) > 0 {
+		i -= len(m.DestinationPort)
+		copy(dAtA[i:], m.DestinationPort)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.DestinationPort)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovChannel(uint64(l))
+	if len(m.SourceChannel) > 0 {
+		i -= len(m.SourceChannel)
+		copy(dAtA[i:], m.SourceChannel)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.SourceChannel)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = m.TimeoutHeight.Size()
-	n += 1 + l + sovChannel(uint64(l))
-	if m.TimeoutTimestamp != 0 {
-		n += 1 + sovChannel(uint64(m.TimeoutTimestamp))
+	if len(m.SourcePort) > 0 {
+		i -= len(m.SourcePort)
+		copy(dAtA[i:], m.SourcePort)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.SourcePort)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.Sequence != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *PacketState) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PacketState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovChannel(uint64(l))
-	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovChannel(uint64(l))
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x22
 	}
 	if m.Sequence != 0 {
-		n += 1 + sovChannel(uint64(m.Sequence))
+		i = encodeVarintChannel(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovChannel(uint64(l))
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *PacketId) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PacketId) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketId) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovChannel(uint64(l))
-	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovChannel(uint64(l))
-	}
 	if m.Sequence != 0 {
-		n += 1 + sovChannel(uint64(m.Sequence))
+		i = encodeVarintChannel(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
-}
-
-func (m *Acknowledgement) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	if m.Response != nil {
-		n += m.Response.Size()
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Acknowledgement_Result) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
+func (m *Acknowledgement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Acknowledgement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Acknowledgement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
 	_ = l
+	if m.Response != nil {
+		{
+			size := m.Response.Size()
+			i -= size
+			if _, err := m.Response.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Acknowledgement_Result) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Acknowledgement_Result) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	if m.Result != nil {
-		l = len(m.Result)
-		n += 2 + l + sovChannel(uint64(l))
+		i -= len(m.Result)
+		copy(dAtA[i:], m.Result)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.Result)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *Acknowledgement_Error) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Acknowledgement_Error) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Acknowledgement_Error) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.Error)
+	copy(dAtA[i:], m.Error)
+	i = encodeVarintChannel(dAtA, i, uint64(len(m.Error)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xb2
+	return len(dAtA) - i, nil
+}
+func (m *Timeout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Timeout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Timeout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Error)
-	n += 2 + l + sovChannel(uint64(l))
-	return n
+	if m.Timestamp != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Height.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintChannel(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
-func (m *Timeout) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Height.Size()
-	n += 1 + l + sovChannel(uint64(l))
-	if m.Timestamp != 0 {
-		n += 1 + sovChannel(uint64(m.Timestamp))
+	if m.PacketIndexRetentionBlocks != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.PacketIndexRetentionBlocks))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.PacketSendTimeEnabled {
+		i--
+		if m.PacketSendTimeEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.AutoPruning.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintChannel(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.UpgradePolicies) > 0 {
+		for iNdEx := len(m.UpgradePolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UpgradePolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintChannel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.PacketIndexEnabled {
+		i--
+		if m.PacketIndexEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.LocalhostAutoRelay.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintChannel(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.UpgradeTimeout.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintChannel(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *UpgradePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpgradePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpgradePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AllowOrderingChange {
+		i--
+		if m.AllowOrderingChange {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.AllowedVersionTransitions) > 0 {
+		for iNdEx := len(m.AllowedVersionTransitions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowedVersionTransitions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintChannel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.AllowCounterpartyInitiated {
+		i--
+		if m.AllowCounterpartyInitiated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.AllowedSigners) > 0 {
+		for iNdEx := len(m.AllowedSigners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedSigners[iNdEx])
+			copy(dAtA[i:], m.AllowedSigners[iNdEx])
+			i = encodeVarintChannel(dAtA, i, uint64(len(m.AllowedSigners[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VersionTransition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VersionTransition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VersionTransition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AutoPruning) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoPruning) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoPruning) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxPrunedPerChannel != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.MaxPrunedPerChannel))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxPrunedPerBlock != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.MaxPrunedPerBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LocalhostAutoRelay) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LocalhostAutoRelay) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LocalhostAutoRelay) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxGasPerPacket != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.MaxGasPerPacket))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxPacketsPerBlock != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.MaxPacketsPerBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IndexedPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexedPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexedPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Acknowledgement) > 0 {
+		i -= len(m.Acknowledgement)
+		copy(dAtA[i:], m.Acknowledgement)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.Acknowledgement)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Status != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintChannel(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PacketSendTime) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketSendTime) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketSendTime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LocalhostRelayEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LocalhostRelayEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LocalhostRelayEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Acknowledgement) > 0 {
+		i -= len(m.Acknowledgement)
+		copy(dAtA[i:], m.Acknowledgement)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.Acknowledgement)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintChannel(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintChannel(dAtA []byte, offset int, v uint64) int {
+	offset -= sovChannel(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Channel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.State != 0 {
+		n += 1 + sovChannel(uint64(m.State))
+	}
+	if m.Ordering != 0 {
+		n += 1 + sovChannel(uint64(m.Ordering))
+	}
+	l = m.Counterparty.Size()
+	n += 1 + l + sovChannel(uint64(l))
+	if len(m.ConnectionHops) > 0 {
+		for _, s := range m.ConnectionHops {
+			l = len(s)
+			n += 1 + l + sovChannel(uint64(l))
+		}
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	if m.UpgradeSequence != 0 {
+		n += 1 + sovChannel(uint64(m.UpgradeSequence))
+	}
+	return n
+}
+
+func (m *IdentifiedChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.State != 0 {
+		n += 1 + sovChannel(uint64(m.State))
+	}
+	if m.Ordering != 0 {
+		n += 1 + sovChannel(uint64(m.Ordering))
+	}
+	l = m.Counterparty.Size()
+	n += 1 + l + sovChannel(uint64(l))
+	if len(m.ConnectionHops) > 0 {
+		for _, s := range m.ConnectionHops {
+			l = len(s)
+			n += 1 + l + sovChannel(uint64(l))
+		}
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	if m.UpgradeSequence != 0 {
+		n += 1 + sovChannel(uint64(m.UpgradeSequence))
+	}
+	return n
+}
+
+func (m *Counterparty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	return n
+}
+
+func (m *Packet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovChannel(uint64(m.Sequence))
+	}
+	l = len(m.SourcePort)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	l = len(m.SourceChannel)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	l = len(m.DestinationPort)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	l = len(m.DestinationChannel)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	l = m.TimeoutHeight.Size()
+	n += 1 + l + sovChannel(uint64(l))
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovChannel(uint64(m.TimeoutTimestamp))
+	}
+	return n
+}
+
+func (m *PacketState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovChannel(uint64(m.Sequence))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	return n
+}
+
+func (m *PacketId) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovChannel(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *Acknowledgement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Response != nil {
+		n += m.Response.Size()
+	}
+	return n
+}
+
+func (m *Acknowledgement_Result) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result != nil {
+		l = len(m.Result)
+		n += 2 + l + sovChannel(uint64(l))
+	}
+	return n
+}
+func (m *Acknowledgement_Error) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Error)
+	n += 2 + l + sovChannel(uint64(l))
+	return n
+}
+func (m *Timeout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Height.Size()
+	n += 1 + l + sovChannel(uint64(l))
+	if m.Timestamp != 0 {
+		n += 1 + sovChannel(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.UpgradeTimeout.Size()
+	n += 1 + l + sovChannel(uint64(l))
+	l = m.LocalhostAutoRelay.Size()
+	n += 1 + l + sovChannel(uint64(l))
+	if m.PacketIndexEnabled {
+		n += 2
+	}
+	if len(m.UpgradePolicies) > 0 {
+		for _, e := range m.UpgradePolicies {
+			l = e.Size()
+			n += 1 + l + sovChannel(uint64(l))
+		}
+	}
+	l = m.AutoPruning.Size()
+	n += 1 + l + sovChannel(uint64(l))
+	if m.PacketSendTimeEnabled {
+		n += 2
+	}
+	if m.PacketIndexRetentionBlocks != 0 {
+		n += 1 + sovChannel(uint64(m.PacketIndexRetentionBlocks))
+	}
+	return n
+}
+
+func (m *UpgradePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	if len(m.AllowedSigners) > 0 {
+		for _, s := range m.AllowedSigners {
+			l = len(s)
+			n += 1 + l + sovChannel(uint64(l))
+		}
+	}
+	if m.AllowCounterpartyInitiated {
+		n += 2
+	}
+	if len(m.AllowedVersionTransitions) > 0 {
+		for _, e := range m.AllowedVersionTransitions {
+			l = e.Size()
+			n += 1 + l + sovChannel(uint64(l))
+		}
+	}
+	if m.AllowOrderingChange {
+		n += 2
+	}
+	return n
+}
+
+func (m *VersionTransition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	return n
+}
+
+func (m *AutoPruning) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if m.MaxPrunedPerBlock != 0 {
+		n += 1 + sovChannel(uint64(m.MaxPrunedPerBlock))
+	}
+	if m.MaxPrunedPerChannel != 0 {
+		n += 1 + sovChannel(uint64(m.MaxPrunedPerChannel))
+	}
+	return n
+}
+
+func (m *LocalhostAutoRelay) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if m.MaxPacketsPerBlock != 0 {
+		n += 1 + sovChannel(uint64(m.MaxPacketsPerBlock))
+	}
+	if m.MaxGasPerPacket != 0 {
+		n += 1 + sovChannel(uint64(m.MaxGasPerPacket))
+	}
+	return n
+}
+
+func (m *IndexedPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Packet.Size()
+	n += 1 + l + sovChannel(uint64(l))
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovChannel(uint64(m.Status))
+	}
+	l = len(m.Acknowledgement)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	return n
+}
+
+func (m *PacketSendTime) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovChannel(uint64(m.Height))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovChannel(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *LocalhostRelayEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Packet.Size()
+	n += 1 + l + sovChannel(uint64(l))
+	l = len(m.Acknowledgement)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	return n
+}
+
+func sovChannel(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozChannel(x uint64) (n int) {
+	return sovChannel(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Channel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Channel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Channel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= State(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ordering", wireType)
+			}
+			m.Ordering = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ordering |= Order(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counterparty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Counterparty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionHops", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionHops = append(m.ConnectionHops, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeSequence", wireType)
+			}
+			m.UpgradeSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpgradeSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IdentifiedChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IdentifiedChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IdentifiedChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= State(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ordering", wireType)
+			}
+			m.Ordering = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ordering |= Order(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counterparty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Counterparty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionHops", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionHops = append(m.ConnectionHops, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeSequence", wireType)
+			}
+			m.UpgradeSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpgradeSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Counterparty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Counterparty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Counterparty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Packet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Packet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Packet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourcePort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourcePort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationPort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationPort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TimeoutHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.UpgradeTimeout.Size()
-	n += 1 + l + sovChannel(uint64(l))
-	return n
-}
-
-func sovChannel(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozChannel(x uint64) (n int) {
-	return sovChannel(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Channel) Unmarshal(dAtA []byte) error {
+func (m *PacketId) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1432,17 +3628,17 @@ func (m *Channel) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Channel: wiretype end group for non-group")
+			return fmt.Errorf("proto: PacketId: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Channel: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PacketId: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
-			m.State = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowChannel
@@ -1452,16 +3648,29 @@ func (m *Channel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= State(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ordering", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
-			m.Ordering = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowChannel
@@ -1471,16 +3680,98 @@ func (m *Channel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Ordering |= Order(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Acknowledgement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Acknowledgement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Acknowledgement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Counterparty", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowChannel
@@ -1490,28 +3781,28 @@ func (m *Channel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthChannel
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthChannel
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Counterparty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Response = &Acknowledgement_Result{v}
 			iNdEx = postIndex
-		case 4:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionHops", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1539,13 +3830,63 @@ func (m *Channel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionHops = append(m.ConnectionHops, string(dAtA[iNdEx:postIndex]))
+			m.Response = &Acknowledgement_Error{string(dAtA[iNdEx:postIndex])}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Timeout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Timeout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Timeout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowChannel
@@ -1555,29 +3896,30 @@ func (m *Channel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthChannel
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthChannel
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			if err := m.Height.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeSequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			m.UpgradeSequence = 0
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowChannel
@@ -1587,7 +3929,7 @@ func (m *Channel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UpgradeSequence |= uint64(b&0x7F) << shift
+				m.Timestamp |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1613,7 +3955,7 @@ func (m *Channel) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IdentifiedChannel) Unmarshal(dAtA []byte) error {
+func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1636,17 +3978,17 @@ func (m *IdentifiedChannel) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IdentifiedChannel: wiretype end group for non-group")
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IdentifiedChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeTimeout", wireType)
 			}
-			m.State = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowChannel
@@ -1656,16 +3998,30 @@ func (m *IdentifiedChannel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= State(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpgradeTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ordering", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalhostAutoRelay", wireType)
 			}
-			m.Ordering = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowChannel
@@ -1675,14 +4031,48 @@ func (m *IdentifiedChannel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Ordering |= Order(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LocalhostAutoRelay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketIndexEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PacketIndexEnabled = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Counterparty", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradePolicies", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1709,13 +4099,136 @@ func (m *IdentifiedChannel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Counterparty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.UpgradePolicies = append(m.UpgradePolicies, UpgradePolicy{})
+			if err := m.UpgradePolicies[len(m.UpgradePolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoPruning", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AutoPruning.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketSendTimeEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PacketSendTimeEnabled = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketIndexRetentionBlocks", wireType)
+			}
+			m.PacketIndexRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketIndexRetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpgradePolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpgradePolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpgradePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionHops", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1743,11 +4256,11 @@ func (m *IdentifiedChannel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionHops = append(m.ConnectionHops, string(dAtA[iNdEx:postIndex]))
+			m.PortId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedSigners", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1775,13 +4288,13 @@ func (m *IdentifiedChannel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.AllowedSigners = append(m.AllowedSigners, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowCounterpartyInitiated", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowChannel
@@ -1791,29 +4304,17 @@ func (m *IdentifiedChannel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthChannel
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthChannel
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+			m.AllowCounterpartyInitiated = bool(v != 0)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedVersionTransitions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowChannel
@@ -1823,29 +4324,31 @@ func (m *IdentifiedChannel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthChannel
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthChannel
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			m.AllowedVersionTransitions = append(m.AllowedVersionTransitions, VersionTransition{})
+			if err := m.AllowedVersionTransitions[len(m.AllowedVersionTransitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeSequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowOrderingChange", wireType)
 			}
-			m.UpgradeSequence = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowChannel
@@ -1855,11 +4358,12 @@ func (m *IdentifiedChannel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UpgradeSequence |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.AllowOrderingChange = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipChannel(dAtA[iNdEx:])
@@ -1881,7 +4385,7 @@ func (m *IdentifiedChannel) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Counterparty) Unmarshal(dAtA []byte) error {
+func (m *VersionTransition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1904,15 +4408,15 @@ func (m *Counterparty) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Counterparty: wiretype end group for non-group")
+			return fmt.Errorf("proto: VersionTransition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Counterparty: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VersionTransition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1940,11 +4444,11 @@ func (m *Counterparty) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1972,7 +4476,7 @@ func (m *Counterparty) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			m.To = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1995,7 +4499,7 @@ func (m *Counterparty) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Packet) Unmarshal(dAtA []byte) error {
+func (m *AutoPruning) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2018,17 +4522,17 @@ func (m *Packet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Packet: wiretype end group for non-group")
+			return fmt.Errorf("proto: AutoPruning: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Packet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AutoPruning: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			m.Sequence = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowChannel
@@ -2038,211 +4542,36 @@ func (m *Packet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Enabled = bool(v != 0)
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourcePort", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowChannel
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthChannel
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthChannel
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SourcePort = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceChannel", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowChannel
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthChannel
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthChannel
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SourceChannel = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestinationPort", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowChannel
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthChannel
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthChannel
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DestinationPort = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestinationChannel", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowChannel
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthChannel
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthChannel
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DestinationChannel = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowChannel
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthChannel
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthChannel
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrunedPerBlock", wireType)
 			}
-			var msglen int
+			m.MaxPrunedPerBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowChannel
 				}
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthChannel
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthChannel
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TimeoutHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPrunedPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrunedPerChannel", wireType)
 			}
-			m.TimeoutTimestamp = 0
+			m.MaxPrunedPerChannel = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowChannel
@@ -2252,7 +4581,7 @@ func (m *Packet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				m.MaxPrunedPerChannel |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2278,7 +4607,7 @@ func (m *Packet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PacketState) Unmarshal(dAtA []byte) error {
+func (m *LocalhostAutoRelay) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2301,17 +4630,17 @@ func (m *PacketState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PacketState: wiretype end group for non-group")
+			return fmt.Errorf("proto: LocalhostAutoRelay: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PacketState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LocalhostAutoRelay: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowChannel
@@ -2321,29 +4650,17 @@ func (m *PacketState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthChannel
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthChannel
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Enabled = bool(v != 0)
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPacketsPerBlock", wireType)
 			}
-			var stringLen uint64
+			m.MaxPacketsPerBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowChannel
@@ -2353,48 +4670,16 @@ func (m *PacketState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxPacketsPerBlock |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthChannel
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthChannel
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
-			}
-			m.Sequence = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowChannel
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPerPacket", wireType)
 			}
-			var byteLen int
+			m.MaxGasPerPacket = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowChannel
@@ -2404,26 +4689,11 @@ func (m *PacketState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.MaxGasPerPacket |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthChannel
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthChannel
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipChannel(dAtA[iNdEx:])
@@ -2445,7 +4715,7 @@ func (m *PacketState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PacketId) Unmarshal(dAtA []byte) error {
+func (m *IndexedPacket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2468,17 +4738,17 @@ func (m *PacketId) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PacketId: wiretype end group for non-group")
+			return fmt.Errorf("proto: IndexedPacket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PacketId: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IndexedPacket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowChannel
@@ -2488,27 +4758,28 @@ func (m *PacketId) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthChannel
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthChannel
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2536,13 +4807,13 @@ func (m *PacketId) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
 			}
-			m.Sequence = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowChannel
@@ -2552,66 +4823,29 @@ func (m *PacketId) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipChannel(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthChannel
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Acknowledgement) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowChannel
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Acknowledgement: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Acknowledgement: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 21:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var byteLen int
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowChannel
@@ -2621,30 +4855,16 @@ func (m *Acknowledgement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Status |= PacketStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthChannel
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthChannel
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := make([]byte, postIndex-iNdEx)
-			copy(v, dAtA[iNdEx:postIndex])
-			m.Response = &Acknowledgement_Result{v}
-			iNdEx = postIndex
-		case 22:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Acknowledgement", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowChannel
@@ -2654,23 +4874,25 @@ func (m *Acknowledgement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthChannel
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthChannel
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Response = &Acknowledgement_Error{string(dAtA[iNdEx:postIndex])}
+			m.Acknowledgement = append(m.Acknowledgement[:0], dAtA[iNdEx:postIndex]...)
+			if m.Acknowledgement == nil {
+				m.Acknowledgement = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2693,7 +4915,7 @@ func (m *Acknowledgement) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Timeout) Unmarshal(dAtA []byte) error {
+func (m *PacketSendTime) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2716,17 +4938,17 @@ func (m *Timeout) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Timeout: wiretype end group for non-group")
+			return fmt.Errorf("proto: PacketSendTime: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Timeout: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PacketSendTime: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
+			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var msglen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowChannel
@@ -2736,25 +4958,11 @@ func (m *Timeout) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthChannel
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthChannel
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Height.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
@@ -2795,7 +5003,7 @@ func (m *Timeout) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *LocalhostRelayEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2818,15 +5026,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: LocalhostRelayEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LocalhostRelayEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2853,10 +5061,44 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UpgradeTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Acknowledgement", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Acknowledgement = append(m.Acknowledgement[:0], dAtA[iNdEx:postIndex]...)
+			if m.Acknowledgement == nil {
+				m.Acknowledgement = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipChannel(dAtA[iNdEx:])
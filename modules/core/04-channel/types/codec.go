@@ -43,6 +43,8 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgChannelUpgradeCancel{},
 		&MsgPruneAcknowledgements{},
 		&MsgUpdateParams{},
+		&MsgChannelUpgradeInitBatch{},
+		&MsgEnableAcknowledgementPruning{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
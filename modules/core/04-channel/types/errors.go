@@ -60,4 +60,13 @@ var (
 	ErrTimeoutElapsed                  = errorsmod.Register(SubModuleName, 40, "timeout elapsed")
 	ErrPruningSequenceStartNotFound    = errorsmod.Register(SubModuleName, 41, "pruning sequence start not found")
 	ErrRecvStartSequenceNotFound       = errorsmod.Register(SubModuleName, 42, "recv start sequence not found")
+	ErrInvalidLocalhostAutoRelay       = errorsmod.Register(SubModuleName, 43, "invalid localhost auto relay configuration")
+	ErrInvalidUpgradeBatch             = errorsmod.Register(SubModuleName, 45, "invalid upgrade batch")
+	ErrUpgradeBatchNotFound            = errorsmod.Register(SubModuleName, 46, "upgrade batch not found")
+	ErrInvalidUpgradeConnection        = errorsmod.Register(SubModuleName, 47, "invalid upgrade connection")
+	ErrInvalidUpgradePolicy            = errorsmod.Register(SubModuleName, 48, "invalid upgrade policy")
+	ErrUpgradeNotPermitted             = errorsmod.Register(SubModuleName, 49, "channel upgrade not permitted by upgrade policy")
+	ErrPacketSkipped                   = errorsmod.Register(SubModuleName, 50, "packet timed out and was skipped")
+	ErrInvalidAutoPruning              = errorsmod.Register(SubModuleName, 51, "invalid auto pruning configuration")
+	ErrPruningAlreadyEnabled           = errorsmod.Register(SubModuleName, 52, "acknowledgement pruning already enabled")
 )
@@ -22,6 +22,21 @@ const (
 	AttributeKeyUpgradeSequence         = "upgrade_sequence"
 	AttributeKeyErrorReceipt            = "error_receipt"
 
+	// localhost auto relay specific keys
+	AttributeKeyLocalhostRelayError = "error"
+
+	// upgrade batch specific keys
+	AttributeKeyUpgradeBatchID             = "upgrade_batch_id"
+	AttributeKeyUpgradeBatchChannels       = "upgrade_batch_channels"
+	AttributeKeyUpgradeBatchFailedChannels = "upgrade_batch_failed_channels"
+	AttributeKeyUpgradeBatchRetries        = "upgrade_batch_retries"
+	AttributeKeyUpgradeBatchError          = "error"
+
+	// acknowledgement pruning specific keys
+	AttributeKeyPrunedSequences    = "pruned_sequences"
+	AttributeKeyRemainingSequences = "remaining_sequences"
+	AttributeKeyRecvStartSequence  = "recv_start_sequence"
+
 	AttributeCounterpartyPortID    = "counterparty_port_id"
 	AttributeCounterpartyChannelID = "counterparty_channel_id"
 
@@ -30,6 +45,7 @@ const (
 	EventTypeWriteAck          = "write_acknowledgement"
 	EventTypeAcknowledgePacket = "acknowledge_packet"
 	EventTypeTimeoutPacket     = "timeout_packet"
+	EventTypeSkipPacket        = "skip_packet"
 
 	// Deprecated: in favor of AttributeKeyDataHex
 	AttributeKeyData = "packet_data"
@@ -51,22 +67,28 @@ const (
 
 // IBC channel events vars
 var (
-	EventTypeChannelOpenInit       = "channel_open_init"
-	EventTypeChannelOpenTry        = "channel_open_try"
-	EventTypeChannelOpenAck        = "channel_open_ack"
-	EventTypeChannelOpenConfirm    = "channel_open_confirm"
-	EventTypeChannelCloseInit      = "channel_close_init"
-	EventTypeChannelCloseConfirm   = "channel_close_confirm"
-	EventTypeChannelClosed         = "channel_close"
-	EventTypeChannelUpgradeInit    = "channel_upgrade_init"
-	EventTypeChannelUpgradeTry     = "channel_upgrade_try"
-	EventTypeChannelUpgradeAck     = "channel_upgrade_ack"
-	EventTypeChannelUpgradeConfirm = "channel_upgrade_confirm"
-	EventTypeChannelUpgradeOpen    = "channel_upgrade_open"
-	EventTypeChannelUpgradeTimeout = "channel_upgrade_timeout"
-	EventTypeChannelUpgradeCancel  = "channel_upgrade_cancelled"
-	EventTypeChannelUpgradeError   = "channel_upgrade_error"
-	EventTypeChannelFlushComplete  = "channel_flush_complete"
+	EventTypeChannelOpenInit        = "channel_open_init"
+	EventTypeChannelOpenTry         = "channel_open_try"
+	EventTypeChannelOpenAck         = "channel_open_ack"
+	EventTypeChannelOpenConfirm     = "channel_open_confirm"
+	EventTypeChannelCloseInit       = "channel_close_init"
+	EventTypeChannelCloseConfirm    = "channel_close_confirm"
+	EventTypeChannelClosed          = "channel_close"
+	EventTypeChannelUpgradeInit     = "channel_upgrade_init"
+	EventTypeChannelUpgradeTry      = "channel_upgrade_try"
+	EventTypeChannelUpgradeAck      = "channel_upgrade_ack"
+	EventTypeChannelUpgradeConfirm  = "channel_upgrade_confirm"
+	EventTypeChannelUpgradeOpen     = "channel_upgrade_open"
+	EventTypeChannelUpgradeTimeout  = "channel_upgrade_timeout"
+	EventTypeChannelUpgradeCancel   = "channel_upgrade_cancelled"
+	EventTypeChannelUpgradeError    = "channel_upgrade_error"
+	EventTypeChannelFlushComplete   = "channel_flush_complete"
+	EventTypeChannelFlushPacket     = "channel_flush_packet"
+	EventTypeLocalhostRelayFailed   = "localhost_relay_failed"
+	EventTypeChannelUpgradeBatch    = "channel_upgrade_batch"
+	EventTypeChannelUpgradeRetry    = "channel_upgrade_batch_retry"
+	EventTypeAcknowledgementsPruned = "acknowledgements_pruned"
+	EventTypePruningEnabled         = "acknowledgement_pruning_enabled"
 
 	AttributeValueCategory = fmt.Sprintf("%s_%s", ibcexported.ModuleName, SubModuleName)
 )
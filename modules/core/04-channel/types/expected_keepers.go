@@ -55,6 +55,16 @@ type ConnectionKeeper interface {
 		sequence uint64,
 		acknowledgement []byte,
 	) error
+	VerifyPacketReceipt(
+		ctx sdk.Context,
+		connection exported.ConnectionI,
+		height exported.Height,
+		proof []byte,
+		portID,
+		channelID string,
+		sequence uint64,
+		receipt []byte,
+	) error
 	VerifyPacketReceiptAbsence(
 		ctx sdk.Context,
 		connection exported.ConnectionI,
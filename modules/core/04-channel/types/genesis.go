@@ -62,15 +62,17 @@ func NewGenesisState(
 // DefaultGenesisState returns the ibc channel submodule's default genesis state.
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		Channels:            []IdentifiedChannel{},
-		Acknowledgements:    []PacketState{},
-		Receipts:            []PacketState{},
-		Commitments:         []PacketState{},
-		SendSequences:       []PacketSequence{},
-		RecvSequences:       []PacketSequence{},
-		AckSequences:        []PacketSequence{},
-		NextChannelSequence: 0,
-		Params:              DefaultParams(),
+		Channels:              []IdentifiedChannel{},
+		Acknowledgements:      []PacketState{},
+		Receipts:              []PacketState{},
+		Commitments:           []PacketState{},
+		SendSequences:         []PacketSequence{},
+		RecvSequences:         []PacketSequence{},
+		AckSequences:          []PacketSequence{},
+		NextChannelSequence:   0,
+		Params:                DefaultParams(),
+		RecvStartSequences:    []PacketSequence{},
+		PruningSequenceStarts: []PacketSequence{},
 	}
 }
 
@@ -142,9 +144,40 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	for i, rs := range gs.RecvStartSequences {
+		if err := rs.Validate(); err != nil {
+			return fmt.Errorf("invalid recv start sequence %v index %d: %w", rs, i, err)
+		}
+	}
+
+	for i, ps := range gs.PruningSequenceStarts {
+		if err := ps.Validate(); err != nil {
+			return fmt.Errorf("invalid pruning sequence start %v index %d: %w", ps, i, err)
+		}
+	}
+
 	return nil
 }
 
+// ExportOptions defines the options used to omit pruneable state from the exported
+// channel submodule genesis.
+//
+// Closed channels are always exported together with their packet state, even without in-flight
+// packets. A counterparty with in-flight packets on the channel times them out with
+// MsgTimeoutOnClose, which requires a proof of the CLOSED channel end and a proof that the packet
+// was not received. Omitting the channel would make the former impossible and the latter
+// forgeable, since the absence of an omitted receipt can be proven.
+type ExportOptions struct {
+	// OmitPruneableAcknowledgements omits the packet acknowledgements of upgraded channels
+	// which may be pruned, i.e. those with a sequence lower than the channel's recv start sequence.
+	OmitPruneableAcknowledgements bool
+	// OmitPruneableReceipts omits the packet receipts of upgraded channels which may be pruned,
+	// i.e. those with a sequence lower than the channel's recv start sequence. Replay protection
+	// of these packets is retained through the exported recv start sequence. Timeout receipts are
+	// never pruned and are always exported.
+	OmitPruneableReceipts bool
+}
+
 func validateGenFields(portID, channelID string, sequence uint64) error {
 	if err := host.PortIdentifierValidator(portID); err != nil {
 		return fmt.Errorf("invalid port Id: %w", err)
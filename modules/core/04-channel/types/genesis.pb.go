@@ -35,6 +35,10 @@ type GenesisState struct {
 	// the sequence for the next generated channel identifier
 	NextChannelSequence uint64 `protobuf:"varint,8,opt,name=next_channel_sequence,json=nextChannelSequence,proto3" json:"next_channel_sequence,omitempty"`
 	Params              Params `protobuf:"bytes,9,opt,name=params,proto3" json:"params"`
+	// the recv start sequences of upgraded channels, used for replay protection of historical packets
+	RecvStartSequences []PacketSequence `protobuf:"bytes,10,rep,name=recv_start_sequences,json=recvStartSequences,proto3" json:"recv_start_sequences"`
+	// the pruning sequence starts of upgraded channels, used as the lower bound for pruning
+	PruningSequenceStarts []PacketSequence `protobuf:"bytes,11,rep,name=pruning_sequence_starts,json=pruningSequenceStarts,proto3" json:"pruning_sequence_starts"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -133,6 +137,20 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetRecvStartSequences() []PacketSequence {
+	if m != nil {
+		return m.RecvStartSequences
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPruningSequenceStarts() []PacketSequence {
+	if m != nil {
+		return m.PruningSequenceStarts
+	}
+	return nil
+}
+
 // PacketSequence defines the genesis type necessary to retrieve and store
 // next send and receive sequences.
 type PacketSequence struct {
@@ -203,37 +221,39 @@ func init() {
 func init() { proto.RegisterFile("ibc/core/channel/v1/genesis.proto", fileDescriptor_cb06ec201f452595) }
 
 var fileDescriptor_cb06ec201f452595 = []byte{
-	// 471 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x41, 0x6f, 0xd3, 0x30,
-	0x18, 0x86, 0x9b, 0xb5, 0xeb, 0x5a, 0x77, 0x9b, 0xc0, 0x03, 0x11, 0x8a, 0xc8, 0xca, 0x90, 0x50,
-	0x2f, 0x8b, 0x59, 0xe1, 0xc0, 0xae, 0xe5, 0x00, 0xbd, 0xa0, 0x29, 0xbb, 0x21, 0xa1, 0x2a, 0xb1,
-	0x3f, 0x32, 0xab, 0x8d, 0x1d, 0x62, 0xb7, 0xc0, 0xbf, 0xe0, 0xb7, 0xf0, 0x2b, 0x76, 0xdc, 0x91,
-	0xd3, 0x84, 0xda, 0x7f, 0xc1, 0x09, 0xc5, 0x71, 0xb2, 0xa2, 0x95, 0x49, 0xbd, 0xc5, 0xdf, 0xf7,
-	0xbe, 0xcf, 0xfb, 0x1e, 0xf2, 0xa1, 0x67, 0x3c, 0xa2, 0x84, 0xca, 0x0c, 0x08, 0xbd, 0x08, 0x85,
-	0x80, 0x29, 0x99, 0x9f, 0x90, 0x18, 0x04, 0x28, 0xae, 0xfc, 0x34, 0x93, 0x5a, 0xe2, 0x03, 0x1e,
-	0x51, 0x3f, 0x97, 0xf8, 0x56, 0xe2, 0xcf, 0x4f, 0xba, 0x0f, 0x62, 0x19, 0x4b, 0xb3, 0x27, 0xf9,
-	0x57, 0x21, 0xed, 0xae, 0xa5, 0x95, 0x2e, 0x23, 0x39, 0xfa, 0xb9, 0x8d, 0x76, 0xdf, 0x15, 0xfc,
-	0x73, 0x1d, 0x6a, 0xc0, 0x9f, 0x50, 0xcb, 0x2a, 0x94, 0xeb, 0xf4, 0xea, 0xfd, 0xce, 0xe0, 0x85,
-	0xbf, 0x26, 0xd1, 0x1f, 0x31, 0x10, 0x9a, 0x7f, 0xe6, 0xc0, 0xde, 0x16, 0xc3, 0xe1, 0xe3, 0xcb,
-	0xeb, 0xc3, 0xda, 0x9f, 0xeb, 0xc3, 0xfb, 0xb7, 0x56, 0x41, 0x85, 0xc4, 0x01, 0xba, 0x17, 0xd2,
-	0x89, 0x90, 0x5f, 0xa7, 0xc0, 0x62, 0x48, 0x40, 0x68, 0xe5, 0x6e, 0x99, 0x98, 0xde, 0xda, 0x98,
-	0xb3, 0x90, 0x4e, 0x40, 0x9b, 0x6a, 0xc3, 0x46, 0x1e, 0x10, 0xdc, 0xf2, 0xe3, 0xf7, 0xa8, 0x43,
-	0x65, 0x92, 0x70, 0x5d, 0xe0, 0xea, 0x1b, 0xe1, 0x56, 0xad, 0x78, 0x88, 0x5a, 0x19, 0x50, 0xe0,
-	0xa9, 0x56, 0x6e, 0x63, 0x23, 0x4c, 0xe5, 0xc3, 0x67, 0x68, 0x5f, 0x81, 0x60, 0x63, 0x05, 0x5f,
-	0x66, 0x20, 0x28, 0x28, 0x77, 0xdb, 0x90, 0x9e, 0xdf, 0x45, 0xb2, 0x5a, 0x0b, 0xdb, 0xcb, 0x01,
-	0xe5, 0xcc, 0x10, 0x33, 0xa0, 0xf3, 0x15, 0x62, 0x73, 0x63, 0x62, 0x0e, 0xb8, 0x21, 0x7e, 0x40,
-	0x7b, 0x21, 0x9d, 0xac, 0x00, 0x77, 0x36, 0x05, 0xee, 0x86, 0x74, 0x72, 0xc3, 0x1b, 0xa0, 0x87,
-	0x02, 0xbe, 0xe9, 0xb1, 0x75, 0x55, 0x60, 0xb7, 0xd5, 0x73, 0xfa, 0x8d, 0xe0, 0x20, 0x5f, 0xda,
-	0x7f, 0xa1, 0x34, 0xe1, 0x53, 0xd4, 0x4c, 0xc3, 0x2c, 0x4c, 0x94, 0xdb, 0xee, 0x39, 0xfd, 0xce,
-	0xe0, 0xc9, 0x7f, 0xc2, 0x73, 0x89, 0x0d, 0xb5, 0x86, 0x23, 0x86, 0xf6, 0xff, 0x2d, 0x85, 0x1f,
-	0xa1, 0x9d, 0x54, 0x66, 0x7a, 0xcc, 0x99, 0xeb, 0xf4, 0x9c, 0x7e, 0x3b, 0x68, 0xe6, 0xcf, 0x11,
-	0xc3, 0x4f, 0x11, 0x2a, 0x4b, 0x71, 0xe6, 0x6e, 0x99, 0x5d, 0xdb, 0x4e, 0x46, 0x0c, 0x77, 0x51,
-	0xab, 0xea, 0x5a, 0x37, 0x5d, 0xab, 0xf7, 0xf0, 0xfc, 0x72, 0xe1, 0x39, 0x57, 0x0b, 0xcf, 0xf9,
-	0xbd, 0xf0, 0x9c, 0x1f, 0x4b, 0xaf, 0x76, 0xb5, 0xf4, 0x6a, 0xbf, 0x96, 0x5e, 0xed, 0xe3, 0x69,
-	0xcc, 0xf5, 0xc5, 0x2c, 0xf2, 0xa9, 0x4c, 0x08, 0x95, 0x2a, 0x91, 0x8a, 0xf0, 0x88, 0x1e, 0xc7,
-	0x92, 0xcc, 0xdf, 0x90, 0x44, 0xb2, 0xd9, 0x14, 0x54, 0x71, 0x77, 0x2f, 0x5f, 0x1f, 0x97, 0xa7,
-	0xa7, 0xbf, 0xa7, 0xa0, 0xa2, 0xa6, 0x39, 0xbb, 0x57, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xaf,
-	0xa9, 0x60, 0xe8, 0xe9, 0x03, 0x00, 0x00,
+	// 511 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x9b, 0xb5, 0x74, 0xad, 0xbb, 0x4d, 0xe0, 0x6d, 0x5a, 0x28, 0x22, 0x2b, 0x43, 0x42,
+	0xbd, 0x2c, 0x61, 0x85, 0x03, 0xbb, 0x96, 0x03, 0xf4, 0x82, 0xa6, 0xee, 0x06, 0x42, 0x95, 0x6b,
+	0x3f, 0x32, 0xab, 0x8d, 0x1d, 0x62, 0xb7, 0xc0, 0xb7, 0xe0, 0x63, 0xed, 0xb8, 0x23, 0xa7, 0x09,
+	0xb5, 0xdf, 0x80, 0x23, 0x27, 0x14, 0xc7, 0x49, 0x8b, 0x56, 0x90, 0x72, 0xab, 0xdf, 0xfb, 0xff,
+	0x7f, 0xff, 0xbe, 0xa7, 0xe8, 0xa1, 0x27, 0x7c, 0x4c, 0x03, 0x2a, 0x13, 0x08, 0xe8, 0x15, 0x11,
+	0x02, 0xa6, 0xc1, 0xfc, 0x2c, 0x08, 0x41, 0x80, 0xe2, 0xca, 0x8f, 0x13, 0xa9, 0x25, 0xde, 0xe7,
+	0x63, 0xea, 0xa7, 0x12, 0xdf, 0x4a, 0xfc, 0xf9, 0x59, 0xfb, 0x20, 0x94, 0xa1, 0x34, 0xfd, 0x20,
+	0xfd, 0x95, 0x49, 0xdb, 0x1b, 0x69, 0xb9, 0xcb, 0x48, 0x4e, 0x7e, 0xd5, 0xd1, 0xce, 0x9b, 0x8c,
+	0x7f, 0xa9, 0x89, 0x06, 0xfc, 0x11, 0x35, 0xac, 0x42, 0xb9, 0x4e, 0xa7, 0xda, 0x6d, 0xf5, 0x9e,
+	0xf9, 0x1b, 0x12, 0xfd, 0x01, 0x03, 0xa1, 0xf9, 0x27, 0x0e, 0xec, 0x75, 0x56, 0xec, 0x3f, 0xbc,
+	0xbe, 0x3d, 0xae, 0xfc, 0xbe, 0x3d, 0x7e, 0x70, 0xa7, 0x35, 0x2c, 0x90, 0x78, 0x88, 0xee, 0x13,
+	0x3a, 0x11, 0xf2, 0xcb, 0x14, 0x58, 0x08, 0x11, 0x08, 0xad, 0xdc, 0x2d, 0x13, 0xd3, 0xd9, 0x18,
+	0x73, 0x41, 0xe8, 0x04, 0xb4, 0xf9, 0x6b, 0xfd, 0x5a, 0x1a, 0x30, 0xbc, 0xe3, 0xc7, 0x6f, 0x51,
+	0x8b, 0xca, 0x28, 0xe2, 0x3a, 0xc3, 0x55, 0x4b, 0xe1, 0xd6, 0xad, 0xb8, 0x8f, 0x1a, 0x09, 0x50,
+	0xe0, 0xb1, 0x56, 0x6e, 0xad, 0x14, 0xa6, 0xf0, 0xe1, 0x0b, 0xb4, 0xa7, 0x40, 0xb0, 0x91, 0x82,
+	0xcf, 0x33, 0x10, 0x14, 0x94, 0x7b, 0xcf, 0x90, 0x9e, 0xfe, 0x8f, 0x64, 0xb5, 0x16, 0xb6, 0x9b,
+	0x02, 0xf2, 0x9a, 0x21, 0x26, 0x40, 0xe7, 0x6b, 0xc4, 0x7a, 0x69, 0x62, 0x0a, 0x58, 0x11, 0xdf,
+	0xa1, 0x5d, 0x42, 0x27, 0x6b, 0xc0, 0xed, 0xb2, 0xc0, 0x1d, 0x42, 0x27, 0x2b, 0x5e, 0x0f, 0x1d,
+	0x0a, 0xf8, 0xaa, 0x47, 0xd6, 0x55, 0x80, 0xdd, 0x46, 0xc7, 0xe9, 0xd6, 0x86, 0xfb, 0x69, 0xd3,
+	0x7e, 0x0b, 0xb9, 0x09, 0x9f, 0xa3, 0x7a, 0x4c, 0x12, 0x12, 0x29, 0xb7, 0xd9, 0x71, 0xba, 0xad,
+	0xde, 0xa3, 0x7f, 0x84, 0xa7, 0x12, 0x1b, 0x6a, 0x0d, 0xf8, 0x03, 0x3a, 0xc8, 0x16, 0xa2, 0x49,
+	0xa2, 0xd7, 0xa6, 0x40, 0x65, 0xa7, 0xc0, 0x66, 0x2d, 0x29, 0x65, 0x35, 0x0b, 0x41, 0x47, 0x71,
+	0x32, 0x13, 0x5c, 0x84, 0x05, 0x39, 0x0b, 0x52, 0x6e, 0xab, 0x2c, 0xff, 0xd0, 0x92, 0xf2, 0xb2,
+	0x89, 0x52, 0x27, 0x0c, 0xed, 0xfd, 0x2d, 0xc7, 0x47, 0x68, 0x3b, 0x96, 0x89, 0x1e, 0x71, 0xe6,
+	0x3a, 0x1d, 0xa7, 0xdb, 0x1c, 0xd6, 0xd3, 0xe7, 0x80, 0xe1, 0xc7, 0x08, 0xe5, 0x4b, 0xe5, 0xcc,
+	0xdd, 0x32, 0xbd, 0xa6, 0xad, 0x0c, 0x18, 0x6e, 0xa3, 0x46, 0xb1, 0xeb, 0xaa, 0xd9, 0x75, 0xf1,
+	0xee, 0x5f, 0x5e, 0x2f, 0x3c, 0xe7, 0x66, 0xe1, 0x39, 0x3f, 0x17, 0x9e, 0xf3, 0x7d, 0xe9, 0x55,
+	0x6e, 0x96, 0x5e, 0xe5, 0xc7, 0xd2, 0xab, 0xbc, 0x3f, 0x0f, 0xb9, 0xbe, 0x9a, 0x8d, 0x7d, 0x2a,
+	0xa3, 0x80, 0x4a, 0x15, 0x49, 0x15, 0xf0, 0x31, 0x3d, 0x0d, 0x65, 0x30, 0x7f, 0x15, 0x44, 0x92,
+	0xcd, 0xa6, 0xa0, 0xb2, 0xbb, 0xf1, 0xfc, 0xe5, 0x69, 0x7e, 0x3a, 0xf4, 0xb7, 0x18, 0xd4, 0xb8,
+	0x6e, 0xce, 0xc6, 0x8b, 0x3f, 0x03, 0x00, 0xc4, 0x4e, 0xbc, 0x9e, 0xa9, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -256,6 +276,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PruningSequenceStarts) > 0 {
+		for iNdEx := len(m.PruningSequenceStarts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PruningSequenceStarts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.RecvStartSequences) > 0 {
+		for iNdEx := len(m.RecvStartSequences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecvStartSequences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -478,6 +526,18 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.RecvStartSequences) > 0 {
+		for _, e := range m.RecvStartSequences {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PruningSequenceStarts) > 0 {
+		for _, e := range m.PruningSequenceStarts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -826,6 +886,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvStartSequences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecvStartSequences = append(m.RecvStartSequences, PacketSequence{})
+			if err := m.RecvStartSequences[len(m.RecvStartSequences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruningSequenceStarts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PruningSequenceStarts = append(m.PruningSequenceStarts, PacketSequence{})
+			if err := m.PruningSequenceStarts[len(m.PruningSequenceStarts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -33,6 +33,31 @@ func TestValidateGenesis(t *testing.T) {
 			genState: types.DefaultGenesisState(),
 			expPass:  true,
 		},
+		{
+			name: "valid recv start sequences and pruning sequence starts",
+			genState: types.GenesisState{
+				RecvStartSequences:    []types.PacketSequence{types.NewPacketSequence(testPort1, testChannel1, 5)},
+				PruningSequenceStarts: []types.PacketSequence{types.NewPacketSequence(testPort1, testChannel1, 1)},
+				Params:                types.DefaultParams(),
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid recv start sequence",
+			genState: types.GenesisState{
+				RecvStartSequences: []types.PacketSequence{types.NewPacketSequence(testPort1, testChannel1, 0)},
+				Params:             types.DefaultParams(),
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid pruning sequence start",
+			genState: types.GenesisState{
+				PruningSequenceStarts: []types.PacketSequence{types.NewPacketSequence(testPort1, "(invalidchannel)", 1)},
+				Params:                types.DefaultParams(),
+			},
+			expPass: false,
+		},
 		{
 			name: "valid genesis",
 			genState: types.NewGenesisState(
@@ -6,6 +6,8 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 )
 
@@ -31,6 +33,58 @@ const (
 
 	// ParamsKey defines the key to store the params in the keeper.
 	ParamsKey = "channelParams"
+
+	// KeyLocalhostRelayQueuePrefix defines the key prefix under which packets awaiting
+	// automatic relay over the 09-localhost connection are stored.
+	KeyLocalhostRelayQueuePrefix = "localhostRelayQueue"
+
+	// KeyNextLocalhostRelayIndex defines the key used to store the index of the next
+	// entry appended to the localhost relay queue.
+	KeyNextLocalhostRelayIndex = "nextLocalhostRelayIndex"
+
+	// KeyPacketIndexPrefix defines the key prefix under which packets added to the
+	// packet lifecycle index are stored.
+	KeyPacketIndexPrefix = "packetIndex"
+
+	// KeyPacketIndexSenderPrefix defines the key prefix of the packet lifecycle index by sender.
+	KeyPacketIndexSenderPrefix = "packetIndexSender"
+
+	// KeyPacketIndexReceiverPrefix defines the key prefix of the packet lifecycle index by receiver.
+	KeyPacketIndexReceiverPrefix = "packetIndexReceiver"
+
+	// KeyPacketIndexExpiryQueuePrefix defines the key prefix under which acknowledged and timed out packets of
+	// the packet lifecycle index are stored ordered by the height after which they are pruned.
+	KeyPacketIndexExpiryQueuePrefix = "packetIndexExpiryQueue"
+
+	// KeyNextUpgradeBatchSequence defines the key used to store the identifier of the next upgrade batch.
+	KeyNextUpgradeBatchSequence = "nextUpgradeBatchSequence"
+
+	// KeyUpgradeBatchPrefix defines the key prefix under which upgrade batches are stored.
+	KeyUpgradeBatchPrefix = "upgradeBatches"
+
+	// KeyActiveUpgradeBatchPrefix defines the key prefix under which the identifiers of the upgrade batches
+	// whose failed upgrades may still be retried are stored.
+	KeyActiveUpgradeBatchPrefix = "activeUpgradeBatches"
+
+	// KeyAutoPruningCursor defines the key used to store the pruneable channel key of the last
+	// channel pruned automatically, after which automatic pruning resumes in the next block.
+	KeyAutoPruningCursor = "autoPruningCursor"
+
+	// KeyPruneableChannelPrefix defines the key prefix under which the channels with packet acknowledgements
+	// and receipts remaining to be pruned are stored.
+	KeyPruneableChannelPrefix = "pruneableChannels"
+
+	// KeyPacketSendTimePrefix defines the key prefix under which the block height and time at which
+	// packets in flight were sent are stored.
+	KeyPacketSendTimePrefix = "packetSendTime"
+
+	// KeyInflightPacketsPrefix defines the key prefix under which the number of packets in flight
+	// of every channel is stored.
+	KeyInflightPacketsPrefix = "inflightPackets"
+
+	// KeySelfInitiatedUpgradePrefix defines the key prefix under which the channels whose upgrade in progress
+	// was initiated by this chain in ChanUpgradeInit are stored.
+	KeySelfInitiatedUpgradePrefix = "selfInitiatedUpgrades"
 )
 
 // FormatChannelIdentifier returns the channel identifier with the sequence appended.
@@ -64,6 +118,90 @@ func ParseChannelSequence(channelID string) (uint64, error) {
 	return sequence, nil
 }
 
+// LocalhostRelayQueuePrefix returns the prefix key for the localhost relay queue.
+func LocalhostRelayQueuePrefix() []byte {
+	return []byte(fmt.Sprintf("%s/", KeyLocalhostRelayQueuePrefix))
+}
+
+// LocalhostRelayQueueKey returns the store key for the localhost relay queue entry at the given index.
+// The index is big endian encoded so that entries are iterated in the order in which they were queued.
+func LocalhostRelayQueueKey(index uint64) []byte {
+	return append(LocalhostRelayQueuePrefix(), sdk.Uint64ToBigEndian(index)...)
+}
+
+// PacketIndexKey returns the store key under which the indexed packet with the given
+// source port, source channel and sequence is stored.
+func PacketIndexKey(portID, channelID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%d", KeyPacketIndexPrefix, portID, channelID, sequence))
+}
+
+// PacketSendTimeKey returns the store key under which the send time of the packet with the given
+// source port, source channel and sequence is stored.
+func PacketSendTimeKey(portID, channelID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%d", KeyPacketSendTimePrefix, portID, channelID, sequence))
+}
+
+// InflightPacketsKey returns the store key under which the number of packets in flight of the given
+// channel is stored.
+func InflightPacketsKey(portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%s/%s", KeyInflightPacketsPrefix, host.KeyPortPrefix, portID, host.KeyChannelPrefix, channelID))
+}
+
+// PacketIndexSenderPrefix returns the prefix key of the packet lifecycle index for the given sender.
+// The sender is length prefixed to prevent senders which are prefixes of each other from overlapping.
+func PacketIndexSenderPrefix(sender string) []byte {
+	return []byte(fmt.Sprintf("%s/%d/%s/", KeyPacketIndexSenderPrefix, len(sender), sender))
+}
+
+// PacketIndexReceiverPrefix returns the prefix key of the packet lifecycle index for the given receiver.
+// The receiver is length prefixed to prevent receivers which are prefixes of each other from overlapping.
+func PacketIndexReceiverPrefix(receiver string) []byte {
+	return []byte(fmt.Sprintf("%s/%d/%s/", KeyPacketIndexReceiverPrefix, len(receiver), receiver))
+}
+
+// PacketIndexExpiryQueuePrefix returns the prefix key of the packet lifecycle index expiry queue.
+func PacketIndexExpiryQueuePrefix() []byte {
+	return []byte(fmt.Sprintf("%s/", KeyPacketIndexExpiryQueuePrefix))
+}
+
+// PacketIndexExpiryQueueKey returns the store key of the packet lifecycle index expiry queue entry for the packet
+// with the given expiry height, source port, source channel and sequence. The expiry height is big endian encoded
+// so that entries are iterated in the order in which they expire.
+func PacketIndexExpiryQueueKey(expiryHeight uint64, portID, channelID string, sequence uint64) []byte {
+	key := append(PacketIndexExpiryQueuePrefix(), sdk.Uint64ToBigEndian(expiryHeight)...)
+	return append(key, []byte(fmt.Sprintf("/%s/%s/%d", portID, channelID, sequence))...)
+}
+
+// PruneableChannelPrefix returns the prefix key of the pruneable channel index.
+func PruneableChannelPrefix() []byte {
+	return []byte(fmt.Sprintf("%s/", KeyPruneableChannelPrefix))
+}
+
+// PruneableChannelKey returns the store key of the pruneable channel index entry for the given channel.
+func PruneableChannelKey(portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%s/%s", KeyPruneableChannelPrefix, host.KeyPortPrefix, portID, host.KeyChannelPrefix, channelID))
+}
+
+// SelfInitiatedUpgradeKey returns the store key marking the upgrade of the given channel as initiated by this chain.
+func SelfInitiatedUpgradeKey(portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%s/%s", KeySelfInitiatedUpgradePrefix, host.KeyPortPrefix, portID, host.KeyChannelPrefix, channelID))
+}
+
+// UpgradeBatchKey returns the store key of the upgrade batch with the given identifier.
+func UpgradeBatchKey(batchID uint64) []byte {
+	return append([]byte(fmt.Sprintf("%s/", KeyUpgradeBatchPrefix)), sdk.Uint64ToBigEndian(batchID)...)
+}
+
+// ActiveUpgradeBatchPrefix returns the prefix key of the active upgrade batch identifiers.
+func ActiveUpgradeBatchPrefix() []byte {
+	return []byte(fmt.Sprintf("%s/", KeyActiveUpgradeBatchPrefix))
+}
+
+// ActiveUpgradeBatchKey returns the store key marking the upgrade batch with the given identifier as active.
+func ActiveUpgradeBatchKey(batchID uint64) []byte {
+	return append(ActiveUpgradeBatchPrefix(), sdk.Uint64ToBigEndian(batchID)...)
+}
+
 // FilteredPortPrefix returns the prefix key for the given port prefix.
 func FilteredPortPrefix(portPrefix string) []byte {
 	return []byte(fmt.Sprintf("%s/%s/%s", host.KeyChannelEndPrefix, host.KeyPortPrefix, portPrefix))
@@ -0,0 +1,9 @@
+package types
+
+// Prometheus metric labels.
+const (
+	LabelPortID        = "port_id"
+	LabelChannelID     = "channel_id"
+	LabelSourcePort    = "source_port"
+	LabelSourceChannel = "source_channel"
+)
@@ -3,12 +3,14 @@ package types
 import (
 	"encoding/base64"
 	"slices"
+	"strings"
 
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
@@ -32,6 +34,8 @@ var (
 	_ sdk.Msg = (*MsgChannelUpgradeTimeout)(nil)
 	_ sdk.Msg = (*MsgChannelUpgradeCancel)(nil)
 	_ sdk.Msg = (*MsgPruneAcknowledgements)(nil)
+	_ sdk.Msg = (*MsgChannelUpgradeInitBatch)(nil)
+	_ sdk.Msg = (*MsgEnableAcknowledgementPruning)(nil)
 
 	_ sdk.HasValidateBasic = (*MsgChannelOpenInit)(nil)
 	_ sdk.HasValidateBasic = (*MsgChannelOpenTry)(nil)
@@ -50,6 +54,8 @@ var (
 	_ sdk.HasValidateBasic = (*MsgChannelUpgradeTimeout)(nil)
 	_ sdk.HasValidateBasic = (*MsgChannelUpgradeCancel)(nil)
 	_ sdk.HasValidateBasic = (*MsgPruneAcknowledgements)(nil)
+	_ sdk.HasValidateBasic = (*MsgChannelUpgradeInitBatch)(nil)
+	_ sdk.HasValidateBasic = (*MsgEnableAcknowledgementPruning)(nil)
 )
 
 // NewMsgChannelOpenInit creates a new MsgChannelOpenInit. It sets the counterparty channel
@@ -774,3 +780,71 @@ func (msg *MsgPruneAcknowledgements) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgChannelUpgradeInitBatch creates a new instance of MsgChannelUpgradeInitBatch.
+func NewMsgChannelUpgradeInitBatch(authority string, filter UpgradeBatchFilter, ordering Order, version string, retryPolicy UpgradeBatchRetryPolicy) *MsgChannelUpgradeInitBatch {
+	return &MsgChannelUpgradeInitBatch{
+		Authority:   authority,
+		Filter:      filter,
+		Ordering:    ordering,
+		Version:     version,
+		RetryPolicy: retryPolicy,
+	}
+}
+
+// ValidateBasic performs basic checks on a MsgChannelUpgradeInitBatch.
+func (msg *MsgChannelUpgradeInitBatch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if err := msg.Filter.Validate(); err != nil {
+		return err
+	}
+
+	// the version is application specific, all upgraded channels must therefore be bound to the same port
+	if msg.Filter.PortId == "" {
+		return errorsmod.Wrap(ErrInvalidUpgradeBatch, "port ID must be set in the filter")
+	}
+
+	if msg.Ordering != NONE && !slices.Contains(connectiontypes.SupportedOrderings, msg.Ordering.String()) {
+		return errorsmod.Wrap(ErrInvalidChannelOrdering, msg.Ordering.String())
+	}
+
+	if strings.TrimSpace(msg.Version) == "" {
+		return errorsmod.Wrap(ErrInvalidChannelVersion, "version cannot be empty")
+	}
+
+	return nil
+}
+
+// NewMsgEnableAcknowledgementPruning creates a new instance of MsgEnableAcknowledgementPruning.
+func NewMsgEnableAcknowledgementPruning(authority, portID, channelID string, recvStartSequence uint64) *MsgEnableAcknowledgementPruning {
+	return &MsgEnableAcknowledgementPruning{
+		Authority:         authority,
+		PortId:            portID,
+		ChannelId:         channelID,
+		RecvStartSequence: recvStartSequence,
+	}
+}
+
+// ValidateBasic performs basic checks on a MsgEnableAcknowledgementPruning.
+func (msg *MsgEnableAcknowledgementPruning) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return errorsmod.Wrap(err, "invalid port ID")
+	}
+
+	if !IsValidChannelID(msg.ChannelId) {
+		return ErrInvalidChannelIdentifier
+	}
+
+	if msg.RecvStartSequence <= 1 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidSequence, "recv start sequence must be greater than one")
+	}
+
+	return nil
+}
@@ -22,6 +22,7 @@ import (
 
 	ibc "github.com/cosmos/ibc-go/v8/modules/core"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
@@ -175,9 +176,9 @@ func (suite *TypesTestSuite) TestMsgChannelOpenInitValidateBasic() {
 		},
 		{
 			"invalid channel order",
-			types.NewMsgChannelOpenInit(portid, version, types.Order(3),
+			types.NewMsgChannelOpenInit(portid, version, types.Order(4),
 				connHops, cpportid, addr),
-			errorsmod.Wrap(types.ErrInvalidChannelOrdering, types.Order(3).String()),
+			errorsmod.Wrap(types.ErrInvalidChannelOrdering, types.Order(4).String()),
 		},
 		{
 			"connection hops more than 1 ",
@@ -1895,6 +1896,76 @@ func (suite *TypesTestSuite) TestMsgUpdateParamsValidateBasic() {
 			},
 			types.ErrInvalidUpgradeTimeout,
 		},
+		{
+			"success: valid upgrade policy",
+			func() {
+				msg.Params.UpgradePolicies = []types.UpgradePolicy{
+					types.NewUpgradePolicy(ibctesting.MockPort, []string{addr}, true, []types.VersionTransition{types.NewVersionTransition(mock.Version, mock.UpgradeVersion)}, false),
+				}
+			},
+			nil,
+		},
+		{
+			"invalid params: upgrade policy with invalid port ID",
+			func() {
+				msg.Params.UpgradePolicies = []types.UpgradePolicy{types.NewUpgradePolicy("", nil, true, nil, false)}
+			},
+			types.ErrInvalidUpgradePolicy,
+		},
+		{
+			"invalid params: upgrade policy with invalid allowed signer",
+			func() {
+				msg.Params.UpgradePolicies = []types.UpgradePolicy{types.NewUpgradePolicy(ibctesting.MockPort, []string{"invalid-address"}, true, nil, false)}
+			},
+			types.ErrInvalidUpgradePolicy,
+		},
+		{
+			"invalid params: upgrade policy with empty version transition",
+			func() {
+				msg.Params.UpgradePolicies = []types.UpgradePolicy{
+					types.NewUpgradePolicy(ibctesting.MockPort, nil, true, []types.VersionTransition{types.NewVersionTransition(mock.Version, "")}, false),
+				}
+			},
+			types.ErrInvalidUpgradePolicy,
+		},
+		{
+			"success: auto pruning enabled",
+			func() {
+				msg.Params.AutoPruning = types.NewAutoPruning(true, types.DefaultAutoPruningMaxPrunedPerBlock, types.DefaultAutoPruningMaxPrunedPerChannel)
+			},
+			nil,
+		},
+		{
+			"success: auto pruning disabled with zero limits",
+			func() {
+				msg.Params.AutoPruning = types.NewAutoPruning(false, 0, 0)
+			},
+			nil,
+		},
+		{
+			"invalid params: auto pruning enabled with zero max pruned per block",
+			func() {
+				msg.Params.AutoPruning = types.NewAutoPruning(true, 0, types.DefaultAutoPruningMaxPrunedPerChannel)
+			},
+			types.ErrInvalidAutoPruning,
+		},
+		{
+			"invalid params: auto pruning enabled with zero max pruned per channel",
+			func() {
+				msg.Params.AutoPruning = types.NewAutoPruning(true, types.DefaultAutoPruningMaxPrunedPerBlock, 0)
+			},
+			types.ErrInvalidAutoPruning,
+		},
+		{
+			"invalid params: duplicate upgrade policies",
+			func() {
+				msg.Params.UpgradePolicies = []types.UpgradePolicy{
+					types.NewUpgradePolicy(ibctesting.MockPort, nil, true, nil, false),
+					types.NewUpgradePolicy(ibctesting.MockPort, nil, false, nil, true),
+				}
+			},
+			types.ErrInvalidUpgradePolicy,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1926,3 +1997,168 @@ func (suite *TypesTestSuite) TestMsgPruneAcknowledgementsGetSigners() {
 	suite.Require().NoError(err)
 	suite.Require().Equal(expSigner.Bytes(), signers[0])
 }
+
+func (suite *TypesTestSuite) TestMsgChannelUpgradeInitBatchValidateBasic() {
+	var msg *types.MsgChannelUpgradeInitBatch
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: unspecified ordering",
+			func() {
+				msg.Ordering = types.NONE
+			},
+			nil,
+		},
+		{
+			"success: filter on connection and counterparty chain",
+			func() {
+				msg.Filter = types.NewUpgradeBatchFilter(ibctesting.MockPort, ibctesting.FirstConnectionID, "testchain")
+			},
+			nil,
+		},
+		{
+			"empty authority address",
+			func() {
+				msg.Authority = emptyAddr
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"empty port identifier",
+			func() {
+				msg.Filter.PortId = ""
+			},
+			types.ErrInvalidUpgradeBatch,
+		},
+		{
+			"invalid port identifier",
+			func() {
+				msg.Filter.PortId = invalidPort
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"invalid connection identifier",
+			func() {
+				msg.Filter.ConnectionId = invalidConnection
+			},
+			connectiontypes.ErrInvalidConnectionIdentifier,
+		},
+		{
+			"counterparty chain identifier with surrounding whitespace",
+			func() {
+				msg.Filter.CounterpartyChainId = " testchain "
+			},
+			types.ErrInvalidUpgradeBatch,
+		},
+		{
+			"unsupported ordering",
+			func() {
+				msg.Ordering = types.Order(100)
+			},
+			types.ErrInvalidChannelOrdering,
+		},
+		{
+			"empty version",
+			func() {
+				msg.Version = "  "
+			},
+			types.ErrInvalidChannelVersion,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			filter := types.NewUpgradeBatchFilter(ibctesting.MockPort, "", "")
+			msg = types.NewMsgChannelUpgradeInitBatch(addr, filter, types.UNORDERED, mock.UpgradeVersion, types.NewUpgradeBatchRetryPolicy(1))
+
+			tc.malleate()
+			err := msg.ValidateBasic()
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgEnableAcknowledgementPruningValidateBasic() {
+	var msg *types.MsgEnableAcknowledgementPruning
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"invalid authority",
+			func() {
+				msg.Authority = "invalid-address"
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"invalid port identifier",
+			func() {
+				msg.PortId = invalidPort
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"invalid channel identifier",
+			func() {
+				msg.ChannelId = invalidChannel
+			},
+			types.ErrInvalidChannelIdentifier,
+		},
+		{
+			"recv start sequence is zero",
+			func() {
+				msg.RecvStartSequence = 0
+			},
+			ibcerrors.ErrInvalidSequence,
+		},
+		{
+			"recv start sequence is one",
+			func() {
+				msg.RecvStartSequence = 1
+			},
+			ibcerrors.ErrInvalidSequence,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			msg = types.NewMsgEnableAcknowledgementPruning(authtypes.NewModuleAddress(govtypes.ModuleName).String(), ibctesting.MockPort, ibctesting.FirstChannelID, 10)
+
+			tc.malleate()
+			err := msg.ValidateBasic()
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
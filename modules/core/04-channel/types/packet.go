@@ -13,6 +13,10 @@ import (
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 )
 
+// TimeoutReceipt is the packet receipt written by the receiving chain of an ORDERED_ALLOW_TIMEOUT channel
+// when a packet has timed out and is skipped. It is proven by the sending chain in order to time out the packet.
+var TimeoutReceipt = []byte{byte(2)}
+
 // CommitPacket returns the packet commitment bytes. The commitment consists of:
 // sha256_hash(timeout_timestamp + timeout_height.RevisionNumber + timeout_height.RevisionHeight + sha256_hash(data))
 // from a given packet. This results in a fixed length preimage.
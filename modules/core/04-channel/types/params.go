@@ -1,11 +1,16 @@
 package types
 
 import (
+	"slices"
+	"strings"
 	"time"
 
 	errorsmod "cosmossdk.io/errors"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 )
 
 // DefaultTimeout defines a default parameter for the channel upgrade protocol.
@@ -13,10 +18,85 @@ import (
 // This parameter can be overridden by a valid authority using the UpdateChannelParams rpc.
 var DefaultTimeout = NewTimeout(clienttypes.ZeroHeight(), uint64(10*time.Minute.Nanoseconds()))
 
+const (
+	// DefaultLocalhostMaxPacketsPerBlock defines the default maximum number of packets and acknowledgements
+	// relayed over the 09-localhost connection in a single block.
+	DefaultLocalhostMaxPacketsPerBlock uint64 = 100
+
+	// DefaultLocalhostMaxGasPerPacket defines the default gas limit for relaying a single packet or
+	// acknowledgement over the 09-localhost connection.
+	DefaultLocalhostMaxGasPerPacket uint64 = 1_000_000
+
+	// DefaultAutoPruningMaxPrunedPerBlock defines the default maximum number of sequences whose packet
+	// acknowledgement and receipt are pruned in a single block.
+	DefaultAutoPruningMaxPrunedPerBlock uint64 = 1000
+
+	// DefaultAutoPruningMaxPrunedPerChannel defines the default maximum number of sequences of a single
+	// channel whose packet acknowledgement and receipt are pruned in a single block.
+	DefaultAutoPruningMaxPrunedPerChannel uint64 = 100
+
+	// DefaultPacketIndexRetentionBlocks defines the default number of blocks for which acknowledged and timed
+	// out packets remain in the packet lifecycle index.
+	DefaultPacketIndexRetentionBlocks uint64 = 100_000
+
+	// MaxIndexedPacketsPrunedPerBlock defines the maximum number of completed packets pruned from the packet
+	// lifecycle index in a single block once their retention period has elapsed.
+	MaxIndexedPacketsPrunedPerBlock = 500
+)
+
+// DefaultLocalhostAutoRelay defines the default configuration for relaying packets over the 09-localhost
+// connection. Automatic relaying is disabled by default and must be enabled using the UpdateChannelParams rpc.
+var DefaultLocalhostAutoRelay = NewLocalhostAutoRelay(false, DefaultLocalhostMaxPacketsPerBlock, DefaultLocalhostMaxGasPerPacket)
+
+// DefaultAutoPruning defines the default configuration for pruning packet acknowledgements and receipts in
+// EndBlock. Automatic pruning is disabled by default and must be enabled using the UpdateChannelParams rpc.
+var DefaultAutoPruning = NewAutoPruning(false, DefaultAutoPruningMaxPrunedPerBlock, DefaultAutoPruningMaxPrunedPerChannel)
+
 // NewParams creates a new parameter configuration for the channel submodule
 func NewParams(upgradeTimeout Timeout) Params {
 	return Params{
-		UpgradeTimeout: upgradeTimeout,
+		UpgradeTimeout:     upgradeTimeout,
+		LocalhostAutoRelay: DefaultLocalhostAutoRelay,
+		AutoPruning:        DefaultAutoPruning,
+
+		PacketIndexRetentionBlocks: DefaultPacketIndexRetentionBlocks,
+	}
+}
+
+// NewLocalhostAutoRelay creates a new LocalhostAutoRelay configuration.
+func NewLocalhostAutoRelay(enabled bool, maxPacketsPerBlock, maxGasPerPacket uint64) LocalhostAutoRelay {
+	return LocalhostAutoRelay{
+		Enabled:            enabled,
+		MaxPacketsPerBlock: maxPacketsPerBlock,
+		MaxGasPerPacket:    maxGasPerPacket,
+	}
+}
+
+// NewAutoPruning creates a new AutoPruning configuration.
+func NewAutoPruning(enabled bool, maxPrunedPerBlock, maxPrunedPerChannel uint64) AutoPruning {
+	return AutoPruning{
+		Enabled:             enabled,
+		MaxPrunedPerBlock:   maxPrunedPerBlock,
+		MaxPrunedPerChannel: maxPrunedPerChannel,
+	}
+}
+
+// NewUpgradePolicy creates a new UpgradePolicy for the given port.
+func NewUpgradePolicy(portID string, allowedSigners []string, allowCounterpartyInitiated bool, allowedVersionTransitions []VersionTransition, allowOrderingChange bool) UpgradePolicy {
+	return UpgradePolicy{
+		PortId:                     portID,
+		AllowedSigners:             allowedSigners,
+		AllowCounterpartyInitiated: allowCounterpartyInitiated,
+		AllowedVersionTransitions:  allowedVersionTransitions,
+		AllowOrderingChange:        allowOrderingChange,
+	}
+}
+
+// NewVersionTransition creates a new VersionTransition.
+func NewVersionTransition(from, to string) VersionTransition {
+	return VersionTransition{
+		From: from,
+		To:   to,
 	}
 }
 
@@ -33,5 +113,105 @@ func (p Params) Validate() error {
 	if p.UpgradeTimeout.Timestamp == 0 {
 		return errorsmod.Wrapf(ErrInvalidUpgradeTimeout, "upgrade timeout timestamp invalid: %v", p.UpgradeTimeout.Timestamp)
 	}
+	if err := p.LocalhostAutoRelay.Validate(); err != nil {
+		return err
+	}
+	if err := p.AutoPruning.Validate(); err != nil {
+		return err
+	}
+
+	portIDs := make(map[string]bool, len(p.UpgradePolicies))
+	for _, policy := range p.UpgradePolicies {
+		if err := policy.Validate(); err != nil {
+			return err
+		}
+		if portIDs[policy.PortId] {
+			return errorsmod.Wrapf(ErrInvalidUpgradePolicy, "duplicate upgrade policy for port ID %s", policy.PortId)
+		}
+		portIDs[policy.PortId] = true
+	}
+	return nil
+}
+
+// GetUpgradePolicy returns the upgrade policy of the given port and a boolean indicating if a policy was found.
+func (p Params) GetUpgradePolicy(portID string) (UpgradePolicy, bool) {
+	for _, policy := range p.UpgradePolicies {
+		if policy.PortId == portID {
+			return policy, true
+		}
+	}
+	return UpgradePolicy{}, false
+}
+
+// Validate performs basic validation of the LocalhostAutoRelay configuration.
+// The per block and per packet limits are only required to be non-zero when automatic relaying is enabled.
+func (r LocalhostAutoRelay) Validate() error {
+	if !r.Enabled {
+		return nil
+	}
+	if r.MaxPacketsPerBlock == 0 {
+		return errorsmod.Wrap(ErrInvalidLocalhostAutoRelay, "max packets per block cannot be zero")
+	}
+	if r.MaxGasPerPacket == 0 {
+		return errorsmod.Wrap(ErrInvalidLocalhostAutoRelay, "max gas per packet cannot be zero")
+	}
+	return nil
+}
+
+// Validate performs basic validation of the AutoPruning configuration.
+// The per block and per channel limits are only required to be non-zero when automatic pruning is enabled.
+func (ap AutoPruning) Validate() error {
+	if !ap.Enabled {
+		return nil
+	}
+	if ap.MaxPrunedPerBlock == 0 {
+		return errorsmod.Wrap(ErrInvalidAutoPruning, "max pruned per block cannot be zero")
+	}
+	if ap.MaxPrunedPerChannel == 0 {
+		return errorsmod.Wrap(ErrInvalidAutoPruning, "max pruned per channel cannot be zero")
+	}
+	return nil
+}
+
+// Validate performs basic validation of the UpgradePolicy.
+func (up UpgradePolicy) Validate() error {
+	if err := host.PortIdentifierValidator(up.PortId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidUpgradePolicy, "invalid port ID: %s", err)
+	}
+	for _, signer := range up.AllowedSigners {
+		if _, err := sdk.AccAddressFromBech32(signer); err != nil {
+			return errorsmod.Wrapf(ErrInvalidUpgradePolicy, "invalid allowed signer address %s: %s", signer, err)
+		}
+	}
+	for _, transition := range up.AllowedVersionTransitions {
+		if strings.TrimSpace(transition.From) == "" || strings.TrimSpace(transition.To) == "" {
+			return errorsmod.Wrapf(ErrInvalidUpgradePolicy, "version transition from %q to %q cannot contain empty versions", transition.From, transition.To)
+		}
+	}
+	return nil
+}
+
+// IsAllowedSigner returns true if the given signer, other than the authority, may initiate upgrades of channels
+// bound to the port of the policy.
+func (up UpgradePolicy) IsAllowedSigner(signer string) bool {
+	return slices.Contains(up.AllowedSigners, signer)
+}
+
+// ValidateUpgradeFields returns an error if upgrading the given channel to the proposed upgrade fields is not
+// permitted by the policy. Upgrades which do not change the channel version are not restricted by the allowed
+// version transitions.
+func (up UpgradePolicy) ValidateUpgradeFields(channel Channel, upgradeFields UpgradeFields) error {
+	if !up.AllowOrderingChange && upgradeFields.Ordering != channel.Ordering {
+		return errorsmod.Wrapf(ErrUpgradeNotPermitted, "ordering of channels bound to port %s cannot change from %s to %s", up.PortId, channel.Ordering, upgradeFields.Ordering)
+	}
+
+	if len(up.AllowedVersionTransitions) == 0 || upgradeFields.Version == channel.Version {
+		return nil
+	}
+
+	if !slices.Contains(up.AllowedVersionTransitions, NewVersionTransition(channel.Version, upgradeFields.Version)) {
+		return errorsmod.Wrapf(ErrUpgradeNotPermitted, "version of channels bound to port %s cannot change from %s to %s", up.PortId, channel.Version, upgradeFields.Version)
+	}
+
 	return nil
 }
@@ -1630,6 +1630,206 @@ func (m *MsgPruneAcknowledgementsResponse) GetTotalRemainingSequences() uint64 {
 	return 0
 }
 
+// MsgChannelUpgradeInitBatch defines the request type for the ChannelUpgradeInitBatch rpc. It initializes the
+// upgrade of all open channels matching the filter to the proposed ordering and version.
+type MsgChannelUpgradeInitBatch struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// the criteria the upgraded channels must match
+	Filter UpgradeBatchFilter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter"`
+	// the proposed ordering of the upgraded channels
+	Ordering Order `protobuf:"varint,3,opt,name=ordering,proto3,enum=ibc.core.channel.v1.Order" json:"ordering,omitempty"`
+	// the proposed version of the upgraded channels
+	Version string `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	// the policy used to retry failed upgrades
+	RetryPolicy UpgradeBatchRetryPolicy `protobuf:"bytes,5,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy"`
+}
+
+func (m *MsgChannelUpgradeInitBatch) Reset()         { *m = MsgChannelUpgradeInitBatch{} }
+func (m *MsgChannelUpgradeInitBatch) String() string { return proto.CompactTextString(m) }
+func (*MsgChannelUpgradeInitBatch) ProtoMessage()    {}
+func (*MsgChannelUpgradeInitBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{38}
+}
+func (m *MsgChannelUpgradeInitBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChannelUpgradeInitBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChannelUpgradeInitBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgChannelUpgradeInitBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChannelUpgradeInitBatch.Merge(m, src)
+}
+func (m *MsgChannelUpgradeInitBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChannelUpgradeInitBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChannelUpgradeInitBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgChannelUpgradeInitBatch proto.InternalMessageInfo
+
+// MsgChannelUpgradeInitBatchResponse defines the MsgChannelUpgradeInitBatch response type.
+type MsgChannelUpgradeInitBatchResponse struct {
+	// the identifier of the upgrade batch
+	BatchId uint64 `protobuf:"varint,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	// the number of channels whose upgrade was initialized
+	InitializedChannels uint64 `protobuf:"varint,2,opt,name=initialized_channels,json=initializedChannels,proto3" json:"initialized_channels,omitempty"`
+	// the number of channels whose upgrade could not be initialized
+	FailedChannels uint64 `protobuf:"varint,3,opt,name=failed_channels,json=failedChannels,proto3" json:"failed_channels,omitempty"`
+}
+
+func (m *MsgChannelUpgradeInitBatchResponse) Reset()         { *m = MsgChannelUpgradeInitBatchResponse{} }
+func (m *MsgChannelUpgradeInitBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgChannelUpgradeInitBatchResponse) ProtoMessage()    {}
+func (*MsgChannelUpgradeInitBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{39}
+}
+func (m *MsgChannelUpgradeInitBatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChannelUpgradeInitBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChannelUpgradeInitBatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgChannelUpgradeInitBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChannelUpgradeInitBatchResponse.Merge(m, src)
+}
+func (m *MsgChannelUpgradeInitBatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChannelUpgradeInitBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChannelUpgradeInitBatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgChannelUpgradeInitBatchResponse proto.InternalMessageInfo
+
+func (m *MsgChannelUpgradeInitBatchResponse) GetBatchId() uint64 {
+	if m != nil {
+		return m.BatchId
+	}
+	return 0
+}
+
+func (m *MsgChannelUpgradeInitBatchResponse) GetInitializedChannels() uint64 {
+	if m != nil {
+		return m.InitializedChannels
+	}
+	return 0
+}
+
+func (m *MsgChannelUpgradeInitBatchResponse) GetFailedChannels() uint64 {
+	if m != nil {
+		return m.FailedChannels
+	}
+	return 0
+}
+
+// MsgEnableAcknowledgementPruning defines the request type for the EnableAcknowledgementPruning rpc. It enables
+// the pruning of the packet acknowledgements and receipts of a channel which has never been upgraded, by setting
+// the recv start sequence of the channel to the given sequence. Packets with a lower sequence are rejected as
+// already received, the authority must therefore ensure that every packet sent by the counterparty with a lower
+// sequence has been received and acknowledged on the counterparty, or timed out.
+type MsgEnableAcknowledgementPruning struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// the port identifier of the channel
+	PortId string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// the channel identifier of the channel
+	ChannelId string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// the sequence below which the acknowledgements and receipts of the channel may be pruned
+	RecvStartSequence uint64 `protobuf:"varint,4,opt,name=recv_start_sequence,json=recvStartSequence,proto3" json:"recv_start_sequence,omitempty"`
+}
+
+func (m *MsgEnableAcknowledgementPruning) Reset()         { *m = MsgEnableAcknowledgementPruning{} }
+func (m *MsgEnableAcknowledgementPruning) String() string { return proto.CompactTextString(m) }
+func (*MsgEnableAcknowledgementPruning) ProtoMessage()    {}
+func (*MsgEnableAcknowledgementPruning) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{40}
+}
+func (m *MsgEnableAcknowledgementPruning) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEnableAcknowledgementPruning) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEnableAcknowledgementPruning.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEnableAcknowledgementPruning) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEnableAcknowledgementPruning.Merge(m, src)
+}
+func (m *MsgEnableAcknowledgementPruning) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEnableAcknowledgementPruning) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEnableAcknowledgementPruning.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEnableAcknowledgementPruning proto.InternalMessageInfo
+
+// MsgEnableAcknowledgementPruningResponse defines the MsgEnableAcknowledgementPruning response type.
+type MsgEnableAcknowledgementPruningResponse struct {
+}
+
+func (m *MsgEnableAcknowledgementPruningResponse) Reset() {
+	*m = MsgEnableAcknowledgementPruningResponse{}
+}
+func (m *MsgEnableAcknowledgementPruningResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgEnableAcknowledgementPruningResponse) ProtoMessage()    {}
+func (*MsgEnableAcknowledgementPruningResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{41}
+}
+func (m *MsgEnableAcknowledgementPruningResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEnableAcknowledgementPruningResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEnableAcknowledgementPruningResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEnableAcknowledgementPruningResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEnableAcknowledgementPruningResponse.Merge(m, src)
+}
+func (m *MsgEnableAcknowledgementPruningResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEnableAcknowledgementPruningResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEnableAcknowledgementPruningResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEnableAcknowledgementPruningResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("ibc.core.channel.v1.ResponseResultType", ResponseResultType_name, ResponseResultType_value)
 	proto.RegisterType((*MsgChannelOpenInit)(nil), "ibc.core.channel.v1.MsgChannelOpenInit")
@@ -1670,136 +1870,154 @@ func init() {
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ibc.core.channel.v1.MsgUpdateParamsResponse")
 	proto.RegisterType((*MsgPruneAcknowledgements)(nil), "ibc.core.channel.v1.MsgPruneAcknowledgements")
 	proto.RegisterType((*MsgPruneAcknowledgementsResponse)(nil), "ibc.core.channel.v1.MsgPruneAcknowledgementsResponse")
+	proto.RegisterType((*MsgChannelUpgradeInitBatch)(nil), "ibc.core.channel.v1.MsgChannelUpgradeInitBatch")
+	proto.RegisterType((*MsgChannelUpgradeInitBatchResponse)(nil), "ibc.core.channel.v1.MsgChannelUpgradeInitBatchResponse")
+	proto.RegisterType((*MsgEnableAcknowledgementPruning)(nil), "ibc.core.channel.v1.MsgEnableAcknowledgementPruning")
+	proto.RegisterType((*MsgEnableAcknowledgementPruningResponse)(nil), "ibc.core.channel.v1.MsgEnableAcknowledgementPruningResponse")
 }
 
 func init() { proto.RegisterFile("ibc/core/channel/v1/tx.proto", fileDescriptor_bc4637e0ac3fc7b7) }
 
 var fileDescriptor_bc4637e0ac3fc7b7 = []byte{
-	// 1975 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcf, 0x6f, 0xdb, 0xc8,
-	0x15, 0x36, 0xf5, 0x33, 0x7e, 0x4e, 0xd6, 0x0a, 0xe5, 0xc4, 0x32, 0x6d, 0x4b, 0x8a, 0x5a, 0x6c,
-	0xbc, 0x6e, 0x22, 0xad, 0xbd, 0x49, 0xd1, 0x0d, 0x16, 0x68, 0x1d, 0x55, 0xe9, 0x1a, 0x88, 0x63,
-	0x83, 0xb2, 0x8a, 0x76, 0xb7, 0xa8, 0x20, 0x53, 0x13, 0x8a, 0x90, 0x44, 0x72, 0x49, 0x4a, 0xbb,
-	0x2e, 0xd0, 0x62, 0xd1, 0x53, 0x90, 0xc3, 0xa2, 0x05, 0xf6, 0x1a, 0xa0, 0x45, 0xff, 0x81, 0x3d,
-	0xf7, 0xc7, 0xa1, 0xb7, 0x3d, 0x15, 0x7b, 0x5c, 0x14, 0xe8, 0xa2, 0x48, 0x0e, 0xb9, 0xf4, 0x2f,
-	0x28, 0x50, 0xa0, 0xe0, 0xcc, 0x90, 0xa2, 0xc8, 0xa1, 0x44, 0x59, 0xaa, 0xd1, 0x9b, 0x38, 0xf3,
-	0xcd, 0x7b, 0x6f, 0xbe, 0xef, 0xcd, 0xe3, 0xcc, 0x50, 0xb0, 0xa5, 0x9c, 0x49, 0x15, 0x49, 0x33,
-	0x50, 0x45, 0xea, 0xb4, 0x54, 0x15, 0xf5, 0x2a, 0xc3, 0xbd, 0x8a, 0xf5, 0x49, 0x59, 0x37, 0x34,
-	0x4b, 0xe3, 0xb3, 0xca, 0x99, 0x54, 0xb6, 0x7b, 0xcb, 0xb4, 0xb7, 0x3c, 0xdc, 0x13, 0xd6, 0x64,
-	0x4d, 0xd6, 0x70, 0x7f, 0xc5, 0xfe, 0x45, 0xa0, 0xc2, 0xba, 0xa4, 0x99, 0x7d, 0xcd, 0xac, 0xf4,
-	0x4d, 0xd9, 0x36, 0xd1, 0x37, 0x65, 0xda, 0x51, 0x18, 0x79, 0xe8, 0x29, 0x48, 0xb5, 0xec, 0x5e,
-	0xf2, 0x8b, 0x02, 0x6e, 0xb1, 0x42, 0x70, 0xfc, 0x4d, 0x80, 0x0c, 0x74, 0xd9, 0x68, 0xb5, 0x11,
-	0x81, 0x94, 0x3e, 0xe7, 0x80, 0x3f, 0x32, 0xe5, 0x2a, 0xe9, 0x3f, 0xd6, 0x91, 0x7a, 0xa8, 0x2a,
-	0x16, 0xbf, 0x0e, 0x69, 0x5d, 0x33, 0xac, 0xa6, 0xd2, 0xce, 0x71, 0x45, 0x6e, 0x67, 0x59, 0x4c,
-	0xd9, 0x8f, 0x87, 0x6d, 0xfe, 0x3d, 0x48, 0x53, 0x5b, 0xb9, 0x58, 0x91, 0xdb, 0x59, 0xd9, 0xdf,
-	0x2a, 0x33, 0x26, 0x5b, 0xa6, 0xf6, 0x1e, 0x26, 0xbe, 0xfc, 0xa6, 0xb0, 0x24, 0x3a, 0x43, 0xf8,
-	0x9b, 0x90, 0x32, 0x15, 0x59, 0x45, 0x46, 0x2e, 0x4e, 0xac, 0x92, 0xa7, 0x07, 0xab, 0xcf, 0x7e,
-	0x57, 0x58, 0xfa, 0xf5, 0xeb, 0x2f, 0x76, 0x69, 0x43, 0xe9, 0x43, 0x10, 0x82, 0x51, 0x89, 0xc8,
-	0xd4, 0x35, 0xd5, 0x44, 0xfc, 0x36, 0x00, 0xb5, 0x38, 0x0a, 0x70, 0x99, 0xb6, 0x1c, 0xb6, 0xf9,
-	0x1c, 0xa4, 0x87, 0xc8, 0x30, 0x15, 0x4d, 0xc5, 0x31, 0x2e, 0x8b, 0xce, 0xe3, 0x83, 0x84, 0xed,
-	0xa7, 0xf4, 0x4d, 0x0c, 0xae, 0x8f, 0x5b, 0x3f, 0x35, 0xce, 0xc3, 0xa7, 0xbc, 0x0f, 0x59, 0xdd,
-	0x40, 0x43, 0x45, 0x1b, 0x98, 0x4d, 0x8f, 0x5b, 0x6c, 0xfa, 0x61, 0x2c, 0xc7, 0x89, 0xd7, 0x9d,
-	0xee, 0xaa, 0x1b, 0x82, 0x87, 0xa6, 0xf8, 0xec, 0x34, 0xed, 0xc1, 0x9a, 0xa4, 0x0d, 0x54, 0x0b,
-	0x19, 0x7a, 0xcb, 0xb0, 0xce, 0x9b, 0xce, 0x6c, 0x12, 0x38, 0xae, 0xac, 0xb7, 0xef, 0xc7, 0xa4,
-	0xcb, 0xa6, 0x44, 0x37, 0x34, 0xed, 0x69, 0x53, 0x51, 0x15, 0x2b, 0x97, 0x2c, 0x72, 0x3b, 0x57,
-	0xc5, 0x65, 0xdc, 0x82, 0xf5, 0xac, 0xc2, 0x55, 0xd2, 0xdd, 0x41, 0x8a, 0xdc, 0xb1, 0x72, 0x29,
-	0x1c, 0x94, 0xe0, 0x09, 0x8a, 0xa4, 0xd6, 0x70, 0xaf, 0xfc, 0x3e, 0x46, 0xd0, 0x90, 0x56, 0xf0,
-	0x28, 0xd2, 0xe4, 0x51, 0x2f, 0x3d, 0x59, 0xbd, 0x0f, 0x60, 0x23, 0xc0, 0xaf, 0x2b, 0x9e, 0x47,
-	0x1d, 0x6e, 0x4c, 0x1d, 0x9f, 0xac, 0x31, 0x9f, 0xac, 0x54, 0xbc, 0xbf, 0x06, 0xc4, 0x3b, 0x90,
-	0xba, 0xe1, 0xe2, 0x4d, 0xb6, 0xc9, 0x7f, 0x17, 0xd6, 0xc7, 0x98, 0xf6, 0x60, 0x49, 0x86, 0xde,
-	0xf0, 0x76, 0x8f, 0xf4, 0xbd, 0x80, 0x42, 0x9b, 0x40, 0xf4, 0x68, 0x5a, 0xc6, 0x39, 0x15, 0xe8,
-	0x0a, 0x6e, 0xb0, 0x93, 0xef, 0x72, 0xf5, 0xd9, 0xf4, 0xeb, 0x73, 0x20, 0x75, 0x1d, 0x7d, 0x4a,
-	0x7f, 0xe7, 0xe0, 0xc6, 0x78, 0x6f, 0x55, 0x53, 0x9f, 0x2a, 0x46, 0xff, 0xc2, 0x24, 0xbb, 0x33,
-	0x6f, 0x49, 0x5d, 0x4c, 0xab, 0x33, 0x73, 0x5b, 0x39, 0xff, 0xcc, 0x13, 0xf3, 0xcd, 0x3c, 0x39,
-	0x79, 0xe6, 0x05, 0xd8, 0x66, 0xce, 0xcd, 0x9d, 0xfd, 0x10, 0xb2, 0x23, 0x40, 0xb5, 0xa7, 0x99,
-	0x68, 0x72, 0x3d, 0x9c, 0x32, 0xf5, 0xc8, 0x05, 0x6f, 0x1b, 0x36, 0x19, 0x7e, 0xdd, 0xb0, 0x7e,
-	0x1f, 0x83, 0x9b, 0xbe, 0xfe, 0x79, 0x55, 0x19, 0xaf, 0x18, 0xf1, 0x69, 0x15, 0x63, 0x91, 0xba,
-	0xf0, 0x0f, 0x61, 0x7b, 0x6c, 0xf9, 0xd0, 0x77, 0x52, 0xd3, 0x44, 0x1f, 0x0d, 0x90, 0x2a, 0x21,
-	0x9c, 0xff, 0x09, 0x71, 0xd3, 0x0b, 0x6a, 0x10, 0x4c, 0x9d, 0x42, 0x82, 0x14, 0x16, 0x21, 0xcf,
-	0xa6, 0xc8, 0x65, 0xf1, 0x15, 0x07, 0xd7, 0x8e, 0x4c, 0x59, 0x44, 0xd2, 0xf0, 0xa4, 0x25, 0x75,
-	0x91, 0xc5, 0xbf, 0x0b, 0x29, 0x1d, 0xff, 0xc2, 0xdc, 0xad, 0xec, 0x6f, 0x32, 0xcb, 0x34, 0x01,
-	0xd3, 0x09, 0xd2, 0x01, 0xfc, 0x5b, 0x90, 0x21, 0x04, 0x49, 0x5a, 0xbf, 0xaf, 0x58, 0x7d, 0xa4,
-	0x5a, 0x98, 0xe4, 0xab, 0xe2, 0x2a, 0x6e, 0xaf, 0xba, 0xcd, 0x01, 0x2e, 0xe3, 0xf3, 0x71, 0x99,
-	0x98, 0x9c, 0x4a, 0x3f, 0xc7, 0xeb, 0x77, 0x34, 0x49, 0xb7, 0xf2, 0x7e, 0x1f, 0x52, 0x06, 0x32,
-	0x07, 0x3d, 0x32, 0xd9, 0x37, 0xf6, 0x6f, 0x33, 0x27, 0xeb, 0xc0, 0x45, 0x0c, 0x3d, 0x3d, 0xd7,
-	0x91, 0x48, 0x87, 0xd1, 0x0a, 0xfc, 0x59, 0x0c, 0xe0, 0xc8, 0x94, 0x4f, 0x95, 0x3e, 0xd2, 0x06,
-	0x8b, 0xa1, 0x70, 0xa0, 0x1a, 0x48, 0x42, 0xca, 0x10, 0xb5, 0xc7, 0x28, 0x6c, 0xb8, 0xcd, 0x8b,
-	0xa1, 0xf0, 0x0e, 0xf0, 0x2a, 0xfa, 0xc4, 0x72, 0xd3, 0xac, 0x69, 0x20, 0x69, 0x88, 0xe9, 0x4c,
-	0x88, 0x19, 0xbb, 0xc7, 0x49, 0x2e, 0x9b, 0xbc, 0xe8, 0x45, 0xe5, 0x43, 0xbc, 0x85, 0xa2, 0x7c,
-	0x2c, 0x9a, 0xed, 0x7f, 0x93, 0xf7, 0x1d, 0xb5, 0x7e, 0xac, 0xe2, 0xc4, 0xbe, 0x24, 0xd2, 0x0b,
-	0xb0, 0x42, 0x53, 0xdc, 0x76, 0x4a, 0x6b, 0x04, 0xa9, 0x1a, 0x24, 0x8c, 0x85, 0x14, 0x09, 0xb6,
-	0x2a, 0xc9, 0xa9, 0xaa, 0xa4, 0x66, 0x2b, 0x29, 0xe9, 0x0b, 0x94, 0x94, 0x33, 0xfc, 0xa2, 0x1c,
-	0xe7, 0x7e, 0xd1, 0x02, 0x3f, 0x8b, 0xe1, 0xf4, 0x39, 0x90, 0xba, 0xaa, 0xf6, 0x71, 0x0f, 0xb5,
-	0x65, 0x84, 0x6b, 0xc6, 0x1c, 0x0a, 0xef, 0xc0, 0x6a, 0x6b, 0xdc, 0x9a, 0x23, 0xb0, 0xaf, 0x79,
-	0x24, 0xb0, 0x3d, 0xb0, 0x3d, 0x26, 0xf0, 0x81, 0xdd, 0x72, 0xc9, 0x6f, 0x67, 0x09, 0xef, 0xfa,
-	0x7d, 0x4c, 0x2c, 0x9a, 0xef, 0x3f, 0x8e, 0xed, 0x6f, 0x68, 0x0a, 0xcc, 0xf5, 0x92, 0xff, 0x01,
-	0xa4, 0x9e, 0x2a, 0xa8, 0xd7, 0x36, 0x69, 0x55, 0x2a, 0x31, 0x03, 0xa3, 0x9e, 0x1e, 0x61, 0xa4,
-	0xa3, 0x18, 0x19, 0x17, 0xbd, 0xb6, 0x7f, 0xc6, 0x79, 0x37, 0x30, 0x9e, 0xe0, 0x5d, 0x96, 0xde,
-	0x83, 0x34, 0x4d, 0x7d, 0x9a, 0x38, 0x5b, 0x93, 0xa2, 0x71, 0x4e, 0x1e, 0x74, 0x88, 0x5d, 0x1c,
-	0x02, 0x0b, 0x27, 0x86, 0x17, 0xce, 0xea, 0xc0, 0xb7, 0x58, 0x08, 0x9b, 0xff, 0x89, 0xc3, 0x5a,
-	0x20, 0xa0, 0x89, 0xc7, 0xa9, 0x29, 0x64, 0xfe, 0x08, 0x8a, 0xba, 0xa1, 0xe9, 0x9a, 0x89, 0xda,
-	0xee, 0x1a, 0x96, 0x34, 0x55, 0x45, 0x92, 0xa5, 0x68, 0x6a, 0xb3, 0xa3, 0xe9, 0x36, 0xcd, 0xf1,
-	0x9d, 0x65, 0x71, 0xdb, 0xc1, 0x51, 0xaf, 0x55, 0x17, 0xf5, 0xbe, 0xa6, 0x9b, 0x7c, 0x07, 0x36,
-	0x99, 0x05, 0x81, 0x4a, 0x95, 0x98, 0x51, 0xaa, 0x0d, 0x46, 0xe1, 0x20, 0x80, 0xe9, 0xa5, 0x27,
-	0x39, 0xb5, 0xf4, 0xf0, 0xdf, 0x82, 0x6b, 0xb4, 0xd4, 0xd2, 0x63, 0x63, 0x0a, 0xaf, 0x45, 0xb2,
-	0xfa, 0x28, 0xbb, 0x23, 0x90, 0xa3, 0x70, 0xda, 0x03, 0xa2, 0x16, 0x03, 0x4b, 0xf6, 0xca, 0x7c,
-	0x4b, 0x76, 0x79, 0x72, 0x42, 0xfe, 0x8d, 0x83, 0x2d, 0x96, 0xfe, 0x97, 0x9e, 0x8f, 0x9e, 0xf2,
-	0x10, 0x9f, 0xa7, 0x3c, 0xfc, 0x23, 0xc6, 0x48, 0xe8, 0x79, 0x8e, 0x98, 0x0d, 0xdf, 0x51, 0xd1,
-	0x61, 0x23, 0x1e, 0x99, 0x8d, 0x2c, 0x23, 0x71, 0x82, 0x09, 0x93, 0x88, 0x92, 0x30, 0xc9, 0x08,
-	0x09, 0xf3, 0xbf, 0x3d, 0x7b, 0x22, 0x46, 0xbe, 0x78, 0x8e, 0x9f, 0x8b, 0xaa, 0xf2, 0x7f, 0x8a,
-	0x43, 0x2e, 0xe0, 0x67, 0xde, 0x23, 0xd3, 0x4f, 0x40, 0x60, 0xde, 0x16, 0x98, 0x56, 0xcb, 0x42,
-	0x34, 0xed, 0x04, 0x66, 0xbc, 0x75, 0x1b, 0x21, 0xe6, 0x18, 0x97, 0x09, 0xb8, 0x27, 0x34, 0x49,
-	0x12, 0x0b, 0x4e, 0x92, 0x64, 0x94, 0x24, 0x49, 0x45, 0x48, 0x92, 0xf4, 0x7c, 0x49, 0x72, 0x65,
-	0x72, 0x92, 0x28, 0x50, 0x0c, 0x13, 0x6f, 0xd1, 0x89, 0xf2, 0x69, 0x9c, 0xb1, 0x1d, 0x38, 0xd6,
-	0x91, 0xfa, 0x7f, 0x98, 0x25, 0x53, 0x5f, 0x34, 0x89, 0x0b, 0xbc, 0x68, 0x58, 0x29, 0x71, 0xb9,
-	0x25, 0xa1, 0xc0, 0xd8, 0xd3, 0xd8, 0x0a, 0xb8, 0xe7, 0xf6, 0x3f, 0xc7, 0x18, 0x8b, 0xd9, 0x39,
-	0x7f, 0x2e, 0xaa, 0x2e, 0xcf, 0x7e, 0x5f, 0x9b, 0x65, 0x08, 0x15, 0xad, 0x2e, 0xfb, 0xf9, 0x4d,
-	0xce, 0xc7, 0x6f, 0x6a, 0x32, 0xbf, 0x25, 0xc6, 0x6a, 0xf2, 0x9d, 0x56, 0x4b, 0x7f, 0x89, 0xc1,
-	0x7a, 0x70, 0xc9, 0xb5, 0x54, 0x09, 0xf5, 0x2e, 0xcc, 0xf0, 0x63, 0xb8, 0x86, 0x0c, 0x43, 0x33,
-	0x9a, 0xf8, 0x40, 0xa9, 0x3b, 0x87, 0xf6, 0x5b, 0x4c, 0x6a, 0x6b, 0x36, 0x52, 0x24, 0x40, 0x3a,
-	0xdb, 0xab, 0xc8, 0xd3, 0xc6, 0x97, 0x21, 0x4b, 0x38, 0x1b, 0xb7, 0x49, 0xe8, 0xbd, 0x8e, 0xbb,
-	0xbc, 0x36, 0x2e, 0x99, 0xe3, 0x5b, 0x50, 0x08, 0xa1, 0xcf, 0xa5, 0xf8, 0x57, 0xb0, 0x7a, 0x64,
-	0xca, 0x0d, 0xbd, 0xdd, 0xb2, 0xd0, 0x49, 0xcb, 0x68, 0xf5, 0x4d, 0x7e, 0x0b, 0x96, 0x5b, 0x03,
-	0xab, 0xa3, 0x19, 0x8a, 0x75, 0xee, 0x7c, 0xc7, 0x70, 0x1b, 0xc8, 0x11, 0xd0, 0xc6, 0xd1, 0x4f,
-	0x2d, 0x61, 0x47, 0x40, 0x1b, 0x32, 0x3a, 0x02, 0xda, 0x4f, 0x0f, 0x78, 0x27, 0xbe, 0x91, 0xb9,
-	0xd2, 0x06, 0x56, 0xd8, 0xeb, 0xdf, 0x0d, 0xed, 0xb7, 0x1c, 0x5e, 0x60, 0x27, 0xc6, 0x40, 0x45,
-	0xbe, 0xe3, 0x97, 0x79, 0x61, 0xf9, 0xd7, 0x20, 0xd9, 0x53, 0xfa, 0xf4, 0x6e, 0x31, 0x21, 0x92,
-	0x87, 0xe8, 0x47, 0x9d, 0xcf, 0x39, 0x9c, 0xb6, 0xcc, 0x98, 0xdc, 0x97, 0xc0, 0x3d, 0xb8, 0x69,
-	0x69, 0x56, 0xab, 0xd7, 0xd4, 0x6d, 0x58, 0xdb, 0xad, 0x84, 0x26, 0x0e, 0x35, 0x21, 0xae, 0xe1,
-	0x5e, 0x6c, 0xa3, 0xed, 0x94, 0x40, 0x93, 0x7f, 0x00, 0x1b, 0x64, 0x94, 0x81, 0xfa, 0x2d, 0x45,
-	0x55, 0x54, 0xd9, 0x33, 0x90, 0x6c, 0x2f, 0xd7, 0x31, 0x40, 0x74, 0xfa, 0xdd, 0xb1, 0xbb, 0x5f,
-	0x73, 0xc0, 0x07, 0x5f, 0x2a, 0xfc, 0x7d, 0x28, 0x8a, 0xb5, 0xfa, 0xc9, 0xf1, 0x93, 0x7a, 0xad,
-	0x29, 0xd6, 0xea, 0x8d, 0xc7, 0xa7, 0xcd, 0xd3, 0x9f, 0x9e, 0xd4, 0x9a, 0x8d, 0x27, 0xf5, 0x93,
-	0x5a, 0xf5, 0xf0, 0xd1, 0x61, 0xed, 0x87, 0x99, 0x25, 0x61, 0xf5, 0xf9, 0x8b, 0xe2, 0x8a, 0xa7,
-	0x89, 0xbf, 0x0d, 0x1b, 0xcc, 0x61, 0x4f, 0x8e, 0x8f, 0x4f, 0x32, 0x9c, 0x70, 0xe5, 0xf9, 0x8b,
-	0x62, 0xc2, 0xfe, 0xcd, 0xdf, 0x85, 0x2d, 0x26, 0xb0, 0xde, 0xa8, 0x56, 0x6b, 0xf5, 0x7a, 0x26,
-	0x26, 0xac, 0x3c, 0x7f, 0x51, 0x4c, 0xd3, 0xc7, 0x50, 0xf8, 0xa3, 0x83, 0xc3, 0xc7, 0x0d, 0xb1,
-	0x96, 0x89, 0x13, 0x38, 0x7d, 0x14, 0x12, 0xcf, 0xfe, 0x90, 0x5f, 0xda, 0xff, 0x57, 0x06, 0xe2,
-	0x47, 0xa6, 0xcc, 0x77, 0x61, 0xd5, 0xff, 0x3d, 0x90, 0xfd, 0x72, 0x0d, 0x7e, 0xa2, 0x13, 0x2a,
-	0x11, 0x81, 0xae, 0x82, 0x1d, 0x78, 0xc3, 0xf7, 0x21, 0xee, 0xcd, 0x08, 0x26, 0x4e, 0x8d, 0x73,
-	0xa1, 0x1c, 0x0d, 0x17, 0xe2, 0xc9, 0xde, 0xd2, 0x47, 0xf1, 0x74, 0x20, 0x75, 0x23, 0x79, 0xf2,
-	0xee, 0x61, 0x2d, 0xe0, 0x19, 0x9f, 0x4f, 0x76, 0x23, 0x58, 0xa1, 0x58, 0x61, 0x3f, 0x3a, 0xd6,
-	0xf5, 0xaa, 0x42, 0x26, 0xf0, 0xdd, 0x62, 0x67, 0x8a, 0x1d, 0x17, 0x29, 0xbc, 0x1d, 0x15, 0xe9,
-	0xfa, 0xfb, 0x18, 0xb2, 0xac, 0xef, 0x11, 0xdf, 0x89, 0x62, 0xc8, 0x99, 0xe7, 0x3b, 0x33, 0x80,
-	0x5d, 0xc7, 0x3f, 0x03, 0xf0, 0x5c, 0xe1, 0x97, 0xc2, 0x4c, 0x8c, 0x30, 0xc2, 0xee, 0x74, 0x8c,
-	0x6b, 0xbd, 0x0e, 0x69, 0x67, 0x6b, 0x51, 0x08, 0x1b, 0x46, 0x01, 0xc2, 0xed, 0x29, 0x00, 0x6f,
-	0xee, 0xf9, 0x6e, 0x70, 0xdf, 0x9c, 0x32, 0x94, 0xe2, 0xc2, 0x73, 0x2f, 0xe4, 0x56, 0xb2, 0x0b,
-	0xab, 0xfe, 0xab, 0xc4, 0xd0, 0x28, 0x7d, 0xc0, 0xf0, 0xc5, 0x1b, 0x76, 0x25, 0x37, 0x4a, 0x74,
-	0xef, 0x3d, 0xda, 0xb4, 0x44, 0xf7, 0x60, 0xa7, 0x26, 0x3a, 0xeb, 0x8a, 0xeb, 0x23, 0xb8, 0x1e,
-	0xbc, 0x6f, 0x7a, 0x2b, 0x9a, 0x21, 0xbb, 0x70, 0xec, 0x45, 0x86, 0x86, 0xbb, 0xb4, 0xcb, 0x47,
-	0x44, 0x97, 0x76, 0x05, 0xd9, 0x8b, 0x0c, 0x75, 0x5d, 0xfe, 0x12, 0x6e, 0xb0, 0x4f, 0xaf, 0x77,
-	0xa3, 0xd9, 0x72, 0x96, 0xd8, 0xfd, 0x99, 0xe0, 0xe1, 0xd2, 0xe2, 0x33, 0x51, 0x44, 0x69, 0x6d,
-	0x6c, 0x54, 0x69, 0xbd, 0x3b, 0xfd, 0xe0, 0xa4, 0x9d, 0xa5, 0x18, 0x71, 0xd2, 0xce, 0xc2, 0xbc,
-	0x3f, 0x13, 0xdc, 0x75, 0xff, 0x0b, 0x58, 0x63, 0xee, 0x80, 0xef, 0x44, 0xe4, 0x10, 0xa3, 0x85,
-	0x7b, 0xb3, 0xa0, 0x5d, 0xdf, 0x0a, 0x64, 0xc9, 0xde, 0x8c, 0xa2, 0xe8, 0x16, 0xf1, 0xdb, 0x61,
-	0xc6, 0xbc, 0x1b, 0x39, 0xe1, 0x4e, 0x14, 0x94, 0x97, 0x65, 0xf6, 0x56, 0x2f, 0x94, 0x65, 0x26,
-	0x3c, 0x9c, 0xe5, 0x89, 0x9b, 0x36, 0x21, 0xf9, 0xe9, 0xeb, 0x2f, 0x76, 0xb9, 0x87, 0xf5, 0x2f,
-	0x5f, 0xe6, 0xb9, 0xaf, 0x5e, 0xe6, 0xb9, 0x7f, 0xbe, 0xcc, 0x73, 0xbf, 0x79, 0x95, 0x5f, 0xfa,
-	0xea, 0x55, 0x7e, 0xe9, 0xeb, 0x57, 0xf9, 0xa5, 0x0f, 0xde, 0x95, 0x15, 0xab, 0x33, 0x38, 0x2b,
-	0x4b, 0x5a, 0xbf, 0x42, 0xff, 0x1f, 0xa5, 0x9c, 0x49, 0x77, 0x65, 0xad, 0x32, 0xfc, 0x5e, 0xa5,
-	0xaf, 0xb5, 0x07, 0x3d, 0x64, 0x92, 0xff, 0x35, 0xbd, 0x7d, 0xef, 0xae, 0xf3, 0xd7, 0x26, 0xeb,
-	0x5c, 0x47, 0xe6, 0x59, 0x0a, 0xff, 0xad, 0xe9, 0x9d, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0xf2,
-	0x6f, 0x97, 0x8a, 0xa1, 0x25, 0x00, 0x00,
+	// 2202 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xdf, 0x6f, 0xdb, 0xd6,
+	0x15, 0x36, 0x25, 0x59, 0xb2, 0x8f, 0x9d, 0xd8, 0xa6, 0x9c, 0x58, 0xa6, 0x7f, 0x29, 0xda, 0x50,
+	0x3b, 0x5e, 0x22, 0xd5, 0x6e, 0xd2, 0xad, 0x41, 0x80, 0xcd, 0xd6, 0x94, 0xd5, 0x40, 0x1c, 0x1b,
+	0x94, 0x3d, 0x6c, 0xed, 0x30, 0x41, 0xa6, 0x6e, 0x64, 0xc2, 0x12, 0xc9, 0x92, 0x94, 0x5a, 0x15,
+	0xd8, 0x50, 0x6c, 0x0f, 0x0b, 0xf2, 0x50, 0x6c, 0x43, 0xdf, 0x86, 0x00, 0x1b, 0xf6, 0x0f, 0xf4,
+	0x79, 0xdd, 0x06, 0xec, 0xad, 0x4f, 0x43, 0x1f, 0x8b, 0x01, 0x2b, 0x86, 0xe4, 0xa1, 0xff, 0xc3,
+	0x80, 0x01, 0xc3, 0xbd, 0xbc, 0xbc, 0xa2, 0xa8, 0x4b, 0x8a, 0xb2, 0x34, 0x63, 0x6f, 0xe2, 0xbd,
+	0xdf, 0x3d, 0xe7, 0xdc, 0xef, 0x3b, 0xf7, 0x90, 0x87, 0x14, 0xac, 0xaa, 0x67, 0x4a, 0x41, 0xd1,
+	0x4d, 0x54, 0x50, 0xce, 0xab, 0x9a, 0x86, 0x1a, 0x85, 0xf6, 0x4e, 0xc1, 0xfe, 0x20, 0x6f, 0x98,
+	0xba, 0xad, 0x8b, 0x69, 0xf5, 0x4c, 0xc9, 0xe3, 0xd9, 0x3c, 0x9d, 0xcd, 0xb7, 0x77, 0xa4, 0xc5,
+	0xba, 0x5e, 0xd7, 0xc9, 0x7c, 0x01, 0xff, 0x72, 0xa0, 0xd2, 0x92, 0xa2, 0x5b, 0x4d, 0xdd, 0x2a,
+	0x34, 0xad, 0x3a, 0x36, 0xd1, 0xb4, 0xea, 0x74, 0x62, 0xa3, 0xeb, 0xa1, 0xa1, 0x22, 0xcd, 0xc6,
+	0xb3, 0xce, 0x2f, 0x0a, 0xb8, 0xc5, 0x0b, 0xc1, 0xf5, 0x17, 0x02, 0x69, 0x19, 0x75, 0xb3, 0x5a,
+	0x43, 0x0e, 0x24, 0xf7, 0x89, 0x00, 0xe2, 0xa1, 0x55, 0x2f, 0x3a, 0xf3, 0x47, 0x06, 0xd2, 0x0e,
+	0x34, 0xd5, 0x16, 0x97, 0x20, 0x65, 0xe8, 0xa6, 0x5d, 0x51, 0x6b, 0x19, 0x21, 0x2b, 0x6c, 0x4d,
+	0xcb, 0x49, 0x7c, 0x79, 0x50, 0x13, 0x1f, 0x42, 0x8a, 0xda, 0xca, 0xc4, 0xb2, 0xc2, 0xd6, 0xcc,
+	0xee, 0x6a, 0x9e, 0xb3, 0xd9, 0x3c, 0xb5, 0xb7, 0x9f, 0xf8, 0xfc, 0xab, 0x8d, 0x09, 0xd9, 0x5d,
+	0x22, 0xde, 0x84, 0xa4, 0xa5, 0xd6, 0x35, 0x64, 0x66, 0xe2, 0x8e, 0x55, 0xe7, 0xea, 0xc1, 0xdc,
+	0xb3, 0xdf, 0x6f, 0x4c, 0xfc, 0xe2, 0xeb, 0x4f, 0xb7, 0xe9, 0x40, 0xee, 0x5d, 0x90, 0xfa, 0xa3,
+	0x92, 0x91, 0x65, 0xe8, 0x9a, 0x85, 0xc4, 0x35, 0x00, 0x6a, 0xb1, 0x1b, 0xe0, 0x34, 0x1d, 0x39,
+	0xa8, 0x89, 0x19, 0x48, 0xb5, 0x91, 0x69, 0xa9, 0xba, 0x46, 0x62, 0x9c, 0x96, 0xdd, 0xcb, 0x07,
+	0x09, 0xec, 0x27, 0xf7, 0x55, 0x0c, 0x16, 0x7a, 0xad, 0x9f, 0x98, 0x9d, 0xe0, 0x2d, 0xef, 0x42,
+	0xda, 0x30, 0x51, 0x5b, 0xd5, 0x5b, 0x56, 0xc5, 0xe3, 0x96, 0x98, 0xde, 0x8f, 0x65, 0x04, 0x79,
+	0xc1, 0x9d, 0x2e, 0xb2, 0x10, 0x3c, 0x34, 0xc5, 0x87, 0xa7, 0x69, 0x07, 0x16, 0x15, 0xbd, 0xa5,
+	0xd9, 0xc8, 0x34, 0xaa, 0xa6, 0xdd, 0xa9, 0xb8, 0xbb, 0x49, 0x90, 0xb8, 0xd2, 0xde, 0xb9, 0x1f,
+	0x3a, 0x53, 0x98, 0x12, 0xc3, 0xd4, 0xf5, 0xa7, 0x15, 0x55, 0x53, 0xed, 0xcc, 0x64, 0x56, 0xd8,
+	0x9a, 0x95, 0xa7, 0xc9, 0x08, 0xd1, 0xb3, 0x08, 0xb3, 0xce, 0xf4, 0x39, 0x52, 0xeb, 0xe7, 0x76,
+	0x26, 0x49, 0x82, 0x92, 0x3c, 0x41, 0x39, 0xa9, 0xd5, 0xde, 0xc9, 0xbf, 0x4d, 0x10, 0x34, 0xa4,
+	0x19, 0xb2, 0xca, 0x19, 0xf2, 0xa8, 0x97, 0x0a, 0x57, 0xef, 0x1d, 0x58, 0xee, 0xe3, 0x97, 0x89,
+	0xe7, 0x51, 0x47, 0xe8, 0x51, 0xc7, 0x27, 0x6b, 0xcc, 0x27, 0x2b, 0x15, 0xef, 0x6f, 0x7d, 0xe2,
+	0xed, 0x29, 0x17, 0xc1, 0xe2, 0x85, 0xdb, 0x14, 0xdf, 0x84, 0xa5, 0x1e, 0xa6, 0x3d, 0x58, 0x27,
+	0x43, 0x6f, 0x78, 0xa7, 0xbb, 0xfa, 0x5e, 0x42, 0xa1, 0x15, 0x70, 0xf4, 0xa8, 0xd8, 0x66, 0x87,
+	0x0a, 0x34, 0x45, 0x06, 0x70, 0xf2, 0x5d, 0xad, 0x3e, 0x2b, 0x7e, 0x7d, 0xf6, 0x94, 0x0b, 0x57,
+	0x9f, 0xdc, 0x3f, 0x04, 0xb8, 0xd1, 0x3b, 0x5b, 0xd4, 0xb5, 0xa7, 0xaa, 0xd9, 0xbc, 0x34, 0xc9,
+	0x6c, 0xe7, 0x55, 0xe5, 0x22, 0x13, 0xf7, 0xec, 0x1c, 0x2b, 0xe7, 0xdf, 0x79, 0x62, 0xb4, 0x9d,
+	0x4f, 0x86, 0xef, 0x7c, 0x03, 0xd6, 0xb8, 0x7b, 0x63, 0xbb, 0x6f, 0x43, 0xba, 0x0b, 0x28, 0x36,
+	0x74, 0x0b, 0x85, 0xd7, 0xc3, 0x01, 0x5b, 0x8f, 0x5c, 0xf0, 0xd6, 0x60, 0x85, 0xe3, 0x97, 0x85,
+	0xf5, 0x87, 0x18, 0xdc, 0xf4, 0xcd, 0x8f, 0xaa, 0x4a, 0x6f, 0xc5, 0x88, 0x0f, 0xaa, 0x18, 0xe3,
+	0xd4, 0x45, 0xdc, 0x87, 0xb5, 0x9e, 0xe3, 0x43, 0xef, 0x49, 0x15, 0x0b, 0xbd, 0xd7, 0x42, 0x9a,
+	0x82, 0x48, 0xfe, 0x27, 0xe4, 0x15, 0x2f, 0xe8, 0xd4, 0xc1, 0x94, 0x29, 0xa4, 0x9f, 0xc2, 0x2c,
+	0xac, 0xf3, 0x29, 0x62, 0x2c, 0xbe, 0x12, 0xe0, 0xda, 0xa1, 0x55, 0x97, 0x91, 0xd2, 0x3e, 0xae,
+	0x2a, 0x17, 0xc8, 0x16, 0xdf, 0x82, 0xa4, 0x41, 0x7e, 0x11, 0xee, 0x66, 0x76, 0x57, 0xb8, 0x65,
+	0xda, 0x01, 0xd3, 0x0d, 0xd2, 0x05, 0xe2, 0x6d, 0x98, 0x77, 0x08, 0x52, 0xf4, 0x66, 0x53, 0xb5,
+	0x9b, 0x48, 0xb3, 0x09, 0xc9, 0xb3, 0xf2, 0x1c, 0x19, 0x2f, 0xb2, 0xe1, 0x3e, 0x2e, 0xe3, 0xa3,
+	0x71, 0x99, 0x08, 0x4f, 0xa5, 0x9f, 0xc2, 0x8d, 0x9e, 0x4d, 0xb2, 0xca, 0xfb, 0x5d, 0x48, 0x9a,
+	0xc8, 0x6a, 0x35, 0x9c, 0xcd, 0x5e, 0xdf, 0xdd, 0xe4, 0x6e, 0xd6, 0x85, 0xcb, 0x04, 0x7a, 0xd2,
+	0x31, 0x90, 0x4c, 0x97, 0xd1, 0x0a, 0xfc, 0x71, 0x0c, 0xe0, 0xd0, 0xaa, 0x9f, 0xa8, 0x4d, 0xa4,
+	0xb7, 0xc6, 0x43, 0x61, 0x4b, 0x33, 0x91, 0x82, 0xd4, 0x36, 0xaa, 0xf5, 0x50, 0x78, 0xca, 0x86,
+	0xc7, 0x43, 0xe1, 0x1d, 0x10, 0x35, 0xf4, 0x81, 0xcd, 0xd2, 0xac, 0x62, 0x22, 0xa5, 0x4d, 0xe8,
+	0x4c, 0xc8, 0xf3, 0x78, 0xc6, 0x4d, 0x2e, 0x4c, 0x5e, 0xf4, 0xa2, 0xf2, 0x2e, 0x88, 0x5d, 0x3e,
+	0xc6, 0xcd, 0xf6, 0xbf, 0x9d, 0xfb, 0x1d, 0xb5, 0x7e, 0xa4, 0x91, 0xc4, 0xbe, 0x22, 0xd2, 0x37,
+	0x60, 0x86, 0xa6, 0x38, 0x76, 0x4a, 0x6b, 0x84, 0x53, 0x35, 0x9c, 0x30, 0xc6, 0x52, 0x24, 0xf8,
+	0xaa, 0x4c, 0x0e, 0x54, 0x25, 0x39, 0x5c, 0x49, 0x49, 0x5d, 0xa2, 0xa4, 0x9c, 0xc1, 0x72, 0x1f,
+	0xf7, 0xe3, 0x16, 0xf8, 0x59, 0x8c, 0xa4, 0xcf, 0x9e, 0x72, 0xa1, 0xe9, 0xef, 0x37, 0x50, 0xad,
+	0x8e, 0x48, 0xcd, 0x18, 0x41, 0xe1, 0x2d, 0x98, 0xab, 0xf6, 0x5a, 0x73, 0x05, 0xf6, 0x0d, 0x77,
+	0x05, 0xc6, 0x0b, 0x6b, 0x3d, 0x02, 0xef, 0xe1, 0x91, 0x2b, 0xbe, 0x3b, 0x2b, 0x20, 0xf5, 0x33,
+	0x31, 0x6e, 0xbe, 0xff, 0xd4, 0xf3, 0x7c, 0x43, 0x53, 0x60, 0xa4, 0x9b, 0xfc, 0xf7, 0x20, 0xf9,
+	0x54, 0x45, 0x8d, 0x9a, 0x45, 0xab, 0x52, 0x8e, 0x1b, 0x18, 0xf5, 0xf4, 0x88, 0x20, 0x5d, 0xc5,
+	0x9c, 0x75, 0xd1, 0x6b, 0xfb, 0xc7, 0x82, 0xf7, 0x01, 0xc6, 0x13, 0x3c, 0x63, 0xe9, 0x21, 0xa4,
+	0x68, 0xea, 0x67, 0x84, 0x90, 0xce, 0x83, 0x2e, 0x75, 0x3b, 0x0f, 0xba, 0x04, 0x17, 0x87, 0xbe,
+	0x83, 0x13, 0x23, 0x07, 0x67, 0xae, 0xe5, 0x3b, 0x2c, 0x0e, 0x9b, 0xff, 0x89, 0xc3, 0x62, 0x5f,
+	0x40, 0xa1, 0xed, 0xd4, 0x00, 0x32, 0x7f, 0x00, 0x59, 0xc3, 0xd4, 0x0d, 0xdd, 0x42, 0x35, 0x76,
+	0x86, 0x15, 0x5d, 0xd3, 0x90, 0x62, 0xab, 0xba, 0x56, 0x39, 0xd7, 0x0d, 0x4c, 0x73, 0x7c, 0x6b,
+	0x5a, 0x5e, 0x73, 0x71, 0xd4, 0x6b, 0x91, 0xa1, 0xde, 0xd6, 0x0d, 0x4b, 0x3c, 0x87, 0x15, 0x6e,
+	0x41, 0xa0, 0x52, 0x25, 0x86, 0x94, 0x6a, 0x99, 0x53, 0x38, 0x1c, 0xc0, 0xe0, 0xd2, 0x33, 0x39,
+	0xb0, 0xf4, 0x88, 0xdf, 0x80, 0x6b, 0xb4, 0xd4, 0xd2, 0xb6, 0x31, 0x49, 0xce, 0xa2, 0x73, 0xfa,
+	0x28, 0xbb, 0x5d, 0x90, 0xab, 0x70, 0xca, 0x03, 0xa2, 0x16, 0xfb, 0x8e, 0xec, 0xd4, 0x68, 0x47,
+	0x76, 0x3a, 0x3c, 0x21, 0xff, 0x2e, 0xc0, 0x2a, 0x4f, 0xff, 0x2b, 0xcf, 0x47, 0x4f, 0x79, 0x88,
+	0x8f, 0x52, 0x1e, 0xfe, 0x19, 0xe3, 0x24, 0xf4, 0x28, 0x2d, 0xe6, 0xa9, 0xaf, 0x55, 0x74, 0xd9,
+	0x88, 0x47, 0x66, 0x23, 0xcd, 0x49, 0x9c, 0xfe, 0x84, 0x49, 0x44, 0x49, 0x98, 0xc9, 0x08, 0x09,
+	0xf3, 0xbf, 0xed, 0x3d, 0x11, 0x27, 0x5f, 0x3c, 0xed, 0xe7, 0xb8, 0xaa, 0xfc, 0x67, 0x71, 0xc8,
+	0xf4, 0xf9, 0x19, 0xb5, 0x65, 0xfa, 0x11, 0x48, 0xdc, 0xb7, 0x05, 0x96, 0x5d, 0xb5, 0x11, 0x4d,
+	0x3b, 0x89, 0x1b, 0x6f, 0x19, 0x23, 0xe4, 0x0c, 0xe7, 0x65, 0x02, 0x99, 0x09, 0x4c, 0x92, 0xc4,
+	0x98, 0x93, 0x64, 0x32, 0x4a, 0x92, 0x24, 0x23, 0x24, 0x49, 0x6a, 0xb4, 0x24, 0x99, 0x0a, 0x4f,
+	0x12, 0x15, 0xb2, 0x41, 0xe2, 0x8d, 0x3b, 0x51, 0x3e, 0x8a, 0x73, 0x1e, 0x07, 0xf0, 0x9b, 0x81,
+	0xff, 0xc3, 0x2c, 0x19, 0x78, 0xa3, 0x49, 0x5c, 0xe2, 0x46, 0xc3, 0x4b, 0x89, 0xab, 0x2d, 0x09,
+	0x1b, 0xb0, 0xc6, 0x55, 0x80, 0xf5, 0xed, 0x7f, 0x8e, 0x71, 0x0e, 0xb3, 0xdb, 0x7f, 0x8e, 0xab,
+	0x2e, 0x0f, 0xff, 0xbe, 0x36, 0xcd, 0x11, 0x2a, 0x5a, 0x5d, 0xf6, 0xf3, 0x3b, 0x39, 0x1a, 0xbf,
+	0xc9, 0x70, 0x7e, 0x73, 0x90, 0x0d, 0x62, 0x8f, 0x51, 0xfc, 0x97, 0x18, 0x2c, 0xf5, 0x1f, 0xb9,
+	0xaa, 0xa6, 0xa0, 0xc6, 0xa5, 0x19, 0x7e, 0x0c, 0xd7, 0x90, 0x69, 0xea, 0x66, 0x85, 0x34, 0x94,
+	0x86, 0xdb, 0xb4, 0xdf, 0xe2, 0x52, 0x5b, 0xc2, 0x48, 0xd9, 0x01, 0xd2, 0xdd, 0xce, 0x22, 0xcf,
+	0x98, 0x98, 0x87, 0xb4, 0xc3, 0x59, 0xaf, 0x4d, 0x87, 0xde, 0x05, 0x32, 0xe5, 0xb5, 0x71, 0xc5,
+	0x1c, 0xdf, 0x82, 0x8d, 0x00, 0xfa, 0x18, 0xc5, 0x3f, 0x87, 0xb9, 0x43, 0xab, 0x7e, 0x6a, 0xd4,
+	0xaa, 0x36, 0x3a, 0xae, 0x9a, 0xd5, 0xa6, 0x25, 0xae, 0xc2, 0x74, 0xb5, 0x65, 0x9f, 0xeb, 0xa6,
+	0x6a, 0x77, 0xdc, 0xef, 0x18, 0x6c, 0xc0, 0x69, 0x01, 0x31, 0x2e, 0x13, 0x0b, 0x6d, 0x01, 0x31,
+	0xa4, 0xdb, 0x02, 0xe2, 0xab, 0x07, 0xa2, 0x1b, 0x5f, 0xd7, 0x5c, 0x6e, 0x19, 0x96, 0x7c, 0xfe,
+	0x59, 0x68, 0xbf, 0x11, 0xc8, 0x01, 0x3b, 0x36, 0x5b, 0x1a, 0xf2, 0xb5, 0x5f, 0xd6, 0xa5, 0xe5,
+	0x5f, 0x84, 0xc9, 0x86, 0xda, 0xa4, 0xef, 0x16, 0x13, 0xb2, 0x73, 0x11, 0xbd, 0xd5, 0xf9, 0x44,
+	0x80, 0x6c, 0x50, 0x4c, 0xec, 0x26, 0x70, 0x0f, 0x6e, 0xda, 0xba, 0x5d, 0x6d, 0x54, 0x0c, 0x0c,
+	0xab, 0xb1, 0x4a, 0x68, 0x91, 0x50, 0x13, 0xf2, 0x22, 0x99, 0x25, 0x36, 0x6a, 0x6e, 0x09, 0xb4,
+	0xc4, 0x07, 0xb0, 0xec, 0xac, 0x32, 0x51, 0xb3, 0xaa, 0x6a, 0xaa, 0x56, 0xf7, 0x2c, 0x74, 0x1e,
+	0x2f, 0x97, 0x08, 0x40, 0x76, 0xe7, 0xd9, 0xda, 0xdc, 0x5f, 0x63, 0x20, 0xf5, 0x29, 0x8d, 0x3b,
+	0xb0, 0xfd, 0xaa, 0xad, 0x9c, 0x0f, 0x50, 0xb4, 0x84, 0x3b, 0xc5, 0x86, 0x8d, 0x4c, 0xaa, 0xe8,
+	0x66, 0xe8, 0x8d, 0x1d, 0x1b, 0x7c, 0x44, 0xe0, 0xdd, 0x76, 0x11, 0x5f, 0x89, 0x6f, 0xc2, 0x94,
+	0x6e, 0xd6, 0x90, 0xa9, 0x6a, 0xf5, 0xd0, 0xfb, 0xc9, 0x11, 0x06, 0xc9, 0x0c, 0xeb, 0xfd, 0xf4,
+	0x92, 0xe8, 0xfd, 0xf4, 0x72, 0x0a, 0xb3, 0x26, 0xb2, 0xcd, 0x4e, 0xc5, 0xd0, 0x1b, 0xaa, 0xd2,
+	0xa1, 0x87, 0xe5, 0xce, 0xc0, 0xf0, 0x64, 0xbc, 0xe8, 0x98, 0xac, 0x71, 0x8f, 0x8f, 0xd9, 0x1d,
+	0xe2, 0xa6, 0xe1, 0xef, 0x04, 0xc8, 0x05, 0x13, 0xc8, 0x94, 0x5d, 0x86, 0xa9, 0x33, 0x3c, 0xe0,
+	0xa6, 0x5d, 0x42, 0x4e, 0x91, 0x6b, 0xe7, 0xe3, 0x0b, 0x7e, 0x67, 0xad, 0x56, 0x1b, 0xea, 0x87,
+	0xa8, 0xe6, 0x16, 0x5a, 0x57, 0xb9, 0xb4, 0x67, 0x8e, 0x3a, 0xb1, 0xc4, 0x4d, 0x98, 0x7b, 0x5a,
+	0x55, 0x1b, 0x5e, 0xb4, 0x93, 0x95, 0xd7, 0x9d, 0x61, 0x17, 0x98, 0xfb, 0x4c, 0x20, 0x07, 0xb9,
+	0xa4, 0x55, 0xcf, 0x1a, 0xfe, 0xb4, 0xc3, 0x69, 0x84, 0x69, 0x0c, 0xd7, 0xd8, 0x73, 0x5c, 0x62,
+	0x21, 0xc7, 0x25, 0xee, 0x3f, 0x2e, 0x79, 0x48, 0xe3, 0x17, 0x5f, 0xf8, 0x31, 0xc1, 0xb4, 0xfd,
+	0xb7, 0xf4, 0x05, 0x3c, 0x55, 0xc6, 0x33, 0xac, 0xff, 0xe6, 0x71, 0x7b, 0x1b, 0x36, 0x07, 0x04,
+	0xef, 0xf2, 0xbb, 0xfd, 0xa5, 0x00, 0x62, 0xff, 0xc3, 0x91, 0x78, 0x1f, 0xb2, 0x72, 0xa9, 0x7c,
+	0x7c, 0xf4, 0xa4, 0x5c, 0xaa, 0xc8, 0xa5, 0xf2, 0xe9, 0xe3, 0x93, 0xca, 0xc9, 0x8f, 0x8f, 0x4b,
+	0x95, 0xd3, 0x27, 0xe5, 0xe3, 0x52, 0xf1, 0xe0, 0xd1, 0x41, 0xe9, 0xfb, 0xf3, 0x13, 0xd2, 0xdc,
+	0xf3, 0x17, 0xd9, 0x19, 0xcf, 0x90, 0xb8, 0x09, 0xcb, 0xdc, 0x65, 0x4f, 0x8e, 0x8e, 0x8e, 0xe7,
+	0x05, 0x69, 0xea, 0xf9, 0x8b, 0x6c, 0x02, 0xff, 0x16, 0xef, 0xc2, 0x2a, 0x17, 0x58, 0x3e, 0x2d,
+	0x16, 0x4b, 0xe5, 0xf2, 0x7c, 0x4c, 0x9a, 0x79, 0xfe, 0x22, 0x9b, 0xa2, 0x97, 0x81, 0xf0, 0x47,
+	0x7b, 0x07, 0x8f, 0x4f, 0xe5, 0xd2, 0x7c, 0xdc, 0x81, 0xd3, 0x4b, 0x29, 0xf1, 0xec, 0x8f, 0xeb,
+	0x13, 0xbb, 0xbf, 0x4a, 0x43, 0xfc, 0xd0, 0xaa, 0x8b, 0x17, 0x30, 0xe7, 0xff, 0xae, 0xcd, 0x3f,
+	0x70, 0xfd, 0x9f, 0x9a, 0xa5, 0x42, 0x44, 0x20, 0xcb, 0xd7, 0x73, 0xb8, 0xee, 0xfb, 0xa0, 0xfc,
+	0x5a, 0x04, 0x13, 0x27, 0x66, 0x47, 0xca, 0x47, 0xc3, 0x05, 0x78, 0xc2, 0xad, 0x69, 0x14, 0x4f,
+	0x7b, 0xca, 0x45, 0x24, 0x4f, 0xde, 0x5e, 0xcc, 0x06, 0x91, 0xf3, 0x19, 0x70, 0x3b, 0x82, 0x15,
+	0x8a, 0x95, 0x76, 0xa3, 0x63, 0x99, 0x57, 0x0d, 0xe6, 0xfb, 0xbe, 0xbf, 0x6d, 0x0d, 0xb0, 0xc3,
+	0x90, 0xd2, 0xeb, 0x51, 0x91, 0xcc, 0xdf, 0xfb, 0x90, 0xe6, 0x7d, 0x57, 0xfb, 0x56, 0x14, 0x43,
+	0xee, 0x3e, 0xdf, 0x18, 0x02, 0xcc, 0x1c, 0xff, 0x04, 0xc0, 0xf3, 0x29, 0x2a, 0x17, 0x64, 0xa2,
+	0x8b, 0x91, 0xb6, 0x07, 0x63, 0x98, 0xf5, 0x32, 0xa4, 0xdc, 0x47, 0xe4, 0x8d, 0xa0, 0x65, 0x14,
+	0x20, 0x6d, 0x0e, 0x00, 0x78, 0x73, 0xcf, 0xf7, 0x25, 0xe2, 0xb5, 0x01, 0x4b, 0x29, 0x4e, 0xca,
+	0x47, 0xc3, 0x31, 0x4f, 0x17, 0x30, 0xe7, 0x7f, 0x25, 0x1e, 0x18, 0xa5, 0x0f, 0x28, 0x15, 0x22,
+	0x02, 0x39, 0x89, 0xee, 0x7d, 0x1f, 0x3c, 0x28, 0xd1, 0x3d, 0x58, 0x69, 0x37, 0x3a, 0x96, 0x79,
+	0x7d, 0x0f, 0x16, 0xfa, 0xdf, 0x9b, 0xde, 0x8e, 0x66, 0x08, 0x17, 0x8e, 0x9d, 0xc8, 0xd0, 0x60,
+	0x97, 0xb8, 0x7c, 0x44, 0x74, 0x89, 0x2b, 0xc8, 0x4e, 0x64, 0x28, 0x73, 0xf9, 0x33, 0xb8, 0xc1,
+	0x7f, 0x0b, 0x73, 0x37, 0x9a, 0x2d, 0xf7, 0x88, 0xdd, 0x1f, 0x0a, 0x1e, 0x2c, 0x2d, 0xe9, 0xed,
+	0x23, 0x4a, 0x8b, 0xb1, 0xd2, 0x6e, 0x74, 0x6c, 0xf0, 0xa6, 0xdd, 0xa3, 0x18, 0x71, 0xd3, 0xee,
+	0xc1, 0xbc, 0x3f, 0x14, 0x9c, 0xb9, 0xff, 0x10, 0x16, 0xb9, 0x9d, 0xdc, 0x9d, 0x88, 0x1c, 0x12,
+	0xb4, 0x74, 0x6f, 0x18, 0x34, 0xf3, 0xad, 0x42, 0xda, 0xe9, 0x31, 0x28, 0x8a, 0xb6, 0x3a, 0xdf,
+	0x0c, 0x32, 0xe6, 0x6d, 0x48, 0xa4, 0x3b, 0x51, 0x50, 0x5e, 0x96, 0xf9, 0x2d, 0x4b, 0x20, 0xcb,
+	0x5c, 0xb8, 0x74, 0x7f, 0x28, 0x38, 0x73, 0xff, 0x4b, 0x01, 0x96, 0x82, 0xfa, 0x80, 0x42, 0xf4,
+	0x7a, 0x40, 0x16, 0x48, 0xdf, 0x1e, 0x72, 0x01, 0x8b, 0xe2, 0xb7, 0x02, 0xac, 0x86, 0x3e, 0xae,
+	0x06, 0xca, 0x18, 0xb6, 0x4a, 0x7a, 0x78, 0x99, 0x55, 0x6e, 0x50, 0xd2, 0xe4, 0x47, 0x5f, 0x7f,
+	0xba, 0x2d, 0xec, 0x97, 0x3f, 0x7f, 0xb9, 0x2e, 0x7c, 0xf1, 0x72, 0x5d, 0xf8, 0xd7, 0xcb, 0x75,
+	0xe1, 0xd7, 0xaf, 0xd6, 0x27, 0xbe, 0x78, 0xb5, 0x3e, 0xf1, 0xe5, 0xab, 0xf5, 0x89, 0x77, 0xde,
+	0xaa, 0xab, 0xf6, 0x79, 0xeb, 0x2c, 0xaf, 0xe8, 0xcd, 0x02, 0xfd, 0x0b, 0xa4, 0x7a, 0xa6, 0xdc,
+	0xad, 0xeb, 0x85, 0xf6, 0x77, 0x0a, 0x4d, 0xbd, 0xd6, 0x6a, 0x20, 0xcb, 0xf9, 0xeb, 0xe2, 0xeb,
+	0xf7, 0xee, 0xba, 0xff, 0x5e, 0xb4, 0x3b, 0x06, 0xb2, 0xce, 0x92, 0xe4, 0x9f, 0x8b, 0x6f, 0xfc,
+	0x77, 0x00, 0x00, 0x71, 0x96, 0x40, 0x84, 0x29, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1853,6 +2071,10 @@ type MsgClient interface {
 	UpdateChannelParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	// PruneAcknowledgements defines a rpc handler method for MsgPruneAcknowledgements.
 	PruneAcknowledgements(ctx context.Context, in *MsgPruneAcknowledgements, opts ...grpc.CallOption) (*MsgPruneAcknowledgementsResponse, error)
+	// ChannelUpgradeInitBatch defines a rpc handler method for MsgChannelUpgradeInitBatch.
+	ChannelUpgradeInitBatch(ctx context.Context, in *MsgChannelUpgradeInitBatch, opts ...grpc.CallOption) (*MsgChannelUpgradeInitBatchResponse, error)
+	// EnableAcknowledgementPruning defines a rpc handler method for MsgEnableAcknowledgementPruning.
+	EnableAcknowledgementPruning(ctx context.Context, in *MsgEnableAcknowledgementPruning, opts ...grpc.CallOption) (*MsgEnableAcknowledgementPruningResponse, error)
 }
 
 type msgClient struct {
@@ -2034,6 +2256,24 @@ func (c *msgClient) PruneAcknowledgements(ctx context.Context, in *MsgPruneAckno
 	return out, nil
 }
 
+func (c *msgClient) ChannelUpgradeInitBatch(ctx context.Context, in *MsgChannelUpgradeInitBatch, opts ...grpc.CallOption) (*MsgChannelUpgradeInitBatchResponse, error) {
+	out := new(MsgChannelUpgradeInitBatchResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.channel.v1.Msg/ChannelUpgradeInitBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) EnableAcknowledgementPruning(ctx context.Context, in *MsgEnableAcknowledgementPruning, opts ...grpc.CallOption) (*MsgEnableAcknowledgementPruningResponse, error) {
+	out := new(MsgEnableAcknowledgementPruningResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.channel.v1.Msg/EnableAcknowledgementPruning", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// ChannelOpenInit defines a rpc handler method for MsgChannelOpenInit.
@@ -2075,6 +2315,10 @@ type MsgServer interface {
 	UpdateChannelParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	// PruneAcknowledgements defines a rpc handler method for MsgPruneAcknowledgements.
 	PruneAcknowledgements(context.Context, *MsgPruneAcknowledgements) (*MsgPruneAcknowledgementsResponse, error)
+	// ChannelUpgradeInitBatch defines a rpc handler method for MsgChannelUpgradeInitBatch.
+	ChannelUpgradeInitBatch(context.Context, *MsgChannelUpgradeInitBatch) (*MsgChannelUpgradeInitBatchResponse, error)
+	// EnableAcknowledgementPruning defines a rpc handler method for MsgEnableAcknowledgementPruning.
+	EnableAcknowledgementPruning(context.Context, *MsgEnableAcknowledgementPruning) (*MsgEnableAcknowledgementPruningResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -2138,6 +2382,12 @@ func (*UnimplementedMsgServer) UpdateChannelParams(ctx context.Context, req *Msg
 func (*UnimplementedMsgServer) PruneAcknowledgements(ctx context.Context, req *MsgPruneAcknowledgements) (*MsgPruneAcknowledgementsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PruneAcknowledgements not implemented")
 }
+func (*UnimplementedMsgServer) ChannelUpgradeInitBatch(ctx context.Context, req *MsgChannelUpgradeInitBatch) (*MsgChannelUpgradeInitBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelUpgradeInitBatch not implemented")
+}
+func (*UnimplementedMsgServer) EnableAcknowledgementPruning(ctx context.Context, req *MsgEnableAcknowledgementPruning) (*MsgEnableAcknowledgementPruningResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableAcknowledgementPruning not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -2485,6 +2735,42 @@ func _Msg_PruneAcknowledgements_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ChannelUpgradeInitBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChannelUpgradeInitBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ChannelUpgradeInitBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.channel.v1.Msg/ChannelUpgradeInitBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChannelUpgradeInitBatch(ctx, req.(*MsgChannelUpgradeInitBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_EnableAcknowledgementPruning_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEnableAcknowledgementPruning)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EnableAcknowledgementPruning(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.channel.v1.Msg/EnableAcknowledgementPruning",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EnableAcknowledgementPruning(ctx, req.(*MsgEnableAcknowledgementPruning))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.core.channel.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -2565,6 +2851,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PruneAcknowledgements",
 			Handler:    _Msg_PruneAcknowledgements_Handler,
 		},
+		{
+			MethodName: "ChannelUpgradeInitBatch",
+			Handler:    _Msg_ChannelUpgradeInitBatch_Handler,
+		},
+		{
+			MethodName: "EnableAcknowledgementPruning",
+			Handler:    _Msg_EnableAcknowledgementPruning_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/core/channel/v1/tx.proto",
@@ -4347,71 +4641,243 @@ func (m *MsgPruneAcknowledgementsResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgChannelUpgradeInitBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgChannelOpenInit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgChannelUpgradeInitBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChannelUpgradeInitBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.RetryPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = m.Channel.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
-}
-
-func (m *MsgChannelOpenInitResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Ordering != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Ordering))
+		i--
+		dAtA[i] = 0x18
 	}
-	var l int
-	_ = l
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgChannelOpenTry) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgChannelUpgradeInitBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChannelUpgradeInitBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChannelUpgradeInitBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.FailedChannels != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FailedChannels))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.PreviousChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.InitializedChannels != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.InitializedChannels))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = m.Channel.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.CounterpartyVersion)
-	if l > 0 {
+	if m.BatchId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BatchId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEnableAcknowledgementPruning) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEnableAcknowledgementPruning) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEnableAcknowledgementPruning) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RecvStartSequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RecvStartSequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEnableAcknowledgementPruningResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEnableAcknowledgementPruningResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEnableAcknowledgementPruningResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgChannelOpenInit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Channel.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgChannelOpenInitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgChannelOpenTry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PreviousChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Channel.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.CounterpartyVersion)
+	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
 	l = len(m.ProofInit)
@@ -5112,6 +5578,81 @@ func (m *MsgPruneAcknowledgementsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgChannelUpgradeInitBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Filter.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Ordering != 0 {
+		n += 1 + sovTx(uint64(m.Ordering))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.RetryPolicy.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgChannelUpgradeInitBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BatchId != 0 {
+		n += 1 + sovTx(uint64(m.BatchId))
+	}
+	if m.InitializedChannels != 0 {
+		n += 1 + sovTx(uint64(m.InitializedChannels))
+	}
+	if m.FailedChannels != 0 {
+		n += 1 + sovTx(uint64(m.FailedChannels))
+	}
+	return n
+}
+
+func (m *MsgEnableAcknowledgementPruning) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RecvStartSequence != 0 {
+		n += 1 + sovTx(uint64(m.RecvStartSequence))
+	}
+	return n
+}
+
+func (m *MsgEnableAcknowledgementPruningResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -10729,6 +11270,527 @@ func (m *MsgPruneAcknowledgementsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgChannelUpgradeInitBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChannelUpgradeInitBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChannelUpgradeInitBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ordering", wireType)
+			}
+			m.Ordering = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ordering |= Order(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RetryPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChannelUpgradeInitBatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChannelUpgradeInitBatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChannelUpgradeInitBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchId", wireType)
+			}
+			m.BatchId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitializedChannels", wireType)
+			}
+			m.InitializedChannels = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitializedChannels |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedChannels", wireType)
+			}
+			m.FailedChannels = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedChannels |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEnableAcknowledgementPruning) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEnableAcknowledgementPruning: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEnableAcknowledgementPruning: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvStartSequence", wireType)
+			}
+			m.RecvStartSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecvStartSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEnableAcknowledgementPruningResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEnableAcknowledgementPruningResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEnableAcknowledgementPruningResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
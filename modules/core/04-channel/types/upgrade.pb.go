@@ -23,6 +23,42 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// UpgradeBatchChannelStatus defines the status of the upgrade of a channel started by an upgrade batch.
+type UpgradeBatchChannelStatus int32
+
+const (
+	// zero-value for the upgrade batch channel status
+	UPGRADE_STATUS_UNSPECIFIED UpgradeBatchChannelStatus = 0
+	// the upgrade handshake is in progress
+	UPGRADE_STATUS_IN_PROGRESS UpgradeBatchChannelStatus = 1
+	// the upgrade handshake completed and the channel is open with the upgraded parameters
+	UPGRADE_STATUS_COMPLETED UpgradeBatchChannelStatus = 2
+	// the upgrade could not be initialized or the upgrade handshake was aborted
+	UPGRADE_STATUS_FAILED UpgradeBatchChannelStatus = 3
+)
+
+var UpgradeBatchChannelStatus_name = map[int32]string{
+	0: "UPGRADE_BATCH_CHANNEL_STATUS_UNSPECIFIED",
+	1: "UPGRADE_BATCH_CHANNEL_STATUS_IN_PROGRESS",
+	2: "UPGRADE_BATCH_CHANNEL_STATUS_COMPLETED",
+	3: "UPGRADE_BATCH_CHANNEL_STATUS_FAILED",
+}
+
+var UpgradeBatchChannelStatus_value = map[string]int32{
+	"UPGRADE_BATCH_CHANNEL_STATUS_UNSPECIFIED": 0,
+	"UPGRADE_BATCH_CHANNEL_STATUS_IN_PROGRESS": 1,
+	"UPGRADE_BATCH_CHANNEL_STATUS_COMPLETED":   2,
+	"UPGRADE_BATCH_CHANNEL_STATUS_FAILED":      3,
+}
+
+func (x UpgradeBatchChannelStatus) String() string {
+	return proto.EnumName(UpgradeBatchChannelStatus_name, int32(x))
+}
+
+func (UpgradeBatchChannelStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fb1cef68588848b2, []int{0}
+}
+
 // Upgrade is a verifiable type which contains the relevant information
 // for an attempted upgrade. It provides the proposed changes to the channel
 // end, the timeout for this upgrade attempt and the next packet sequence
@@ -151,42 +187,422 @@ func (m *ErrorReceipt) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ErrorReceipt proto.InternalMessageInfo
 
+// UpgradeBatchFilter defines the criteria the channels upgraded by an upgrade batch must match. Empty criteria
+// match all channels.
+type UpgradeBatchFilter struct {
+	// the port identifier of the channels
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// the identifier of the connection the channels are built upon
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// the chain identifier of the counterparty chain, as tracked by the client of the connection of the channels
+	CounterpartyChainId string `protobuf:"bytes,3,opt,name=counterparty_chain_id,json=counterpartyChainId,proto3" json:"counterparty_chain_id,omitempty"`
+}
+
+func (m *UpgradeBatchFilter) Reset()         { *m = UpgradeBatchFilter{} }
+func (m *UpgradeBatchFilter) String() string { return proto.CompactTextString(m) }
+func (*UpgradeBatchFilter) ProtoMessage()    {}
+func (*UpgradeBatchFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb1cef68588848b2, []int{3}
+}
+func (m *UpgradeBatchFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpgradeBatchFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpgradeBatchFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpgradeBatchFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpgradeBatchFilter.Merge(m, src)
+}
+func (m *UpgradeBatchFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpgradeBatchFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpgradeBatchFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpgradeBatchFilter proto.InternalMessageInfo
+
+func (m *UpgradeBatchFilter) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *UpgradeBatchFilter) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *UpgradeBatchFilter) GetCounterpartyChainId() string {
+	if m != nil {
+		return m.CounterpartyChainId
+	}
+	return ""
+}
+
+// UpgradeBatchRetryPolicy defines how the upgrades started by an upgrade batch are retried when they fail.
+type UpgradeBatchRetryPolicy struct {
+	// the maximum number of times the upgrade of a single channel is re-initialized after failing. Failed
+	// upgrades are not retried if zero.
+	MaxRetries uint64 `protobuf:"varint,1,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+}
+
+func (m *UpgradeBatchRetryPolicy) Reset()         { *m = UpgradeBatchRetryPolicy{} }
+func (m *UpgradeBatchRetryPolicy) String() string { return proto.CompactTextString(m) }
+func (*UpgradeBatchRetryPolicy) ProtoMessage()    {}
+func (*UpgradeBatchRetryPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb1cef68588848b2, []int{4}
+}
+func (m *UpgradeBatchRetryPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpgradeBatchRetryPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpgradeBatchRetryPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpgradeBatchRetryPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpgradeBatchRetryPolicy.Merge(m, src)
+}
+func (m *UpgradeBatchRetryPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpgradeBatchRetryPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpgradeBatchRetryPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpgradeBatchRetryPolicy proto.InternalMessageInfo
+
+func (m *UpgradeBatchRetryPolicy) GetMaxRetries() uint64 {
+	if m != nil {
+		return m.MaxRetries
+	}
+	return 0
+}
+
+// UpgradeBatchChannel tracks the upgrade of a single channel started by an upgrade batch.
+type UpgradeBatchChannel struct {
+	PortId    string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// the upgrade sequence of the channel after the latest upgrade initialization attempt
+	UpgradeSequence uint64 `protobuf:"varint,3,opt,name=upgrade_sequence,json=upgradeSequence,proto3" json:"upgrade_sequence,omitempty"`
+	// the number of times the upgrade has been re-initialized by the retry policy
+	Retries uint64 `protobuf:"varint,4,opt,name=retries,proto3" json:"retries,omitempty"`
+	// the error returned by the latest upgrade initialization attempt, if any
+	InitError string `protobuf:"bytes,5,opt,name=init_error,json=initError,proto3" json:"init_error,omitempty"`
+}
+
+func (m *UpgradeBatchChannel) Reset()         { *m = UpgradeBatchChannel{} }
+func (m *UpgradeBatchChannel) String() string { return proto.CompactTextString(m) }
+func (*UpgradeBatchChannel) ProtoMessage()    {}
+func (*UpgradeBatchChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb1cef68588848b2, []int{5}
+}
+func (m *UpgradeBatchChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpgradeBatchChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpgradeBatchChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpgradeBatchChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpgradeBatchChannel.Merge(m, src)
+}
+func (m *UpgradeBatchChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpgradeBatchChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpgradeBatchChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpgradeBatchChannel proto.InternalMessageInfo
+
+func (m *UpgradeBatchChannel) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *UpgradeBatchChannel) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *UpgradeBatchChannel) GetUpgradeSequence() uint64 {
+	if m != nil {
+		return m.UpgradeSequence
+	}
+	return 0
+}
+
+func (m *UpgradeBatchChannel) GetRetries() uint64 {
+	if m != nil {
+		return m.Retries
+	}
+	return 0
+}
+
+func (m *UpgradeBatchChannel) GetInitError() string {
+	if m != nil {
+		return m.InitError
+	}
+	return ""
+}
+
+// UpgradeBatch defines a set of channel upgrades started at once by MsgChannelUpgradeInitBatch.
+type UpgradeBatch struct {
+	// the unique identifier of the upgrade batch
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// the filter the upgraded channels were selected with
+	Filter UpgradeBatchFilter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter"`
+	// the proposed ordering of the upgraded channels
+	Ordering Order `protobuf:"varint,3,opt,name=ordering,proto3,enum=ibc.core.channel.v1.Order" json:"ordering,omitempty"`
+	// the proposed version of the upgraded channels
+	Version string `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	// the policy used to retry failed upgrades
+	RetryPolicy UpgradeBatchRetryPolicy `protobuf:"bytes,5,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy"`
+	// the channels matching the filter at the time the upgrade batch was started
+	Channels []UpgradeBatchChannel `protobuf:"bytes,6,rep,name=channels,proto3" json:"channels"`
+}
+
+func (m *UpgradeBatch) Reset()         { *m = UpgradeBatch{} }
+func (m *UpgradeBatch) String() string { return proto.CompactTextString(m) }
+func (*UpgradeBatch) ProtoMessage()    {}
+func (*UpgradeBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb1cef68588848b2, []int{6}
+}
+func (m *UpgradeBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpgradeBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpgradeBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpgradeBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpgradeBatch.Merge(m, src)
+}
+func (m *UpgradeBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpgradeBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpgradeBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpgradeBatch proto.InternalMessageInfo
+
+func (m *UpgradeBatch) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *UpgradeBatch) GetFilter() UpgradeBatchFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return UpgradeBatchFilter{}
+}
+
+func (m *UpgradeBatch) GetOrdering() Order {
+	if m != nil {
+		return m.Ordering
+	}
+	return NONE
+}
+
+func (m *UpgradeBatch) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *UpgradeBatch) GetRetryPolicy() UpgradeBatchRetryPolicy {
+	if m != nil {
+		return m.RetryPolicy
+	}
+	return UpgradeBatchRetryPolicy{}
+}
+
+func (m *UpgradeBatch) GetChannels() []UpgradeBatchChannel {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+// UpgradeBatchChannelState defines the current status of the upgrade of a channel started by an upgrade batch.
+type UpgradeBatchChannelState struct {
+	Channel UpgradeBatchChannel       `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel"`
+	Status  UpgradeBatchChannelStatus `protobuf:"varint,2,opt,name=status,proto3,enum=ibc.core.channel.v1.UpgradeBatchChannelStatus" json:"status,omitempty"`
+	// the error receipt of the latest aborted upgrade handshake, set if the status is failed and the handshake was aborted
+	ErrorReceipt *ErrorReceipt `protobuf:"bytes,3,opt,name=error_receipt,json=errorReceipt,proto3" json:"error_receipt,omitempty"`
+}
+
+func (m *UpgradeBatchChannelState) Reset()         { *m = UpgradeBatchChannelState{} }
+func (m *UpgradeBatchChannelState) String() string { return proto.CompactTextString(m) }
+func (*UpgradeBatchChannelState) ProtoMessage()    {}
+func (*UpgradeBatchChannelState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb1cef68588848b2, []int{7}
+}
+func (m *UpgradeBatchChannelState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpgradeBatchChannelState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpgradeBatchChannelState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpgradeBatchChannelState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpgradeBatchChannelState.Merge(m, src)
+}
+func (m *UpgradeBatchChannelState) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpgradeBatchChannelState) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpgradeBatchChannelState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpgradeBatchChannelState proto.InternalMessageInfo
+
+func (m *UpgradeBatchChannelState) GetChannel() UpgradeBatchChannel {
+	if m != nil {
+		return m.Channel
+	}
+	return UpgradeBatchChannel{}
+}
+
+func (m *UpgradeBatchChannelState) GetStatus() UpgradeBatchChannelStatus {
+	if m != nil {
+		return m.Status
+	}
+	return UPGRADE_STATUS_UNSPECIFIED
+}
+
+func (m *UpgradeBatchChannelState) GetErrorReceipt() *ErrorReceipt {
+	if m != nil {
+		return m.ErrorReceipt
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("ibc.core.channel.v1.UpgradeBatchChannelStatus", UpgradeBatchChannelStatus_name, UpgradeBatchChannelStatus_value)
 	proto.RegisterType((*Upgrade)(nil), "ibc.core.channel.v1.Upgrade")
 	proto.RegisterType((*UpgradeFields)(nil), "ibc.core.channel.v1.UpgradeFields")
 	proto.RegisterType((*ErrorReceipt)(nil), "ibc.core.channel.v1.ErrorReceipt")
+	proto.RegisterType((*UpgradeBatchFilter)(nil), "ibc.core.channel.v1.UpgradeBatchFilter")
+	proto.RegisterType((*UpgradeBatchRetryPolicy)(nil), "ibc.core.channel.v1.UpgradeBatchRetryPolicy")
+	proto.RegisterType((*UpgradeBatchChannel)(nil), "ibc.core.channel.v1.UpgradeBatchChannel")
+	proto.RegisterType((*UpgradeBatch)(nil), "ibc.core.channel.v1.UpgradeBatch")
+	proto.RegisterType((*UpgradeBatchChannelState)(nil), "ibc.core.channel.v1.UpgradeBatchChannelState")
 }
 
 func init() { proto.RegisterFile("ibc/core/channel/v1/upgrade.proto", fileDescriptor_fb1cef68588848b2) }
 
 var fileDescriptor_fb1cef68588848b2 = []byte{
-	// 406 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0xe3, 0xad, 0x5a, 0x57, 0x03, 0x03, 0x19, 0x0e, 0x51, 0x85, 0xb2, 0xd2, 0x0b, 0x3d,
-	0xb0, 0x98, 0x0d, 0x84, 0x00, 0x71, 0x40, 0x93, 0x40, 0x88, 0x0b, 0x92, 0x0b, 0x17, 0x2e, 0x55,
-	0xe3, 0x3c, 0x52, 0x4b, 0x8d, 0x5f, 0xb0, 0x9d, 0x08, 0xbe, 0x01, 0xc7, 0x7d, 0x04, 0xbe, 0x08,
-	0xf7, 0x1d, 0x77, 0xe4, 0x84, 0x50, 0xfb, 0x45, 0x50, 0x9c, 0xa4, 0x68, 0x52, 0x6e, 0x7e, 0x7e,
-	0xbf, 0xff, 0xdf, 0xff, 0xe7, 0x47, 0x1f, 0xa8, 0x44, 0x72, 0x89, 0x06, 0xb8, 0x5c, 0x2d, 0xb5,
-	0x86, 0x35, 0xaf, 0x4e, 0x79, 0x59, 0x64, 0x66, 0x99, 0x42, 0x5c, 0x18, 0x74, 0xc8, 0xee, 0xaa,
-	0x44, 0xc6, 0x35, 0x12, 0xb7, 0x48, 0x5c, 0x9d, 0x8e, 0xef, 0x65, 0x98, 0xa1, 0xef, 0xf3, 0xfa,
-	0xd4, 0xa0, 0xe3, 0x5e, 0xb7, 0x4e, 0xe5, 0x91, 0xe9, 0x2f, 0x42, 0x87, 0x9f, 0x1a, 0x7f, 0xf6,
-	0x9a, 0x1e, 0x7c, 0x51, 0xb0, 0x4e, 0x6d, 0x48, 0x26, 0x64, 0x76, 0xe3, 0x6c, 0x1a, 0xf7, 0x3c,
-	0x15, 0xb7, 0xf4, 0x5b, 0x4f, 0x9e, 0x0f, 0x2e, 0xff, 0x1c, 0x07, 0xa2, 0xd5, 0xb1, 0x57, 0x74,
-	0xe8, 0x54, 0x0e, 0x58, 0xba, 0x70, 0xcf, 0x5b, 0xdc, 0xef, 0xb5, 0xf8, 0xd8, 0x30, 0xad, 0xb8,
-	0x93, 0xb0, 0x47, 0x94, 0x69, 0xf8, 0xe6, 0x16, 0x16, 0xbe, 0x96, 0xa0, 0x25, 0x2c, 0x2c, 0xe8,
-	0x34, 0xdc, 0x9f, 0x90, 0xd9, 0x40, 0xdc, 0xa9, 0x3b, 0xf3, 0xb6, 0x31, 0x07, 0x9d, 0xbe, 0x1c,
-	0xfc, 0xf8, 0x79, 0x1c, 0x4c, 0x2f, 0x08, 0xbd, 0x75, 0x2d, 0x11, 0x7b, 0x46, 0x0f, 0xd1, 0xa4,
-	0x60, 0x94, 0xce, 0xfc, 0x1c, 0x47, 0x67, 0xe3, 0xde, 0x10, 0x1f, 0x6a, 0x48, 0xec, 0x58, 0xf6,
-	0x90, 0xde, 0x96, 0xa8, 0x35, 0x48, 0xa7, 0x50, 0x2f, 0x56, 0x58, 0xd8, 0x70, 0x6f, 0xb2, 0x3f,
-	0x1b, 0x89, 0xa3, 0xff, 0xd7, 0xef, 0xb0, 0xb0, 0x2c, 0xa4, 0xc3, 0x0a, 0x8c, 0x55, 0xa8, 0x7d,
-	0xb6, 0x91, 0xe8, 0xca, 0x36, 0xd2, 0x7b, 0x7a, 0xf3, 0x8d, 0x31, 0x68, 0x04, 0x48, 0x50, 0x85,
-	0x63, 0x63, 0x7a, 0xd8, 0x4d, 0xe4, 0x03, 0x0d, 0xc4, 0xae, 0xae, 0xbd, 0x72, 0xb0, 0x76, 0x99,
-	0x81, 0xff, 0xb0, 0x91, 0xe8, 0xca, 0xc6, 0xeb, 0x7c, 0x7e, 0xb9, 0x89, 0xc8, 0xd5, 0x26, 0x22,
-	0x7f, 0x37, 0x11, 0xb9, 0xd8, 0x46, 0xc1, 0xd5, 0x36, 0x0a, 0x7e, 0x6f, 0xa3, 0xe0, 0xf3, 0x8b,
-	0x4c, 0xb9, 0x55, 0x99, 0xc4, 0x12, 0x73, 0x2e, 0xd1, 0xe6, 0x68, 0xb9, 0x4a, 0xe4, 0x49, 0x86,
-	0xbc, 0x7a, 0xce, 0x73, 0x4c, 0xcb, 0x35, 0xd8, 0x66, 0xf7, 0x8f, 0x9f, 0x9e, 0x74, 0xeb, 0x77,
-	0xdf, 0x0b, 0xb0, 0xc9, 0x81, 0x5f, 0xfd, 0x93, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xcc, 0x31,
-	0xc0, 0xc6, 0x6d, 0x02, 0x00, 0x00,
+	// 875 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xd1, 0x6e, 0x1a, 0x47,
+	0x14, 0x65, 0x31, 0x05, 0x73, 0xc1, 0x0e, 0x1a, 0x37, 0xca, 0x66, 0x95, 0x62, 0x42, 0xa4, 0x86,
+	0x56, 0x09, 0xdb, 0xd0, 0xaa, 0x6a, 0xa3, 0x3e, 0x14, 0xf0, 0x12, 0x88, 0x5c, 0x1b, 0xed, 0xc2,
+	0x4b, 0x5f, 0x56, 0xcb, 0xee, 0x14, 0x46, 0x82, 0x9d, 0xed, 0xec, 0x60, 0x99, 0x1f, 0xa8, 0x22,
+	0x3f, 0xe5, 0x07, 0x2c, 0x55, 0xea, 0x17, 0xf4, 0x03, 0xfa, 0x1e, 0xa9, 0x2f, 0x79, 0xac, 0xfa,
+	0x50, 0x55, 0xf6, 0x2f, 0xf4, 0x03, 0xaa, 0x9d, 0x9d, 0xc5, 0x9b, 0x94, 0xd8, 0xcd, 0x1b, 0x73,
+	0xef, 0xb9, 0x67, 0xce, 0xdd, 0x73, 0x2f, 0x03, 0xf7, 0xc9, 0xc4, 0xd5, 0x5d, 0xca, 0xb0, 0xee,
+	0xce, 0x1c, 0xdf, 0xc7, 0x73, 0xfd, 0xe4, 0x89, 0xbe, 0x0c, 0xa6, 0xcc, 0xf1, 0x70, 0x33, 0x60,
+	0x94, 0x53, 0xb4, 0x47, 0x26, 0x6e, 0x33, 0x82, 0x34, 0x25, 0xa4, 0x79, 0xf2, 0x44, 0xfb, 0x70,
+	0x4a, 0xa7, 0x54, 0xe4, 0xf5, 0xe8, 0x57, 0x0c, 0xd5, 0x36, 0xb2, 0x25, 0x55, 0x02, 0x52, 0xff,
+	0x4d, 0x81, 0xc2, 0x38, 0xe6, 0x47, 0xdf, 0x42, 0xfe, 0x07, 0x82, 0xe7, 0x5e, 0xa8, 0x2a, 0x35,
+	0xa5, 0x51, 0x6a, 0xd5, 0x9b, 0x1b, 0xae, 0x6a, 0x4a, 0x74, 0x4f, 0x20, 0x3b, 0xb9, 0x57, 0x7f,
+	0xed, 0x67, 0x4c, 0x59, 0x87, 0xbe, 0x81, 0x02, 0x27, 0x0b, 0x4c, 0x97, 0x5c, 0xcd, 0x0a, 0x8a,
+	0x7b, 0x1b, 0x29, 0x46, 0x31, 0x46, 0x16, 0x27, 0x25, 0xe8, 0x11, 0x20, 0x1f, 0x9f, 0x72, 0x3b,
+	0xc4, 0x3f, 0x2e, 0xb1, 0xef, 0x62, 0x3b, 0xc4, 0xbe, 0xa7, 0x6e, 0xd5, 0x94, 0x46, 0xce, 0xac,
+	0x44, 0x19, 0x4b, 0x26, 0x2c, 0xec, 0x7b, 0x4f, 0x73, 0x2f, 0x7e, 0xde, 0xcf, 0xd4, 0x5f, 0x2a,
+	0xb0, 0xf3, 0x86, 0x22, 0xf4, 0x25, 0x6c, 0x53, 0xe6, 0x61, 0x46, 0xfc, 0xa9, 0xe8, 0x63, 0xb7,
+	0xa5, 0x6d, 0x14, 0x71, 0x1c, 0x81, 0xcc, 0x35, 0x16, 0x3d, 0x84, 0x5b, 0x2e, 0xf5, 0x7d, 0xec,
+	0x72, 0x42, 0x7d, 0x7b, 0x46, 0x83, 0x50, 0xcd, 0xd6, 0xb6, 0x1a, 0x45, 0x73, 0xf7, 0x2a, 0xdc,
+	0xa7, 0x41, 0x88, 0x54, 0x28, 0x9c, 0x60, 0x16, 0x12, 0xea, 0x0b, 0x6d, 0x45, 0x33, 0x39, 0x4a,
+	0x49, 0xcf, 0xa1, 0x6c, 0x30, 0x46, 0x99, 0x89, 0x5d, 0x4c, 0x02, 0x8e, 0x34, 0xd8, 0x4e, 0x3a,
+	0x12, 0x82, 0x72, 0xe6, 0xfa, 0x1c, 0x71, 0x2d, 0x70, 0x18, 0x3a, 0x53, 0x2c, 0x3e, 0x58, 0xd1,
+	0x4c, 0x8e, 0x92, 0xeb, 0x27, 0x05, 0x90, 0x6c, 0xaf, 0xe3, 0x70, 0x77, 0xd6, 0x23, 0x73, 0x8e,
+	0x19, 0xba, 0x03, 0x85, 0x80, 0x32, 0x6e, 0x13, 0x4f, 0x30, 0x16, 0xcd, 0x7c, 0x74, 0x1c, 0x78,
+	0xe8, 0x01, 0xec, 0xa4, 0x9a, 0x20, 0x9e, 0x64, 0x2d, 0x5f, 0x05, 0x07, 0x1e, 0x6a, 0xc1, 0x6d,
+	0x97, 0x2e, 0x7d, 0x8e, 0x59, 0xe0, 0x30, 0xbe, 0xb2, 0xdd, 0x99, 0x43, 0x04, 0x38, 0x6e, 0x67,
+	0x2f, 0x9d, 0xec, 0x46, 0xb9, 0x81, 0x57, 0x7f, 0x0a, 0x77, 0xd2, 0x3a, 0x4c, 0xcc, 0xd9, 0x6a,
+	0x48, 0xe7, 0xc4, 0x5d, 0xa1, 0x7d, 0x28, 0x2d, 0x9c, 0x53, 0x9b, 0x61, 0xce, 0x08, 0x0e, 0x65,
+	0x8b, 0xb0, 0x70, 0x4e, 0xcd, 0x38, 0x52, 0xff, 0x55, 0x81, 0xbd, 0x74, 0x71, 0x37, 0x36, 0xe1,
+	0xdd, 0x5d, 0x7c, 0x04, 0x20, 0x8d, 0xba, 0x6a, 0xa1, 0x28, 0x23, 0x03, 0x0f, 0x7d, 0x02, 0x15,
+	0xb9, 0x12, 0xeb, 0x51, 0x91, 0x53, 0x72, 0x4b, 0xc6, 0xad, 0xd4, 0xf7, 0x4d, 0x74, 0xe5, 0x04,
+	0x22, 0x39, 0x46, 0x77, 0x10, 0x9f, 0x70, 0x1b, 0x47, 0x56, 0xa9, 0x1f, 0xc4, 0x77, 0x44, 0x11,
+	0xe1, 0x5d, 0xfd, 0xcf, 0x2c, 0x94, 0xd3, 0x9a, 0xd1, 0x2e, 0x64, 0xa5, 0xce, 0x9c, 0x99, 0x25,
+	0x1e, 0x32, 0xa2, 0x65, 0x89, 0xcc, 0x90, 0x93, 0xfe, 0xf0, 0xba, 0x65, 0x49, 0x79, 0x77, 0xb5,
+	0x31, 0xc2, 0xc9, 0xf4, 0xb4, 0x6e, 0xbd, 0xc7, 0xb4, 0xa6, 0x86, 0x30, 0xf7, 0xc6, 0x10, 0xa2,
+	0x31, 0x94, 0xa3, 0x1e, 0x57, 0x76, 0x20, 0xec, 0x11, 0xad, 0x95, 0x5a, 0x8f, 0x6e, 0x94, 0x97,
+	0xb2, 0x54, 0x6a, 0x2c, 0xb1, 0x94, 0xcb, 0xcf, 0x61, 0x5b, 0x16, 0x86, 0x6a, 0xbe, 0xb6, 0xd5,
+	0x28, 0xb5, 0x1a, 0x37, 0x52, 0x4a, 0xa3, 0x25, 0xdd, 0xba, 0xbe, 0xfe, 0x8f, 0x02, 0xea, 0x06,
+	0x9c, 0xc5, 0x1d, 0x8e, 0x51, 0x1f, 0x0a, 0x12, 0x28, 0xff, 0x86, 0xde, 0xf7, 0x9e, 0xa4, 0x1c,
+	0xf5, 0x20, 0x1f, 0x72, 0x87, 0x2f, 0x43, 0x61, 0xd1, 0x6e, 0xab, 0xf9, 0x7f, 0x89, 0x2c, 0x51,
+	0x65, 0xca, 0x6a, 0xd4, 0x83, 0x1d, 0x31, 0x25, 0x36, 0x8b, 0x37, 0x5a, 0x18, 0x55, 0x6a, 0xdd,
+	0xdf, 0x48, 0x97, 0x5e, 0x7d, 0xb3, 0x8c, 0x53, 0xa7, 0x4f, 0x7f, 0xcf, 0xc2, 0xdd, 0x77, 0xde,
+	0x86, 0x0e, 0xa1, 0x31, 0x1e, 0x3e, 0x33, 0xdb, 0x07, 0x86, 0xdd, 0x69, 0x8f, 0xba, 0x7d, 0xbb,
+	0xdb, 0x6f, 0x1f, 0x1d, 0x19, 0x87, 0xb6, 0x35, 0x6a, 0x8f, 0xc6, 0x96, 0x3d, 0x3e, 0xb2, 0x86,
+	0x46, 0x77, 0xd0, 0x1b, 0x18, 0x07, 0x95, 0x8c, 0x56, 0x3d, 0x3b, 0xaf, 0x69, 0x09, 0xfe, 0xbf,
+	0x88, 0x1b, 0xd9, 0x06, 0x47, 0xf6, 0xd0, 0x3c, 0x7e, 0x66, 0x1a, 0x96, 0x55, 0x51, 0x36, 0xb2,
+	0xa5, 0x10, 0xa8, 0x0f, 0x1f, 0x5f, 0xcb, 0xd6, 0x3d, 0xfe, 0x6e, 0x78, 0x68, 0x8c, 0x8c, 0x83,
+	0x4a, 0x56, 0xbb, 0x77, 0x76, 0x5e, 0x53, 0xdf, 0xe2, 0x5a, 0xe7, 0x51, 0x07, 0x1e, 0x5c, 0xcb,
+	0xd4, 0x6b, 0x0f, 0x0e, 0x8d, 0x83, 0xca, 0x96, 0x76, 0xf7, 0xec, 0xbc, 0x76, 0xfb, 0x2d, 0x9a,
+	0x38, 0xa9, 0xe5, 0x5e, 0xfc, 0x52, 0xcd, 0x74, 0xac, 0x57, 0x17, 0x55, 0xe5, 0xf5, 0x45, 0x55,
+	0xf9, 0xfb, 0xa2, 0xaa, 0xbc, 0xbc, 0xac, 0x66, 0x5e, 0x5f, 0x56, 0x33, 0x7f, 0x5c, 0x56, 0x33,
+	0xdf, 0x7f, 0x3d, 0x25, 0x7c, 0xb6, 0x9c, 0x34, 0x5d, 0xba, 0xd0, 0x5d, 0x1a, 0x2e, 0x68, 0xa8,
+	0x93, 0x89, 0xfb, 0x78, 0x4a, 0xf5, 0x93, 0xaf, 0xf4, 0x05, 0xf5, 0x96, 0x73, 0x1c, 0xc6, 0xcf,
+	0xe2, 0x67, 0x5f, 0x3c, 0x4e, 0x5e, 0x46, 0xbe, 0x0a, 0x70, 0x38, 0xc9, 0x8b, 0x57, 0xf1, 0xf3,
+	0x7f, 0x07, 0x00, 0xe6, 0x90, 0x98, 0xff, 0x88, 0x07, 0x00, 0x00,
 }
 
 func (m *Upgrade) Marshal() (dAtA []byte, err error) {
@@ -316,78 +732,1019 @@ func (m *ErrorReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintUpgrade(dAtA []byte, offset int, v uint64) int {
-	offset -= sovUpgrade(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *UpgradeBatchFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Upgrade) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *UpgradeBatchFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpgradeBatchFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Fields.Size()
-	n += 1 + l + sovUpgrade(uint64(l))
-	l = m.Timeout.Size()
-	n += 1 + l + sovUpgrade(uint64(l))
-	if m.NextSequenceSend != 0 {
-		n += 1 + sovUpgrade(uint64(m.NextSequenceSend))
+	if len(m.CounterpartyChainId) > 0 {
+		i -= len(m.CounterpartyChainId)
+		copy(dAtA[i:], m.CounterpartyChainId)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.CounterpartyChainId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *UpgradeFields) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UpgradeBatchRetryPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UpgradeBatchRetryPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpgradeBatchRetryPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Ordering != 0 {
-		n += 1 + sovUpgrade(uint64(m.Ordering))
+	if m.MaxRetries != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.MaxRetries))
+		i--
+		dAtA[i] = 0x8
 	}
-	if len(m.ConnectionHops) > 0 {
-		for _, s := range m.ConnectionHops {
-			l = len(s)
-			n += 1 + l + sovUpgrade(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpgradeBatchChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovUpgrade(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *UpgradeBatchChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpgradeBatchChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InitError) > 0 {
+		i -= len(m.InitError)
+		copy(dAtA[i:], m.InitError)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.InitError)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Retries != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.Retries))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UpgradeSequence != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.UpgradeSequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpgradeBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpgradeBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpgradeBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Channels) > 0 {
+		for iNdEx := len(m.Channels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Channels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintUpgrade(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size, err := m.RetryPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintUpgrade(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Ordering != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.Ordering))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintUpgrade(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Id != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpgradeBatchChannelState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpgradeBatchChannelState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpgradeBatchChannelState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ErrorReceipt != nil {
+		{
+			size, err := m.ErrorReceipt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintUpgrade(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Status != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Channel.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintUpgrade(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintUpgrade(dAtA []byte, offset int, v uint64) int {
+	offset -= sovUpgrade(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Upgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Fields.Size()
+	n += 1 + l + sovUpgrade(uint64(l))
+	l = m.Timeout.Size()
+	n += 1 + l + sovUpgrade(uint64(l))
+	if m.NextSequenceSend != 0 {
+		n += 1 + sovUpgrade(uint64(m.NextSequenceSend))
+	}
+	return n
+}
+
+func (m *UpgradeFields) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Ordering != 0 {
+		n += 1 + sovUpgrade(uint64(m.Ordering))
+	}
+	if len(m.ConnectionHops) > 0 {
+		for _, s := range m.ConnectionHops {
+			l = len(s)
+			n += 1 + l + sovUpgrade(uint64(l))
+		}
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	return n
+}
+
+func (m *ErrorReceipt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovUpgrade(uint64(m.Sequence))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	return n
+}
+
+func (m *UpgradeBatchFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	l = len(m.CounterpartyChainId)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	return n
+}
+
+func (m *UpgradeBatchRetryPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxRetries != 0 {
+		n += 1 + sovUpgrade(uint64(m.MaxRetries))
+	}
+	return n
+}
+
+func (m *UpgradeBatchChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	if m.UpgradeSequence != 0 {
+		n += 1 + sovUpgrade(uint64(m.UpgradeSequence))
+	}
+	if m.Retries != 0 {
+		n += 1 + sovUpgrade(uint64(m.Retries))
+	}
+	l = len(m.InitError)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	return n
+}
+
+func (m *UpgradeBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovUpgrade(uint64(m.Id))
+	}
+	l = m.Filter.Size()
+	n += 1 + l + sovUpgrade(uint64(l))
+	if m.Ordering != 0 {
+		n += 1 + sovUpgrade(uint64(m.Ordering))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	l = m.RetryPolicy.Size()
+	n += 1 + l + sovUpgrade(uint64(l))
+	if len(m.Channels) > 0 {
+		for _, e := range m.Channels {
+			l = e.Size()
+			n += 1 + l + sovUpgrade(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UpgradeBatchChannelState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Channel.Size()
+	n += 1 + l + sovUpgrade(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovUpgrade(uint64(m.Status))
+	}
+	if m.ErrorReceipt != nil {
+		l = m.ErrorReceipt.Size()
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	return n
+}
+
+func sovUpgrade(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozUpgrade(x uint64) (n int) {
+	return sovUpgrade(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Upgrade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Upgrade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Upgrade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fields.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Timeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSequenceSend", wireType)
+			}
+			m.NextSequenceSend = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSequenceSend |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpgradeFields) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpgradeFields: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpgradeFields: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ordering", wireType)
+			}
+			m.Ordering = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ordering |= Order(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionHops", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionHops = append(m.ConnectionHops, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ErrorReceipt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ErrorReceipt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ErrorReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpgradeBatchFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpgradeBatchFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpgradeBatchFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpgradeBatchRetryPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpgradeBatchRetryPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpgradeBatchRetryPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetries", wireType)
+			}
+			m.MaxRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRetries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *ErrorReceipt) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Sequence != 0 {
-		n += 1 + sovUpgrade(uint64(m.Sequence))
-	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovUpgrade(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovUpgrade(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozUpgrade(x uint64) (n int) {
-	return sovUpgrade(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Upgrade) Unmarshal(dAtA []byte) error {
+func (m *UpgradeBatchChannel) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -410,17 +1767,17 @@ func (m *Upgrade) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Upgrade: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpgradeBatchChannel: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Upgrade: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpgradeBatchChannel: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUpgrade
@@ -430,30 +1787,29 @@ func (m *Upgrade) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthUpgrade
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthUpgrade
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Fields.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUpgrade
@@ -463,30 +1819,29 @@ func (m *Upgrade) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthUpgrade
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthUpgrade
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Timeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextSequenceSend", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeSequence", wireType)
 			}
-			m.NextSequenceSend = 0
+			m.UpgradeSequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUpgrade
@@ -496,11 +1851,62 @@ func (m *Upgrade) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextSequenceSend |= uint64(b&0x7F) << shift
+				m.UpgradeSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
+			}
+			m.Retries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Retries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUpgrade(dAtA[iNdEx:])
@@ -522,7 +1928,7 @@ func (m *Upgrade) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpgradeFields) Unmarshal(dAtA []byte) error {
+func (m *UpgradeBatch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -545,17 +1951,17 @@ func (m *UpgradeFields) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpgradeFields: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpgradeBatch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpgradeFields: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpgradeBatch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ordering", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.Ordering = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUpgrade
@@ -565,16 +1971,16 @@ func (m *UpgradeFields) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Ordering |= Order(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionHops", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUpgrade
@@ -584,25 +1990,45 @@ func (m *UpgradeFields) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthUpgrade
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthUpgrade
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionHops = append(m.ConnectionHops, string(dAtA[iNdEx:postIndex]))
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ordering", wireType)
+			}
+			m.Ordering = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ordering |= Order(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
@@ -634,6 +2060,73 @@ func (m *UpgradeFields) Unmarshal(dAtA []byte) error {
 			}
 			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RetryPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channels = append(m.Channels, UpgradeBatchChannel{})
+			if err := m.Channels[len(m.Channels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUpgrade(dAtA[iNdEx:])
@@ -655,7 +2148,7 @@ func (m *UpgradeFields) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ErrorReceipt) Unmarshal(dAtA []byte) error {
+func (m *UpgradeBatchChannelState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -678,17 +2171,17 @@ func (m *ErrorReceipt) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ErrorReceipt: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpgradeBatchChannelState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ErrorReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpgradeBatchChannelState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
 			}
-			m.Sequence = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUpgrade
@@ -698,16 +2191,49 @@ func (m *ErrorReceipt) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Channel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= UpgradeBatchChannelStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorReceipt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUpgrade
@@ -717,23 +2243,27 @@ func (m *ErrorReceipt) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthUpgrade
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthUpgrade
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			if m.ErrorReceipt == nil {
+				m.ErrorReceipt = &ErrorReceipt{}
+			}
+			if err := m.ErrorReceipt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -0,0 +1,62 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// MaxUpgradeRetriesPerBlock defines the maximum number of failed channel upgrades of upgrade batches which are
+// re-initialized in a single block. Failed upgrades beyond this limit are retried in the following blocks.
+const MaxUpgradeRetriesPerBlock = 50
+
+// NewUpgradeBatchFilter creates a new UpgradeBatchFilter instance.
+func NewUpgradeBatchFilter(portID, connectionID, counterpartyChainID string) UpgradeBatchFilter {
+	return UpgradeBatchFilter{
+		PortId:              portID,
+		ConnectionId:        connectionID,
+		CounterpartyChainId: counterpartyChainID,
+	}
+}
+
+// Validate performs a basic validation of the criteria which are set in the filter.
+func (f UpgradeBatchFilter) Validate() error {
+	if f.PortId != "" {
+		if err := host.PortIdentifierValidator(f.PortId); err != nil {
+			return errorsmod.Wrap(err, "invalid port ID")
+		}
+	}
+
+	if f.ConnectionId != "" && !connectiontypes.IsValidConnectionID(f.ConnectionId) {
+		return errorsmod.Wrapf(connectiontypes.ErrInvalidConnectionIdentifier, "invalid connection ID: %s", f.ConnectionId)
+	}
+
+	if f.CounterpartyChainId != strings.TrimSpace(f.CounterpartyChainId) {
+		return errorsmod.Wrapf(ErrInvalidUpgradeBatch, "counterparty chain ID cannot contain leading or trailing whitespace: %q", f.CounterpartyChainId)
+	}
+
+	return nil
+}
+
+// NewUpgradeBatchRetryPolicy creates a new UpgradeBatchRetryPolicy instance.
+func NewUpgradeBatchRetryPolicy(maxRetries uint64) UpgradeBatchRetryPolicy {
+	return UpgradeBatchRetryPolicy{
+		MaxRetries: maxRetries,
+	}
+}
+
+// IsTerminal returns true if the channel upgrade has completed, or has failed and may not be retried
+// under the given retry policy.
+func (s UpgradeBatchChannelState) IsTerminal(retryPolicy UpgradeBatchRetryPolicy) bool {
+	switch s.Status {
+	case UPGRADE_STATUS_COMPLETED:
+		return true
+	case UPGRADE_STATUS_FAILED:
+		return s.Channel.Retries >= retryPolicy.MaxRetries
+	default:
+		return false
+	}
+}
@@ -10,4 +10,8 @@ var (
 	ErrPortNotFound = errorsmod.Register(SubModuleName, 3, "port not found")
 	ErrInvalidPort  = errorsmod.Register(SubModuleName, 4, "invalid port")
 	ErrInvalidRoute = errorsmod.Register(SubModuleName, 5, "route not found")
+
+	ErrInvalidPacketDataSchema  = errorsmod.Register(SubModuleName, 6, "invalid packet data schema")
+	ErrPacketDataSchemaNotFound = errorsmod.Register(SubModuleName, 7, "packet data schema not found")
+	ErrDecodePacketData         = errorsmod.Register(SubModuleName, 8, "failed to decode packet data")
 )
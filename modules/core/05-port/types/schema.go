@@ -0,0 +1,207 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// PortWildcard may be used as the final character of a port identifier registered with the PacketDataRegistry
+// to register a schema for all ports with the preceding prefix, e.g. "icacontroller-*".
+const PortWildcard = "*"
+
+// maxVersionUnwrapDepth bounds the number of times a channel version is unwrapped when looking up
+// a packet data schema, which prevents cycles between registered version unwrappers.
+const maxVersionUnwrapDepth = 8
+
+// PacketDataSchema defines the encoding of the packet data sent over channels of a given port and application version.
+// At least one of ProtoMessage or JSONSchema must be set.
+type PacketDataSchema struct {
+	// ProtoMessage is the proto message which the packet data is encoded as using the proto3 JSON mapping.
+	ProtoMessage proto.Message
+	// JSONSchema is a JSON schema document describing the packet data.
+	JSONSchema string
+}
+
+// ValidateBasic performs basic validation of the packet data schema.
+func (s PacketDataSchema) ValidateBasic() error {
+	if s.ProtoMessage == nil && strings.TrimSpace(s.JSONSchema) == "" {
+		return errorsmod.Wrap(ErrInvalidPacketDataSchema, "proto message and JSON schema cannot both be empty")
+	}
+
+	if s.JSONSchema != "" && !json.Valid([]byte(s.JSONSchema)) {
+		return errorsmod.Wrap(ErrInvalidPacketDataSchema, "JSON schema is not a valid JSON document")
+	}
+
+	return nil
+}
+
+// Decode decodes the provided packet data. If the schema defines a proto message, the packet data is unmarshaled into
+// a new instance of the proto message which is returned along with its canonical JSON encoding. Otherwise the packet
+// data is checked to be valid JSON and returned as is.
+func (s PacketDataSchema) Decode(cdc codec.JSONCodec, data []byte) (proto.Message, []byte, error) {
+	if s.ProtoMessage == nil {
+		if !json.Valid(data) {
+			return nil, nil, errorsmod.Wrap(ErrDecodePacketData, "packet data is not valid JSON")
+		}
+
+		return nil, data, nil
+	}
+
+	msg := proto.Clone(s.ProtoMessage)
+	msg.Reset()
+	if err := cdc.UnmarshalJSON(data, msg); err != nil {
+		return nil, nil, errorsmod.Wrapf(ErrDecodePacketData, "failed to unmarshal packet data into %s: %s", proto.MessageName(s.ProtoMessage), err)
+	}
+
+	bz, err := cdc.MarshalJSON(msg)
+	if err != nil {
+		return nil, nil, errorsmod.Wrapf(ErrDecodePacketData, "failed to marshal %s to JSON: %s", proto.MessageName(s.ProtoMessage), err)
+	}
+
+	return msg, bz, nil
+}
+
+// VersionUnwrapper returns the application version wrapped by a channel version, e.g. by middleware which
+// encodes its own version alongside the version of the underlying application. A false boolean is returned
+// if the provided version is not of the format expected by the unwrapper.
+type VersionUnwrapper func(version string) (string, bool)
+
+// PacketDataRegistry is a registry of the packet data schemas used by applications for a given
+// port and application version. It allows packet data sent over any port to be decoded by clients
+// such as block explorers and indexers.
+type PacketDataRegistry struct {
+	schemas    map[string]map[string]PacketDataSchema
+	unwrappers []VersionUnwrapper
+	sealed     bool
+}
+
+// NewPacketDataRegistry creates a new, empty PacketDataRegistry.
+func NewPacketDataRegistry() *PacketDataRegistry {
+	return &PacketDataRegistry{
+		schemas: make(map[string]map[string]PacketDataSchema),
+	}
+}
+
+// Seal prevents any subsequent schemas or version unwrappers from being registered.
+// Seal will panic if called more than once.
+func (r *PacketDataRegistry) Seal() {
+	if r.sealed {
+		panic(errors.New("packet data registry already sealed"))
+	}
+	r.sealed = true
+}
+
+// Sealed returns a boolean signifying if the PacketDataRegistry is sealed or not.
+func (r PacketDataRegistry) Sealed() bool {
+	return r.sealed
+}
+
+// Register registers the packet data schema for the provided port identifier and application version. The port
+// identifier may end with the PortWildcard in order to register the schema for all ports with the given prefix.
+// It returns the PacketDataRegistry so Register calls can be linked. It will panic if the registry is sealed,
+// the schema is invalid or a schema is already registered for the port and version.
+func (r *PacketDataRegistry) Register(portID, version string, schema PacketDataSchema) *PacketDataRegistry {
+	if r.sealed {
+		panic(fmt.Errorf("packet data registry sealed; cannot register schema for port %s and version %s", portID, version))
+	}
+	if strings.TrimSpace(portID) == "" || strings.TrimSpace(version) == "" {
+		panic(errors.New("port identifier and version cannot be empty"))
+	}
+	if err := schema.ValidateBasic(); err != nil {
+		panic(err)
+	}
+
+	versions, ok := r.schemas[portID]
+	if !ok {
+		versions = make(map[string]PacketDataSchema)
+		r.schemas[portID] = versions
+	}
+
+	if _, ok := versions[version]; ok {
+		panic(fmt.Errorf("packet data schema for port %s and version %s has already been registered", portID, version))
+	}
+
+	versions[version] = schema
+	return r
+}
+
+// RegisterVersionUnwrapper registers a VersionUnwrapper used to resolve the application version of channels
+// whose version is wrapped by middleware. It returns the PacketDataRegistry so calls can be linked.
+// It will panic if the registry is sealed.
+func (r *PacketDataRegistry) RegisterVersionUnwrapper(unwrapper VersionUnwrapper) *PacketDataRegistry {
+	if r.sealed {
+		panic(errors.New("packet data registry sealed; cannot register version unwrapper"))
+	}
+	if unwrapper == nil {
+		panic(errors.New("version unwrapper cannot be nil"))
+	}
+
+	r.unwrappers = append(r.unwrappers, unwrapper)
+	return r
+}
+
+// GetSchema returns the packet data schema registered for the provided port identifier and channel version
+// along with the application version the schema is registered for. Schemas registered for the exact port
+// identifier take precedence over schemas registered using the PortWildcard, with the longest matching
+// prefix being selected. If no schema is registered for the channel version, each registered VersionUnwrapper
+// is applied to the version until a registered application version is found.
+func (r PacketDataRegistry) GetSchema(portID, version string) (PacketDataSchema, string, bool) {
+	versions, ok := r.getPortSchemas(portID)
+	if !ok {
+		return PacketDataSchema{}, "", false
+	}
+
+	for i := 0; i <= maxVersionUnwrapDepth; i++ {
+		if schema, ok := versions[version]; ok {
+			return schema, version, true
+		}
+
+		unwrapped := false
+		for _, unwrap := range r.unwrappers {
+			if appVersion, ok := unwrap(version); ok && appVersion != version {
+				version = appVersion
+				unwrapped = true
+				break
+			}
+		}
+
+		if !unwrapped {
+			break
+		}
+	}
+
+	return PacketDataSchema{}, "", false
+}
+
+// getPortSchemas returns the schemas registered for the exact port identifier if they exist, or otherwise
+// the schemas registered for the longest matching wildcard port prefix.
+func (r PacketDataRegistry) getPortSchemas(portID string) (map[string]PacketDataSchema, bool) {
+	if versions, ok := r.schemas[portID]; ok {
+		return versions, true
+	}
+
+	var (
+		match  map[string]PacketDataSchema
+		prefix string
+	)
+	for registeredPortID, versions := range r.schemas {
+		registeredPrefix, ok := strings.CutSuffix(registeredPortID, PortWildcard)
+		if !ok || !strings.HasPrefix(portID, registeredPrefix) {
+			continue
+		}
+
+		if match == nil || len(registeredPrefix) > len(prefix) {
+			match, prefix = versions, registeredPrefix
+		}
+	}
+
+	return match, match != nil
+}
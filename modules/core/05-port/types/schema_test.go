@@ -0,0 +1,113 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+)
+
+const (
+	testVersion    = "ics100-1"
+	testJSONSchema = `{"type":"object","properties":{"memo":{"type":"string"}}}`
+)
+
+var protoSchema = types.PacketDataSchema{ProtoMessage: &channeltypes.Acknowledgement{}}
+
+func TestPacketDataSchemaValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name    string
+		schema  types.PacketDataSchema
+		expPass bool
+	}{
+		{"success: proto message", protoSchema, true},
+		{"success: JSON schema", types.PacketDataSchema{JSONSchema: testJSONSchema}, true},
+		{"empty schema", types.PacketDataSchema{}, false},
+		{"invalid JSON schema", types.PacketDataSchema{JSONSchema: "{"}, false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.schema.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestPacketDataSchemaDecode(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+
+	msg, bz, err := protoSchema.Decode(cdc, cdc.MustMarshalJSON(&ack))
+	require.NoError(t, err)
+	require.Equal(t, &ack, msg)
+	require.JSONEq(t, string(cdc.MustMarshalJSON(&ack)), string(bz))
+
+	_, _, err = protoSchema.Decode(cdc, []byte("invalid"))
+	require.ErrorIs(t, err, types.ErrDecodePacketData)
+
+	jsonSchema := types.PacketDataSchema{JSONSchema: testJSONSchema}
+	msg, bz, err = jsonSchema.Decode(cdc, []byte(`{"memo":"hello"}`))
+	require.NoError(t, err)
+	require.Nil(t, msg)
+	require.Equal(t, `{"memo":"hello"}`, string(bz))
+
+	_, _, err = jsonSchema.Decode(cdc, []byte("invalid"))
+	require.ErrorIs(t, err, types.ErrDecodePacketData)
+}
+
+func TestPacketDataRegistry(t *testing.T) {
+	jsonSchema := types.PacketDataSchema{JSONSchema: testJSONSchema}
+	wrappedPrefix := "wrapped:"
+
+	registry := types.NewPacketDataRegistry().
+		Register("port", testVersion, protoSchema).
+		Register("prefix-*", testVersion, jsonSchema).
+		Register("prefix-long-*", testVersion, protoSchema).
+		RegisterVersionUnwrapper(func(version string) (string, bool) {
+			return strings.CutPrefix(version, wrappedPrefix)
+		})
+
+	testCases := []struct {
+		name       string
+		portID     string
+		version    string
+		expSchema  types.PacketDataSchema
+		expVersion string
+		expFound   bool
+	}{
+		{"exact port and version", "port", testVersion, protoSchema, testVersion, true},
+		{"wildcard port", "prefix-owner", testVersion, jsonSchema, testVersion, true},
+		{"longest wildcard port prefix", "prefix-long-owner", testVersion, protoSchema, testVersion, true},
+		{"wrapped version", "port", wrappedPrefix + testVersion, protoSchema, testVersion, true},
+		{"version wrapped multiple times", "port", wrappedPrefix + wrappedPrefix + testVersion, protoSchema, testVersion, true},
+		{"port not registered", "other", testVersion, types.PacketDataSchema{}, "", false},
+		{"version not registered", "port", "ics100-2", types.PacketDataSchema{}, "", false},
+		{"wildcard does not match exact prefix of other port", "prefix", testVersion, types.PacketDataSchema{}, "", false},
+	}
+
+	for _, tc := range testCases {
+		schema, version, found := registry.GetSchema(tc.portID, tc.version)
+		require.Equal(t, tc.expFound, found, tc.name)
+		require.Equal(t, tc.expSchema, schema, tc.name)
+		require.Equal(t, tc.expVersion, version, tc.name)
+	}
+
+	require.Panics(t, func() { registry.Register("port", testVersion, jsonSchema) }, "duplicate registration")
+	require.Panics(t, func() { registry.Register("", testVersion, jsonSchema) }, "empty port")
+	require.Panics(t, func() { registry.Register("port", "ics100-2", types.PacketDataSchema{}) }, "invalid schema")
+	require.Panics(t, func() { registry.RegisterVersionUnwrapper(nil) }, "nil unwrapper")
+
+	registry.Seal()
+	require.True(t, registry.Sealed())
+	require.Panics(t, func() { registry.Register("port", "ics100-2", jsonSchema) }, "registry sealed")
+	require.Panics(t, func() { registry.Seal() }, "registry already sealed")
+}
@@ -20,9 +20,15 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs *types.GenesisState) {
 
 // ExportGenesis returns the ibc exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return ExportGenesisWithOptions(ctx, k, types.ExportOptions{})
+}
+
+// ExportGenesisWithOptions returns the ibc exported genesis, omitting the pruneable
+// state selected by the provided export options.
+func ExportGenesisWithOptions(ctx sdk.Context, k keeper.Keeper, opts types.ExportOptions) *types.GenesisState {
 	return &types.GenesisState{
-		ClientGenesis:     client.ExportGenesis(ctx, k.ClientKeeper),
+		ClientGenesis:     client.ExportGenesisWithOptions(ctx, k.ClientKeeper, opts.ClientOptions),
 		ConnectionGenesis: connection.ExportGenesis(ctx, k.ConnectionKeeper),
-		ChannelGenesis:    channel.ExportGenesis(ctx, k.ChannelKeeper),
+		ChannelGenesis:    channel.ExportGenesisWithOptions(ctx, k.ChannelKeeper, opts.ChannelOptions),
 	}
 }
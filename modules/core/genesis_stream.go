@@ -0,0 +1,104 @@
+package ibc
+
+import (
+	"github.com/cosmos/gogoproto/proto"
+
+	"cosmossdk.io/core/appmodule"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/internal/genesis"
+	client "github.com/cosmos/ibc-go/v8/modules/core/02-client"
+	connection "github.com/cosmos/ibc-go/v8/modules/core/03-connection"
+	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	channel "github.com/cosmos/ibc-go/v8/modules/core/04-channel"
+	"github.com/cosmos/ibc-go/v8/modules/core/keeper"
+	"github.com/cosmos/ibc-go/v8/modules/core/types"
+)
+
+const (
+	clientGenesisField     = "client_genesis"
+	connectionGenesisField = "connection_genesis"
+	channelGenesisField    = "channel_genesis"
+)
+
+// StreamInitGenesis initializes the ibc state from the provided genesis source. The client and
+// channel submodule genesis are decoded incrementally, such that the genesis state is never held
+// in memory as a whole. The connection submodule genesis is decoded as a whole.
+func StreamInitGenesis(ctx sdk.Context, k keeper.Keeper, cdc codec.JSONCodec, source appmodule.GenesisSource) error {
+	if err := client.StreamInitGenesis(ctx, k.ClientKeeper, cdc, genesis.SourceField(source, clientGenesisField)); err != nil {
+		return err
+	}
+
+	var connectionGenesis connectiontypes.GenesisState
+	if err := genesis.Decode(genesis.SourceField(source, connectionGenesisField), cdc, func(d *genesis.Decoder) error {
+		return d.Message(&connectionGenesis)
+	}); err != nil {
+		return err
+	}
+	connection.InitGenesis(ctx, k.ConnectionKeeper, connectionGenesis)
+
+	return channel.StreamInitGenesis(ctx, k.ChannelKeeper, cdc, genesis.SourceField(source, channelGenesisField))
+}
+
+// StreamExportGenesis writes the ibc exported genesis to the provided genesis target, omitting
+// the pruneable state selected by the provided export options. The output is equal to the JSON
+// encoding of the genesis state returned by ExportGenesisWithOptions.
+func StreamExportGenesis(ctx sdk.Context, k keeper.Keeper, cdc codec.JSONCodec, opts types.ExportOptions, target appmodule.GenesisTarget) error {
+	if err := client.StreamExportGenesis(ctx, k.ClientKeeper, cdc, opts.ClientOptions, genesis.TargetField(target, clientGenesisField)); err != nil {
+		return err
+	}
+
+	connectionGenesis := connection.ExportGenesis(ctx, k.ConnectionKeeper)
+	if err := genesis.Encode(genesis.TargetField(target, connectionGenesisField), cdc, func(enc *genesis.Encoder) {
+		enc.Message(&connectionGenesis)
+	}); err != nil {
+		return err
+	}
+
+	return channel.StreamExportGenesis(ctx, k.ChannelKeeper, cdc, opts.ChannelOptions, genesis.TargetField(target, channelGenesisField))
+}
+
+// StreamValidateGenesis performs the basic genesis state validation of GenesisState.Validate
+// on the provided genesis source.
+func StreamValidateGenesis(cdc codec.JSONCodec, source appmodule.GenesisSource) error {
+	if err := client.StreamValidateGenesis(cdc, genesis.SourceField(source, clientGenesisField)); err != nil {
+		return err
+	}
+
+	var connectionGenesis connectiontypes.GenesisState
+	if err := genesis.Decode(genesis.SourceField(source, connectionGenesisField), cdc, func(d *genesis.Decoder) error {
+		return d.Message(&connectionGenesis)
+	}); err != nil {
+		return err
+	}
+
+	if err := connectionGenesis.Validate(); err != nil {
+		return err
+	}
+
+	return channel.StreamValidateGenesis(cdc, genesis.SourceField(source, channelGenesisField))
+}
+
+// StreamDefaultGenesis writes the default ibc genesis state to the provided genesis target.
+func StreamDefaultGenesis(cdc codec.JSONCodec, target appmodule.GenesisTarget) error {
+	gs := types.DefaultGenesisState()
+
+	for _, submodule := range []struct {
+		field   string
+		genesis proto.Message
+	}{
+		{clientGenesisField, &gs.ClientGenesis},
+		{connectionGenesisField, &gs.ConnectionGenesis},
+		{channelGenesisField, &gs.ChannelGenesis},
+	} {
+		if err := genesis.Encode(genesis.TargetField(target, submodule.field), cdc, func(enc *genesis.Encoder) {
+			enc.Message(submodule.genesis)
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
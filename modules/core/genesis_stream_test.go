@@ -0,0 +1,277 @@
+package ibc_test
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/appmodule"
+	coregenesis "cosmossdk.io/core/genesis"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	ibc "github.com/cosmos/ibc-go/v8/modules/core"
+	channel "github.com/cosmos/ibc-go/v8/modules/core/04-channel"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	"github.com/cosmos/ibc-go/v8/modules/core/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	"github.com/cosmos/ibc-go/v8/testing/simapp"
+)
+
+func (suite *IBCTestSuite) TestStreamGenesis() {
+	testCases := []struct {
+		msg  string
+		opts types.ExportOptions
+	}{
+		{
+			"without export options",
+			types.ExportOptions{},
+		},
+		{
+			"with pruneable export options",
+			types.PruneableExportOptions(),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest()
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			for i := 0; i < 3; i++ {
+				timeoutHeight := suite.chainB.GetTimeoutHeight()
+				sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				packet := channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+				suite.Require().NoError(path.EndpointB.RecvPacket(packet))
+			}
+
+			// an in-flight packet
+			_, err := path.EndpointA.SendPacket(suite.chainB.GetTimeoutHeight(), 0, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+
+			cdc := suite.chainB.App.AppCodec()
+			ctx := suite.chainB.GetContext()
+			ibcKeeper := suite.chainB.App.GetIBCKeeper()
+
+			// a timeout receipt, which must not be imported as a regular packet receipt
+			ibcKeeper.ChannelKeeper.SetPacketTimeoutReceipt(ctx, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, 10)
+
+			expGenesis := ibc.ExportGenesisWithOptions(ctx, *ibcKeeper, tc.opts)
+
+			// export through the module manager, which writes to the genesis target of the streaming module
+			am := ibc.NewStreamingAppModule(ibc.NewAppModule(ibcKeeper).WithExportOptions(tc.opts), cdc)
+			genesisData, err := module.NewManager(am).ExportGenesis(ctx, cdc)
+			suite.Require().NoError(err)
+
+			bz, err := cdc.MarshalJSON(expGenesis)
+			suite.Require().NoError(err)
+			suite.Require().JSONEq(string(bz), string(genesisData[exported.ModuleName]))
+
+			source, err := coregenesis.SourceFromRawJSON(genesisData[exported.ModuleName])
+			suite.Require().NoError(err)
+			suite.Require().NoError(ibc.StreamValidateGenesis(cdc, source))
+
+			// import on a new app
+			app := simapp.Setup(suite.T(), false)
+			newCtx := app.BaseApp.NewContext(false)
+			am = ibc.NewStreamingAppModule(ibc.NewAppModule(app.IBCKeeper), cdc)
+			suite.Require().NoError(am.InitGenesis(newCtx, source))
+
+			suite.Require().Equal(expGenesis.ConnectionGenesis, ibc.ExportGenesis(newCtx, *app.IBCKeeper).ConnectionGenesis)
+			suite.Require().Equal(expGenesis.ChannelGenesis, channel.ExportGenesis(newCtx, app.IBCKeeper.ChannelKeeper))
+		})
+	}
+}
+
+func (suite *IBCTestSuite) TestStreamValidateGenesis() {
+	var genesisState *types.GenesisState
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"valid default genesis",
+			func() {},
+			true,
+		},
+		{
+			"invalid client genesis",
+			func() {
+				genesisState.ClientGenesis.Params.AllowedClients = []string{""}
+			},
+			false,
+		},
+		{
+			"invalid connection genesis",
+			func() {
+				genesisState.ConnectionGenesis.Params.MaxExpectedTimePerBlock = 0
+			},
+			false,
+		},
+		{
+			"invalid acknowledgement",
+			func() {
+				genesisState.ChannelGenesis.Acknowledgements = []channeltypes.PacketState{
+					channeltypes.NewPacketState(port1, channel1, 1, nil),
+				}
+			},
+			false,
+		},
+		{
+			"invalid receipt sequence",
+			func() {
+				genesisState.ChannelGenesis.Receipts = []channeltypes.PacketState{
+					channeltypes.NewPacketState(port1, channel1, 0, []byte{byte(1)}),
+				}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			genesisState = types.DefaultGenesisState()
+
+			tc.malleate()
+
+			cdc := suite.chainA.App.AppCodec()
+			bz, err := cdc.MarshalJSON(genesisState)
+			suite.Require().NoError(err)
+
+			source, err := coregenesis.SourceFromRawJSON(bz)
+			suite.Require().NoError(err)
+
+			err = ibc.StreamValidateGenesis(cdc, source)
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// TestStreamGenesisLargeState exports and imports an ibc genesis state with thousands of packet
+// acknowledgements and receipts through files, such that the genesis state is never held in memory.
+// The million-entry case is covered by BenchmarkStreamGenesisLargeState.
+func (suite *IBCTestSuite) TestStreamGenesisLargeState() {
+	const numPackets = 5_000
+
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	cdc := suite.chainA.App.AppCodec()
+	ctx := suite.chainA.GetContext()
+	ibcKeeper := suite.chainA.App.GetIBCKeeper()
+
+	portID, channelID := path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID
+	for sequence := uint64(1); sequence <= numPackets; sequence++ {
+		ibcKeeper.ChannelKeeper.SetPacketAcknowledgement(ctx, portID, channelID, sequence, ibctesting.MockAcknowledgement)
+		ibcKeeper.ChannelKeeper.SetPacketReceipt(ctx, portID, channelID, sequence)
+	}
+
+	dir := suite.T().TempDir()
+	suite.Require().NoError(ibc.StreamExportGenesis(ctx, *ibcKeeper, cdc, types.ExportOptions{}, fileTarget(dir)))
+	suite.Require().NoError(ibc.StreamValidateGenesis(cdc, fileSource(dir)))
+
+	app := simapp.Setup(suite.T(), false)
+	newCtx := app.BaseApp.NewContext(false)
+	suite.Require().NoError(ibc.StreamInitGenesis(newCtx, *app.IBCKeeper, cdc, fileSource(dir)))
+
+	var numAcks, numReceipts int
+	app.IBCKeeper.ChannelKeeper.IteratePacketAcknowledgement(newCtx, func(_, _ string, _ uint64, _ []byte) bool {
+		numAcks++
+		return false
+	})
+	app.IBCKeeper.ChannelKeeper.IteratePacketReceipt(newCtx, func(_, _ string, _ uint64, _ []byte) bool {
+		numReceipts++
+		return false
+	})
+
+	suite.Require().Equal(numPackets, numAcks)
+	suite.Require().Equal(numPackets, numReceipts)
+}
+
+// BenchmarkStreamGenesisLargeState exports, validates and imports an ibc genesis state with a million
+// packet acknowledgements and receipts through files. It is not run by go test without -bench, run it with:
+//
+//	go test ./modules/core -run ^$ -bench BenchmarkStreamGenesisLargeState -benchtime 1x
+func BenchmarkStreamGenesisLargeState(b *testing.B) {
+	const numPackets = 1_000_000
+
+	coord := &ibctesting.Coordinator{CurrentTime: time.Now()}
+	chain := newBenchmarkChain(b, coord, ibctesting.GetChainID(1))
+
+	cdc := chain.App.AppCodec()
+	ctx := chain.GetContext()
+	ibcKeeper := chain.App.GetIBCKeeper()
+
+	for sequence := uint64(1); sequence <= numPackets; sequence++ {
+		ibcKeeper.ChannelKeeper.SetPacketAcknowledgement(ctx, ibctesting.MockPort, ibctesting.FirstChannelID, sequence, ibctesting.MockAcknowledgement)
+		ibcKeeper.ChannelKeeper.SetPacketReceipt(ctx, ibctesting.MockPort, ibctesting.FirstChannelID, sequence)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		dir := b.TempDir()
+		newChain := newBenchmarkChain(b, coord, ibctesting.GetChainID(2))
+		newCtx := newChain.GetContext()
+		b.StartTimer()
+
+		require.NoError(b, ibc.StreamExportGenesis(ctx, *ibcKeeper, cdc, types.ExportOptions{}, fileTarget(dir)))
+		require.NoError(b, ibc.StreamValidateGenesis(cdc, fileSource(dir)))
+		require.NoError(b, ibc.StreamInitGenesis(newCtx, *newChain.App.GetIBCKeeper(), cdc, fileSource(dir)))
+	}
+}
+
+// newBenchmarkChain returns a test chain with a single validator, as ibctesting.NewTestChain requires a *testing.T.
+func newBenchmarkChain(b *testing.B, coord *ibctesting.Coordinator, chainID string) *ibctesting.TestChain {
+	b.Helper()
+
+	_, privVal := cmttypes.RandValidator(false, 100)
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(b, err)
+
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(pubKey, 1)})
+	signers := map[string]cmttypes.PrivValidator{pubKey.Address().String(): privVal}
+
+	return ibctesting.NewTestChainWithValSet(b, coord, chainID, valSet, signers)
+}
+
+// fileTarget returns a genesis target writing every field to a JSON file in dir.
+func fileTarget(dir string) appmodule.GenesisTarget {
+	return func(field string) (io.WriteCloser, error) {
+		return os.Create(filepath.Join(dir, field+".json"))
+	}
+}
+
+// fileSource returns a genesis source reading every field from a JSON file in dir.
+func fileSource(dir string) appmodule.GenesisSource {
+	return func(field string) (io.ReadCloser, error) {
+		f, err := os.Open(filepath.Join(dir, field+".json"))
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return f, err
+	}
+}
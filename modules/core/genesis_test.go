@@ -112,7 +112,7 @@ func (suite *IBCTestSuite) TestValidateGenesis() {
 						connectiontypes.NewConnectionPaths(clientID, []string{connectionID}),
 					},
 					0,
-					connectiontypes.NewParams(10),
+					connectiontypes.NewParams(10, uint64(connectiontypes.DefaultUpgradeTimeout), false),
 				),
 				ChannelGenesis: channeltypes.NewGenesisState(
 					[]channeltypes.IdentifiedChannel{
@@ -271,7 +271,7 @@ func (suite *IBCTestSuite) TestInitGenesis() {
 						connectiontypes.NewConnectionPaths(clientID, []string{connectionID}),
 					},
 					0,
-					connectiontypes.NewParams(10),
+					connectiontypes.NewParams(10, uint64(connectiontypes.DefaultUpgradeTimeout), false),
 				),
 				ChannelGenesis: channeltypes.NewGenesisState(
 					[]channeltypes.IdentifiedChannel{
@@ -366,3 +366,121 @@ func (suite *IBCTestSuite) TestExportGenesis() {
 		})
 	}
 }
+
+func (suite *IBCTestSuite) TestExportGenesisWithOptions() {
+	var (
+		path    *ibctesting.Path
+		expPass func(gs *types.GenesisState)
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+	}{
+		{
+			"pruneable acknowledgements and receipts are omitted",
+			func() {
+				channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+				portID, channelID := path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID
+				for seq := uint64(1); seq <= 6; seq++ {
+					channelKeeper.SetPacketAcknowledgement(suite.chainA.GetContext(), portID, channelID, seq, ibctesting.MockAcknowledgement)
+					channelKeeper.SetPacketReceipt(suite.chainA.GetContext(), portID, channelID, seq)
+				}
+				channelKeeper.SetRecvStartSequence(suite.chainA.GetContext(), portID, channelID, 5)
+				channelKeeper.SetPruningSequenceStart(suite.chainA.GetContext(), portID, channelID, 2)
+
+				expPass = func(gs *types.GenesisState) {
+					channelGenesis := gs.ChannelGenesis
+					suite.Require().Len(channelGenesis.Acknowledgements, 2)
+					suite.Require().Len(channelGenesis.Receipts, 2)
+					for _, packetState := range append(channelGenesis.Acknowledgements, channelGenesis.Receipts...) {
+						suite.Require().GreaterOrEqual(packetState.Sequence, uint64(5))
+					}
+
+					suite.Require().Equal([]channeltypes.PacketSequence{channeltypes.NewPacketSequence(portID, channelID, 5)}, channelGenesis.RecvStartSequences)
+					suite.Require().Equal([]channeltypes.PacketSequence{channeltypes.NewPacketSequence(portID, channelID, 5)}, channelGenesis.PruningSequenceStarts)
+				}
+			},
+		},
+		{
+			"pruneable timeout receipts are exported",
+			func() {
+				channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+				portID, channelID := path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID
+				channelKeeper.SetPacketReceipt(suite.chainA.GetContext(), portID, channelID, 1)
+				channelKeeper.SetPacketTimeoutReceipt(suite.chainA.GetContext(), portID, channelID, 2)
+				channelKeeper.SetRecvStartSequence(suite.chainA.GetContext(), portID, channelID, 5)
+				channelKeeper.SetPruningSequenceStart(suite.chainA.GetContext(), portID, channelID, 1)
+
+				expPass = func(gs *types.GenesisState) {
+					channelGenesis := gs.ChannelGenesis
+					suite.Require().Equal([]channeltypes.PacketState{channeltypes.NewPacketState(portID, channelID, 2, channeltypes.TimeoutReceipt)}, channelGenesis.Receipts)
+				}
+			},
+		},
+		{
+			"closed channel and its packet state are exported",
+			func() {
+				channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+				portID, channelID := path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID
+				channelKeeper.SetPacketAcknowledgement(suite.chainA.GetContext(), portID, channelID, 1, ibctesting.MockAcknowledgement)
+				channelKeeper.SetPacketReceipt(suite.chainA.GetContext(), portID, channelID, 1)
+				suite.Require().NoError(path.EndpointA.SetChannelState(channeltypes.CLOSED))
+
+				expPass = func(gs *types.GenesisState) {
+					// the counterparty must remain able to prove the closure of the channel and the receipt of its packets
+					channelGenesis := gs.ChannelGenesis
+					suite.Require().Len(channelGenesis.Channels, 1)
+					suite.Require().Equal(channeltypes.CLOSED, channelGenesis.Channels[0].State)
+					suite.Require().Len(channelGenesis.Acknowledgements, 1)
+					suite.Require().Len(channelGenesis.Receipts, 1)
+					suite.Require().Len(channelGenesis.SendSequences, 1)
+					suite.Require().Len(channelGenesis.RecvSequences, 1)
+					suite.Require().Len(channelGenesis.AckSequences, 1)
+				}
+			},
+		},
+		{
+			"expired consensus states are omitted",
+			func() {
+				suite.coordinator.IncrementTimeBy(ibctesting.TrustingPeriod)
+				suite.coordinator.CommitBlock(suite.chainA)
+
+				expPass = func(gs *types.GenesisState) {
+					clientGenesis := gs.ClientGenesis
+					suite.Require().Len(clientGenesis.Clients, 2) // tendermint and localhost clients
+					suite.Require().Empty(clientGenesis.ClientsConsensus)
+					suite.Require().Empty(clientGenesis.ClientsMetadata)
+
+					// the consensus states remain in state
+					consensusStates := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetAllConsensusStates(suite.chainA.GetContext())
+					suite.Require().NotEmpty(consensusStates)
+				}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			tc.malleate()
+
+			gs := ibc.ExportGenesisWithOptions(suite.chainA.GetContext(), *suite.chainA.App.GetIBCKeeper(), types.PruneableExportOptions())
+			suite.Require().NoError(gs.Validate())
+
+			expPass(gs)
+
+			// init genesis based on export
+			app := simapp.Setup(suite.T(), false)
+			suite.NotPanics(func() {
+				ibc.InitGenesis(app.BaseApp.NewContext(false), *app.IBCKeeper, gs)
+			})
+		})
+	}
+}
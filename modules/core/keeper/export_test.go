@@ -7,3 +7,9 @@ import sdk "github.com/cosmos/cosmos-sdk/types"
 func ConvertToErrorEvents(events sdk.Events) sdk.Events {
 	return convertToErrorEvents(events)
 }
+
+// RetryFailedChannelUpgradesWithLimit is a wrapper around retryFailedChannelUpgrades
+// to allow the function to be directly called in tests.
+func (k *Keeper) RetryFailedChannelUpgradesWithLimit(ctx sdk.Context, maxRetries int) {
+	k.retryFailedChannelUpgrades(ctx, maxRetries)
+}
@@ -163,7 +163,42 @@ func (k Keeper) Upgrade(c context.Context, req *channeltypes.QueryUpgradeRequest
 	return k.ChannelKeeper.Upgrade(c, req)
 }
 
+// UpgradeFlushStatus implements the IBC QueryServer interface
+func (k Keeper) UpgradeFlushStatus(c context.Context, req *channeltypes.QueryUpgradeFlushStatusRequest) (*channeltypes.QueryUpgradeFlushStatusResponse, error) {
+	return k.ChannelKeeper.UpgradeFlushStatus(c, req)
+}
+
 // ChannelParams implements the IBC QueryServer interface
 func (k Keeper) ChannelParams(c context.Context, req *channeltypes.QueryChannelParamsRequest) (*channeltypes.QueryChannelParamsResponse, error) {
 	return k.ChannelKeeper.ChannelParams(c, req)
 }
+
+// PacketsBySender implements the IBC QueryServer interface
+func (k Keeper) PacketsBySender(c context.Context, req *channeltypes.QueryPacketsBySenderRequest) (*channeltypes.QueryPacketsBySenderResponse, error) {
+	return k.ChannelKeeper.PacketsBySender(c, req)
+}
+
+// PacketsByReceiver implements the IBC QueryServer interface
+func (k Keeper) PacketsByReceiver(c context.Context, req *channeltypes.QueryPacketsByReceiverRequest) (*channeltypes.QueryPacketsByReceiverResponse, error) {
+	return k.ChannelKeeper.PacketsByReceiver(c, req)
+}
+
+// UpgradeBatch implements the IBC QueryServer interface
+func (k Keeper) UpgradeBatch(c context.Context, req *channeltypes.QueryUpgradeBatchRequest) (*channeltypes.QueryUpgradeBatchResponse, error) {
+	return k.ChannelKeeper.UpgradeBatch(c, req)
+}
+
+// PruningProgress implements the IBC QueryServer interface
+func (k Keeper) PruningProgress(c context.Context, req *channeltypes.QueryPruningProgressRequest) (*channeltypes.QueryPruningProgressResponse, error) {
+	return k.ChannelKeeper.PruningProgress(c, req)
+}
+
+// DecodePacketData implements the IBC QueryServer interface
+func (k Keeper) DecodePacketData(c context.Context, req *channeltypes.QueryDecodePacketDataRequest) (*channeltypes.QueryDecodePacketDataResponse, error) {
+	return k.ChannelKeeper.DecodePacketData(c, req)
+}
+
+// Invariants implements the IBC QueryServer interface
+func (k Keeper) Invariants(c context.Context, req *channeltypes.QueryInvariantsRequest) (*channeltypes.QueryInvariantsResponse, error) {
+	return k.ChannelKeeper.Invariants(c, req)
+}
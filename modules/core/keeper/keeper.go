@@ -91,6 +91,14 @@ func (k *Keeper) SetRouter(rtr *porttypes.Router) {
 	k.Router.Seal()
 }
 
+// SetPacketDataRegistry sets the PacketDataRegistry used to decode packet data and seals it.
+// The method panics if the registry is already sealed.
+func (k *Keeper) SetPacketDataRegistry(registry *porttypes.PacketDataRegistry) {
+	registry.Seal()
+
+	k.ChannelKeeper.SetPacketDataRegistry(registry)
+}
+
 // GetAuthority returns the ibc module's authority.
 func (k Keeper) GetAuthority() string {
 	return k.authority
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channelkeeper "github.com/cosmos/ibc-go/v8/modules/core/04-channel/keeper"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	localhost "github.com/cosmos/ibc-go/v8/modules/light-clients/09-localhost"
+)
+
+// RelayLocalhostPackets relays the packets and acknowledgements queued on channels built upon the 09-localhost
+// connection when automatic relaying is enabled in the channel params. Entries are relayed in the order in which
+// they were queued, so acknowledgements written synchronously while receiving a packet are processed within the
+// same block. At most MaxPacketsPerBlock entries are relayed per block, any remaining entries are relayed in
+// subsequent blocks.
+//
+// Entries which fail to be relayed are removed from the queue and a localhost_relay_failed event is emitted.
+// Such packets remain in state and may still be relayed, acknowledged or timed out by an off-chain relayer.
+func (k *Keeper) RelayLocalhostPackets(ctx sdk.Context) {
+	params := k.ChannelKeeper.GetParams(ctx).LocalhostAutoRelay
+	if !params.Enabled {
+		return
+	}
+
+	relayer := authtypes.NewModuleAddress(exported.ModuleName).String()
+	for i := uint64(0); i < params.MaxPacketsPerBlock; i++ {
+		index, entry, found := k.ChannelKeeper.GetNextLocalhostRelayEntry(ctx)
+		if !found {
+			return
+		}
+
+		k.ChannelKeeper.DeleteLocalhostRelayEntry(ctx, index)
+
+		if err := k.relayLocalhostEntry(ctx, entry, relayer, params.MaxGasPerPacket); err != nil {
+			ctx.Logger().Error("localhost auto relay failed", "port-id", entry.Packet.SourcePort, "channel-id", entry.Packet.SourceChannel, "sequence", entry.Packet.Sequence, "error", err)
+			channelkeeper.EmitLocalhostRelayFailedEvent(ctx, entry.Packet, entry.Acknowledgement, err)
+		}
+	}
+}
+
+// relayLocalhostEntry delivers a single localhost relay queue entry using the same handlers as MsgRecvPacket
+// and MsgAcknowledgement. State changes are only written if relaying succeeds within the provided gas limit.
+func (k *Keeper) relayLocalhostEntry(ctx sdk.Context, entry channeltypes.LocalhostRelayEntry, relayer string, gasLimit uint64) (err error) {
+	cacheCtx, writeFn := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(storetypes.NewGasMeter(gasLimit))
+
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(storetypes.ErrorOutOfGas); ok {
+				err = errorsmod.Wrapf(ibcerrors.ErrOutOfGas, "localhost relay exceeded gas limit %d", gasLimit)
+				return
+			}
+			err = fmt.Errorf("localhost relay panicked with: %v", r)
+		}
+	}()
+
+	proofHeight := clienttypes.GetSelfHeight(ctx)
+	if len(entry.Acknowledgement) == 0 {
+		msg := channeltypes.NewMsgRecvPacket(entry.Packet, localhost.SentinelProof, proofHeight, relayer)
+		if _, err := k.RecvPacket(cacheCtx, msg); err != nil {
+			return err
+		}
+	} else {
+		msg := channeltypes.NewMsgAcknowledgement(entry.Packet, entry.Acknowledgement, localhost.SentinelProof, proofHeight, relayer)
+		if _, err := k.Acknowledgement(cacheCtx, msg); err != nil {
+			return err
+		}
+	}
+
+	writeFn()
+
+	return nil
+}
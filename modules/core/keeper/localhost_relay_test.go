@@ -0,0 +1,128 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	localhost "github.com/cosmos/ibc-go/v8/modules/light-clients/09-localhost"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func (suite *KeeperTestSuite) TestRelayLocalhostPackets() {
+	var autoRelay channeltypes.LocalhostAutoRelay
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		expRelayed bool
+	}{
+		{
+			"success: packet received and acknowledged within the same block",
+			func() {},
+			true,
+		},
+		{
+			"packet is not relayed when auto relay is disabled",
+			func() {
+				autoRelay.Enabled = false
+			},
+			false,
+		},
+		{
+			"packet is not relayed when the gas limit is exceeded",
+			func() {
+				autoRelay.MaxGasPerPacket = 1
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			autoRelay = channeltypes.NewLocalhostAutoRelay(true, channeltypes.DefaultLocalhostMaxPacketsPerBlock, channeltypes.DefaultLocalhostMaxGasPerPacket)
+
+			tc.malleate()
+
+			channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+			params := channelKeeper.GetParams(suite.chainA.GetContext())
+			params.LocalhostAutoRelay = autoRelay
+			channelKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			channelIDA, channelIDB := suite.openLocalhostTransferChannel()
+
+			coin := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))
+			msgTransfer := transfertypes.NewMsgTransfer(
+				transfertypes.PortID, channelIDA, coin,
+				suite.chainA.SenderAccount.GetAddress().String(), ibctesting.TestAccAddress,
+				suite.chainA.GetTimeoutHeight(), 0, "",
+			)
+
+			_, err := suite.chainA.SendMsgs(msgTransfer)
+			suite.Require().NoError(err)
+
+			ctx := suite.chainA.GetContext()
+			suite.Require().Empty(channelKeeper.GetAllLocalhostRelayEntries(ctx))
+
+			ibcDenom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(transfertypes.PortID, channelIDB, sdk.DefaultBondDenom)).IBCDenom()
+			receiver, err := sdk.AccAddressFromBech32(ibctesting.TestAccAddress)
+			suite.Require().NoError(err)
+			balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(ctx, receiver, ibcDenom)
+
+			if tc.expRelayed {
+				suite.Require().False(channelKeeper.HasPacketCommitment(ctx, transfertypes.PortID, channelIDA, 1))
+				suite.Require().True(channelKeeper.HasPacketAcknowledgement(ctx, transfertypes.PortID, channelIDB, 1))
+				suite.Require().Equal(coin.Amount, balance.Amount)
+			} else {
+				suite.Require().True(channelKeeper.HasPacketCommitment(ctx, transfertypes.PortID, channelIDA, 1))
+				suite.Require().False(channelKeeper.HasPacketAcknowledgement(ctx, transfertypes.PortID, channelIDB, 1))
+				suite.Require().True(balance.IsZero())
+			}
+		})
+	}
+}
+
+// openLocalhostTransferChannel opens a transfer channel on chainA over the localhost connection
+// and returns the identifiers of both channel ends.
+func (suite *KeeperTestSuite) openLocalhostTransferChannel() (string, string) {
+	channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+	signer := suite.chainA.SenderAccount.GetAddress().String()
+	connectionHops := []string{exported.LocalhostConnectionID}
+
+	channelIDA := channeltypes.FormatChannelIdentifier(channelKeeper.GetNextChannelSequence(suite.chainA.GetContext()))
+	msgChanOpenInit := channeltypes.NewMsgChannelOpenInit(
+		transfertypes.PortID, transfertypes.Version, channeltypes.UNORDERED, connectionHops, transfertypes.PortID, signer,
+	)
+	_, err := suite.chainA.SendMsgs(msgChanOpenInit)
+	suite.Require().NoError(err)
+
+	channelIDB := channeltypes.FormatChannelIdentifier(channelKeeper.GetNextChannelSequence(suite.chainA.GetContext()))
+	msgChanOpenTry := channeltypes.NewMsgChannelOpenTry(
+		transfertypes.PortID, transfertypes.Version, channeltypes.UNORDERED, connectionHops,
+		transfertypes.PortID, channelIDA, transfertypes.Version, localhost.SentinelProof, clienttypes.ZeroHeight(), signer,
+	)
+	_, err = suite.chainA.SendMsgs(msgChanOpenTry)
+	suite.Require().NoError(err)
+
+	msgChanOpenAck := channeltypes.NewMsgChannelOpenAck(
+		transfertypes.PortID, channelIDA, channelIDB, transfertypes.Version, localhost.SentinelProof, clienttypes.ZeroHeight(), signer,
+	)
+	_, err = suite.chainA.SendMsgs(msgChanOpenAck)
+	suite.Require().NoError(err)
+
+	msgChanOpenConfirm := channeltypes.NewMsgChannelOpenConfirm(
+		transfertypes.PortID, channelIDB, localhost.SentinelProof, clienttypes.ZeroHeight(), signer,
+	)
+	_, err = suite.chainA.SendMsgs(msgChanOpenConfirm)
+	suite.Require().NoError(err)
+
+	return channelIDA, channelIDB
+}
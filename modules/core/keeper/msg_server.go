@@ -198,6 +198,89 @@ func (k Keeper) ConnectionOpenConfirm(goCtx context.Context, msg *connectiontype
 	return &connectiontypes.MsgConnectionOpenConfirmResponse{}, nil
 }
 
+// ConnectionUpgradeInit defines a rpc handler method for MsgConnectionUpgradeInit.
+func (k Keeper) ConnectionUpgradeInit(goCtx context.Context, msg *connectiontypes.MsgConnectionUpgradeInit) (*connectiontypes.MsgConnectionUpgradeInitResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	upgradeSequence, err := k.ConnectionKeeper.ConnUpgradeInit(ctx, msg.ConnectionId, msg.Fields)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "connection upgrade init failed")
+	}
+
+	return &connectiontypes.MsgConnectionUpgradeInitResponse{UpgradeSequence: upgradeSequence}, nil
+}
+
+// ConnectionUpgradeTry defines a rpc handler method for MsgConnectionUpgradeTry.
+func (k Keeper) ConnectionUpgradeTry(goCtx context.Context, msg *connectiontypes.MsgConnectionUpgradeTry) (*connectiontypes.MsgConnectionUpgradeTryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	upgradeSequence, err := k.ConnectionKeeper.ConnUpgradeTry(
+		ctx, msg.ConnectionId, msg.CounterpartyUpgradeFields, msg.CounterpartyUpgradeSequence,
+		msg.ProofConnection, msg.ProofHeight,
+	)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "connection upgrade try failed")
+	}
+
+	return &connectiontypes.MsgConnectionUpgradeTryResponse{UpgradeSequence: upgradeSequence}, nil
+}
+
+// ConnectionUpgradeAck defines a rpc handler method for MsgConnectionUpgradeAck.
+func (k Keeper) ConnectionUpgradeAck(goCtx context.Context, msg *connectiontypes.MsgConnectionUpgradeAck) (*connectiontypes.MsgConnectionUpgradeAckResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.ConnectionKeeper.ConnUpgradeAck(
+		ctx, msg.ConnectionId, msg.CounterpartyUpgradeFields, msg.ProofConnection, msg.ProofHeight,
+	); err != nil {
+		return nil, errorsmod.Wrap(err, "connection upgrade ack failed")
+	}
+
+	return &connectiontypes.MsgConnectionUpgradeAckResponse{}, nil
+}
+
+// ConnectionUpgradeConfirm defines a rpc handler method for MsgConnectionUpgradeConfirm.
+func (k Keeper) ConnectionUpgradeConfirm(goCtx context.Context, msg *connectiontypes.MsgConnectionUpgradeConfirm) (*connectiontypes.MsgConnectionUpgradeConfirmResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.ConnectionKeeper.ConnUpgradeConfirm(ctx, msg.ConnectionId, msg.ProofConnection, msg.ProofHeight); err != nil {
+		return nil, errorsmod.Wrap(err, "connection upgrade confirm failed")
+	}
+
+	return &connectiontypes.MsgConnectionUpgradeConfirmResponse{}, nil
+}
+
+// ConnectionUpgradeTimeout defines a rpc handler method for MsgConnectionUpgradeTimeout.
+func (k Keeper) ConnectionUpgradeTimeout(goCtx context.Context, msg *connectiontypes.MsgConnectionUpgradeTimeout) (*connectiontypes.MsgConnectionUpgradeTimeoutResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.ConnectionKeeper.ConnUpgradeTimeout(
+		ctx, msg.ConnectionId, msg.CounterpartyConnection, msg.ProofConnection, msg.ProofHeight,
+	); err != nil {
+		return nil, errorsmod.Wrap(err, "connection upgrade timeout failed")
+	}
+
+	return &connectiontypes.MsgConnectionUpgradeTimeoutResponse{}, nil
+}
+
+// ConnectionUpgradeCancel defines a rpc handler method for MsgConnectionUpgradeCancel.
+func (k Keeper) ConnectionUpgradeCancel(goCtx context.Context, msg *connectiontypes.MsgConnectionUpgradeCancel) (*connectiontypes.MsgConnectionUpgradeCancelResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.ConnectionKeeper.ConnUpgradeCancel(ctx, msg.ConnectionId); err != nil {
+		return nil, errorsmod.Wrap(err, "connection upgrade cancel failed")
+	}
+
+	return &connectiontypes.MsgConnectionUpgradeCancelResponse{}, nil
+}
+
 // ChannelOpenInit defines a rpc handler method for MsgChannelOpenInit.
 // ChannelOpenInit will perform 04-channel checks, route to the application
 // callback, and write an OpenInit channel into state upon successful execution.
@@ -481,6 +564,11 @@ func (k Keeper) RecvPacket(goCtx context.Context, msg *channeltypes.MsgRecvPacke
 		// no-ops do not need event emission as they will be ignored
 		ctx.Logger().Debug("no-op on redundant relay", "port-id", msg.Packet.SourcePort, "channel-id", msg.Packet.SourceChannel)
 		return &channeltypes.MsgRecvPacketResponse{Result: channeltypes.NOOP}, nil
+	case channeltypes.ErrPacketSkipped:
+		// timed out packets on ORDERED_ALLOW_TIMEOUT channels are skipped without executing the application callback
+		writeFn()
+		ctx.Logger().Info("receive packet skipped", "port-id", msg.Packet.SourcePort, "channel-id", msg.Packet.SourceChannel, "sequence", msg.Packet.Sequence)
+		return &channeltypes.MsgRecvPacketResponse{Result: channeltypes.SUCCESS}, nil
 	default:
 		ctx.Logger().Error("receive packet failed", "port-id", msg.Packet.SourcePort, "channel-id", msg.Packet.SourceChannel, "error", errorsmod.Wrap(err, "receive packet verification failed"))
 		return nil, errorsmod.Wrap(err, "receive packet verification failed")
@@ -741,8 +829,8 @@ func (k Keeper) Acknowledgement(goCtx context.Context, msg *channeltypes.MsgAckn
 func (k Keeper) ChannelUpgradeInit(goCtx context.Context, msg *channeltypes.MsgChannelUpgradeInit) (*channeltypes.MsgChannelUpgradeInitResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	if k.GetAuthority() != msg.Signer {
-		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	if k.GetAuthority() != msg.Signer && !k.ChannelKeeper.IsAllowedUpgradeSigner(ctx, msg.PortId, msg.Signer) {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s or a signer allowed by the upgrade policy of port %s, got %s", k.GetAuthority(), msg.PortId, msg.Signer)
 	}
 
 	module, _, err := k.ChannelKeeper.LookupModuleByChannel(ctx, msg.PortId, msg.ChannelId)
@@ -1099,6 +1187,48 @@ func (k Keeper) PruneAcknowledgements(goCtx context.Context, msg *channeltypes.M
 	}, nil
 }
 
+// EnableAcknowledgementPruning defines a rpc handler method for MsgEnableAcknowledgementPruning.
+func (k Keeper) EnableAcknowledgementPruning(goCtx context.Context, msg *channeltypes.MsgEnableAcknowledgementPruning) (*channeltypes.MsgEnableAcknowledgementPruningResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.ChannelKeeper.EnableAcknowledgementPruning(ctx, msg.PortId, msg.ChannelId, msg.RecvStartSequence); err != nil {
+		return nil, errorsmod.Wrap(err, "enable acknowledgement pruning failed")
+	}
+
+	ctx.Logger().Info("acknowledgement pruning enabled", "port-id", msg.PortId, "channel-id", msg.ChannelId, "recv-start-sequence", msg.RecvStartSequence)
+
+	return &channeltypes.MsgEnableAcknowledgementPruningResponse{}, nil
+}
+
+// ChannelUpgradeInitBatch defines a rpc handler method for MsgChannelUpgradeInitBatch.
+func (k Keeper) ChannelUpgradeInitBatch(goCtx context.Context, msg *channeltypes.MsgChannelUpgradeInitBatch) (*channeltypes.MsgChannelUpgradeInitBatchResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	batch := k.initUpgradeBatch(ctx, msg)
+
+	var failed uint64
+	for _, channel := range batch.Channels {
+		if channel.InitError != "" {
+			failed++
+		}
+	}
+
+	ctx.Logger().Info("channel upgrade batch initialized", "batch-id", batch.Id, "channels", len(batch.Channels), "failed", failed)
+	keeper.EmitChannelUpgradeBatchEvent(ctx, batch)
+
+	return &channeltypes.MsgChannelUpgradeInitBatchResponse{
+		BatchId:             batch.Id,
+		InitializedChannels: uint64(len(batch.Channels)) - failed,
+		FailedChannels:      failed,
+	}, nil
+}
+
 // UpdateClientParams defines a rpc handler method for MsgUpdateParams.
 func (k Keeper) UpdateClientParams(goCtx context.Context, msg *clienttypes.MsgUpdateParams) (*clienttypes.MsgUpdateParamsResponse, error) {
 	if k.GetAuthority() != msg.Signer {
@@ -222,6 +222,48 @@ func (suite *KeeperTestSuite) TestHandleRecvPacket() {
 	}
 }
 
+// TestHandleRecvPacketSkipped tests that timed out packets on ORDERED_ALLOW_TIMEOUT channels are skipped
+// without executing the application callback.
+func (suite *KeeperTestSuite) TestHandleRecvPacketSkipped() {
+	suite.SetupTest() // reset
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetChannelOrderedAllowTimeout()
+	path.Setup()
+
+	packetTimeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+	sequence, err := path.EndpointA.SendPacket(packetTimeoutHeight, 0, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+
+	packet := channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, packetTimeoutHeight, 0)
+
+	packetKey := host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	proof, proofHeight := path.EndpointA.QueryProof(packetKey)
+
+	msg := channeltypes.NewMsgRecvPacket(packet, proof, proofHeight, suite.chainB.SenderAccount.GetAddress().String())
+
+	ctx := suite.chainB.GetContext()
+	res, err := keeper.Keeper.RecvPacket(*suite.chainB.App.GetIBCKeeper(), ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(channeltypes.SUCCESS, res.Result)
+
+	// the application callback is not executed and no acknowledgement is written
+	_, exists := suite.chainB.GetSimApp().ScopedIBCMockKeeper.GetCapability(suite.chainB.GetContext(), ibcmock.GetMockRecvCanaryCapabilityName(packet))
+	suite.Require().False(exists)
+	suite.Require().NotContains(ctx.EventManager().Events(), ibcmock.NewMockRecvPacketEvent())
+
+	_, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	suite.Require().False(found)
+
+	nextSeqRecv, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel())
+	suite.Require().True(found)
+	suite.Require().Equal(sequence+1, nextSeqRecv)
+
+	// replay is treated as a no-op
+	res, err = keeper.Keeper.RecvPacket(*suite.chainB.App.GetIBCKeeper(), suite.chainB.GetContext(), msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(channeltypes.NOOP, res.Result)
+}
+
 func (suite *KeeperTestSuite) TestRecoverClient() {
 	var msg *clienttypes.MsgRecoverClient
 
@@ -980,6 +1022,29 @@ func (suite *KeeperTestSuite) TestChannelUpgradeInit() {
 				suite.Require().Empty(events)
 			},
 		},
+		{
+			"success: signer is allowed by the upgrade policy of the port",
+			func() {
+				msg = channeltypes.NewMsgChannelUpgradeInit(
+					path.EndpointA.ChannelConfig.PortID,
+					path.EndpointA.ChannelID,
+					path.EndpointA.GetProposedUpgrade().Fields,
+					path.EndpointA.Chain.SenderAccount.GetAddress().String(),
+				)
+
+				channelKeeper := suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper
+				params := channelKeeper.GetParams(suite.chainA.GetContext())
+				params.UpgradePolicies = []channeltypes.UpgradePolicy{
+					channeltypes.NewUpgradePolicy(path.EndpointA.ChannelConfig.PortID, []string{msg.Signer}, true, nil, false),
+				}
+				channelKeeper.SetParams(suite.chainA.GetContext(), params)
+			},
+			func(res *channeltypes.MsgChannelUpgradeInitResponse, events []abci.Event, err error) {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(uint64(1), res.UpgradeSequence)
+			},
+		},
 		{
 			"ibc application does not implement the UpgradeableModule interface",
 			func() {
@@ -2736,6 +2801,129 @@ func (suite *KeeperTestSuite) TestUpdateConnectionParams() {
 	}
 }
 
+// TestConnectionUpgradeInit tests the ConnectionUpgradeInit rpc handler
+func (suite *KeeperTestSuite) TestConnectionUpgradeInit() {
+	var (
+		path *ibctesting.Path
+		msg  *connectiontypes.MsgConnectionUpgradeInit
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"unauthorized signer",
+			func() {
+				msg.Signer = ibctesting.TestAccAddress
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"connection upgrade init fails",
+			func() {
+				msg.ConnectionId = ibctesting.InvalidID
+			},
+			connectiontypes.ErrConnectionNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupConnections()
+
+			connection := path.EndpointA.GetConnection()
+			upgradeFields := connectiontypes.NewConnectionUpgradeFields(connection.Versions, connection.DelayPeriod+1, connection.Counterparty.Prefix)
+			msg = connectiontypes.NewMsgConnectionUpgradeInit(path.EndpointA.ConnectionID, upgradeFields, suite.chainA.App.GetIBCKeeper().GetAuthority())
+
+			tc.malleate()
+
+			res, err := keeper.Keeper.ConnectionUpgradeInit(*suite.chainA.App.GetIBCKeeper(), suite.chainA.GetContext(), msg)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(uint64(1), res.UpgradeSequence)
+				suite.Require().Equal(&upgradeFields, path.EndpointA.GetConnection().ProposedUpgrade)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+// TestConnectionUpgradeCancel tests the ConnectionUpgradeCancel rpc handler
+func (suite *KeeperTestSuite) TestConnectionUpgradeCancel() {
+	var (
+		path *ibctesting.Path
+		msg  *connectiontypes.MsgConnectionUpgradeCancel
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"unauthorized signer",
+			func() {
+				msg.Signer = ibctesting.TestAccAddress
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"connection upgrade not found",
+			func() {
+				err := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.ConnUpgradeCancel(suite.chainA.GetContext(), path.EndpointA.ConnectionID)
+				suite.Require().NoError(err)
+			},
+			connectiontypes.ErrUpgradeNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupConnections()
+
+			connection := path.EndpointA.GetConnection()
+			upgradeFields := connectiontypes.NewConnectionUpgradeFields(connection.Versions, connection.DelayPeriod+1, connection.Counterparty.Prefix)
+			suite.Require().NoError(path.EndpointA.ConnUpgradeInit(upgradeFields))
+
+			msg = connectiontypes.NewMsgConnectionUpgradeCancel(path.EndpointA.ConnectionID, suite.chainA.App.GetIBCKeeper().GetAuthority())
+
+			tc.malleate()
+
+			_, err := keeper.Keeper.ConnectionUpgradeCancel(*suite.chainA.App.GetIBCKeeper(), suite.chainA.GetContext(), msg)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().Nil(path.EndpointA.GetConnection().ProposedUpgrade)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
 // TestUpdateChannelParams tests the UpdateChannelParams rpc handler
 func (suite *KeeperTestSuite) TestUpdateChannelParams() {
 	authority := suite.chainA.App.GetIBCKeeper().GetAuthority()
@@ -2865,3 +3053,66 @@ func (suite *KeeperTestSuite) TestPruneAcknowledgements() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestEnableAcknowledgementPruning() {
+	var msg *channeltypes.MsgEnableAcknowledgementPruning
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: unauthorized authority address",
+			func() {
+				msg.Authority = ibctesting.TestAccAddress
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: core keeper function fails, channel not found",
+			func() {
+				msg.ChannelId = "channel-100"
+			},
+			channeltypes.ErrChannelNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			msg = channeltypes.NewMsgEnableAcknowledgementPruning(
+				suite.chainA.App.GetIBCKeeper().GetAuthority(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				10,
+			)
+
+			tc.malleate()
+
+			resp, err := suite.chainA.App.GetIBCKeeper().EnableAcknowledgementPruning(suite.chainA.GetContext(), msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(resp)
+
+				recvStartSequence, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetRecvStartSequence(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(uint64(10), recvStartSequence)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(resp)
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channelkeeper "github.com/cosmos/ibc-go/v8/modules/core/04-channel/keeper"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// initUpgradeBatch initializes the upgrade of every open channel matching the filter of the message and stores
+// the resulting upgrade batch. The upgrade of each channel keeps its connection hops and, unless the message
+// specifies one, its ordering. Channels whose upgrade cannot be initialized are recorded in the batch along with
+// the initialization error, they do not prevent the upgrade of the other channels.
+func (k *Keeper) initUpgradeBatch(ctx sdk.Context, msg *channeltypes.MsgChannelUpgradeInitBatch) channeltypes.UpgradeBatch {
+	batch := channeltypes.UpgradeBatch{
+		Filter:      msg.Filter,
+		Ordering:    msg.Ordering,
+		Version:     msg.Version,
+		RetryPolicy: msg.RetryPolicy,
+	}
+
+	for _, channel := range k.ChannelKeeper.GetUpgradeBatchChannels(ctx, msg.Filter) {
+		batchChannel := channeltypes.UpgradeBatchChannel{
+			PortId:    channel.PortId,
+			ChannelId: channel.ChannelId,
+		}
+
+		k.initBatchChannelUpgrade(ctx, batch, &batchChannel)
+		batch.Channels = append(batch.Channels, batchChannel)
+	}
+
+	return k.ChannelKeeper.SetUpgradeBatch(ctx, batch)
+}
+
+// RetryFailedChannelUpgrades re-initializes the failed upgrades of the channels of upgrade batches whose retry
+// policy allows it. The upgrade of a channel is retried at most MaxRetries times, once per block, and is not
+// retried while an upgrade initialized outside of the batch is pending on the channel. At most
+// MaxUpgradeRetriesPerBlock upgrades are retried per block. Batches are no longer retried once the upgrade of
+// every channel has completed or failed without remaining retries.
+func (k *Keeper) RetryFailedChannelUpgrades(ctx sdk.Context) {
+	k.retryFailedChannelUpgrades(ctx, channeltypes.MaxUpgradeRetriesPerBlock)
+}
+
+// retryFailedChannelUpgrades re-initializes at most maxRetries failed upgrades of the channels of active upgrade
+// batches, in order of batch identifier and channel.
+func (k *Keeper) retryFailedChannelUpgrades(ctx sdk.Context, maxRetries int) {
+	var batches []channeltypes.UpgradeBatch
+	k.ChannelKeeper.IterateActiveUpgradeBatches(ctx, func(batch channeltypes.UpgradeBatch) bool {
+		batches = append(batches, batch)
+		return false
+	})
+
+	var retried int
+	for _, batch := range batches {
+		if retried >= maxRetries {
+			return
+		}
+
+		states, _ := k.ChannelKeeper.GetUpgradeBatchStatus(ctx, batch)
+		for i, state := range states {
+			if retried >= maxRetries {
+				break
+			}
+
+			if state.Status != channeltypes.UPGRADE_STATUS_FAILED || state.IsTerminal(batch.RetryPolicy) {
+				continue
+			}
+
+			batchChannel := &batch.Channels[i]
+			if _, found := k.ChannelKeeper.GetUpgrade(ctx, batchChannel.PortId, batchChannel.ChannelId); found {
+				continue
+			}
+
+			batchChannel.Retries++
+			k.initBatchChannelUpgrade(ctx, batch, batchChannel)
+			channelkeeper.EmitChannelUpgradeRetryEvent(ctx, batch.Id, *batchChannel)
+			retried++
+		}
+
+		k.ChannelKeeper.SetUpgradeBatch(ctx, batch)
+	}
+}
+
+// initBatchChannelUpgrade initializes the upgrade of a channel of an upgrade batch using the same handler as
+// MsgChannelUpgradeInit and records the resulting upgrade sequence, or the initialization error, in the batch
+// channel. State changes are only written if the upgrade is initialized successfully.
+func (k *Keeper) initBatchChannelUpgrade(ctx sdk.Context, batch channeltypes.UpgradeBatch, batchChannel *channeltypes.UpgradeBatchChannel) {
+	channel, found := k.ChannelKeeper.GetChannel(ctx, batchChannel.PortId, batchChannel.ChannelId)
+	if !found {
+		batchChannel.InitError = errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", batchChannel.PortId, batchChannel.ChannelId).Error()
+		return
+	}
+
+	ordering := batch.Ordering
+	if ordering == channeltypes.NONE {
+		ordering = channel.Ordering
+	}
+
+	fields := channeltypes.NewUpgradeFields(ordering, channel.ConnectionHops, batch.Version)
+	msg := channeltypes.NewMsgChannelUpgradeInit(batchChannel.PortId, batchChannel.ChannelId, fields, k.GetAuthority())
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	res, err := k.ChannelUpgradeInit(cacheCtx, msg)
+	if err != nil {
+		batchChannel.UpgradeSequence = channel.UpgradeSequence
+		batchChannel.InitError = err.Error()
+		return
+	}
+
+	writeCache()
+
+	batchChannel.UpgradeSequence = res.UpgradeSequence
+	batchChannel.InitError = ""
+}
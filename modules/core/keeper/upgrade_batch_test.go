@@ -0,0 +1,332 @@
+package keeper_test
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	ibcmock "github.com/cosmos/ibc-go/v8/testing/mock"
+)
+
+var errUpgradeInit = errors.New("upgrade init failed")
+
+func (suite *KeeperTestSuite) TestChannelUpgradeInitBatch() {
+	var (
+		path, path2 *ibctesting.Path
+		msg         *channeltypes.MsgChannelUpgradeInitBatch
+	)
+
+	testCases := []struct {
+		name           string
+		malleate       func()
+		expInitialized int
+		expFailed      int
+		expErr         error
+	}{
+		{
+			"success: all channels bound to the port",
+			func() {},
+			2,
+			0,
+			nil,
+		},
+		{
+			"success: channels built upon the connection",
+			func() {
+				msg.Filter.ConnectionId = path2.EndpointA.ConnectionID
+			},
+			1,
+			0,
+			nil,
+		},
+		{
+			"success: channels connected to the counterparty chain",
+			func() {
+				msg.Filter.CounterpartyChainId = suite.chainB.ChainID
+			},
+			2,
+			0,
+			nil,
+		},
+		{
+			"success: no channels connected to the counterparty chain",
+			func() {
+				msg.Filter.CounterpartyChainId = "otherchain-1"
+			},
+			0,
+			0,
+			nil,
+		},
+		{
+			"success: channels whose upgrade cannot be initialized are recorded as failed",
+			func() {
+				suite.chainA.GetSimApp().IBCMockModule.IBCApp.OnChanUpgradeInit = func(ctx sdk.Context, portID, channelID string, order channeltypes.Order, connectionHops []string, version string) (string, error) {
+					if channelID == path.EndpointA.ChannelID {
+						return "", errUpgradeInit
+					}
+					return version, nil
+				}
+			},
+			1,
+			1,
+			nil,
+		},
+		{
+			"failure: authority is not signer of the message",
+			func() {
+				msg.Authority = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			0,
+			0,
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			path2 = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path2.Setup()
+
+			msg = newMsgChannelUpgradeInitBatch(suite.chainA, 0)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().GetIBCKeeper().ChannelUpgradeInitBatch(ctx, msg)
+
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(uint64(1), res.BatchId)
+			suite.Require().Equal(uint64(tc.expInitialized), res.InitializedChannels)
+			suite.Require().Equal(uint64(tc.expFailed), res.FailedChannels)
+
+			channelKeeper := suite.chainA.GetSimApp().GetIBCKeeper().ChannelKeeper
+			batch, found := channelKeeper.GetUpgradeBatch(ctx, res.BatchId)
+			suite.Require().True(found)
+			suite.Require().Len(batch.Channels, tc.expInitialized+tc.expFailed)
+
+			states, done := channelKeeper.GetUpgradeBatchStatus(ctx, batch)
+			suite.Require().Equal(tc.expInitialized == 0, done)
+
+			for _, state := range states {
+				_, found := channelKeeper.GetUpgrade(ctx, state.Channel.PortId, state.Channel.ChannelId)
+
+				if state.Channel.InitError == "" {
+					suite.Require().Equal(channeltypes.UPGRADE_STATUS_IN_PROGRESS, state.Status)
+					suite.Require().Equal(uint64(1), state.Channel.UpgradeSequence)
+					suite.Require().True(found)
+				} else {
+					suite.Require().Equal(channeltypes.UPGRADE_STATUS_FAILED, state.Status)
+					suite.Require().Equal(path.EndpointA.ChannelID, state.Channel.ChannelId)
+					suite.Require().Contains(state.Channel.InitError, errUpgradeInit.Error())
+					suite.Require().False(found)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRetryFailedChannelUpgrades() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		name        string
+		initFails   bool
+		malleate    func()
+		maxRetries  uint64
+		retries     int
+		expStatus   channeltypes.UpgradeBatchChannelStatus
+		expRetries  uint64
+		expSequence uint64
+		expDone     bool
+	}{
+		{
+			"success: failed upgrade initialization is retried",
+			true,
+			func() {
+				suite.chainA.GetSimApp().IBCMockModule.IBCApp.OnChanUpgradeInit = nil
+			},
+			2,
+			1,
+			channeltypes.UPGRADE_STATUS_IN_PROGRESS,
+			1,
+			1,
+			false,
+		},
+		{
+			"success: aborted upgrade handshake is retried",
+			false,
+			func() {
+				suite.chainA.GetSimApp().GetIBCKeeper().ChannelKeeper.MustAbortUpgrade(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, errUpgradeInit)
+			},
+			2,
+			1,
+			channeltypes.UPGRADE_STATUS_IN_PROGRESS,
+			1,
+			2,
+			false,
+		},
+		{
+			"success: completed upgrade is not retried",
+			false,
+			func() {
+				suite.coordinator.CommitBlock(suite.chainA)
+
+				suite.Require().NoError(path.EndpointB.ChanUpgradeTry())
+				suite.Require().NoError(path.EndpointA.ChanUpgradeAck())
+				suite.Require().NoError(path.EndpointB.ChanUpgradeConfirm())
+				suite.Require().NoError(path.EndpointA.ChanUpgradeOpen())
+			},
+			2,
+			1,
+			channeltypes.UPGRADE_STATUS_COMPLETED,
+			0,
+			1,
+			true,
+		},
+		{
+			"upgrade in progress is not retried",
+			false,
+			func() {},
+			2,
+			1,
+			channeltypes.UPGRADE_STATUS_IN_PROGRESS,
+			0,
+			1,
+			false,
+		},
+		{
+			"failed upgrade is not retried without a retry policy",
+			true,
+			func() {
+				suite.chainA.GetSimApp().IBCMockModule.IBCApp.OnChanUpgradeInit = nil
+			},
+			0,
+			1,
+			channeltypes.UPGRADE_STATUS_FAILED,
+			0,
+			0,
+			true,
+		},
+		{
+			"failed upgrade is retried until the retries are exhausted",
+			true,
+			func() {},
+			2,
+			3,
+			channeltypes.UPGRADE_STATUS_FAILED,
+			2,
+			0,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			path.EndpointA.ChannelConfig.ProposedUpgrade.Fields.Version = ibcmock.UpgradeVersion
+			path.EndpointB.ChannelConfig.ProposedUpgrade.Fields.Version = ibcmock.UpgradeVersion
+
+			if tc.initFails {
+				suite.chainA.GetSimApp().IBCMockModule.IBCApp.OnChanUpgradeInit = func(ctx sdk.Context, portID, channelID string, order channeltypes.Order, connectionHops []string, version string) (string, error) {
+					return "", errUpgradeInit
+				}
+			}
+
+			res, err := suite.chainA.GetSimApp().GetIBCKeeper().ChannelUpgradeInitBatch(suite.chainA.GetContext(), newMsgChannelUpgradeInitBatch(suite.chainA, tc.maxRetries))
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			for i := 0; i < tc.retries; i++ {
+				suite.chainA.GetSimApp().GetIBCKeeper().RetryFailedChannelUpgrades(suite.chainA.GetContext())
+			}
+
+			channelKeeper := suite.chainA.GetSimApp().GetIBCKeeper().ChannelKeeper
+			batch, found := channelKeeper.GetUpgradeBatch(suite.chainA.GetContext(), res.BatchId)
+			suite.Require().True(found)
+
+			states, done := channelKeeper.GetUpgradeBatchStatus(suite.chainA.GetContext(), batch)
+			suite.Require().Len(states, 1)
+			suite.Require().Equal(tc.expStatus, states[0].Status)
+			suite.Require().Equal(tc.expRetries, states[0].Channel.Retries)
+			suite.Require().Equal(tc.expSequence, states[0].Channel.UpgradeSequence)
+			suite.Require().Equal(tc.expDone, done)
+
+			var active bool
+			channelKeeper.IterateActiveUpgradeBatches(suite.chainA.GetContext(), func(activeBatch channeltypes.UpgradeBatch) bool {
+				active = active || activeBatch.Id == batch.Id
+				return false
+			})
+			suite.Require().Equal(!tc.expDone && tc.maxRetries > 0, active)
+		})
+	}
+}
+
+// TestRetryFailedChannelUpgradesLimit asserts that the number of failed upgrades retried in a single block
+// is bounded, the remaining failed upgrades being retried in the following blocks.
+func (suite *KeeperTestSuite) TestRetryFailedChannelUpgradesLimit() {
+	const numChannels = 3
+
+	for i := 0; i < numChannels; i++ {
+		path := ibctesting.NewPath(suite.chainA, suite.chainB)
+		path.Setup()
+	}
+
+	suite.chainA.GetSimApp().IBCMockModule.IBCApp.OnChanUpgradeInit = func(ctx sdk.Context, portID, channelID string, order channeltypes.Order, connectionHops []string, version string) (string, error) {
+		return "", errUpgradeInit
+	}
+
+	res, err := suite.chainA.GetSimApp().GetIBCKeeper().ChannelUpgradeInitBatch(suite.chainA.GetContext(), newMsgChannelUpgradeInitBatch(suite.chainA, 1))
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(numChannels), res.FailedChannels)
+
+	channelKeeper := suite.chainA.GetSimApp().GetIBCKeeper().ChannelKeeper
+	retries := func() []uint64 {
+		batch, found := channelKeeper.GetUpgradeBatch(suite.chainA.GetContext(), res.BatchId)
+		suite.Require().True(found)
+
+		var retries []uint64
+		for _, channel := range batch.Channels {
+			retries = append(retries, channel.Retries)
+		}
+		return retries
+	}
+
+	suite.chainA.GetSimApp().GetIBCKeeper().RetryFailedChannelUpgradesWithLimit(suite.chainA.GetContext(), 2)
+	suite.Require().Equal([]uint64{1, 1, 0}, retries())
+
+	suite.chainA.GetSimApp().GetIBCKeeper().RetryFailedChannelUpgradesWithLimit(suite.chainA.GetContext(), 2)
+	suite.Require().Equal([]uint64{1, 1, 1}, retries())
+}
+
+// newMsgChannelUpgradeInitBatch returns a MsgChannelUpgradeInitBatch signed by the authority of the chain which
+// upgrades all channels bound to the mock port to the mock upgrade version.
+func newMsgChannelUpgradeInitBatch(chain *ibctesting.TestChain, maxRetries uint64) *channeltypes.MsgChannelUpgradeInitBatch {
+	return channeltypes.NewMsgChannelUpgradeInitBatch(
+		chain.GetSimApp().IBCKeeper.GetAuthority(),
+		channeltypes.NewUpgradeBatchFilter(ibctesting.MockPort, "", ""),
+		channeltypes.NONE,
+		ibcmock.UpgradeVersion,
+		channeltypes.NewUpgradeBatchRetryPolicy(maxRetries),
+	)
+}
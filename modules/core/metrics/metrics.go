@@ -0,0 +1,145 @@
+// Package metrics configures and reports the telemetry metrics of the ibc core and application modules
+// which go beyond the counters of the msg server: client status and trusting period, in-flight packets,
+// packet latency, fee escrow totals and callback gas. These metrics are reported through the cosmos-sdk
+// telemetry only when enabled by the ibc metrics configuration, since some of them require iterating
+// over state at the end of every block.
+package metrics
+
+import (
+	"sync"
+
+	"github.com/hashicorp/go-metrics"
+	"github.com/spf13/cast"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+const (
+	// FlagEnabled defines the app option enabling the ibc metrics.
+	FlagEnabled = "ibc.metrics-enabled"
+	// FlagMaxLabelValues defines the app option bounding the number of distinct values reported per metric label.
+	FlagMaxLabelValues = "ibc.metrics-max-label-values"
+
+	// DefaultMaxLabelValues defines the default number of distinct values reported per metric label.
+	DefaultMaxLabelValues = 100
+
+	// OtherLabelValue defines the label value reported in place of the values exceeding the maximum number
+	// of distinct values of a label.
+	OtherLabelValue = "other"
+)
+
+// DefaultConfigTemplate defines the app.toml configuration template of the ibc metrics. Applications
+// embedding Config in their app config under the ibc mapstructure key may append it to their template.
+const DefaultConfigTemplate = `
+###############################################################################
+###                             IBC Configuration                           ###
+###############################################################################
+
+[ibc]
+
+# Enables the ibc client, channel, packet latency, fee escrow and callback gas metrics.
+# The metrics are reported through the telemetry configured above.
+metrics-enabled = {{ .IBC.Enabled }}
+
+# The maximum number of distinct values reported per metric label, e.g. client or channel identifiers.
+# Any further value is reported as "other".
+metrics-max-label-values = {{ .IBC.MaxLabelValues }}
+`
+
+// Config defines the configuration of the ibc metrics.
+type Config struct {
+	// Enabled enables the reporting of the ibc metrics.
+	Enabled bool `mapstructure:"metrics-enabled"`
+	// MaxLabelValues bounds the number of distinct values reported per metric label.
+	MaxLabelValues int `mapstructure:"metrics-max-label-values"`
+}
+
+// DefaultConfig returns the default configuration of the ibc metrics, which are disabled.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:        false,
+		MaxLabelValues: DefaultMaxLabelValues,
+	}
+}
+
+// NewConfigFromAppOptions returns the configuration of the ibc metrics set in the provided app options.
+// The default configuration is used for the options which are not set.
+func NewConfigFromAppOptions(appOpts servertypes.AppOptions) Config {
+	cfg := DefaultConfig()
+	if enabled := appOpts.Get(FlagEnabled); enabled != nil {
+		cfg.Enabled = cast.ToBool(enabled)
+	}
+
+	if maxLabelValues := appOpts.Get(FlagMaxLabelValues); maxLabelValues != nil {
+		cfg.MaxLabelValues = cast.ToInt(maxLabelValues)
+	}
+
+	return cfg
+}
+
+var (
+	mtx         sync.RWMutex
+	config      = DefaultConfig()
+	labelValues = make(map[string]map[string]struct{})
+)
+
+// Configure sets the configuration of the ibc metrics and resets the label values reported so far.
+// It is expected to be called once when constructing the application.
+func Configure(cfg Config) {
+	mtx.Lock()
+	defer mtx.Unlock()
+
+	config = cfg
+	labelValues = make(map[string]map[string]struct{})
+}
+
+// Enabled returns true if the ibc metrics are enabled.
+func Enabled() bool {
+	mtx.RLock()
+	defer mtx.RUnlock()
+
+	return config.Enabled
+}
+
+// Label returns a metric label with the given name and value. Once the maximum number of distinct values
+// has been reported for the label name, any further value is replaced by OtherLabelValue, such that the
+// cardinality of the metrics labelled with identifiers created by arbitrary users remains bounded.
+func Label(name, value string) metrics.Label {
+	mtx.Lock()
+	defer mtx.Unlock()
+
+	values, ok := labelValues[name]
+	if !ok {
+		values = make(map[string]struct{})
+		labelValues[name] = values
+	}
+
+	if _, ok := values[value]; !ok {
+		if len(values) >= config.MaxLabelValues {
+			return telemetry.NewLabel(name, OtherLabelValue)
+		}
+
+		values[value] = struct{}{}
+	}
+
+	return telemetry.NewLabel(name, value)
+}
+
+// SetGauge sets the gauge with the given keys and labels if the ibc metrics are enabled.
+func SetGauge(keys []string, val float32, labels ...metrics.Label) {
+	if !Enabled() {
+		return
+	}
+
+	telemetry.SetGaugeWithLabels(keys, val, labels)
+}
+
+// AddSample adds a sample to the histogram with the given keys and labels if the ibc metrics are enabled.
+func AddSample(keys []string, val float32, labels ...metrics.Label) {
+	if !Enabled() {
+		return
+	}
+
+	metrics.AddSampleWithLabels(keys, val, labels)
+}
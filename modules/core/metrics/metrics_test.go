@@ -0,0 +1,81 @@
+package metrics_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+
+	ibcmetrics "github.com/cosmos/ibc-go/v8/modules/core/metrics"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func TestNewConfigFromAppOptions(t *testing.T) {
+	testCases := []struct {
+		name      string
+		appOpts   simtestutil.AppOptionsMap
+		expConfig ibcmetrics.Config
+	}{
+		{
+			"default config",
+			simtestutil.AppOptionsMap{},
+			ibcmetrics.DefaultConfig(),
+		},
+		{
+			"metrics enabled",
+			simtestutil.AppOptionsMap{ibcmetrics.FlagEnabled: true},
+			ibcmetrics.Config{Enabled: true, MaxLabelValues: ibcmetrics.DefaultMaxLabelValues},
+		},
+		{
+			"metrics enabled with max label values",
+			simtestutil.AppOptionsMap{ibcmetrics.FlagEnabled: "true", ibcmetrics.FlagMaxLabelValues: "10"},
+			ibcmetrics.Config{Enabled: true, MaxLabelValues: 10},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expConfig, ibcmetrics.NewConfigFromAppOptions(tc.appOpts))
+		})
+	}
+}
+
+func TestLabel(t *testing.T) {
+	ibcmetrics.Configure(ibcmetrics.Config{Enabled: true, MaxLabelValues: 3})
+	defer ibcmetrics.Configure(ibcmetrics.DefaultConfig())
+
+	for i := 0; i < 3; i++ {
+		require.Equal(t, fmt.Sprintf("channel-%d", i), ibcmetrics.Label("channel_id", fmt.Sprintf("channel-%d", i)).Value)
+	}
+
+	// values already reported are kept once the maximum is reached
+	require.Equal(t, "channel-0", ibcmetrics.Label("channel_id", "channel-0").Value)
+	require.Equal(t, ibcmetrics.OtherLabelValue, ibcmetrics.Label("channel_id", "channel-3").Value)
+
+	// the maximum applies per label name
+	require.Equal(t, "07-tendermint-0", ibcmetrics.Label("client_id", "07-tendermint-0").Value)
+
+	// the reported values are reset when configuring the metrics
+	ibcmetrics.Configure(ibcmetrics.Config{Enabled: true, MaxLabelValues: 3})
+	require.Equal(t, "channel-3", ibcmetrics.Label("channel_id", "channel-3").Value)
+}
+
+func TestReportDisabled(t *testing.T) {
+	sink := ibctesting.EnableMetrics(t)
+
+	ibcmetrics.SetGauge([]string{"ibc", "test"}, 1)
+	ibcmetrics.AddSample([]string{"ibc", "test", "sample"}, 1)
+
+	ibcmetrics.Configure(ibcmetrics.DefaultConfig())
+
+	ibcmetrics.SetGauge([]string{"ibc", "test"}, 2)
+	ibcmetrics.AddSample([]string{"ibc", "test", "sample"}, 2)
+
+	data := sink.Data()
+	require.Len(t, data, 1)
+	require.Equal(t, float32(1), data[0].Gauges["ibc.test"].Value)
+	require.Equal(t, 1, data[0].Samples["ibc.test.sample"].Count)
+}
@@ -39,9 +39,12 @@ var (
 	_ module.HasName             = (*AppModule)(nil)
 	_ module.HasConsensusVersion = (*AppModule)(nil)
 	_ module.HasServices         = (*AppModule)(nil)
+	_ module.HasInvariants       = (*AppModule)(nil)
 	_ module.HasProposalMsgs     = (*AppModule)(nil)
 	_ appmodule.AppModule        = (*AppModule)(nil)
 	_ appmodule.HasBeginBlocker  = (*AppModule)(nil)
+	_ appmodule.HasEndBlocker    = (*AppModule)(nil)
+	_ appmodule.HasGenesis       = (*StreamingAppModule)(nil)
 )
 
 // AppModuleBasic defines the basic application module used by the ibc module.
@@ -111,7 +114,8 @@ func (AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry)
 // AppModule implements an application module for the ibc module.
 type AppModule struct {
 	AppModuleBasic
-	keeper *keeper.Keeper
+	keeper        *keeper.Keeper
+	exportOptions types.ExportOptions
 }
 
 // NewAppModule creates a new AppModule object
@@ -121,6 +125,13 @@ func NewAppModule(k *keeper.Keeper) AppModule {
 	}
 }
 
+// WithExportOptions returns a copy of the AppModule which omits the pruneable state selected
+// by the provided export options from its exported genesis. See types.PruneableExportOptions.
+func (am AppModule) WithExportOptions(opts types.ExportOptions) AppModule {
+	am.exportOptions = opts
+	return am
+}
+
 // Name returns the ibc module's name.
 func (AppModule) Name() string {
 	return exported.ModuleName
@@ -164,6 +175,21 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err != nil {
 		panic(err)
 	}
+
+	if err := cfg.RegisterMigration(exported.ModuleName, 6, func(ctx sdk.Context) error {
+		if err := connectionMigrator.Migrate6to7(ctx); err != nil {
+			return err
+		}
+
+		return channelMigrator.Migrate6to7(ctx)
+	}); err != nil {
+		panic(err)
+	}
+}
+
+// RegisterInvariants registers the invariants of the ibc module.
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	channelkeeper.RegisterInvariants(ir, &am.keeper.ChannelKeeper)
 }
 
 // InitGenesis performs genesis initialization for the ibc module. It returns
@@ -180,11 +206,51 @@ func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, bz json.Ra
 // ExportGenesis returns the exported genesis state as raw bytes for the ibc
 // module.
 func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
-	return cdc.MustMarshalJSON(ExportGenesis(ctx, *am.keeper))
+	return cdc.MustMarshalJSON(ExportGenesisWithOptions(ctx, *am.keeper, am.exportOptions))
+}
+
+// StreamingAppModule wraps the ibc AppModule to import and export its genesis incrementally
+// through the genesis sources and targets of the core appmodule API, such that the ibc genesis
+// state is never held in memory as a whole. The genesis JSON format is unchanged.
+type StreamingAppModule struct {
+	AppModule
+	cdc codec.JSONCodec
+}
+
+// NewStreamingAppModule creates a new StreamingAppModule wrapping the provided AppModule.
+func NewStreamingAppModule(am AppModule, cdc codec.JSONCodec) StreamingAppModule {
+	return StreamingAppModule{
+		AppModule: am,
+		cdc:       cdc,
+	}
+}
+
+// DefaultGenesis writes the default genesis state of the ibc module to the genesis target.
+func (am StreamingAppModule) DefaultGenesis(target appmodule.GenesisTarget) error {
+	return StreamDefaultGenesis(am.cdc, target)
+}
+
+// ValidateGenesis performs genesis state validation for the ibc module.
+func (am StreamingAppModule) ValidateGenesis(source appmodule.GenesisSource) error {
+	if err := StreamValidateGenesis(am.cdc, source); err != nil {
+		return fmt.Errorf("invalid %s genesis state: %w", exported.ModuleName, err)
+	}
+
+	return nil
+}
+
+// InitGenesis performs genesis initialization for the ibc module from the genesis source.
+func (am StreamingAppModule) InitGenesis(ctx context.Context, source appmodule.GenesisSource) error {
+	return StreamInitGenesis(sdk.UnwrapSDKContext(ctx), *am.keeper, am.cdc, source)
+}
+
+// ExportGenesis writes the exported genesis state of the ibc module to the genesis target.
+func (am StreamingAppModule) ExportGenesis(ctx context.Context, target appmodule.GenesisTarget) error {
+	return StreamExportGenesis(sdk.UnwrapSDKContext(ctx), *am.keeper, am.cdc, am.exportOptions, target)
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 6 }
+func (AppModule) ConsensusVersion() uint64 { return 7 }
 
 // BeginBlock returns the begin blocker for the ibc module.
 func (am AppModule) BeginBlock(ctx context.Context) error {
@@ -192,6 +258,22 @@ func (am AppModule) BeginBlock(ctx context.Context) error {
 	return nil
 }
 
+// EndBlock returns the end blocker for the ibc module. It relays packets sent over the localhost connection
+// when automatic relaying is enabled, retries the failed channel upgrades of upgrade batches with a retry
+// policy, prunes stale packet acknowledgements and receipts when automatic pruning is enabled and prunes
+// completed packets from the packet lifecycle index once their retention period has elapsed. The client and
+// channel metrics are reported when the ibc metrics are enabled.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	am.keeper.RelayLocalhostPackets(sdkCtx)
+	am.keeper.RetryFailedChannelUpgrades(sdkCtx)
+	am.keeper.ChannelKeeper.AutoPruneAcknowledgements(sdkCtx)
+	am.keeper.ChannelKeeper.PruneExpiredIndexedPackets(sdkCtx)
+	am.keeper.ClientKeeper.ReportClientMetrics(sdkCtx)
+	am.keeper.ChannelKeeper.ReportChannelMetrics(sdkCtx)
+	return nil
+}
+
 // AppModuleSimulation functions
 
 // GenerateGenesisState creates a randomized GenState of the ibc module.
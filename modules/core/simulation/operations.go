@@ -0,0 +1,374 @@
+package simulation
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/rand"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	"github.com/cosmos/ibc-go/v8/modules/core/keeper"
+	localhost "github.com/cosmos/ibc-go/v8/modules/light-clients/09-localhost"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightChannelOpenHandshake    = "op_weight_channel_open_handshake"    // #nosec
+	OpWeightChannelUpgradeHandshake = "op_weight_channel_upgrade_handshake" // #nosec
+
+	DefaultWeightChannelOpenHandshake    int = 5
+	DefaultWeightChannelUpgradeHandshake int = 10
+)
+
+// PortKeeper defines the expected keeper of the application whose channels are opened and upgraded by the
+// simulation operations.
+type PortKeeper interface {
+	GetPort(ctx sdk.Context) string
+}
+
+// WeightedOperations returns the weighted operations which open and upgrade channels of the port bound by the
+// application over the 09-localhost connection. The application version of each channel is chosen at random from
+// the provided versions.
+func WeightedOperations(appParams simtypes.AppParams, k *keeper.Keeper, pk PortKeeper, versions []string) simulation.WeightedOperations {
+	var weightChannelOpenHandshake, weightChannelUpgradeHandshake int
+	appParams.GetOrGenerate(OpWeightChannelOpenHandshake, &weightChannelOpenHandshake, nil,
+		func(_ *rand.Rand) { weightChannelOpenHandshake = DefaultWeightChannelOpenHandshake },
+	)
+
+	appParams.GetOrGenerate(OpWeightChannelUpgradeHandshake, &weightChannelUpgradeHandshake, nil,
+		func(_ *rand.Rand) { weightChannelUpgradeHandshake = DefaultWeightChannelUpgradeHandshake },
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightChannelOpenHandshake,
+			SimulateChannelOpenHandshake(k, pk, versions),
+		),
+		simulation.NewWeightedOperation(
+			weightChannelUpgradeHandshake,
+			SimulateChannelUpgradeHandshake(k, pk, versions),
+		),
+	}
+}
+
+// SimulateChannelOpenHandshake returns an operation which opens an unordered channel between two ends of the port
+// bound by the application over the 09-localhost connection, using a randomly chosen application version. The handshake messages are
+// executed against the msg server of the ibc keeper and signed by a random simulation account acting as relayer.
+// State changes are only written if every step of the handshake succeeds.
+func SimulateChannelOpenHandshake(k *keeper.Keeper, pk PortKeeper, versions []string) simtypes.Operation {
+	return func(
+		r *rand.Rand, _ *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&channeltypes.MsgChannelOpenInit{})
+		if len(versions) == 0 {
+			return simtypes.NoOpMsg(exported.ModuleName, msgType, "no application versions"), nil, nil
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		signer := simAccount.Address.String()
+		portID := pk.GetPort(ctx)
+		version := versions[r.Intn(len(versions))]
+		connectionHops := []string{exported.LocalhostConnectionID}
+
+		cacheCtx, writeFn := ctx.CacheContext()
+
+		msgInit := channeltypes.NewMsgChannelOpenInit(portID, version, channeltypes.UNORDERED, connectionHops, portID, signer)
+		resInit, err := k.ChannelOpenInit(cacheCtx, msgInit)
+		if err != nil {
+			return simtypes.NoOpMsg(exported.ModuleName, msgType, "channel open init failed"), nil, err
+		}
+
+		channelInit, _ := k.ChannelKeeper.GetChannel(cacheCtx, portID, resInit.ChannelId)
+		msgTry := channeltypes.NewMsgChannelOpenTry(
+			portID, version, channeltypes.UNORDERED, connectionHops, portID, resInit.ChannelId,
+			channelInit.Version, localhost.SentinelProof, clienttypes.GetSelfHeight(cacheCtx), signer,
+		)
+		resTry, err := k.ChannelOpenTry(cacheCtx, msgTry)
+		if err != nil {
+			return simtypes.NoOpMsg(exported.ModuleName, msgType, "channel open try failed"), nil, err
+		}
+
+		channelTry, _ := k.ChannelKeeper.GetChannel(cacheCtx, portID, resTry.ChannelId)
+		msgAck := channeltypes.NewMsgChannelOpenAck(
+			portID, resInit.ChannelId, resTry.ChannelId, channelTry.Version, localhost.SentinelProof, clienttypes.GetSelfHeight(cacheCtx), signer,
+		)
+		if _, err := k.ChannelOpenAck(cacheCtx, msgAck); err != nil {
+			return simtypes.NoOpMsg(exported.ModuleName, msgType, "channel open ack failed"), nil, err
+		}
+
+		msgConfirm := channeltypes.NewMsgChannelOpenConfirm(portID, resTry.ChannelId, localhost.SentinelProof, clienttypes.GetSelfHeight(cacheCtx), signer)
+		if _, err := k.ChannelOpenConfirm(cacheCtx, msgConfirm); err != nil {
+			return simtypes.NoOpMsg(exported.ModuleName, msgType, "channel open confirm failed"), nil, err
+		}
+
+		writeFn()
+
+		return simtypes.NewOperationMsg(msgInit, true, ""), nil, nil
+	}
+}
+
+// SimulateChannelUpgradeHandshake returns an operation which upgrades a random open channel of the port bound by the
+// application built upon the 09-localhost connection to a different, randomly chosen application version. Channels are only upgraded
+// when neither end has an upgrade in progress or packets in flight, so that the upgrade completes within the
+// operation. The upgrade is initialized with the authority of the ibc keeper and the remaining handshake messages are
+// signed by a random simulation account acting as relayer. State changes are only written if every step of the
+// handshake succeeds.
+func SimulateChannelUpgradeHandshake(k *keeper.Keeper, pk PortKeeper, versions []string) simtypes.Operation {
+	return func(
+		r *rand.Rand, _ *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&channeltypes.MsgChannelUpgradeInit{})
+
+		channels := upgradableLocalhostChannels(ctx, k, pk.GetPort(ctx))
+		if len(channels) == 0 {
+			return simtypes.NoOpMsg(exported.ModuleName, msgType, "no upgradable localhost channels"), nil, nil
+		}
+
+		channel := channels[r.Intn(len(channels))]
+
+		var candidates []string
+		for _, version := range versions {
+			if version != channel.Version {
+				candidates = append(candidates, version)
+			}
+		}
+
+		if len(candidates) == 0 {
+			return simtypes.NoOpMsg(exported.ModuleName, msgType, "no alternative application versions"), nil, nil
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		signer := simAccount.Address.String()
+		portA, channelA := channel.PortId, channel.ChannelId
+		portB, channelB := channel.Counterparty.PortId, channel.Counterparty.ChannelId
+
+		cacheCtx, writeFn := ctx.CacheContext()
+
+		fields := channeltypes.NewUpgradeFields(channel.Ordering, channel.ConnectionHops, candidates[r.Intn(len(candidates))])
+		msgInit := channeltypes.NewMsgChannelUpgradeInit(portA, channelA, fields, k.GetAuthority())
+		if _, err := k.ChannelUpgradeInit(cacheCtx, msgInit); err != nil {
+			return simtypes.NoOpMsg(exported.ModuleName, msgType, "channel upgrade init failed"), nil, err
+		}
+
+		endA, _ := k.ChannelKeeper.GetChannel(cacheCtx, portA, channelA)
+		upgradeA, _ := k.ChannelKeeper.GetUpgrade(cacheCtx, portA, channelA)
+		msgTry := channeltypes.NewMsgChannelUpgradeTry(
+			portB, channelB, channel.ConnectionHops, upgradeA.Fields, endA.UpgradeSequence,
+			localhost.SentinelProof, localhost.SentinelProof, clienttypes.GetSelfHeight(cacheCtx), signer,
+		)
+		if _, err := k.ChannelUpgradeTry(cacheCtx, msgTry); err != nil {
+			return simtypes.NoOpMsg(exported.ModuleName, msgType, "channel upgrade try failed"), nil, err
+		}
+
+		upgradeB, _ := k.ChannelKeeper.GetUpgrade(cacheCtx, portB, channelB)
+		msgAck := channeltypes.NewMsgChannelUpgradeAck(
+			portA, channelA, upgradeB, localhost.SentinelProof, localhost.SentinelProof, clienttypes.GetSelfHeight(cacheCtx), signer,
+		)
+		if _, err := k.ChannelUpgradeAck(cacheCtx, msgAck); err != nil {
+			return simtypes.NoOpMsg(exported.ModuleName, msgType, "channel upgrade ack failed"), nil, err
+		}
+
+		endA, _ = k.ChannelKeeper.GetChannel(cacheCtx, portA, channelA)
+		upgradeA, _ = k.ChannelKeeper.GetUpgrade(cacheCtx, portA, channelA)
+		msgConfirm := channeltypes.NewMsgChannelUpgradeConfirm(
+			portB, channelB, endA.State, upgradeA, localhost.SentinelProof, localhost.SentinelProof, clienttypes.GetSelfHeight(cacheCtx), signer,
+		)
+		if _, err := k.ChannelUpgradeConfirm(cacheCtx, msgConfirm); err != nil {
+			return simtypes.NoOpMsg(exported.ModuleName, msgType, "channel upgrade confirm failed"), nil, err
+		}
+
+		endB, _ := k.ChannelKeeper.GetChannel(cacheCtx, portB, channelB)
+		msgOpen := channeltypes.NewMsgChannelUpgradeOpen(
+			portA, channelA, endB.State, endB.UpgradeSequence, localhost.SentinelProof, clienttypes.GetSelfHeight(cacheCtx), signer,
+		)
+		if _, err := k.ChannelUpgradeOpen(cacheCtx, msgOpen); err != nil {
+			return simtypes.NoOpMsg(exported.ModuleName, msgType, "channel upgrade open failed"), nil, err
+		}
+
+		writeFn()
+
+		return simtypes.NewOperationMsg(msgInit, true, ""), nil, nil
+	}
+}
+
+// SimulateRelayPacket returns an operation which relays the given packet sent on a channel built upon the 09-localhost
+// connection. The packet is timed out if its timeout has elapsed, otherwise it is received and, if an acknowledgement
+// is written synchronously, acknowledged. The relay messages are signed by a random simulation account acting as
+// relayer. Packets which have already been relayed, for example by the localhost auto relay, are skipped.
+//
+// Applications sending packets over localhost channels are expected to schedule this operation as a future operation.
+func SimulateRelayPacket(k *keeper.Keeper, packet channeltypes.Packet) simtypes.Operation {
+	return func(
+		r *rand.Rand, _ *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&channeltypes.MsgRecvPacket{})
+
+		if !k.ChannelKeeper.HasPacketCommitment(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence) {
+			return simtypes.NoOpMsg(exported.ModuleName, msgType, "packet has already been relayed"), nil, nil
+		}
+
+		if _, found := k.ChannelKeeper.GetPacketReceipt(ctx, packet.DestinationPort, packet.DestinationChannel, packet.Sequence); found {
+			return simtypes.NoOpMsg(exported.ModuleName, msgType, "packet has already been received"), nil, nil
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		signer := simAccount.Address.String()
+		proofHeight := clienttypes.GetSelfHeight(ctx)
+
+		cacheCtx, writeFn := ctx.CacheContext()
+
+		timeout := channeltypes.NewTimeout(packet.TimeoutHeight, packet.TimeoutTimestamp)
+		if timeout.Elapsed(proofHeight, uint64(ctx.BlockTime().UnixNano())) {
+			nextSequenceRecv, _ := k.ChannelKeeper.GetNextSequenceRecv(cacheCtx, packet.DestinationPort, packet.DestinationChannel)
+			msgTimeout := channeltypes.NewMsgTimeout(packet, nextSequenceRecv, localhost.SentinelProof, proofHeight, signer)
+			if _, err := k.Timeout(cacheCtx, msgTimeout); err != nil {
+				return simtypes.NoOpMsg(exported.ModuleName, sdk.MsgTypeURL(msgTimeout), "timeout packet failed"), nil, err
+			}
+
+			writeFn()
+
+			return simtypes.NewOperationMsg(msgTimeout, true, ""), nil, nil
+		}
+
+		msgRecvPacket := channeltypes.NewMsgRecvPacket(packet, localhost.SentinelProof, proofHeight, signer)
+		if _, err := k.RecvPacket(cacheCtx, msgRecvPacket); err != nil {
+			return simtypes.NoOpMsg(exported.ModuleName, msgType, "recv packet failed"), nil, err
+		}
+
+		acknowledgement, err := acknowledgementFromEvents(cacheCtx.EventManager().Events(), packet)
+		if err != nil {
+			return simtypes.NoOpMsg(exported.ModuleName, msgType, "invalid acknowledgement event"), nil, err
+		}
+
+		if len(acknowledgement) != 0 {
+			msgAck := channeltypes.NewMsgAcknowledgement(packet, acknowledgement, localhost.SentinelProof, proofHeight, signer)
+			if _, err := k.Acknowledgement(cacheCtx, msgAck); err != nil {
+				return simtypes.NoOpMsg(exported.ModuleName, sdk.MsgTypeURL(msgAck), "acknowledge packet failed"), nil, err
+			}
+		}
+
+		writeFn()
+
+		return simtypes.NewOperationMsg(msgRecvPacket, true, ""), nil, nil
+	}
+}
+
+// upgradableLocalhostChannels returns the open channels of the given port built upon the 09-localhost connection for
+// which neither channel end has an upgrade in progress or packets in flight.
+func upgradableLocalhostChannels(ctx sdk.Context, k *keeper.Keeper, portID string) []channeltypes.IdentifiedChannel {
+	var channels []channeltypes.IdentifiedChannel
+	for _, channel := range k.ChannelKeeper.GetAllChannelsWithPortPrefix(ctx, portID) {
+		if channel.State != channeltypes.OPEN || channel.ConnectionHops[0] != exported.LocalhostConnectionID {
+			continue
+		}
+
+		counterparty, found := k.ChannelKeeper.GetChannel(ctx, channel.Counterparty.PortId, channel.Counterparty.ChannelId)
+		if !found || counterparty.State != channeltypes.OPEN {
+			continue
+		}
+
+		if !isUpgradable(ctx, k, channel.PortId, channel.ChannelId) || !isUpgradable(ctx, k, channel.Counterparty.PortId, channel.Counterparty.ChannelId) {
+			continue
+		}
+
+		channels = append(channels, channel)
+	}
+
+	return channels
+}
+
+// isUpgradable returns true if the channel end has no upgrade in progress and no packets in flight.
+func isUpgradable(ctx sdk.Context, k *keeper.Keeper, portID, channelID string) bool {
+	if _, found := k.ChannelKeeper.GetUpgrade(ctx, portID, channelID); found {
+		return false
+	}
+
+	return !k.ChannelKeeper.HasInflightPackets(ctx, portID, channelID)
+}
+
+// PacketFromEvents returns the packet sent on the given port and channel with the given sequence from the send_packet
+// events in the provided events. An error is returned if no such packet is found.
+func PacketFromEvents(events sdk.Events, portID, channelID string, sequence uint64) (channeltypes.Packet, error) {
+	for _, event := range events {
+		if event.Type != channeltypes.EventTypeSendPacket {
+			continue
+		}
+
+		packet, _, err := parsePacketEvent(event)
+		if err != nil {
+			return channeltypes.Packet{}, err
+		}
+
+		if packet.SourcePort == portID && packet.SourceChannel == channelID && packet.Sequence == sequence {
+			return packet, nil
+		}
+	}
+
+	return channeltypes.Packet{}, fmt.Errorf("packet with port ID %s, channel ID %s and sequence %d not found in events", portID, channelID, sequence)
+}
+
+// acknowledgementFromEvents returns the acknowledgement written for the given packet in the provided events. An empty
+// acknowledgement is returned if no acknowledgement was written.
+func acknowledgementFromEvents(events sdk.Events, packet channeltypes.Packet) ([]byte, error) {
+	for _, event := range events {
+		if event.Type != channeltypes.EventTypeWriteAck {
+			continue
+		}
+
+		eventPacket, acknowledgement, err := parsePacketEvent(event)
+		if err != nil {
+			return nil, err
+		}
+
+		if eventPacket.DestinationPort == packet.DestinationPort && eventPacket.DestinationChannel == packet.DestinationChannel && eventPacket.Sequence == packet.Sequence {
+			return acknowledgement, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// parsePacketEvent parses the packet and the acknowledgement, if any, from the attributes of a send_packet or
+// write_acknowledgement event.
+func parsePacketEvent(event sdk.Event) (channeltypes.Packet, []byte, error) {
+	var (
+		packet          channeltypes.Packet
+		acknowledgement []byte
+		err             error
+	)
+	for _, attr := range event.Attributes {
+		switch attr.Key {
+		case channeltypes.AttributeKeyDataHex:
+			packet.Data, err = hex.DecodeString(attr.Value)
+		case channeltypes.AttributeKeyAckHex:
+			acknowledgement, err = hex.DecodeString(attr.Value)
+		case channeltypes.AttributeKeySequence:
+			packet.Sequence, err = strconv.ParseUint(attr.Value, 10, 64)
+		case channeltypes.AttributeKeySrcPort:
+			packet.SourcePort = attr.Value
+		case channeltypes.AttributeKeySrcChannel:
+			packet.SourceChannel = attr.Value
+		case channeltypes.AttributeKeyDstPort:
+			packet.DestinationPort = attr.Value
+		case channeltypes.AttributeKeyDstChannel:
+			packet.DestinationChannel = attr.Value
+		case channeltypes.AttributeKeyTimeoutHeight:
+			packet.TimeoutHeight, err = clienttypes.ParseHeight(attr.Value)
+		case channeltypes.AttributeKeyTimeoutTimestamp:
+			packet.TimeoutTimestamp, err = strconv.ParseUint(attr.Value, 10, 64)
+		}
+
+		if err != nil {
+			return channeltypes.Packet{}, nil, err
+		}
+	}
+
+	return packet, acknowledgement, nil
+}
@@ -0,0 +1,57 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	ibcfeetypes "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	"github.com/cosmos/ibc-go/v8/modules/core/simulation"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func TestSimulateChannelOpenAndUpgradeHandshake(t *testing.T) {
+	coord := ibctesting.NewCoordinator(t, 1)
+	chain := coord.GetChain(ibctesting.GetChainID(1))
+	ibcKeeper := chain.App.GetIBCKeeper()
+
+	r := rand.New(rand.NewSource(1))
+	accs := simtypes.RandomAccounts(r, 3)
+
+	feeVersion := string(ibcfeetypes.ModuleCdc.MustMarshalJSON(&ibcfeetypes.Metadata{FeeVersion: ibcfeetypes.Version, AppVersion: transfertypes.Version}))
+
+	// no channels can be upgraded before a channel is opened
+	operationMsg, _, err := simulation.SimulateChannelUpgradeHandshake(ibcKeeper, chain.GetSimApp().TransferKeeper, []string{transfertypes.Version, feeVersion})(r, nil, chain.GetContext(), accs, "")
+	require.NoError(t, err)
+	require.False(t, operationMsg.OK)
+
+	operationMsg, _, err = simulation.SimulateChannelOpenHandshake(ibcKeeper, chain.GetSimApp().TransferKeeper, []string{transfertypes.Version})(r, nil, chain.GetContext(), accs, "")
+	require.NoError(t, err)
+	require.True(t, operationMsg.OK)
+
+	channels := ibcKeeper.ChannelKeeper.GetAllChannelsWithPortPrefix(chain.GetContext(), transfertypes.PortID)
+	require.Len(t, channels, 2)
+	for _, channel := range channels {
+		require.Equal(t, channeltypes.OPEN, channel.State)
+		require.Equal(t, transfertypes.Version, channel.Version)
+		require.Equal(t, []string{exported.LocalhostConnectionID}, channel.ConnectionHops)
+	}
+
+	// the only alternative version is the fee enabled transfer version
+	operationMsg, _, err = simulation.SimulateChannelUpgradeHandshake(ibcKeeper, chain.GetSimApp().TransferKeeper, []string{transfertypes.Version, feeVersion})(r, nil, chain.GetContext(), accs, "")
+	require.NoError(t, err)
+	require.True(t, operationMsg.OK)
+
+	for _, channel := range ibcKeeper.ChannelKeeper.GetAllChannelsWithPortPrefix(chain.GetContext(), transfertypes.PortID) {
+		require.Equal(t, channeltypes.OPEN, channel.State)
+		require.Equal(t, feeVersion, channel.Version)
+		require.Equal(t, uint64(1), channel.UpgradeSequence)
+		require.True(t, chain.GetSimApp().IBCFeeKeeper.IsFeeEnabled(chain.GetContext(), channel.PortId, channel.ChannelId))
+	}
+}
@@ -19,6 +19,28 @@ func DefaultGenesisState() *GenesisState {
 	}
 }
 
+// ExportOptions defines the options used to omit pruneable state from the exported ibc genesis.
+// The zero value exports the complete ibc state. Closed channels are never omitted, see
+// channeltypes.ExportOptions.
+type ExportOptions struct {
+	ClientOptions  clienttypes.ExportOptions
+	ChannelOptions channeltypes.ExportOptions
+}
+
+// PruneableExportOptions returns export options which omit all pruneable state from the
+// exported ibc genesis.
+func PruneableExportOptions() ExportOptions {
+	return ExportOptions{
+		ClientOptions: clienttypes.ExportOptions{
+			OmitExpiredConsensusStates: true,
+		},
+		ChannelOptions: channeltypes.ExportOptions{
+			OmitPruneableAcknowledgements: true,
+			OmitPruneableReceipts:         true,
+		},
+	}
+}
+
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (gs GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	return gs.ClientGenesis.UnpackInterfaces(unpacker)
@@ -32,6 +32,11 @@ func TestCodecTypeRegistration(t *testing.T) {
 			sdk.MsgTypeURL(&tendermint.Header{}),
 			true,
 		},
+		{
+			"success: HeaderChain",
+			sdk.MsgTypeURL(&tendermint.HeaderChain{}),
+			true,
+		},
 		{
 			"success: Misbehaviour",
 			sdk.MsgTypeURL(&tendermint.Misbehaviour{}),
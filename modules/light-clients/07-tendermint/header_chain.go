@@ -0,0 +1,82 @@
+package tendermint
+
+import (
+	"bytes"
+
+	errorsmod "cosmossdk.io/errors"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+var _ exported.ClientMessage = (*HeaderChain)(nil)
+
+// MaxHeaderChainLength defines the maximum number of headers which may be submitted in a single HeaderChain.
+const MaxHeaderChainLength = 16
+
+// NewHeaderChain creates a new HeaderChain instance.
+func NewHeaderChain(storeCheckpoints bool, headers ...*Header) *HeaderChain {
+	return &HeaderChain{
+		Headers:          headers,
+		StoreCheckpoints: storeCheckpoints,
+	}
+}
+
+// ClientType defines that the HeaderChain is a Tendermint consensus algorithm
+func (HeaderChain) ClientType() string {
+	return exported.Tendermint
+}
+
+// GetHeight returns the height of the final header in the chain.
+// NOTE: the header chain is checked to be non empty in ValidateBasic.
+func (hc HeaderChain) GetHeight() exported.Height {
+	return hc.Headers[len(hc.Headers)-1].GetHeight()
+}
+
+// ValidateBasic performs basic validation on each header in the chain and checks
+// that every header after the first is trusted by the preceding header. That is the
+// TrustedHeight must equal the height of the preceding header and the TrustedValidators
+// must hash to the NextValidatorsHash of the preceding header.
+func (hc HeaderChain) ValidateBasic() error {
+	if len(hc.Headers) == 0 {
+		return errorsmod.Wrap(ErrInvalidHeader, "header chain cannot be empty")
+	}
+	if len(hc.Headers) > MaxHeaderChainLength {
+		return errorsmod.Wrapf(ErrInvalidHeader, "header chain length %d exceeds maximum %d", len(hc.Headers), MaxHeaderChainLength)
+	}
+
+	for i, header := range hc.Headers {
+		if header == nil {
+			return errorsmod.Wrapf(ErrInvalidHeader, "header %d cannot be nil", i)
+		}
+		if err := header.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "header %d failed basic validation", i)
+		}
+		if header.TrustedValidators == nil {
+			return errorsmod.Wrapf(ErrInvalidValidatorSet, "trusted validator set in header %d cannot be empty", i)
+		}
+
+		if i == 0 {
+			continue
+		}
+
+		prev := hc.Headers[i-1]
+		if header.Header.ChainID != prev.Header.ChainID {
+			return errorsmod.Wrapf(ErrInvalidChainID, "header %d chain-id %s does not match preceding header chain-id %s", i, header.Header.ChainID, prev.Header.ChainID)
+		}
+		if !header.TrustedHeight.EQ(prev.GetHeight()) {
+			return errorsmod.Wrapf(ErrInvalidHeaderHeight, "header %d trusted height %s does not equal preceding header height %s", i, header.TrustedHeight, prev.GetHeight())
+		}
+
+		tmTrustedValidators, err := cmttypes.ValidatorSetFromProto(header.TrustedValidators)
+		if err != nil {
+			return errorsmod.Wrapf(err, "trusted validator set in header %d is not tendermint validator set type", i)
+		}
+		if !bytes.Equal(tmTrustedValidators.Hash(), prev.Header.NextValidatorsHash) {
+			return errorsmod.Wrapf(ErrInvalidValidatorSet, "trusted validators of header %d do not hash to the next validators hash of the preceding header", i)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,219 @@
+package tendermint_test
+
+import (
+	"time"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+// createHeaderChain returns a HeaderChain which updates the client on chainA across a complete change of
+// chainB's validator set. The first header is signed by the trusted validator set and commits to the
+// alternative validator set as its next validator set. The second header is signed by the alternative
+// validator set, which cannot be verified directly against the trusted consensus state.
+func (suite *TendermintTestSuite) createHeaderChain(path *ibctesting.Path, altValSet *cmttypes.ValidatorSet, altSigners map[string]cmttypes.PrivValidator) *ibctm.HeaderChain {
+	trustedHeight := path.EndpointA.GetClientState().GetLatestHeight().(clienttypes.Height)
+
+	trustedVals, found := suite.chainB.GetValsAtHeight(int64(trustedHeight.RevisionHeight) + 1)
+	suite.Require().True(found)
+
+	header1 := suite.chainB.CreateTMClientHeader(suite.chainB.ChainID, suite.chainB.ProposedHeader.Height, trustedHeight, suite.chainB.ProposedHeader.Time, suite.chainB.Vals, altValSet, trustedVals, suite.chainB.Signers)
+	header2 := suite.chainB.CreateTMClientHeader(suite.chainB.ChainID, suite.chainB.ProposedHeader.Height+1, header1.GetHeight().(clienttypes.Height), suite.chainB.ProposedHeader.Time.Add(time.Second), altValSet, altValSet, altValSet, altSigners)
+
+	return ibctm.NewHeaderChain(false, header1, header2)
+}
+
+func (suite *TendermintTestSuite) TestHeaderChainValidateBasic() {
+	var headerChain *ibctm.HeaderChain
+
+	altPrivVal := cmttypes.NewMockPV()
+	altPubKey, err := altPrivVal.GetPubKey()
+	suite.Require().NoError(err)
+	altVal := cmttypes.NewValidator(altPubKey, 100)
+	altValSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{altVal})
+	altSigners := getAltSigners(altVal, altPrivVal)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"success", func() {}, true},
+		{"success: single header", func() {
+			headerChain.Headers = headerChain.Headers[:1]
+		}, true},
+		{"empty header chain", func() {
+			headerChain.Headers = nil
+		}, false},
+		{"header chain exceeds maximum length", func() {
+			for len(headerChain.Headers) <= ibctm.MaxHeaderChainLength {
+				headerChain.Headers = append(headerChain.Headers, headerChain.Headers[0])
+			}
+		}, false},
+		{"nil header", func() {
+			headerChain.Headers[1] = nil
+		}, false},
+		{"header fails basic validation", func() {
+			headerChain.Headers[1].SignedHeader = nil
+		}, false},
+		{"trusted validators are nil", func() {
+			headerChain.Headers[1].TrustedValidators = nil
+		}, false},
+		{"trusted height does not equal preceding header height", func() {
+			trustedHeight, ok := headerChain.Headers[1].TrustedHeight.Decrement()
+			suite.Require().True(ok)
+			headerChain.Headers[1].TrustedHeight = trustedHeight.(clienttypes.Height)
+		}, false},
+		{"trusted validators do not hash to preceding next validators hash", func() {
+			trustedVals, err := suite.chainB.Vals.ToProto()
+			suite.Require().NoError(err)
+			headerChain.Headers[1].TrustedValidators = trustedVals
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(path)
+
+			headerChain = suite.createHeaderChain(path, altValSet, altSigners)
+
+			tc.malleate()
+
+			err := headerChain.ValidateBasic()
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TendermintTestSuite) TestVerifyHeaderChain() {
+	var (
+		path        *ibctesting.Path
+		headerChain *ibctm.HeaderChain
+	)
+
+	altPrivVal := cmttypes.NewMockPV()
+	altPubKey, err := altPrivVal.GetPubKey()
+	suite.Require().NoError(err)
+	altVal := cmttypes.NewValidator(altPubKey, 100)
+	altValSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{altVal})
+	altSigners := getAltSigners(altVal, altPrivVal)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: header chain crosses complete validator set change",
+			func() {},
+			true,
+		},
+		{
+			"failure: header signed by new validator set without intermediate header",
+			func() {
+				header := headerChain.Headers[1]
+				header.TrustedHeight = headerChain.Headers[0].TrustedHeight
+				header.TrustedValidators = headerChain.Headers[0].TrustedValidators
+				headerChain.Headers = []*ibctm.Header{header}
+			},
+			false,
+		},
+		{
+			"failure: trusted consensus state not found for first header",
+			func() {
+				headerChain.Headers[0].TrustedHeight = headerChain.Headers[0].TrustedHeight.Increment().(clienttypes.Height)
+			},
+			false,
+		},
+		{
+			"failure: header not signed by the validator set committed to by the preceding header",
+			func() {
+				header := suite.chainB.CreateTMClientHeader(suite.chainB.ChainID, headerChain.Headers[1].Header.Height, headerChain.Headers[1].TrustedHeight, headerChain.Headers[1].GetTime(), suite.chainB.Vals, suite.chainB.Vals, altValSet, suite.chainB.Signers)
+				headerChain.Headers[1] = header
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(path)
+
+			headerChain = suite.createHeaderChain(path, altValSet, altSigners)
+
+			tc.malleate()
+
+			clientState := path.EndpointA.GetClientState()
+			clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID)
+
+			err := clientState.VerifyClientMessage(suite.chainA.GetContext(), suite.chainA.App.AppCodec(), clientStore, headerChain)
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TendermintTestSuite) TestUpdateStateHeaderChain() {
+	altPrivVal := cmttypes.NewMockPV()
+	altPubKey, err := altPrivVal.GetPubKey()
+	suite.Require().NoError(err)
+	altVal := cmttypes.NewValidator(altPubKey, 100)
+	altValSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{altVal})
+	altSigners := getAltSigners(altVal, altPrivVal)
+
+	testCases := []struct {
+		name             string
+		storeCheckpoints bool
+	}{
+		{"only final consensus state is stored", false},
+		{"intermediate consensus states are stored as checkpoints", true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(path)
+
+			headerChain := suite.createHeaderChain(path, altValSet, altSigners)
+			headerChain.StoreCheckpoints = tc.storeCheckpoints
+
+			err := path.EndpointA.Chain.App.GetIBCKeeper().ClientKeeper.UpdateClient(suite.chainA.GetContext(), path.EndpointA.ClientID, headerChain)
+			suite.Require().NoError(err)
+
+			intermediateHeight := headerChain.Headers[0].GetHeight()
+			finalHeight := headerChain.Headers[1].GetHeight()
+
+			clientState := path.EndpointA.GetClientState()
+			suite.Require().True(clientState.GetLatestHeight().EQ(finalHeight))
+
+			clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID)
+			consensusState, found := ibctm.GetConsensusState(clientStore, suite.chainA.App.AppCodec(), finalHeight)
+			suite.Require().True(found)
+			suite.Require().Equal(headerChain.Headers[1].ConsensusState(), consensusState)
+
+			_, found = ibctm.GetConsensusState(clientStore, suite.chainA.App.AppCodec(), intermediateHeight)
+			suite.Require().Equal(tc.storeCheckpoints, found)
+		})
+	}
+}
@@ -18,9 +18,17 @@ import (
 )
 
 // CheckForMisbehaviour detects duplicate height misbehaviour and BFT time violation misbehaviour
-// in a submitted Header message and verifies the correctness of a submitted Misbehaviour ClientMessage
-func (ClientState) CheckForMisbehaviour(ctx sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore, msg exported.ClientMessage) bool {
+// in a submitted Header or HeaderChain message and verifies the correctness of a submitted Misbehaviour ClientMessage
+func (cs ClientState) CheckForMisbehaviour(ctx sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore, msg exported.ClientMessage) bool {
 	switch msg := msg.(type) {
+	case *HeaderChain:
+		// every header in the chain has been verified, thus any header which conflicts with
+		// an existing consensus state is evidence of misbehaviour
+		for _, header := range msg.Headers {
+			if cs.CheckForMisbehaviour(ctx, cdc, clientStore, header) {
+				return true
+			}
+		}
 	case *Header:
 		tmHeader := msg
 		consState := tmHeader.ConsensusState()
@@ -19,7 +19,7 @@ import (
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 )
 
-// VerifyClientMessage checks if the clientMessage is of type Header or Misbehaviour and verifies the message
+// VerifyClientMessage checks if the clientMessage is of type Header, HeaderChain or Misbehaviour and verifies the message
 func (cs *ClientState) VerifyClientMessage(
 	ctx sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore,
 	clientMsg exported.ClientMessage,
@@ -27,6 +27,8 @@ func (cs *ClientState) VerifyClientMessage(
 	switch msg := clientMsg.(type) {
 	case *Header:
 		return cs.verifyHeader(ctx, clientStore, cdc, msg)
+	case *HeaderChain:
+		return cs.verifyHeaderChain(ctx, clientStore, cdc, msg)
 	case *Misbehaviour:
 		return cs.verifyMisbehaviour(ctx, clientStore, cdc, msg)
 	default:
@@ -46,14 +48,46 @@ func (cs *ClientState) verifyHeader(
 	ctx sdk.Context, clientStore storetypes.KVStore, cdc codec.BinaryCodec,
 	header *Header,
 ) error {
-	currentTimestamp := ctx.BlockTime()
-
 	// Retrieve trusted consensus states for each Header in misbehaviour
 	consState, found := GetConsensusState(clientStore, cdc, header.TrustedHeight)
 	if !found {
 		return errorsmod.Wrapf(clienttypes.ErrConsensusStateNotFound, "could not get trusted consensus state from clientStore for Header at TrustedHeight: %s", header.TrustedHeight)
 	}
 
+	return cs.verifyHeaderWithConsensusState(ctx, header, consState)
+}
+
+// verifyHeaderChain verifies each header of the HeaderChain in order. The first header is verified against
+// the trusted consensus state stored at its TrustedHeight. Each subsequent header is verified against the
+// consensus state of the preceding header, which has been verified in the previous iteration.
+func (cs *ClientState) verifyHeaderChain(
+	ctx sdk.Context, clientStore storetypes.KVStore, cdc codec.BinaryCodec,
+	headerChain *HeaderChain,
+) error {
+	if err := headerChain.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if err := cs.verifyHeader(ctx, clientStore, cdc, headerChain.Headers[0]); err != nil {
+		return errorsmod.Wrap(err, "failed to verify header 0 in header chain")
+	}
+
+	for i := 1; i < len(headerChain.Headers); i++ {
+		trustedConsState := headerChain.Headers[i-1].ConsensusState()
+		if err := cs.verifyHeaderWithConsensusState(ctx, headerChain.Headers[i], trustedConsState); err != nil {
+			return errorsmod.Wrapf(err, "failed to verify header %d in header chain", i)
+		}
+	}
+
+	return nil
+}
+
+// verifyHeaderWithConsensusState verifies the header against the provided trusted consensus state.
+func (cs *ClientState) verifyHeaderWithConsensusState(
+	ctx sdk.Context, header *Header, consState *ConsensusState,
+) error {
+	currentTimestamp := ctx.BlockTime()
+
 	if err := checkTrustedHeader(header, consState); err != nil {
 		return err
 	}
@@ -131,18 +165,40 @@ func (cs *ClientState) verifyHeader(
 // UpdateState must only be used to update within a single revision, thus header revision number and trusted height's revision
 // number must be the same. To update to a new revision, use a separate upgrade path
 // UpdateState will prune the oldest consensus state if it is expired.
+// For a HeaderChain only the consensus state of the final header is created, unless StoreCheckpoints is set
+// in which case a consensus state is created for every header in the chain.
 func (cs ClientState) UpdateState(ctx sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore, clientMsg exported.ClientMessage) []exported.Height {
-	header, ok := clientMsg.(*Header)
-	if !ok {
-		panic(fmt.Errorf("expected type %T, got %T", &Header{}, clientMsg))
+	var headers []*Header
+	switch msg := clientMsg.(type) {
+	case *Header:
+		headers = []*Header{msg}
+	case *HeaderChain:
+		headers = msg.Headers
+		if !msg.StoreCheckpoints {
+			headers = msg.Headers[len(msg.Headers)-1:]
+		}
+	default:
+		panic(fmt.Errorf("expected type %T or %T, got %T", &Header{}, &HeaderChain{}, clientMsg))
 	}
 
 	cs.pruneOldestConsensusState(ctx, cdc, clientStore)
 
+	heights := make([]exported.Height, 0, len(headers))
+	for _, header := range headers {
+		heights = append(heights, cs.updateStateWithHeader(ctx, cdc, clientStore, header))
+	}
+
+	return heights
+}
+
+// updateStateWithHeader creates the consensus state and associated metadata for the provided header,
+// updating the latest height of the client state if the header height is greater.
+// A no-op is performed if a consensus state already exists at the header height.
+func (cs *ClientState) updateStateWithHeader(ctx sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore, header *Header) exported.Height {
 	// check for duplicate update
 	if _, found := GetConsensusState(clientStore, cdc, header.GetHeight()); found {
 		// perform no-op
-		return []exported.Height{header.GetHeight()}
+		return header.GetHeight()
 	}
 
 	height := header.GetHeight().(clienttypes.Height)
@@ -157,11 +213,11 @@ func (cs ClientState) UpdateState(ctx sdk.Context, cdc codec.BinaryCodec, client
 	}
 
 	// set client state, consensus state and associated metadata
-	setClientState(clientStore, cdc, &cs)
+	setClientState(clientStore, cdc, cs)
 	setConsensusState(clientStore, cdc, consensusState, header.GetHeight())
 	setConsensusMetadata(ctx, clientStore, header.GetHeight())
 
-	return []exported.Height{height}
+	return height
 }
 
 // pruneOldestConsensusState will retrieve the earliest consensus state for this clientID and check if it is expired. If it is,
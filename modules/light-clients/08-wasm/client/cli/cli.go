@@ -19,6 +19,11 @@ func GetQueryCmd() *cobra.Command {
 	queryCmd.AddCommand(
 		getCmdCode(),
 		getCmdChecksums(),
+		getCmdParams(),
+		getCmdClientGasUsage(),
+		getCmdChecksumStatus(),
+		getCmdChecksumClients(),
+		getCmdClientMigrations(),
 	)
 
 	return queryCmd
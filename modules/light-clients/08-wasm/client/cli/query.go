@@ -78,3 +78,180 @@ func getCmdChecksums() *cobra.Command {
 
 	return cmd
 }
+
+// getCmdParams defines the command to query the 08-wasm params.
+func getCmdParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "params",
+		Short:   "Query the current 08-wasm parameters",
+		Long:    "Query the current 08-wasm parameters",
+		Example: fmt.Sprintf("%s query %s wasm params", version.AppName, ibcexported.ModuleName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getCmdClientGasUsage defines the command to query the gas consumed by the light client contract of a client.
+func getCmdClientGasUsage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "gas-usage [client-id]",
+		Short:   "Query the gas consumed by a wasm client",
+		Long:    "Query the total gas consumed by the light client contract of a wasm client along with the gas consumed in each of the retained blocks",
+		Example: fmt.Sprintf("%s query %s wasm gas-usage [client-id]", version.AppName, ibcexported.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := types.QueryClientGasUsageRequest{
+				ClientId:   args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ClientGasUsage(cmd.Context(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "gas usage history")
+
+	return cmd
+}
+
+// getCmdChecksumStatus defines the command to query the lifecycle state of a checksum.
+func getCmdChecksumStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "checksum-status [checksum]",
+		Short:   "Query the lifecycle state of a checksum",
+		Long:    "Query the lifecycle state (staging, active or deprecated) of a light client wasm contract with a given checksum",
+		Example: fmt.Sprintf("%s query %s wasm checksum-status [checksum]", version.AppName, ibcexported.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := types.QueryChecksumStatusRequest{
+				Checksum: args[0],
+			}
+
+			res, err := queryClient.ChecksumStatus(cmd.Context(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getCmdChecksumClients defines the command to query the clients using a checksum.
+func getCmdChecksumClients() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "checksum-clients [checksum]",
+		Short:   "Query the clients using a checksum",
+		Long:    "Query the identifiers of the wasm clients using the light client wasm contract with a given checksum",
+		Example: fmt.Sprintf("%s query %s wasm checksum-clients [checksum]", version.AppName, ibcexported.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := types.QueryChecksumClientsRequest{
+				Checksum:   args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ChecksumClients(cmd.Context(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "checksum clients")
+
+	return cmd
+}
+
+// getCmdClientMigrations defines the command to query the pending client migrations.
+func getCmdClientMigrations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "client-migrations",
+		Short:   "Query the pending client migrations",
+		Long:    "Query the pending migrations of all clients using a checksum to a new checksum",
+		Example: fmt.Sprintf("%s query %s wasm client-migrations", version.AppName, ibcexported.ModuleName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ClientMigrations(cmd.Context(), &types.QueryClientMigrationsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "client migrations")
+
+	return cmd
+}
@@ -19,7 +19,10 @@ import (
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
 )
 
-const FlagAuthority = "authority"
+const (
+	FlagAuthority = "authority"
+	FlagStaged    = "staged"
+)
 
 // newSubmitStoreCodeProposalCmd returns the command to send a proposal to store new wasm bytecode.
 func newSubmitStoreCodeProposalCmd() *cobra.Command {
@@ -54,9 +57,15 @@ func newSubmitStoreCodeProposalCmd() *cobra.Command {
 				return err
 			}
 
+			staged, err := cmd.Flags().GetBool(FlagStaged)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgStoreCode{
 				Signer:       authority,
 				WasmByteCode: code,
+				Staged:       staged,
 			}
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -72,6 +81,7 @@ func newSubmitStoreCodeProposalCmd() *cobra.Command {
 	}
 
 	cmd.Flags().String(FlagAuthority, "", "The address of the wasm client module authority (defaults to gov)")
+	cmd.Flags().Bool(FlagStaged, false, "Store the wasm code in the staging state, the code cannot be used by clients until it is activated")
 
 	flags.AddTxFlagsToCmd(cmd)
 	govcli.AddGovPropFlagsToCmd(cmd)
@@ -129,3 +129,29 @@ type QueryPluginsI interface {
 	// HandleQuery will route the query to the correct plugin and return the result
 	HandleQuery(ctx sdk.Context, caller string, request wasmvmtypes.QueryRequest) ([]byte, error)
 }
+
+// GasManager defines the interface used to limit and record the gas consumed by calls to light client contracts.
+type GasManager interface {
+	// GetContractGasLimit returns the maximum amount of gas, in SDK gas units, which may be consumed by a single
+	// call to the contract with the given checksum. A value of zero signifies that no limit applies. An error is
+	// returned if the gas which may be consumed by contract calls within the current block has been exhausted.
+	GetContractGasLimit(ctx sdk.Context, checksum []byte) (uint64, error)
+
+	// ConsumeContractGas records the gas, in SDK gas units, consumed by a contract call on behalf of the given client.
+	ConsumeContractGas(ctx sdk.Context, clientID string, gasUsed uint64) error
+}
+
+// MessageRouter defines the interface used to route the messages dispatched by light client contracts.
+type MessageRouter interface {
+	// Handler returns the MsgServiceHandler for a given message or nil
+	// if not found
+	Handler(msg sdk.Msg) baseapp.MsgServiceHandler
+}
+
+// MessageDispatcher defines the interface used to execute the messages returned by calls to light client contracts.
+type MessageDispatcher interface {
+	// DispatchMessages executes the messages returned by a call to the contract with the given checksum on
+	// behalf of the given client. An error is returned if the contract has not been granted the capability
+	// to dispatch any of the messages or if the execution of any of the messages fails.
+	DispatchMessages(ctx sdk.Context, clientID string, checksum []byte, msgs []wasmvmtypes.SubMsg) error
+}
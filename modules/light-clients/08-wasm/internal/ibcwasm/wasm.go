@@ -12,13 +12,29 @@ var (
 
 	queryRouter  QueryRouter
 	queryPlugins QueryPluginsI
+	gasManager   GasManager
+	dispatcher   MessageDispatcher
 
 	// state management
 	Schema    collections.Schema
 	Checksums collections.KeySet[[]byte]
+	// ChecksumStatuses maps checksums to their lifecycle state, see types.ChecksumStatus
+	ChecksumStatuses collections.Map[[]byte, int32]
 
 	// ChecksumsKey is the key under which all checksums are stored
 	ChecksumsKey = collections.NewPrefix(0)
+	// ParamsKey is the key under which the 08-wasm params are stored
+	ParamsKey = collections.NewPrefix(1)
+	// BlockGasUsageKey is the key under which the gas consumed by contract calls in the current block is stored
+	BlockGasUsageKey = collections.NewPrefix(2)
+	// ClientGasUsageKey is the key under which the total gas consumed by each client is stored
+	ClientGasUsageKey = collections.NewPrefix(3)
+	// ClientGasUsageHistoryKey is the key under which the gas consumed by each client per block is stored
+	ClientGasUsageHistoryKey = collections.NewPrefix(4)
+	// ChecksumStatusesKey is the key under which the lifecycle state of each checksum is stored
+	ChecksumStatusesKey = collections.NewPrefix(5)
+	// ClientMigrationsKey is the key under which the pending client migrations are stored
+	ClientMigrationsKey = collections.NewPrefix(6)
 )
 
 // SetVM sets the wasm VM for the 08-wasm module.
@@ -62,11 +78,40 @@ func GetQueryPlugins() QueryPluginsI {
 	return queryPlugins
 }
 
+// SetGasManager sets the gas manager used to limit and record the gas consumed by contract calls.
+// Panics if the gas manager is nil.
+func SetGasManager(manager GasManager) {
+	if manager == nil {
+		panic(errors.New("gas manager must be not nil"))
+	}
+	gasManager = manager
+}
+
+// GetGasManager returns the gas manager used to limit and record the gas consumed by contract calls.
+func GetGasManager() GasManager {
+	return gasManager
+}
+
+// SetMessageDispatcher sets the dispatcher used to execute the messages returned by contract calls.
+// Panics if the message dispatcher is nil.
+func SetMessageDispatcher(messageDispatcher MessageDispatcher) {
+	if messageDispatcher == nil {
+		panic(errors.New("message dispatcher must be not nil"))
+	}
+	dispatcher = messageDispatcher
+}
+
+// GetMessageDispatcher returns the dispatcher used to execute the messages returned by contract calls.
+func GetMessageDispatcher() MessageDispatcher {
+	return dispatcher
+}
+
 // SetupWasmStoreService sets up the 08-wasm module's collections.
 func SetupWasmStoreService(storeService storetypes.KVStoreService) {
 	sb := collections.NewSchemaBuilder(storeService)
 
 	Checksums = collections.NewKeySet(sb, ChecksumsKey, "checksums", collections.BytesKey)
+	ChecksumStatuses = collections.NewMap(sb, ChecksumStatusesKey, "checksum_statuses", collections.BytesKey, collections.Int32Value)
 
 	schema, err := sb.Build()
 	if err != nil {
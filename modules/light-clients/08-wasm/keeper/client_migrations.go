@@ -0,0 +1,183 @@
+package keeper
+
+import (
+	"bytes"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// GetClientMigration returns the pending migration of the clients using the given checksum.
+func (k Keeper) GetClientMigration(ctx sdk.Context, fromChecksum []byte) (types.ClientMigration, bool) {
+	migration, err := k.clientMigrations.Get(ctx, fromChecksum)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.ClientMigration{}, false
+	}
+	if err != nil {
+		panic(err)
+	}
+
+	return migration, true
+}
+
+// SetClientMigration sets a pending client migration.
+func (k Keeper) SetClientMigration(ctx sdk.Context, migration types.ClientMigration) {
+	if err := k.clientMigrations.Set(ctx, migration.FromChecksum, migration); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllClientMigrations returns all pending client migrations.
+func (k Keeper) GetAllClientMigrations(ctx sdk.Context) []types.ClientMigration {
+	iterator, err := k.clientMigrations.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	migrations, err := iterator.Values()
+	if err != nil {
+		panic(err)
+	}
+
+	return migrations
+}
+
+// isClientMigrationTarget returns true if the given checksum is the target of a pending client migration.
+func (k Keeper) isClientMigrationTarget(ctx sdk.Context, checksum []byte) bool {
+	for _, migration := range k.GetAllClientMigrations(ctx) {
+		if bytes.Equal(migration.ToChecksum, checksum) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MigrateClients processes the pending client migrations, migrating at most MaxClientMigrationsPerBlock clients
+// in total. Clients are processed in ascending order of their identifiers. The state changes of a client which
+// fails to be migrated are discarded and a client_migration_failed event is emitted, such a client may still be
+// migrated using MsgMigrateContract. A migration is removed once all clients using its checksum have been processed.
+func (k Keeper) MigrateClients(ctx sdk.Context) {
+	limit := k.GetParams(ctx).MaxClientMigrationsPerBlock
+	for _, migration := range k.GetAllClientMigrations(ctx) {
+		if limit == 0 {
+			return
+		}
+
+		limit -= k.migrateClientsBatch(ctx, migration, limit)
+	}
+}
+
+// migrateClientsBatch migrates up to limit clients of the given client migration and returns the number of
+// clients processed.
+func (k Keeper) migrateClientsBatch(ctx sdk.Context, migration types.ClientMigration, limit uint64) uint64 {
+	var clientIDs []string
+	completed := true
+	// client states cannot be written while iterating, the clients to migrate are therefore collected first
+	k.iterateChecksumClients(ctx, migration.FromChecksum, func(clientID string) bool {
+		if clientID <= migration.LastClientId {
+			return false
+		}
+
+		if uint64(len(clientIDs)) == limit {
+			completed = false
+			return true
+		}
+
+		clientIDs = append(clientIDs, clientID)
+		return false
+	})
+
+	for _, clientID := range clientIDs {
+		cacheCtx, writeFn := ctx.CacheContext()
+		if err := k.migrateContractCode(cacheCtx, clientID, migration.ToChecksum, migration.Msg); err != nil {
+			types.Logger(ctx).Error("client migration failed", "client-id", clientID, "error", err)
+			emitClientMigrationFailedEvent(ctx, clientID, migration, err)
+			migration.Failed++
+		} else {
+			writeFn()
+			migration.Migrated++
+		}
+
+		migration.LastClientId = clientID
+	}
+
+	if !completed {
+		k.SetClientMigration(ctx, migration)
+		return uint64(len(clientIDs))
+	}
+
+	if err := k.clientMigrations.Remove(ctx, migration.FromChecksum); err != nil {
+		panic(err)
+	}
+
+	emitClientMigrationCompletedEvent(ctx, migration)
+
+	return uint64(len(clientIDs))
+}
+
+// iterateChecksumClients iterates over the identifiers of the clients using the given checksum in ascending order
+// and calls the provided callback for each of them. Iteration stops when the callback returns true.
+func (k Keeper) iterateChecksumClients(ctx sdk.Context, checksum []byte, cb func(clientID string) bool) {
+	k.clientKeeper.IterateClientStates(ctx, []byte(types.Wasm), func(clientID string, clientState exported.ClientState) bool {
+		wasmClientState, ok := clientState.(*types.ClientState)
+		if !ok || !bytes.Equal(wasmClientState.Checksum, checksum) {
+			return false
+		}
+
+		return cb(clientID)
+	})
+}
+
+// paginateChecksumClients returns a page of the identifiers of the clients using the given checksum. The page key
+// is the identifier of the first client of the page.
+func (k Keeper) paginateChecksumClients(ctx sdk.Context, checksum []byte, pageReq *query.PageRequest) ([]string, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+
+	if len(pageReq.Key) > 0 && pageReq.Offset > 0 {
+		return nil, nil, errors.New("invalid request, either offset or key is expected, got both")
+	}
+
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	var (
+		clientIDs []string
+		nextKey   []byte
+		total     uint64
+	)
+	k.iterateChecksumClients(ctx, checksum, func(clientID string) bool {
+		if clientID < string(pageReq.Key) {
+			return false
+		}
+
+		total++
+		switch {
+		case total <= pageReq.Offset:
+		case uint64(len(clientIDs)) < limit:
+			clientIDs = append(clientIDs, clientID)
+		case nextKey == nil:
+			nextKey = []byte(clientID)
+			return !pageReq.CountTotal
+		}
+
+		return false
+	})
+
+	pageRes := &query.PageResponse{NextKey: nextKey}
+	if pageReq.CountTotal {
+		pageRes.Total = total
+	}
+
+	return clientIDs, pageRes, nil
+}
@@ -0,0 +1,174 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+
+	wasmvm "github.com/CosmWasm/wasmvm"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	wasmtesting "github.com/cosmos/ibc-go/modules/light-clients/08-wasm/testing"
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
+)
+
+// createWasmClients creates the given number of wasm clients using the checksum of wasmtesting.Code
+// and returns their identifiers.
+func (suite *KeeperTestSuite) createWasmClients(n int) []string {
+	var clientIDs []string
+	for i := 0; i < n; i++ {
+		endpoint := wasmtesting.NewWasmEndpoint(suite.chainA)
+		err := endpoint.CreateClient()
+		suite.Require().NoError(err)
+
+		clientIDs = append(clientIDs, endpoint.ClientID)
+	}
+
+	return clientIDs
+}
+
+// setMaxClientMigrationsPerBlock sets the maximum number of clients migrated per block in the 08-wasm params.
+func (suite *KeeperTestSuite) setMaxClientMigrationsPerBlock(limit uint64) {
+	wasmClientKeeper := GetSimApp(suite.chainA).WasmClientKeeper
+	params := wasmClientKeeper.GetParams(suite.chainA.GetContext())
+	params.MaxClientMigrationsPerBlock = limit
+	wasmClientKeeper.SetParams(suite.chainA.GetContext(), params)
+}
+
+func (suite *KeeperTestSuite) TestMigrateClients() {
+	var (
+		oldChecksum []byte
+		newChecksum []byte
+		clientIDs   []string
+		failing     map[string]bool
+	)
+
+	testCases := []struct {
+		name          string
+		malleate      func()
+		expBatches    [][]string
+		expMigrated   uint64
+		expFailed     uint64
+		expCompletion int // the block in which the migration completes, -1 if it does not complete
+	}{
+		{
+			"success: all clients migrated in a single block",
+			func() {
+				suite.setMaxClientMigrationsPerBlock(10)
+			},
+			[][]string{{"08-wasm-0", "08-wasm-1", "08-wasm-2"}},
+			3,
+			0,
+			1,
+		},
+		{
+			"success: clients migrated in batches across blocks",
+			func() {
+				suite.setMaxClientMigrationsPerBlock(2)
+			},
+			[][]string{{"08-wasm-0", "08-wasm-1"}, {"08-wasm-2"}},
+			3,
+			0,
+			2,
+		},
+		{
+			"success: batch size equal to the number of clients",
+			func() {
+				suite.setMaxClientMigrationsPerBlock(3)
+			},
+			[][]string{{"08-wasm-0", "08-wasm-1", "08-wasm-2"}},
+			3,
+			0,
+			1,
+		},
+		{
+			"success: failed client migrations are skipped",
+			func() {
+				suite.setMaxClientMigrationsPerBlock(2)
+				failing["08-wasm-1"] = true
+			},
+			[][]string{{"08-wasm-0"}, {"08-wasm-2"}},
+			2,
+			1,
+			2,
+		},
+		{
+			"success: migrations paused",
+			func() {
+				suite.setMaxClientMigrationsPerBlock(0)
+			},
+			[][]string{{}, {}},
+			0,
+			0,
+			-1,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupWasmWithMockVM()
+			failing = make(map[string]bool)
+
+			oldChecksum = storeWasmCode(suite, wasmtesting.Code)
+			newChecksum = storeWasmCode(suite, wasmtesting.CreateMockContract([]byte("MockByteCode-TestMigrateClients")))
+			clientIDs = suite.createWasmClients(3)
+
+			suite.mockVM.MigrateFn = func(_ wasmvm.Checksum, env wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+				if failing[env.Contract.Address] {
+					return nil, wasmtesting.DefaultGasUsed, wasmtesting.ErrMockContract
+				}
+
+				data, err := json.Marshal(types.EmptyResult{})
+				suite.Require().NoError(err)
+
+				return &wasmvmtypes.Response{Data: data}, wasmtesting.DefaultGasUsed, nil
+			}
+
+			tc.malleate()
+
+			wasmClientKeeper := GetSimApp(suite.chainA).WasmClientKeeper
+			msg := types.NewMsgMigrateAllClients(wasmClientKeeper.GetAuthority(), oldChecksum, newChecksum, []byte("{}"))
+			_, err := wasmClientKeeper.MigrateAllClients(suite.chainA.GetContext(), msg)
+			suite.Require().NoError(err)
+
+			// each call to MigrateClients processes the batch of a single block
+			migrated := make(map[string]bool)
+			for i, expBatch := range tc.expBatches {
+				ctx := suite.chainA.GetContext()
+				wasmClientKeeper.MigrateClients(ctx)
+
+				for _, clientID := range expBatch {
+					migrated[clientID] = true
+				}
+
+				for _, clientID := range clientIDs {
+					clientState, err := wasmClientKeeper.GetWasmClientState(ctx, clientID)
+					suite.Require().NoError(err)
+
+					expChecksum := oldChecksum
+					if migrated[clientID] {
+						expChecksum = newChecksum
+					}
+					suite.Require().Equal(types.Checksum(expChecksum), types.Checksum(clientState.Checksum), "block %d, client %s", i, clientID)
+				}
+
+				// the migration is removed in the block in which it completes
+				_, found := wasmClientKeeper.GetClientMigration(ctx, oldChecksum)
+				suite.Require().Equal(tc.expCompletion < 0 || i+1 < tc.expCompletion, found, "block %d", i)
+
+				if i+1 == tc.expCompletion {
+					expEvent := sdk.NewEvent(
+						types.EventTypeClientMigrationCompleted,
+						sdk.NewAttribute(types.AttributeKeyWasmChecksum, hex.EncodeToString(oldChecksum)),
+						sdk.NewAttribute(types.AttributeKeyNewChecksum, hex.EncodeToString(newChecksum)),
+						sdk.NewAttribute(types.AttributeKeyMigratedClients, strconv.FormatUint(tc.expMigrated, 10)),
+						sdk.NewAttribute(types.AttributeKeyFailedClients, strconv.FormatUint(tc.expFailed, 10)),
+					)
+					suite.Require().Contains(ctx.EventManager().Events(), expEvent)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,165 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/hex"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/internal/ibcwasm"
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
+)
+
+var _ ibcwasm.MessageDispatcher = (*Keeper)(nil)
+
+// DispatchMessages implements ibcwasm.MessageDispatcher. The messages are dispatched on behalf of the client
+// address of the given client and must be allowed by the capabilities granted to the contract with the given
+// checksum. The messages are executed atomically on a branch of the state with a gas meter bounded by the gas
+// limit of the capabilities, the gas consumed is charged to the gas meter of the context.
+func (k Keeper) DispatchMessages(ctx sdk.Context, clientID string, checksum []byte, msgs []wasmvmtypes.SubMsg) error {
+	capabilities, found := k.GetParams(ctx).GetCapabilities(checksum)
+	if !found {
+		return errorsmod.Wrapf(types.ErrWasmSubMessagesNotAllowed, "checksum (%s) has not been granted any capabilities", hex.EncodeToString(checksum))
+	}
+
+	if k.msgRouter == nil {
+		return errorsmod.Wrap(types.ErrWasmSubMessagesNotAllowed, "message router has not been set")
+	}
+
+	sender := types.ClientAddress(clientID)
+	sdkMsgs := make([]sdk.Msg, len(msgs))
+	for i, subMsg := range msgs {
+		msg, err := k.toSDKMsg(sender, subMsg)
+		if err != nil {
+			return err
+		}
+
+		if !capabilities.IsMessageAllowed(sdk.MsgTypeURL(msg)) {
+			return errorsmod.Wrapf(types.ErrWasmSubMessagesNotAllowed, "message %s is not allowed for checksum (%s)", sdk.MsgTypeURL(msg), hex.EncodeToString(checksum))
+		}
+
+		if err := k.validateSigners(msg, sender); err != nil {
+			return err
+		}
+
+		sdkMsgs[i] = msg
+	}
+
+	return k.executeMessages(ctx, clientID, checksum, sdkMsgs, capabilities.GasLimit)
+}
+
+// executeMessages executes the messages dispatched by a contract with a gas meter bounded by the given gas limit.
+// An out of gas panic raised by the execution of the messages is recovered and returned as an error.
+func (k Keeper) executeMessages(ctx sdk.Context, clientID string, checksum []byte, msgs []sdk.Msg, gasLimit uint64) (err error) {
+	gasMeter := storetypes.NewGasMeter(gasLimit)
+
+	defer func() {
+		ctx.GasMeter().ConsumeGas(gasMeter.GasConsumedToLimit(), "Executing wasm contract messages")
+
+		if r := recover(); r != nil {
+			outOfGas, ok := r.(storetypes.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+
+			err = errorsmod.Wrapf(types.ErrSubMessageFailed, "out of gas in location: %s; gas limit: %d", outOfGas.Descriptor, gasLimit)
+		}
+	}()
+
+	// CacheContext returns a new context with the multi-store branched into a cached storage object
+	// writeCache is called only if all msgs succeed, performing state transitions atomically
+	cacheCtx, writeCache := ctx.WithGasMeter(gasMeter).CacheContext()
+	for _, msg := range msgs {
+		handler := k.msgRouter.Handler(msg)
+		if handler == nil {
+			return errorsmod.Wrapf(types.ErrInvalidSubMessage, "no message handler found for %s", sdk.MsgTypeURL(msg))
+		}
+
+		res, err := handler(cacheCtx, msg)
+		if err != nil {
+			return errorsmod.Wrapf(types.ErrSubMessageFailed, "message %s: %s", sdk.MsgTypeURL(msg), err.Error())
+		}
+
+		// NOTE: The sdk msg handler creates a new EventManager, so events must be correctly propagated back to the current context
+		cacheCtx.EventManager().EmitEvents(res.GetEvents())
+		emitDispatchContractMessageEvent(cacheCtx, clientID, checksum, msg)
+	}
+
+	writeCache()
+
+	return nil
+}
+
+// toSDKMsg converts a message returned by a contract into the sdk.Msg it represents. Only bank send and
+// stargate messages without replies or gas limits are supported.
+func (k Keeper) toSDKMsg(sender sdk.AccAddress, subMsg wasmvmtypes.SubMsg) (sdk.Msg, error) {
+	if subMsg.ReplyOn != wasmvmtypes.ReplyNever {
+		return nil, errorsmod.Wrap(types.ErrInvalidSubMessage, "replies are not supported, reply on must be never")
+	}
+
+	if subMsg.GasLimit != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidSubMessage, "gas limits are not supported, messages are bounded by the gas limit of the contract capabilities")
+	}
+
+	switch {
+	case subMsg.Msg.Bank != nil && subMsg.Msg.Bank.Send != nil:
+		coins := make(sdk.Coins, len(subMsg.Msg.Bank.Send.Amount))
+		for i, coin := range subMsg.Msg.Bank.Send.Amount {
+			amount, ok := sdkmath.NewIntFromString(coin.Amount)
+			if !ok {
+				return nil, errorsmod.Wrapf(types.ErrInvalidSubMessage, "invalid amount %s for denom %s", coin.Amount, coin.Denom)
+			}
+
+			coins[i] = sdk.Coin{Denom: coin.Denom, Amount: amount}
+		}
+
+		return &banktypes.MsgSend{
+			FromAddress: sender.String(),
+			ToAddress:   subMsg.Msg.Bank.Send.ToAddress,
+			Amount:      coins,
+		}, nil
+	case subMsg.Msg.Stargate != nil:
+		var msg sdk.Msg
+		if err := k.cdc.UnpackAny(&codectypes.Any{TypeUrl: subMsg.Msg.Stargate.TypeURL, Value: subMsg.Msg.Stargate.Value}, &msg); err != nil {
+			return nil, errorsmod.Wrapf(types.ErrInvalidSubMessage, "failed to unpack stargate message: %s", err.Error())
+		}
+
+		return msg, nil
+	default:
+		return nil, errorsmod.Wrap(types.ErrInvalidSubMessage, "only bank send and stargate messages are supported")
+	}
+}
+
+// validateSigners returns an error if the message has a signer other than the given sender.
+func (k Keeper) validateSigners(msg sdk.Msg, sender sdk.AccAddress) error {
+	signerCodec, ok := k.cdc.(codec.Codec)
+	if !ok {
+		return errorsmod.Wrap(types.ErrInvalidSubMessage, "codec does not support retrieving message signers")
+	}
+
+	signers, _, err := signerCodec.GetMsgV1Signers(msg)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidSubMessage, "failed to retrieve signers of message %s: %s", sdk.MsgTypeURL(msg), err.Error())
+	}
+
+	if len(signers) == 0 {
+		return errorsmod.Wrapf(types.ErrInvalidSubMessage, "message %s has no signers", sdk.MsgTypeURL(msg))
+	}
+
+	for _, signer := range signers {
+		if !bytes.Equal(signer, sender) {
+			return errorsmod.Wrapf(types.ErrInvalidSubMessage, "expected signer %s, got %s", sender, sdk.AccAddress(signer))
+		}
+	}
+
+	return nil
+}
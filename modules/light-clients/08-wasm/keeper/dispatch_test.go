@@ -0,0 +1,251 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	wasmtesting "github.com/cosmos/ibc-go/modules/light-clients/08-wasm/testing"
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
+)
+
+// bankSendSubMsg returns a contract message sending the given amount of the bond denom to the given address.
+func bankSendSubMsg(toAddress string, amount int64) wasmvmtypes.SubMsg {
+	return wasmvmtypes.SubMsg{
+		Msg: wasmvmtypes.CosmosMsg{
+			Bank: &wasmvmtypes.BankMsg{
+				Send: &wasmvmtypes.SendMsg{
+					ToAddress: toAddress,
+					Amount:    wasmvmtypes.Coins{wasmvmtypes.NewCoin(uint64(amount), sdk.DefaultBondDenom)},
+				},
+			},
+		},
+		ReplyOn: wasmvmtypes.ReplyNever,
+	}
+}
+
+// stargateSubMsg returns a contract message containing the given proto encoded message.
+func (suite *KeeperTestSuite) stargateSubMsg(msg sdk.Msg) wasmvmtypes.SubMsg {
+	bz, err := suite.chainA.Codec.Marshal(msg)
+	suite.Require().NoError(err)
+
+	return wasmvmtypes.SubMsg{
+		Msg: wasmvmtypes.CosmosMsg{
+			Stargate: &wasmvmtypes.StargateMsg{
+				TypeURL: sdk.MsgTypeURL(msg),
+				Value:   bz,
+			},
+		},
+		ReplyOn: wasmvmtypes.ReplyNever,
+	}
+}
+
+func (suite *KeeperTestSuite) TestDispatchMessages() {
+	var (
+		checksum     types.Checksum
+		capabilities []types.ContractCapabilities
+		msgs         []wasmvmtypes.SubMsg
+	)
+
+	const (
+		clientID      = "08-wasm-0"
+		clientBalance = 1000
+	)
+
+	clientAddress := types.ClientAddress(clientID)
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		expBalance int64
+		expError   error
+	}{
+		{
+			"success: bank send",
+			func() {},
+			100,
+			nil,
+		},
+		{
+			"success: stargate message",
+			func() {
+				msgs = []wasmvmtypes.SubMsg{suite.stargateSubMsg(banktypes.NewMsgSend(clientAddress, suite.chainA.SenderAccount.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 200))))}
+			},
+			200,
+			nil,
+		},
+		{
+			"success: multiple messages",
+			func() {
+				msgs = append(msgs, bankSendSubMsg(suite.chainA.SenderAccount.GetAddress().String(), 300))
+			},
+			400,
+			nil,
+		},
+		{
+			"success: no messages",
+			func() {
+				msgs = nil
+			},
+			0,
+			nil,
+		},
+		{
+			"failure: contract has not been granted any capabilities",
+			func() {
+				capabilities = nil
+			},
+			0,
+			types.ErrWasmSubMessagesNotAllowed,
+		},
+		{
+			"failure: message is not allowed",
+			func() {
+				capabilities[0].AllowedMessages = []string{sdk.MsgTypeURL(&banktypes.MsgMultiSend{})}
+			},
+			0,
+			types.ErrWasmSubMessagesNotAllowed,
+		},
+		{
+			"failure: reply on success",
+			func() {
+				msgs[0].ReplyOn = wasmvmtypes.ReplySuccess
+			},
+			0,
+			types.ErrInvalidSubMessage,
+		},
+		{
+			"failure: message gas limit",
+			func() {
+				gasLimit := uint64(1000)
+				msgs[0].GasLimit = &gasLimit
+			},
+			0,
+			types.ErrInvalidSubMessage,
+		},
+		{
+			"failure: unsupported message",
+			func() {
+				msgs[0].Msg = wasmvmtypes.CosmosMsg{Bank: &wasmvmtypes.BankMsg{Burn: &wasmvmtypes.BurnMsg{}}}
+			},
+			0,
+			types.ErrInvalidSubMessage,
+		},
+		{
+			"failure: invalid stargate message",
+			func() {
+				msgs[0] = wasmvmtypes.SubMsg{Msg: wasmvmtypes.CosmosMsg{Stargate: &wasmvmtypes.StargateMsg{TypeURL: "/invalid.Msg"}}, ReplyOn: wasmvmtypes.ReplyNever}
+			},
+			0,
+			types.ErrInvalidSubMessage,
+		},
+		{
+			"failure: message signer is not the client address",
+			func() {
+				msgs = []wasmvmtypes.SubMsg{suite.stargateSubMsg(banktypes.NewMsgSend(suite.chainA.SenderAccount.GetAddress(), clientAddress, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))))}
+			},
+			0,
+			types.ErrInvalidSubMessage,
+		},
+		{
+			"failure: execution of a message fails, previous messages are reverted",
+			func() {
+				msgs = append(msgs, bankSendSubMsg(suite.chainA.SenderAccount.GetAddress().String(), clientBalance))
+			},
+			0,
+			types.ErrSubMessageFailed,
+		},
+		{
+			"failure: messages run out of gas",
+			func() {
+				capabilities[0].GasLimit = 1
+			},
+			0,
+			types.ErrSubMessageFailed,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupWasmWithMockVM()
+
+			checksum = storeWasmCode(suite, wasmtesting.Code)
+			capabilities = []types.ContractCapabilities{
+				types.NewContractCapabilities(checksum, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}, 1_000_000),
+			}
+			msgs = []wasmvmtypes.SubMsg{bankSendSubMsg(suite.chainA.SenderAccount.GetAddress().String(), 100)}
+
+			ctx := suite.chainA.GetContext()
+			simApp := GetSimApp(suite.chainA)
+			err := simApp.BankKeeper.SendCoins(ctx, suite.chainA.SenderAccount.GetAddress(), clientAddress, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, clientBalance)))
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			params := simApp.WasmClientKeeper.GetParams(ctx)
+			params.ContractCapabilities = capabilities
+			simApp.WasmClientKeeper.SetParams(ctx, params)
+
+			err = simApp.WasmClientKeeper.DispatchMessages(ctx, clientID, checksum, msgs)
+
+			balance := simApp.BankKeeper.GetBalance(ctx, clientAddress, sdk.DefaultBondDenom)
+			suite.Require().Equal(sdkmath.NewInt(clientBalance-tc.expBalance), balance.Amount)
+
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+
+				expEvent := sdk.NewEvent(
+					types.EventTypeDispatchContractMessage,
+					sdk.NewAttribute(types.AttributeKeyClientID, clientID),
+					sdk.NewAttribute(types.AttributeKeyWasmChecksum, hex.EncodeToString(checksum)),
+					sdk.NewAttribute(types.AttributeKeyMessageType, sdk.MsgTypeURL(&banktypes.MsgSend{})),
+				)
+
+				var dispatched int
+				for _, event := range ctx.EventManager().Events() {
+					if event.Type == types.EventTypeDispatchContractMessage {
+						suite.Require().Equal(expEvent, event)
+						dispatched++
+					}
+				}
+				suite.Require().Equal(len(msgs), dispatched)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestDispatchMessagesGasConsumption() {
+	const clientID = "08-wasm-0"
+
+	suite.SetupWasmWithMockVM()
+
+	checksum := storeWasmCode(suite, wasmtesting.Code)
+
+	ctx := suite.chainA.GetContext()
+	wasmClientKeeper := GetSimApp(suite.chainA).WasmClientKeeper
+
+	gasLimit := uint64(5000)
+	params := wasmClientKeeper.GetParams(ctx)
+	params.ContractCapabilities = []types.ContractCapabilities{
+		types.NewContractCapabilities(checksum, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}, gasLimit),
+	}
+	wasmClientKeeper.SetParams(ctx, params)
+
+	// the client address holds no funds, the message runs out of gas before its execution fails
+	gasConsumed := ctx.GasMeter().GasConsumed()
+	err := wasmClientKeeper.DispatchMessages(ctx, clientID, checksum, []wasmvmtypes.SubMsg{bankSendSubMsg(suite.chainA.SenderAccount.GetAddress().String(), 100)})
+	suite.Require().ErrorIs(err, types.ErrSubMessageFailed)
+	suite.Require().ErrorContains(err, "out of gas")
+
+	// the gas consumed by the messages is charged up to the gas limit of the capabilities
+	suite.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed(), gasConsumed+gasLimit)
+}
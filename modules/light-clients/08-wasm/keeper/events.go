@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"encoding/hex"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -37,3 +38,72 @@ func emitMigrateContractEvent(ctx sdk.Context, clientID string, checksum, newChe
 		),
 	})
 }
+
+// emitUpdateChecksumStatusEvent emits an update checksum status event
+func emitUpdateChecksumStatusEvent(ctx sdk.Context, checksum types.Checksum, status, newStatus types.ChecksumStatus) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUpdateChecksumStatus,
+			sdk.NewAttribute(types.AttributeKeyWasmChecksum, hex.EncodeToString(checksum)),
+			sdk.NewAttribute(types.AttributeKeyChecksumStatus, status.String()),
+			sdk.NewAttribute(types.AttributeKeyNewChecksumStatus, newStatus.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// emitMigrateAllClientsEvent emits a migrate all clients event
+func emitMigrateAllClientsEvent(ctx sdk.Context, checksum, newChecksum types.Checksum) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeMigrateAllClients,
+			sdk.NewAttribute(types.AttributeKeyWasmChecksum, hex.EncodeToString(checksum)),
+			sdk.NewAttribute(types.AttributeKeyNewChecksum, hex.EncodeToString(newChecksum)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// emitClientMigrationFailedEvent emits a client migration failed event
+func emitClientMigrationFailedEvent(ctx sdk.Context, clientID string, migration types.ClientMigration, err error) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeClientMigrationFailed,
+			sdk.NewAttribute(types.AttributeKeyClientID, clientID),
+			sdk.NewAttribute(types.AttributeKeyWasmChecksum, hex.EncodeToString(migration.FromChecksum)),
+			sdk.NewAttribute(types.AttributeKeyNewChecksum, hex.EncodeToString(migration.ToChecksum)),
+			sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+		),
+	)
+}
+
+// emitClientMigrationCompletedEvent emits a client migration completed event
+func emitClientMigrationCompletedEvent(ctx sdk.Context, migration types.ClientMigration) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeClientMigrationCompleted,
+			sdk.NewAttribute(types.AttributeKeyWasmChecksum, hex.EncodeToString(migration.FromChecksum)),
+			sdk.NewAttribute(types.AttributeKeyNewChecksum, hex.EncodeToString(migration.ToChecksum)),
+			sdk.NewAttribute(types.AttributeKeyMigratedClients, strconv.FormatUint(migration.Migrated, 10)),
+			sdk.NewAttribute(types.AttributeKeyFailedClients, strconv.FormatUint(migration.Failed, 10)),
+		),
+	)
+}
+
+// emitDispatchContractMessageEvent emits a dispatch contract message event
+func emitDispatchContractMessageEvent(ctx sdk.Context, clientID string, checksum types.Checksum, msg sdk.Msg) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDispatchContractMessage,
+			sdk.NewAttribute(types.AttributeKeyClientID, clientID),
+			sdk.NewAttribute(types.AttributeKeyWasmChecksum, hex.EncodeToString(checksum)),
+			sdk.NewAttribute(types.AttributeKeyMessageType, sdk.MsgTypeURL(msg)),
+		),
+	)
+}
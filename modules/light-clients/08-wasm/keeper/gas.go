@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/internal/ibcwasm"
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
+)
+
+var _ ibcwasm.GasManager = (*Keeper)(nil)
+
+// GetParams returns the 08-wasm params. The default params are returned if the params have not been set.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	params, err := k.params.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.DefaultParams()
+	}
+	if err != nil {
+		panic(err)
+	}
+
+	return params
+}
+
+// SetParams sets the 08-wasm params.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	if err := k.params.Set(ctx, params); err != nil {
+		panic(err)
+	}
+}
+
+// GetContractGasLimit implements ibcwasm.GasManager. It returns the gas limit of the contract with the given
+// checksum bounded by the gas remaining within the block gas limit.
+func (k Keeper) GetContractGasLimit(ctx sdk.Context, checksum []byte) (uint64, error) {
+	params := k.GetParams(ctx)
+	gasLimit := params.GetContractGasLimit(checksum)
+	if params.BlockGasLimit == 0 {
+		return gasLimit, nil
+	}
+
+	blockGasUsed := k.GetBlockGasUsage(ctx).GasUsed
+	if blockGasUsed >= params.BlockGasLimit {
+		return 0, errorsmod.Wrapf(types.ErrBlockGasLimitExceeded, "gas used (%d) has reached the block gas limit (%d)", blockGasUsed, params.BlockGasLimit)
+	}
+
+	if remaining := params.BlockGasLimit - blockGasUsed; gasLimit == 0 || remaining < gasLimit {
+		gasLimit = remaining
+	}
+
+	return gasLimit, nil
+}
+
+// ConsumeContractGas implements ibcwasm.GasManager. It adds the gas consumed by a contract call to the gas usage
+// of the current block, the total gas usage of the client and, if enabled, the gas usage history of the client.
+// Gas usage history older than the number of blocks set in the params is pruned.
+func (k Keeper) ConsumeContractGas(ctx sdk.Context, clientID string, gasUsed uint64) error {
+	height := uint64(ctx.BlockHeight())
+
+	if err := k.blockGasUsage.Set(ctx, k.GetBlockGasUsage(ctx).Add(height, gasUsed)); err != nil {
+		return err
+	}
+
+	total, err := k.GetClientGasUsage(ctx, clientID)
+	if err != nil {
+		return err
+	}
+
+	if err := k.clientGasUsage.Set(ctx, clientID, total.Add(height, gasUsed)); err != nil {
+		return err
+	}
+
+	historyBlocks := k.GetParams(ctx).GasUsageHistoryBlocks
+	if historyBlocks == 0 {
+		return nil
+	}
+
+	key := collections.Join(clientID, height)
+	usage, err := k.clientGasUsageHistory.Get(ctx, key)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	if err := k.clientGasUsageHistory.Set(ctx, key, usage.Add(height, gasUsed)); err != nil {
+		return err
+	}
+
+	if height < historyBlocks {
+		return nil
+	}
+
+	return k.clientGasUsageHistory.Clear(ctx, collections.NewPrefixedPairRange[string, uint64](clientID).EndExclusive(height-historyBlocks+1))
+}
+
+// GetBlockGasUsage returns the gas consumed by contract calls within the current block.
+func (k Keeper) GetBlockGasUsage(ctx sdk.Context) types.GasUsage {
+	usage, err := k.blockGasUsage.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		panic(err)
+	}
+
+	// gas usage recorded in previous blocks does not count towards the current block
+	if usage.Height != uint64(ctx.BlockHeight()) {
+		return types.GasUsage{}
+	}
+
+	return usage
+}
+
+// GetClientGasUsage returns the total gas consumed by contract calls on behalf of the given client.
+func (k Keeper) GetClientGasUsage(ctx sdk.Context, clientID string) (types.GasUsage, error) {
+	usage, err := k.clientGasUsage.Get(ctx, clientID)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return types.GasUsage{}, err
+	}
+
+	return usage, nil
+}
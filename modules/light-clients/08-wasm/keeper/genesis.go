@@ -11,33 +11,56 @@ import (
 // state.
 func (k Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) error {
 	for _, contract := range gs.Contracts {
-		_, err := k.storeWasmCode(ctx, contract.CodeBytes, ibcwasm.GetVM().StoreCodeUnchecked)
+		// contracts exported prior to the introduction of checksum lifecycle states are active
+		status := contract.Status
+		if status == types.ChecksumStatusUnspecified {
+			status = types.ChecksumStatusActive
+		}
+
+		_, err := k.storeWasmCode(ctx, contract.CodeBytes, status, ibcwasm.GetVM().StoreCodeUnchecked)
 		if err != nil {
 			return err
 		}
 	}
+
+	for _, migration := range gs.ClientMigrations {
+		if err := k.clientMigrations.Set(ctx, migration.FromChecksum, migration); err != nil {
+			return err
+		}
+	}
+
+	k.SetParams(ctx, gs.Params)
 	return nil
 }
 
 // ExportGenesis returns the 08-wasm module's exported genesis. This includes the code
-// for all contracts previously stored.
-func (Keeper) ExportGenesis(ctx sdk.Context) types.GenesisState {
+// and lifecycle state of all contracts previously stored, the pending client migrations
+// and the 08-wasm params.
+func (k Keeper) ExportGenesis(ctx sdk.Context) types.GenesisState {
 	checksums, err := types.GetAllChecksums(ctx)
 	if err != nil {
 		panic(err)
 	}
 
 	// Grab code from wasmVM and add to genesis state.
-	var genesisState types.GenesisState
+	genesisState := types.GenesisState{Params: k.GetParams(ctx)}
 	for _, checksum := range checksums {
 		code, err := ibcwasm.GetVM().GetCode(checksum)
 		if err != nil {
 			panic(err)
 		}
+		status, err := types.GetChecksumStatus(ctx, checksum)
+		if err != nil {
+			panic(err)
+		}
+
 		genesisState.Contracts = append(genesisState.Contracts, types.Contract{
 			CodeBytes: code,
+			Status:    status,
 		})
 	}
 
+	genesisState.ClientMigrations = k.GetAllClientMigrations(ctx)
+
 	return genesisState
 }
@@ -14,6 +14,7 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	var (
 		genesisState types.GenesisState
 		expChecksums []string
+		expStatus    types.ChecksumStatus
 	)
 
 	testCases := []struct {
@@ -31,6 +32,7 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 							CodeBytes: wasmtesting.Code,
 						},
 					},
+					types.DefaultParams(),
 				)
 
 				expChecksums = []string{checksum}
@@ -39,7 +41,36 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 		{
 			"success with empty genesis contract",
 			func() {
-				genesisState = *types.NewGenesisState([]types.Contract{})
+				genesisState = *types.NewGenesisState([]types.Contract{}, types.DefaultParams())
+				expChecksums = []string{}
+			},
+		},
+		{
+			"success with staged contract and pending client migration",
+			func() {
+				checksum := "b3a49b2914f5e6a673215e74325c1d153bb6776e079774e52c5b7e674d9ad3ab" //nolint:gosec // these are not hard-coded credentials
+
+				genesisState = *types.NewGenesisState(
+					[]types.Contract{
+						{
+							CodeBytes: wasmtesting.Code,
+							Status:    types.ChecksumStatusStaging,
+						},
+					},
+					types.DefaultParams(),
+				)
+				genesisState.ClientMigrations = []types.ClientMigration{
+					types.NewClientMigration(make([]byte, 32), wasmtesting.Code[:32], []byte("{}")),
+				}
+
+				expChecksums = []string{checksum}
+				expStatus = types.ChecksumStatusStaging
+			},
+		},
+		{
+			"success with non-default params",
+			func() {
+				genesisState = *types.NewGenesisState([]types.Contract{}, types.NewParams(1_000_000, nil, 10_000_000, 0, 10, 10))
 				expChecksums = []string{}
 			},
 		},
@@ -50,6 +81,7 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 			suite.SetupWasmWithMockVM()
 
 			ctx := suite.chainA.GetContext()
+			expStatus = types.ChecksumStatusActive
 			tc.malleate()
 
 			err := GetSimApp(suite.chainA).WasmClientKeeper.InitGenesis(ctx, genesisState)
@@ -61,10 +93,21 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 
 			for _, hash := range checksums {
 				storedHashes = append(storedHashes, hex.EncodeToString(hash))
+
+				status, err := types.GetChecksumStatus(suite.chainA.GetContext(), hash)
+				suite.Require().NoError(err)
+				suite.Require().Equal(expStatus, status)
 			}
 
 			suite.Require().Equal(len(expChecksums), len(storedHashes))
 			suite.Require().ElementsMatch(expChecksums, storedHashes)
+			suite.Require().Equal(genesisState.Params, GetSimApp(suite.chainA).WasmClientKeeper.GetParams(suite.chainA.GetContext()))
+
+			for _, migration := range genesisState.ClientMigrations {
+				storedMigration, found := GetSimApp(suite.chainA).WasmClientKeeper.GetClientMigration(suite.chainA.GetContext(), migration.FromChecksum)
+				suite.Require().True(found)
+				suite.Require().Equal(migration, storedMigration)
+			}
 		})
 	}
 }
@@ -83,7 +126,19 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 	suite.Require().NoError(err)
 	suite.Require().Equal(expChecksum, hex.EncodeToString(res.Checksum))
 
+	params := types.NewParams(1_000_000, nil, 10_000_000, 0, 10, 10)
+	GetSimApp(suite.chainA).WasmClientKeeper.SetParams(ctx, params)
+
+	err = types.SetChecksumStatus(ctx, res.Checksum, types.ChecksumStatusDeprecated)
+	suite.Require().NoError(err)
+
+	migration := types.NewClientMigration(res.Checksum, make([]byte, 32), []byte("{}"))
+	GetSimApp(suite.chainA).WasmClientKeeper.SetClientMigration(ctx, migration)
+
 	genesisState := GetSimApp(suite.chainA).WasmClientKeeper.ExportGenesis(ctx)
 	suite.Require().Len(genesisState.Contracts, 1)
 	suite.Require().NotEmpty(genesisState.Contracts[0].CodeBytes)
+	suite.Require().Equal(types.ChecksumStatusDeprecated, genesisState.Contracts[0].Status)
+	suite.Require().Equal([]types.ClientMigration{migration}, genesisState.ClientMigrations)
+	suite.Require().Equal(params, genesisState.Params)
 }
@@ -63,3 +63,127 @@ func (Keeper) Checksums(goCtx context.Context, req *types.QueryChecksumsRequest)
 		Pagination: pageRes,
 	}, nil
 }
+
+// Params implements the Query/Params gRPC method
+func (k Keeper) Params(goCtx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{
+		Params: &params,
+	}, nil
+}
+
+// ClientGasUsage implements the Query/ClientGasUsage gRPC method. It returns the total gas consumed by the
+// light client contract of a client along with the gas consumed in each of the retained blocks.
+func (k Keeper) ClientGasUsage(goCtx context.Context, req *types.QueryClientGasUsageRequest) (*types.QueryClientGasUsageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := types.ValidateClientID(req.ClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	total, err := k.GetClientGasUsage(ctx, req.ClientId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	history, pageRes, err := sdkquery.CollectionPaginate(
+		goCtx,
+		k.clientGasUsageHistory,
+		req.Pagination,
+		func(_ collections.Pair[string, uint64], usage types.GasUsage) (types.GasUsage, error) {
+			return usage, nil
+		},
+		sdkquery.WithCollectionPaginationPairPrefix[string, uint64](req.ClientId),
+	)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryClientGasUsageResponse{
+		Total:      total,
+		History:    history,
+		Pagination: pageRes,
+	}, nil
+}
+
+// ChecksumStatus implements the Query/ChecksumStatus gRPC method
+func (Keeper) ChecksumStatus(goCtx context.Context, req *types.QueryChecksumStatusRequest) (*types.QueryChecksumStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	checksum, err := hex.DecodeString(req.Checksum)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid checksum")
+	}
+
+	checksumStatus, err := types.GetChecksumStatus(goCtx, checksum)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if checksumStatus == types.ChecksumStatusUnspecified {
+		return nil, status.Error(codes.NotFound, errorsmod.Wrap(types.ErrWasmChecksumNotFound, req.Checksum).Error())
+	}
+
+	return &types.QueryChecksumStatusResponse{
+		Status: checksumStatus,
+	}, nil
+}
+
+// ChecksumClients implements the Query/ChecksumClients gRPC method. It returns the identifiers of the clients
+// using the given checksum in ascending order.
+func (k Keeper) ChecksumClients(goCtx context.Context, req *types.QueryChecksumClientsRequest) (*types.QueryChecksumClientsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	checksum, err := hex.DecodeString(req.Checksum)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid checksum")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !types.HasChecksum(ctx, checksum) {
+		return nil, status.Error(codes.NotFound, errorsmod.Wrap(types.ErrWasmChecksumNotFound, req.Checksum).Error())
+	}
+
+	clientIDs, pageRes, err := k.paginateChecksumClients(ctx, checksum, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryChecksumClientsResponse{
+		ClientIds:  clientIDs,
+		Pagination: pageRes,
+	}, nil
+}
+
+// ClientMigrations implements the Query/ClientMigrations gRPC method
+func (k Keeper) ClientMigrations(goCtx context.Context, req *types.QueryClientMigrationsRequest) (*types.QueryClientMigrationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	migrations, pageRes, err := sdkquery.CollectionPaginate(
+		goCtx,
+		k.clientMigrations,
+		req.Pagination,
+		func(_ []byte, migration types.ClientMigration) (types.ClientMigration, error) {
+			return migration, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryClientMigrationsResponse{
+		ClientMigrations: migrations,
+		Pagination:       pageRes,
+	}, nil
+}
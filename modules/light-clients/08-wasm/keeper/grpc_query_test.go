@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"encoding/hex"
 
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
@@ -116,3 +117,310 @@ func (suite *KeeperTestSuite) TestQueryChecksums() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryParams() {
+	suite.SetupWasmWithMockVM()
+
+	ctx := suite.chainA.GetContext()
+	res, err := GetSimApp(suite.chainA).WasmClientKeeper.Params(ctx, &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DefaultParams(), *res.Params)
+
+	params := types.NewParams(1000, nil, 10_000, 100, 10, 10)
+	GetSimApp(suite.chainA).WasmClientKeeper.SetParams(ctx, params)
+
+	res, err = GetSimApp(suite.chainA).WasmClientKeeper.Params(ctx, &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, *res.Params)
+}
+
+func (suite *KeeperTestSuite) TestQueryClientGasUsage() {
+	const clientID = "08-wasm-0"
+
+	var (
+		req        *types.QueryClientGasUsageRequest
+		expTotal   types.GasUsage
+		expHistory []types.GasUsage
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: no gas consumed",
+			func() {},
+			true,
+		},
+		{
+			"success: gas consumed in multiple blocks",
+			func() {
+				consumeContractGas(suite, clientID, 10, 100, 200)
+				consumeContractGas(suite, clientID, 11, 300)
+
+				expTotal = types.GasUsage{Height: 11, GasUsed: 600, Calls: 3}
+				expHistory = []types.GasUsage{{Height: 10, GasUsed: 300, Calls: 2}, {Height: 11, GasUsed: 300, Calls: 1}}
+			},
+			true,
+		},
+		{
+			"success: gas usage history is pruned",
+			func() {
+				params := types.DefaultParams()
+				params.GasUsageHistoryBlocks = 2
+				GetSimApp(suite.chainA).WasmClientKeeper.SetParams(suite.chainA.GetContext(), params)
+
+				consumeContractGas(suite, clientID, 10, 100)
+				consumeContractGas(suite, clientID, 11, 200)
+				consumeContractGas(suite, clientID, 12, 300)
+
+				expTotal = types.GasUsage{Height: 12, GasUsed: 600, Calls: 3}
+				expHistory = []types.GasUsage{{Height: 11, GasUsed: 200, Calls: 1}, {Height: 12, GasUsed: 300, Calls: 1}}
+			},
+			true,
+		},
+		{
+			"success: gas usage history is disabled",
+			func() {
+				params := types.DefaultParams()
+				params.GasUsageHistoryBlocks = 0
+				GetSimApp(suite.chainA).WasmClientKeeper.SetParams(suite.chainA.GetContext(), params)
+
+				consumeContractGas(suite, clientID, 10, 100)
+
+				expTotal = types.GasUsage{Height: 10, GasUsed: 100, Calls: 1}
+			},
+			true,
+		},
+		{
+			"success: gas usage of other clients is not returned",
+			func() {
+				consumeContractGas(suite, "08-wasm-1", 10, 100)
+			},
+			true,
+		},
+		{
+			"failure: invalid client identifier",
+			func() {
+				req.ClientId = "07-tendermint-0"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupWasmWithMockVM()
+
+			req = &types.QueryClientGasUsageRequest{ClientId: clientID}
+			expTotal = types.GasUsage{}
+			expHistory = nil
+
+			tc.malleate()
+
+			res, err := GetSimApp(suite.chainA).WasmClientKeeper.ClientGasUsage(suite.chainA.GetContext(), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expTotal, res.Total)
+				suite.Require().Equal(expHistory, res.History)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// consumeContractGas records the gas consumed by contract calls of the given client at the provided height.
+func consumeContractGas(suite *KeeperTestSuite, clientID string, height int64, gasUsed ...uint64) {
+	ctx := suite.chainA.GetContext().WithBlockHeight(height)
+	for _, gas := range gasUsed {
+		err := GetSimApp(suite.chainA).WasmClientKeeper.ConsumeContractGas(ctx, clientID, gas)
+		suite.Require().NoError(err)
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryChecksumStatus() {
+	var (
+		req       *types.QueryChecksumStatusRequest
+		expStatus types.ChecksumStatus
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: active",
+			func() {},
+			true,
+		},
+		{
+			"success: deprecated",
+			func() {
+				checksum, err := hex.DecodeString(req.Checksum)
+				suite.Require().NoError(err)
+
+				err = types.SetChecksumStatus(suite.chainA.GetContext(), checksum, types.ChecksumStatusDeprecated)
+				suite.Require().NoError(err)
+
+				expStatus = types.ChecksumStatusDeprecated
+			},
+			true,
+		},
+		{
+			"fails with invalid checksum",
+			func() {
+				req = &types.QueryChecksumStatusRequest{Checksum: "test"}
+			},
+			false,
+		},
+		{
+			"fails with non-existent checksum",
+			func() {
+				req = &types.QueryChecksumStatusRequest{Checksum: hex.EncodeToString(make([]byte, 32))}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupWasmWithMockVM()
+
+			checksum := storeWasmCode(suite, wasmtesting.Code)
+			req = &types.QueryChecksumStatusRequest{Checksum: hex.EncodeToString(checksum)}
+			expStatus = types.ChecksumStatusActive
+
+			tc.malleate()
+
+			res, err := GetSimApp(suite.chainA).WasmClientKeeper.ChecksumStatus(suite.chainA.GetContext(), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expStatus, res.Status)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryChecksumClients() {
+	var (
+		req          *types.QueryChecksumClientsRequest
+		expClientIDs []string
+		expNextKey   []byte
+		expTotal     uint64
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {
+				expClientIDs = []string{"08-wasm-0", "08-wasm-1", "08-wasm-2"}
+			},
+			true,
+		},
+		{
+			"success: with limit and count total",
+			func() {
+				req.Pagination = &query.PageRequest{Limit: 2, CountTotal: true}
+
+				expClientIDs = []string{"08-wasm-0", "08-wasm-1"}
+				expNextKey = []byte("08-wasm-2")
+				expTotal = 3
+			},
+			true,
+		},
+		{
+			"success: with key",
+			func() {
+				req.Pagination = &query.PageRequest{Key: []byte("08-wasm-1"), Limit: 1}
+
+				expClientIDs = []string{"08-wasm-1"}
+				expNextKey = []byte("08-wasm-2")
+			},
+			true,
+		},
+		{
+			"success: with offset",
+			func() {
+				req.Pagination = &query.PageRequest{Offset: 2}
+
+				expClientIDs = []string{"08-wasm-2"}
+			},
+			true,
+		},
+		{
+			"success: no clients using checksum",
+			func() {
+				checksum := storeWasmCode(suite, wasmtesting.CreateMockContract([]byte("MockByteCode-TestQueryChecksumClients")))
+				req = &types.QueryChecksumClientsRequest{Checksum: hex.EncodeToString(checksum)}
+
+				expClientIDs = nil
+			},
+			true,
+		},
+		{
+			"fails with key and offset",
+			func() {
+				req.Pagination = &query.PageRequest{Key: []byte("08-wasm-1"), Offset: 1}
+			},
+			false,
+		},
+		{
+			"fails with non-existent checksum",
+			func() {
+				req = &types.QueryChecksumClientsRequest{Checksum: hex.EncodeToString(make([]byte, 32))}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupWasmWithMockVM()
+
+			checksum := storeWasmCode(suite, wasmtesting.Code)
+			suite.createWasmClients(3)
+
+			req = &types.QueryChecksumClientsRequest{Checksum: hex.EncodeToString(checksum)}
+			expNextKey = nil
+			expTotal = 0
+
+			tc.malleate()
+
+			res, err := GetSimApp(suite.chainA).WasmClientKeeper.ChecksumClients(suite.chainA.GetContext(), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expClientIDs, res.ClientIds)
+				suite.Require().Equal(expNextKey, res.Pagination.NextKey)
+				suite.Require().Equal(expTotal, res.Pagination.Total)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryClientMigrations() {
+	suite.SetupWasmWithMockVM()
+
+	fromChecksum := storeWasmCode(suite, wasmtesting.Code)
+	toChecksum := storeWasmCode(suite, wasmtesting.CreateMockContract([]byte("MockByteCode-TestQueryClientMigrations")))
+
+	migration := types.NewClientMigration(fromChecksum, toChecksum, []byte("{}"))
+	GetSimApp(suite.chainA).WasmClientKeeper.SetClientMigration(suite.chainA.GetContext(), migration)
+
+	res, err := GetSimApp(suite.chainA).WasmClientKeeper.ClientMigrations(suite.chainA.GetContext(), &types.QueryClientMigrationsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.ClientMigration{migration}, res.ClientMigrations)
+}
@@ -9,6 +9,7 @@ import (
 
 	wasmvm "github.com/CosmWasm/wasmvm"
 
+	"cosmossdk.io/collections"
 	storetypes "cosmossdk.io/core/store"
 	errorsmod "cosmossdk.io/errors"
 
@@ -31,6 +32,25 @@ type Keeper struct {
 	clientKeeper types.ClientKeeper
 
 	authority string
+
+	params                collections.Item[types.Params]
+	blockGasUsage         collections.Item[types.GasUsage]
+	clientGasUsage        collections.Map[string, types.GasUsage]
+	clientGasUsageHistory collections.Map[collections.Pair[string, uint64], types.GasUsage]
+	clientMigrations      collections.Map[[]byte, types.ClientMigration]
+
+	// msgRouter routes the messages dispatched by light client contracts, see WithMessageRouter
+	msgRouter ibcwasm.MessageRouter
+
+	// ownedVM is set when the wasm VM is instantiated by the keeper
+	ownedVM *ownedVM
+}
+
+// ownedVM holds a wasm VM instantiated by the keeper along with the configuration it was instantiated with.
+type ownedVM struct {
+	vm              *wasmvm.VM
+	config          types.WasmConfig
+	memoryCacheSize uint32
 }
 
 // NewKeeperWithVM creates a new Keeper instance with the provided Wasm VM.
@@ -61,11 +81,21 @@ func NewKeeperWithVM(
 		panic(errors.New("authority must be non-empty"))
 	}
 
+	sb := collections.NewSchemaBuilder(storeService)
 	keeper := &Keeper{
-		cdc:          cdc,
-		storeService: storeService,
-		clientKeeper: clientKeeper,
-		authority:    authority,
+		cdc:                   cdc,
+		storeService:          storeService,
+		clientKeeper:          clientKeeper,
+		authority:             authority,
+		params:                collections.NewItem(sb, ibcwasm.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		blockGasUsage:         collections.NewItem(sb, ibcwasm.BlockGasUsageKey, "block_gas_usage", codec.CollValue[types.GasUsage](cdc)),
+		clientGasUsage:        collections.NewMap(sb, ibcwasm.ClientGasUsageKey, "client_gas_usage", collections.StringKey, codec.CollValue[types.GasUsage](cdc)),
+		clientGasUsageHistory: collections.NewMap(sb, ibcwasm.ClientGasUsageHistoryKey, "client_gas_usage_history", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), codec.CollValue[types.GasUsage](cdc)),
+		clientMigrations:      collections.NewMap(sb, ibcwasm.ClientMigrationsKey, "client_migrations", collections.BytesKey, codec.CollValue[types.ClientMigration](cdc)),
+	}
+
+	if _, err := sb.Build(); err != nil {
+		panic(err)
 	}
 
 	// set query plugins to ensure there is a non-nil query plugin
@@ -77,6 +107,8 @@ func NewKeeperWithVM(
 
 	ibcwasm.SetVM(vm)
 	ibcwasm.SetQueryRouter(queryRouter)
+	ibcwasm.SetGasManager(keeper)
+	ibcwasm.SetMessageDispatcher(keeper)
 	ibcwasm.SetupWasmStoreService(storeService)
 
 	return *keeper
@@ -94,12 +126,20 @@ func NewKeeperWithConfig(
 	queryRouter ibcwasm.QueryRouter,
 	opts ...Option,
 ) Keeper {
-	vm, err := wasmvm.NewVM(wasmConfig.DataDir, wasmConfig.SupportedCapabilities, types.ContractMemoryLimit, wasmConfig.ContractDebugMode, types.MemoryCacheSize)
+	vm, err := newVM(wasmConfig, types.MemoryCacheSize)
 	if err != nil {
 		panic(fmt.Errorf("failed to instantiate new Wasm VM instance: %v", err))
 	}
 
-	return NewKeeperWithVM(cdc, storeService, clientKeeper, authority, vm, queryRouter, opts...)
+	keeper := NewKeeperWithVM(cdc, storeService, clientKeeper, authority, vm, queryRouter, opts...)
+	keeper.ownedVM = &ownedVM{vm: vm, config: wasmConfig, memoryCacheSize: types.MemoryCacheSize}
+
+	return keeper
+}
+
+// newVM instantiates a new wasm VM using the provided configuration and memory cache size in MiB.
+func newVM(wasmConfig types.WasmConfig, memoryCacheSize uint32) (*wasmvm.VM, error) {
+	return wasmvm.NewVM(wasmConfig.DataDir, wasmConfig.SupportedCapabilities, types.ContractMemoryLimit, wasmConfig.ContractDebugMode, memoryCacheSize)
 }
 
 // GetAuthority returns the 08-wasm module's authority.
@@ -107,7 +147,7 @@ func (k Keeper) GetAuthority() string {
 	return k.authority
 }
 
-func (Keeper) storeWasmCode(ctx sdk.Context, code []byte, storeFn func(code wasmvm.WasmCode) (wasmvm.Checksum, error)) ([]byte, error) {
+func (Keeper) storeWasmCode(ctx sdk.Context, code []byte, status types.ChecksumStatus, storeFn func(code wasmvm.WasmCode) (wasmvm.Checksum, error)) ([]byte, error) {
 	var err error
 	if types.IsGzip(code) {
 		ctx.GasMeter().ConsumeGas(types.VMGasRegister.UncompressCosts(len(code)), "Uncompress gzip bytecode")
@@ -155,6 +195,10 @@ func (Keeper) storeWasmCode(ctx sdk.Context, code []byte, storeFn func(code wasm
 		return nil, errorsmod.Wrap(err, "failed to store checksum")
 	}
 
+	if err := types.SetChecksumStatus(ctx, checksum, status); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to store checksum status")
+	}
+
 	return checksum, nil
 }
 
@@ -205,6 +249,27 @@ func (k Keeper) GetWasmClientState(ctx sdk.Context, clientID string) (*types.Cli
 	return wasmClientState, nil
 }
 
+// InitializeVM initializes the wasm VM according to the 08-wasm params and pins all stored contracts to the
+// in-memory cache of the VM. When the wasm VM was instantiated by the keeper using NewKeeperWithConfig, it is
+// re-instantiated if the memory cache size set in the params differs from the size the VM was instantiated with.
+// Changes to the memory cache size therefore take effect when a node is restarted. InitializeVM should be called
+// once the latest state of the application has been loaded, in place of InitializePinnedCodes.
+func (k Keeper) InitializeVM(ctx sdk.Context) error {
+	if memoryCacheSize := k.GetParams(ctx).MemoryCacheSize; k.ownedVM != nil && k.ownedVM.memoryCacheSize != memoryCacheSize {
+		vm, err := newVM(k.ownedVM.config, memoryCacheSize)
+		if err != nil {
+			return errorsmod.Wrapf(err, "failed to instantiate Wasm VM instance with memory cache size %d MiB", memoryCacheSize)
+		}
+
+		k.ownedVM.vm.Cleanup()
+		k.ownedVM.vm = vm
+		k.ownedVM.memoryCacheSize = memoryCacheSize
+		ibcwasm.SetVM(vm)
+	}
+
+	return InitializePinnedCodes(ctx)
+}
+
 // InitializePinnedCodes updates wasmvm to pin to cache all contracts marked as pinned
 func InitializePinnedCodes(ctx sdk.Context) error {
 	checksums, err := types.GetAllChecksums(ctx)
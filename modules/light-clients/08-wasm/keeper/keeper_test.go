@@ -324,3 +324,29 @@ func (suite *KeeperTestSuite) TestInitializedPinnedCodes() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestInitializeVM() {
+	suite.SetupWasmWithMockVM()
+
+	var capturedChecksums []wasmvm.Checksum
+	suite.mockVM.PinFn = func(checksum wasmvm.Checksum) error {
+		capturedChecksums = append(capturedChecksums, checksum)
+		return nil
+	}
+
+	ctx := suite.chainA.GetContext()
+	wasmClientKeeper := GetSimApp(suite.chainA).WasmClientKeeper
+
+	checksum := storeWasmCode(suite, wasmtesting.Code)
+	capturedChecksums = nil
+
+	// the memory cache size is only applied to wasm VMs instantiated by the keeper
+	params := types.DefaultParams()
+	params.MemoryCacheSize = 100
+	wasmClientKeeper.SetParams(ctx, params)
+
+	err := wasmClientKeeper.InitializeVM(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.mockVM, ibcwasm.GetVM())
+	suite.Require().ElementsMatch([]wasmvm.Checksum{checksum}, capturedChecksums)
+}
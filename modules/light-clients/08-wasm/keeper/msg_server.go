@@ -21,8 +21,13 @@ func (k Keeper) StoreCode(goCtx context.Context, msg *types.MsgStoreCode) (*type
 		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
 	}
 
+	status := types.ChecksumStatusActive
+	if msg.Staged {
+		status = types.ChecksumStatusStaging
+	}
+
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	checksum, err := k.storeWasmCode(ctx, msg.WasmByteCode, ibcwasm.GetVM().StoreCode)
+	checksum, err := k.storeWasmCode(ctx, msg.WasmByteCode, status, ibcwasm.GetVM().StoreCode)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to store wasm bytecode")
 	}
@@ -44,11 +49,20 @@ func (k Keeper) RemoveChecksum(goCtx context.Context, msg *types.MsgRemoveChecks
 		return nil, types.ErrWasmChecksumNotFound
 	}
 
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if k.isClientMigrationTarget(ctx, msg.Checksum) {
+		return nil, errorsmod.Wrapf(types.ErrClientMigrationExists, "checksum (%s) is the target of a pending client migration", hex.EncodeToString(msg.Checksum))
+	}
+
 	err := ibcwasm.Checksums.Remove(goCtx, msg.Checksum)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to remove checksum")
 	}
 
+	if err := ibcwasm.ChecksumStatuses.Remove(goCtx, msg.Checksum); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to remove checksum status")
+	}
+
 	// unpin the code from the vm in-memory cache
 	if err := ibcwasm.GetVM().Unpin(msg.Checksum); err != nil {
 		return nil, errorsmod.Wrapf(err, "failed to unpin contract with checksum (%s) from vm cache", hex.EncodeToString(msg.Checksum))
@@ -74,3 +88,76 @@ func (k Keeper) MigrateContract(goCtx context.Context, msg *types.MsgMigrateCont
 
 	return &types.MsgMigrateContractResponse{}, nil
 }
+
+// UpdateParams defines a rpc handler method for MsgUpdateParams
+func (k Keeper) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SetParams(ctx, msg.Params)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// UpdateChecksumStatus defines a rpc handler method for MsgUpdateChecksumStatus
+func (k Keeper) UpdateChecksumStatus(goCtx context.Context, msg *types.MsgUpdateChecksumStatus) (*types.MsgUpdateChecksumStatusResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	status, err := types.GetChecksumStatus(goCtx, msg.Checksum)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to retrieve checksum status")
+	}
+
+	if status == types.ChecksumStatusUnspecified {
+		return nil, types.ErrWasmChecksumNotFound
+	}
+
+	if err := status.ValidateTransition(msg.Status); err != nil {
+		return nil, err
+	}
+
+	if err := types.SetChecksumStatus(goCtx, msg.Checksum, msg.Status); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to store checksum status")
+	}
+
+	emitUpdateChecksumStatusEvent(sdk.UnwrapSDKContext(goCtx), msg.Checksum, status, msg.Status)
+
+	return &types.MsgUpdateChecksumStatusResponse{}, nil
+}
+
+// MigrateAllClients defines a rpc handler method for MsgMigrateAllClients. The clients using the
+// checksum are migrated in batches at the end of each block, see MigrateClients.
+func (k Keeper) MigrateAllClients(goCtx context.Context, msg *types.MsgMigrateAllClients) (*types.MsgMigrateAllClientsResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	if !types.HasChecksum(goCtx, msg.FromChecksum) {
+		return nil, errorsmod.Wrapf(types.ErrWasmChecksumNotFound, "checksum (%s)", hex.EncodeToString(msg.FromChecksum))
+	}
+
+	status, err := types.GetChecksumStatus(goCtx, msg.ToChecksum)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to retrieve checksum status")
+	}
+
+	if status != types.ChecksumStatusActive {
+		return nil, errorsmod.Wrapf(types.ErrChecksumNotActive, "checksum (%s) has status %s", hex.EncodeToString(msg.ToChecksum), status)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetClientMigration(ctx, msg.FromChecksum); found {
+		return nil, errorsmod.Wrapf(types.ErrClientMigrationExists, "clients using checksum (%s) are already being migrated", hex.EncodeToString(msg.FromChecksum))
+	}
+
+	migration := types.NewClientMigration(msg.FromChecksum, msg.ToChecksum, msg.Msg)
+	k.SetClientMigration(ctx, migration)
+
+	emitMigrateAllClientsEvent(ctx, msg.FromChecksum, msg.ToChecksum)
+
+	return &types.MsgMigrateAllClientsResponse{}, nil
+}
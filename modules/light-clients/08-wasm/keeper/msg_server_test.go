@@ -24,9 +24,10 @@ import (
 
 func (suite *KeeperTestSuite) TestMsgStoreCode() {
 	var (
-		msg    *types.MsgStoreCode
-		signer string
-		data   []byte
+		msg       *types.MsgStoreCode
+		signer    string
+		data      []byte
+		expStatus types.ChecksumStatus
 	)
 
 	testCases := []struct {
@@ -41,6 +42,16 @@ func (suite *KeeperTestSuite) TestMsgStoreCode() {
 			},
 			nil,
 		},
+		{
+			"success: staged",
+			func() {
+				msg = types.NewMsgStoreCode(signer, data)
+				msg.Staged = true
+
+				expStatus = types.ChecksumStatusStaging
+			},
+			nil,
+		},
 		{
 			"fails with duplicate wasm code",
 			func() {
@@ -99,6 +110,7 @@ func (suite *KeeperTestSuite) TestMsgStoreCode() {
 
 			signer = authtypes.NewModuleAddress(govtypes.ModuleName).String()
 			data = wasmtesting.Code
+			expStatus = types.ChecksumStatusActive
 
 			tc.malleate()
 
@@ -111,6 +123,10 @@ func (suite *KeeperTestSuite) TestMsgStoreCode() {
 				suite.Require().NotNil(res)
 				suite.Require().NotEmpty(res.Checksum)
 
+				status, err := types.GetChecksumStatus(ctx, res.Checksum)
+				suite.Require().NoError(err)
+				suite.Require().Equal(expStatus, status)
+
 				// Verify events
 				expectedEvents := sdk.Events{
 					sdk.NewEvent(
@@ -198,6 +214,26 @@ func (suite *KeeperTestSuite) TestMsgMigrateContract() {
 			},
 			types.ErrWasmCodeExists,
 		},
+		{
+			"failure: checksum is deprecated",
+			func() {
+				msg = types.NewMsgMigrateContract(govAcc, defaultWasmClientID, newChecksum, []byte("{}"))
+
+				err := types.SetChecksumStatus(suite.chainA.GetContext(), newChecksum, types.ChecksumStatusDeprecated)
+				suite.Require().NoError(err)
+			},
+			types.ErrChecksumNotActive,
+		},
+		{
+			"failure: checksum is staged",
+			func() {
+				msg = types.NewMsgMigrateContract(govAcc, defaultWasmClientID, newChecksum, []byte("{}"))
+
+				err := types.SetChecksumStatus(suite.chainA.GetContext(), newChecksum, types.ChecksumStatusStaging)
+				suite.Require().NoError(err)
+			},
+			types.ErrChecksumNotActive,
+		},
 		{
 			"failure: unauthorized signer",
 			func() {
@@ -367,6 +403,16 @@ func (suite *KeeperTestSuite) TestMsgRemoveChecksum() {
 			},
 			ibcerrors.ErrUnauthorized,
 		},
+		{
+			"failure: checksum is the target of a pending client migration",
+			func() {
+				msg = types.NewMsgRemoveChecksum(govAcc, checksum)
+
+				fromChecksum := storeWasmCode(suite, wasmtesting.CreateMockContract([]byte("MockByteCode-TestMsgRemoveChecksum")))
+				GetSimApp(suite.chainA).WasmClientKeeper.SetClientMigration(suite.chainA.GetContext(), types.NewClientMigration(fromChecksum, checksum, []byte("{}")))
+			},
+			types.ErrClientMigrationExists,
+		},
 		{
 			"failure: code has could not be unpinned",
 			func() {
@@ -406,6 +452,14 @@ func (suite *KeeperTestSuite) TestMsgRemoveChecksum() {
 				// Check equality of checksums up to order
 				suite.Require().ElementsMatch(expChecksums, checksums)
 
+				status, err := types.GetChecksumStatus(suite.chainA.GetContext(), msg.Checksum)
+				suite.Require().NoError(err)
+				suite.Require().Equal(types.ChecksumStatusUnspecified, status)
+
+				found, err := ibcwasm.ChecksumStatuses.Has(suite.chainA.GetContext(), msg.Checksum)
+				suite.Require().NoError(err)
+				suite.Require().False(found)
+
 				// Verify events
 				suite.Require().Len(events, 0)
 			} else {
@@ -415,3 +469,263 @@ func (suite *KeeperTestSuite) TestMsgRemoveChecksum() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMsgUpdateParams() {
+	govAcc := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	checksum, err := types.CreateChecksum(wasmtesting.Code)
+	suite.Require().NoError(err)
+
+	var msg *types.MsgUpdateParams
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {
+				msg = types.NewMsgUpdateParams(govAcc, types.NewParams(1000, []types.ContractGasLimit{types.NewContractGasLimit(checksum, 500)}, 10_000, 100, 10, 10))
+			},
+			nil,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				msg = types.NewMsgUpdateParams(suite.chainA.SenderAccount.GetAddress().String(), types.DefaultParams())
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupWasmWithMockVM()
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := GetSimApp(suite.chainA).WasmClientKeeper.UpdateParams(ctx, msg)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(msg.Params, GetSimApp(suite.chainA).WasmClientKeeper.GetParams(ctx))
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgUpdateChecksumStatus() {
+	govAcc := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	var (
+		checksum []byte
+		msg      *types.MsgUpdateChecksumStatus
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: active to deprecated",
+			func() {
+				msg = types.NewMsgUpdateChecksumStatus(govAcc, checksum, types.ChecksumStatusDeprecated)
+			},
+			nil,
+		},
+		{
+			"success: deprecated to active",
+			func() {
+				err := types.SetChecksumStatus(suite.chainA.GetContext(), checksum, types.ChecksumStatusDeprecated)
+				suite.Require().NoError(err)
+
+				msg = types.NewMsgUpdateChecksumStatus(govAcc, checksum, types.ChecksumStatusActive)
+			},
+			nil,
+		},
+		{
+			"success: staging to active",
+			func() {
+				err := types.SetChecksumStatus(suite.chainA.GetContext(), checksum, types.ChecksumStatusStaging)
+				suite.Require().NoError(err)
+
+				msg = types.NewMsgUpdateChecksumStatus(govAcc, checksum, types.ChecksumStatusActive)
+			},
+			nil,
+		},
+		{
+			"failure: active to staging",
+			func() {
+				msg = types.NewMsgUpdateChecksumStatus(govAcc, checksum, types.ChecksumStatusStaging)
+			},
+			types.ErrInvalidChecksumStatus,
+		},
+		{
+			"failure: status unchanged",
+			func() {
+				msg = types.NewMsgUpdateChecksumStatus(govAcc, checksum, types.ChecksumStatusActive)
+			},
+			types.ErrInvalidChecksumStatus,
+		},
+		{
+			"failure: checksum not found",
+			func() {
+				msg = types.NewMsgUpdateChecksumStatus(govAcc, []byte{1}, types.ChecksumStatusDeprecated)
+			},
+			types.ErrWasmChecksumNotFound,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				msg = types.NewMsgUpdateChecksumStatus(suite.chainA.SenderAccount.GetAddress().String(), checksum, types.ChecksumStatusDeprecated)
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupWasmWithMockVM()
+
+			checksum = storeWasmCode(suite, wasmtesting.Code)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := GetSimApp(suite.chainA).WasmClientKeeper.UpdateChecksumStatus(ctx, msg)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				status, err := types.GetChecksumStatus(ctx, checksum)
+				suite.Require().NoError(err)
+				suite.Require().Equal(msg.Status, status)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgMigrateAllClients() {
+	govAcc := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	var (
+		oldChecksum []byte
+		newChecksum []byte
+		msg         *types.MsgMigrateAllClients
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: clients of a deprecated checksum",
+			func() {
+				err := types.SetChecksumStatus(suite.chainA.GetContext(), oldChecksum, types.ChecksumStatusDeprecated)
+				suite.Require().NoError(err)
+			},
+			nil,
+		},
+		{
+			"failure: from checksum not found",
+			func() {
+				msg.FromChecksum = []byte{1}
+			},
+			types.ErrWasmChecksumNotFound,
+		},
+		{
+			"failure: to checksum not found",
+			func() {
+				msg.ToChecksum = []byte{1}
+			},
+			types.ErrChecksumNotActive,
+		},
+		{
+			"failure: to checksum is staged",
+			func() {
+				err := types.SetChecksumStatus(suite.chainA.GetContext(), newChecksum, types.ChecksumStatusStaging)
+				suite.Require().NoError(err)
+			},
+			types.ErrChecksumNotActive,
+		},
+		{
+			"failure: migration already pending",
+			func() {
+				_, err := GetSimApp(suite.chainA).WasmClientKeeper.MigrateAllClients(suite.chainA.GetContext(), msg)
+				suite.Require().NoError(err)
+			},
+			types.ErrClientMigrationExists,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				msg.Signer = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupWasmWithMockVM()
+
+			oldChecksum = storeWasmCode(suite, wasmtesting.Code)
+			newChecksum = storeWasmCode(suite, wasmtesting.CreateMockContract([]byte("MockByteCode-TestMsgMigrateAllClients")))
+			clientIDs := suite.createWasmClients(2)
+
+			suite.mockVM.MigrateFn = func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+				data, err := json.Marshal(types.EmptyResult{})
+				suite.Require().NoError(err)
+
+				return &wasmvmtypes.Response{Data: data}, wasmtesting.DefaultGasUsed, nil
+			}
+
+			msg = types.NewMsgMigrateAllClients(govAcc, oldChecksum, newChecksum, []byte("{}"))
+
+			tc.malleate()
+
+			wasmClientKeeper := GetSimApp(suite.chainA).WasmClientKeeper
+			res, err := wasmClientKeeper.MigrateAllClients(suite.chainA.GetContext(), msg)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				migration, found := wasmClientKeeper.GetClientMigration(suite.chainA.GetContext(), oldChecksum)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewClientMigration(oldChecksum, newChecksum, msg.Msg), migration)
+
+				// the clients are migrated at the end of the block
+				suite.coordinator.CommitBlock(suite.chainA)
+
+				for _, clientID := range clientIDs {
+					clientState, err := wasmClientKeeper.GetWasmClientState(suite.chainA.GetContext(), clientID)
+					suite.Require().NoError(err)
+					suite.Require().Equal(newChecksum, clientState.Checksum)
+				}
+
+				_, found = wasmClientKeeper.GetClientMigration(suite.chainA.GetContext(), oldChecksum)
+				suite.Require().False(found)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
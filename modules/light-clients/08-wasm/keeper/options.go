@@ -30,3 +30,15 @@ func WithQueryPlugins(plugins *types.QueryPlugins) Option {
 		ibcwasm.SetQueryPlugins(&newPlugins)
 	})
 }
+
+// WithMessageRouter is an optional constructor parameter to pass the message router used to execute the messages
+// dispatched by light client contracts. Contracts may only dispatch the messages allowed by the capabilities granted
+// to them through the 08-wasm params. Without a message router, contracts may not dispatch any messages.
+func WithMessageRouter(router ibcwasm.MessageRouter) Option {
+	return optsFn(func(k *Keeper) {
+		if router == nil {
+			panic(errors.New("message router must be not nil"))
+		}
+		k.msgRouter = router
+	})
+}
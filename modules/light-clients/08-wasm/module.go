@@ -32,6 +32,7 @@ var (
 	_ module.HasConsensusVersion = (*AppModule)(nil)
 	_ module.HasServices         = (*AppModule)(nil)
 	_ appmodule.AppModule        = (*AppModule)(nil)
+	_ appmodule.HasEndBlocker    = (*AppModule)(nil)
 )
 
 // IsOnePerModuleType implements the depinject.OnePerModuleType interface.
@@ -59,11 +60,9 @@ func (AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry)
 	types.RegisterInterfaces(registry)
 }
 
-// DefaultGenesis returns an empty state, i.e. no contracts
+// DefaultGenesis returns a state without contracts and with the default params
 func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
-	return cdc.MustMarshalJSON(&types.GenesisState{
-		Contracts: []types.Contract{},
-	})
+	return cdc.MustMarshalJSON(types.NewGenesisState([]types.Contract{}, types.DefaultParams()))
 }
 
 // ValidateGenesis performs a no-op.
@@ -126,6 +125,13 @@ func (AppModule) ProposalMsgs(simState module.SimulationState) []simtypes.Weight
 	return simulation.ProposalMsgs()
 }
 
+// EndBlock implements the appmodule.HasEndBlocker interface. It migrates the clients of the pending
+// client migrations in batches.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	am.keeper.MigrateClients(sdk.UnwrapSDKContext(ctx))
+	return nil
+}
+
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, bz json.RawMessage) {
 	var gs types.GenesisState
 	err := cdc.UnmarshalJSON(bz, &gs)
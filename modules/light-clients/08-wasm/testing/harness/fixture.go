@@ -0,0 +1,168 @@
+package harness
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+)
+
+// StepType defines the light client method called by a step.
+type StepType string
+
+const (
+	// StepInitialize calls Initialize, i.e. the instantiate entry point of the contract.
+	StepInitialize StepType = "initialize"
+	// StepVerifyClientMessage calls VerifyClientMessage, i.e. the verify_client_message query of the contract.
+	StepVerifyClientMessage StepType = "verify_client_message"
+	// StepUpdateState calls UpdateState, i.e. the update_state sudo message of the contract.
+	StepUpdateState StepType = "update_state"
+	// StepVerifyMembership calls VerifyMembership, i.e. the verify_membership sudo message of the contract.
+	StepVerifyMembership StepType = "verify_membership"
+)
+
+// Fixture defines a recorded sequence of steps driven by a harness along with their results.
+type Fixture struct {
+	// ChainID is the chain identifier passed to the contract.
+	ChainID string `json:"chain_id"`
+	// ClientID is the identifier of the client.
+	ClientID string `json:"client_id"`
+	// Checksum is the hex encoded checksum of the contract the fixture was recorded with.
+	Checksum string `json:"checksum"`
+	// Steps are the recorded steps in order of execution.
+	Steps []Step `json:"steps"`
+}
+
+// Step defines the inputs and results of a single call to the light client contract.
+type Step struct {
+	Type        StepType `json:"type"`
+	BlockHeight int64    `json:"block_height"`
+	// BlockTime is the block time in unix nanoseconds.
+	BlockTime int64 `json:"block_time"`
+
+	// inputs
+	ClientState      []byte              `json:"client_state,omitempty"`
+	ConsensusState   []byte              `json:"consensus_state,omitempty"`
+	ClientMessage    []byte              `json:"client_message,omitempty"`
+	Height           *clienttypes.Height `json:"height,omitempty"`
+	DelayTimePeriod  uint64              `json:"delay_time_period,omitempty"`
+	DelayBlockPeriod uint64              `json:"delay_block_period,omitempty"`
+	Proof            []byte              `json:"proof,omitempty"`
+	Path             []string            `json:"path,omitempty"`
+	Value            []byte              `json:"value,omitempty"`
+
+	// results
+	Error            string               `json:"error,omitempty"`
+	ConsensusHeights []clienttypes.Height `json:"consensus_heights,omitempty"`
+	GasUsed          uint64               `json:"gas_used"`
+	// StoreHash is the hex encoded sha256 hash of the client store after the step.
+	StoreHash string `json:"store_hash"`
+}
+
+// err returns the error recorded by the step.
+func (s Step) err() error {
+	if s.Error == "" {
+		return nil
+	}
+
+	return errors.New(s.Error)
+}
+
+// compare returns an error describing the first result of the step which differs from the expected step.
+func (s Step) compare(expected Step) error {
+	switch {
+	case s.Error != expected.Error:
+		return fmt.Errorf("expected error %q, got %q", expected.Error, s.Error)
+	case !reflect.DeepEqual(s.ConsensusHeights, expected.ConsensusHeights):
+		return fmt.Errorf("expected consensus heights %v, got %v", expected.ConsensusHeights, s.ConsensusHeights)
+	case s.GasUsed != expected.GasUsed:
+		return fmt.Errorf("expected gas used %d, got %d", expected.GasUsed, s.GasUsed)
+	case s.StoreHash != expected.StoreHash:
+		return fmt.Errorf("expected client store hash %s, got %s", expected.StoreHash, s.StoreHash)
+	default:
+		return nil
+	}
+}
+
+// Compare returns an error describing the first step of the fixture whose results differ from the expected fixture.
+func (f Fixture) Compare(expected Fixture) error {
+	if len(f.Steps) != len(expected.Steps) {
+		return fmt.Errorf("expected %d steps, got %d", len(expected.Steps), len(f.Steps))
+	}
+
+	for i, step := range f.Steps {
+		if step.Type != expected.Steps[i].Type {
+			return fmt.Errorf("step %d: expected type %s, got %s", i, expected.Steps[i].Type, step.Type)
+		}
+
+		if err := step.compare(expected.Steps[i]); err != nil {
+			return fmt.Errorf("step %d (%s): %w", i, step.Type, err)
+		}
+	}
+
+	return nil
+}
+
+// LoadFixture reads the JSON encoded fixture at the given path.
+func LoadFixture(path string) (Fixture, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return Fixture{}, err
+	}
+
+	var fixture Fixture
+	if err := json.Unmarshal(bz, &fixture); err != nil {
+		return Fixture{}, fmt.Errorf("failed to unmarshal fixture %s: %w", path, err)
+	}
+
+	return fixture, nil
+}
+
+// WriteFixture writes the fixture JSON encoded to the given path, creating the parent directories if necessary.
+func WriteFixture(path string, fixture Fixture) error {
+	bz, err := json.MarshalIndent(fixture, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(bz, '\n'), 0o600)
+}
+
+// Golden compares the steps driven by the harness against the golden fixture at the given path.
+// If update is true, the golden fixture is instead overwritten with the steps driven by the harness.
+func (h *Harness) Golden(path string, update bool) error {
+	if update {
+		return WriteFixture(path, h.Fixture())
+	}
+
+	expected, err := LoadFixture(path)
+	if err != nil {
+		return err
+	}
+
+	return h.Fixture().Compare(expected)
+}
+
+// Replay executes the steps of the given fixture and returns an error describing the first step whose
+// results differ from the recorded results. The fixture may have been recorded with a different version
+// of the contract, in which case replaying it checks the new version for regressions.
+func (h *Harness) Replay(fixture Fixture) error {
+	for _, step := range fixture.Steps {
+		h.SetBlock(step.BlockHeight, time.Unix(0, step.BlockTime))
+		h.run(step)
+	}
+
+	replayed := h.Fixture()
+	replayed.Steps = replayed.Steps[len(replayed.Steps)-len(fixture.Steps):]
+
+	return replayed.Compare(fixture)
+}
@@ -0,0 +1,338 @@
+package harness
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"time"
+
+	wasmvm "github.com/CosmWasm/wasmvm"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/internal/ibcwasm"
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+const (
+	// ChainID is the chain identifier passed to the contract in the environment of each call.
+	ChainID = "harness-chain"
+	// ClientID is the identifier of the client driven by the harness.
+	ClientID = "08-wasm-0"
+	// GasLimit is the gas limit, in SDK gas units, of each step driven by the harness.
+	GasLimit uint64 = 100_000_000
+)
+
+// DefaultBlockTime is the block time of the steps driven by the harness until SetBlock is called.
+var DefaultBlockTime = time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// Harness drives a light client contract through the 08-wasm light client using in-memory stores,
+// without requiring an application. Each step driven by the harness is recorded, together with its
+// results, in a Fixture which can be written as a golden file and replayed to detect regressions.
+//
+// NOTE: the harness sets the global wasm VM, query plugins and store service of the 08-wasm module.
+// Harnesses must not be used concurrently, nor in the same process as an 08-wasm keeper.
+type Harness struct {
+	ownedVM  *wasmvm.VM
+	cdc      codec.Codec
+	ctx      sdk.Context
+	storeKey *storetypes.KVStoreKey
+	checksum types.Checksum
+
+	height    int64
+	blockTime time.Time
+	fixture   Fixture
+}
+
+// NewWithVM creates a new Harness which stores the given contract code in the provided wasm VM.
+// The mock VM of the 08-wasm testing package may be provided to drive the harness without a compiled contract.
+func NewWithVM(vm ibcwasm.WasmEngine, code []byte) (*Harness, error) {
+	storeKey := storetypes.NewKVStoreKey(ibcexported.StoreKey)
+	wasmStoreKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithKeys(map[string]*storetypes.KVStoreKey{
+		ibcexported.StoreKey: storeKey,
+		types.StoreKey:       wasmStoreKey,
+	}, nil, nil)
+
+	registry := codectypes.NewInterfaceRegistry()
+	clienttypes.RegisterInterfaces(registry)
+	types.RegisterInterfaces(registry)
+
+	ibcwasm.SetVM(vm)
+	ibcwasm.SetQueryPlugins(types.NewDefaultQueryPlugins())
+	ibcwasm.SetupWasmStoreService(runtime.NewKVStoreService(wasmStoreKey))
+
+	checksum, err := vm.StoreCode(code)
+	if err != nil {
+		return nil, fmt.Errorf("failed to store contract: %w", err)
+	}
+
+	if err := ibcwasm.Checksums.Set(ctx, checksum); err != nil {
+		return nil, fmt.Errorf("failed to store checksum: %w", err)
+	}
+
+	return &Harness{
+		cdc:       codec.NewProtoCodec(registry),
+		ctx:       ctx,
+		storeKey:  storeKey,
+		checksum:  checksum,
+		height:    1,
+		blockTime: DefaultBlockTime,
+		fixture: Fixture{
+			ChainID:  ChainID,
+			ClientID: ClientID,
+			Checksum: hex.EncodeToString(checksum),
+		},
+	}, nil
+}
+
+// NewWithConfig creates a new Harness which instantiates a wasm VM using the provided configuration
+// and stores the given compiled contract code in it. The VM is released by Close.
+func NewWithConfig(wasmConfig types.WasmConfig, code []byte) (*Harness, error) {
+	vm, err := wasmvm.NewVM(wasmConfig.DataDir, wasmConfig.SupportedCapabilities, types.ContractMemoryLimit, wasmConfig.ContractDebugMode, types.MemoryCacheSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to instantiate new Wasm VM instance: %w", err)
+	}
+
+	harness, err := NewWithVM(vm, code)
+	if err != nil {
+		vm.Cleanup()
+		return nil, err
+	}
+
+	harness.ownedVM = vm
+	return harness, nil
+}
+
+// NewFromFile creates a new Harness which instantiates a wasm VM using the provided configuration and
+// stores the compiled contract code read from the file at the given path in it. The file may contain
+// either plain or gzip compressed wasm code, e.g. a .wasm or .wasm.gz artifact. The VM is released by Close.
+func NewFromFile(wasmConfig types.WasmConfig, path string) (*Harness, error) {
+	code, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read contract: %w", err)
+	}
+
+	if types.IsGzip(code) {
+		code, err = types.Uncompress(code, types.MaxWasmByteSize())
+		if err != nil {
+			return nil, fmt.Errorf("failed to uncompress contract %s: %w", path, err)
+		}
+	}
+
+	return NewWithConfig(wasmConfig, code)
+}
+
+// Close releases the wasm VM instantiated by NewWithConfig or NewFromFile.
+func (h *Harness) Close() {
+	if h.ownedVM != nil {
+		h.ownedVM.Cleanup()
+	}
+}
+
+// Checksum returns the checksum of the contract driven by the harness.
+func (h *Harness) Checksum() types.Checksum {
+	return h.checksum
+}
+
+// Codec returns the codec used to encode the client and consensus states stored by the contract.
+func (h *Harness) Codec() codec.Codec {
+	return h.cdc
+}
+
+// SetBlock sets the block height and time of the environment of the following steps.
+func (h *Harness) SetBlock(height int64, blockTime time.Time) {
+	h.height = height
+	h.blockTime = blockTime
+}
+
+// ClientStore returns the store of the client driven by the harness.
+func (h *Harness) ClientStore() storetypes.KVStore {
+	return clientStore(h.ctx, h.storeKey)
+}
+
+// ClientState returns the client state stored by the contract.
+func (h *Harness) ClientState() (*types.ClientState, error) {
+	return getClientState(h.ClientStore(), h.cdc)
+}
+
+// Fixture returns the steps driven by the harness along with their results.
+func (h *Harness) Fixture() Fixture {
+	fixture := h.fixture
+	fixture.Steps = append([]Step(nil), h.fixture.Steps...)
+	return fixture
+}
+
+// Initialize instantiates the contract with the given client and consensus states of the underlying light client.
+func (h *Harness) Initialize(clientState, consensusState []byte, latestHeight clienttypes.Height) error {
+	return h.run(Step{
+		Type:           StepInitialize,
+		ClientState:    clientState,
+		ConsensusState: consensusState,
+		Height:         &latestHeight,
+	}).err()
+}
+
+// VerifyClientMessage verifies the given client message, e.g. a header or misbehaviour, of the underlying light client.
+func (h *Harness) VerifyClientMessage(clientMessage []byte) error {
+	return h.run(Step{
+		Type:          StepVerifyClientMessage,
+		ClientMessage: clientMessage,
+	}).err()
+}
+
+// UpdateState updates the client with the given client message and returns the heights of the consensus states added.
+func (h *Harness) UpdateState(clientMessage []byte) ([]clienttypes.Height, error) {
+	step := h.run(Step{
+		Type:          StepUpdateState,
+		ClientMessage: clientMessage,
+	})
+
+	return step.ConsensusHeights, step.err()
+}
+
+// VerifyMembership verifies the proof of the existence of the value at the given path at the given height.
+func (h *Harness) VerifyMembership(height clienttypes.Height, delayTimePeriod, delayBlockPeriod uint64, proof []byte, path commitmenttypes.MerklePath, value []byte) error {
+	return h.run(Step{
+		Type:             StepVerifyMembership,
+		Height:           &height,
+		DelayTimePeriod:  delayTimePeriod,
+		DelayBlockPeriod: delayBlockPeriod,
+		Proof:            proof,
+		Path:             path.KeyPath,
+		Value:            value,
+	}).err()
+}
+
+// run executes and records the given step.
+func (h *Harness) run(step Step) Step {
+	step.BlockHeight = h.height
+	step.BlockTime = h.blockTime.UnixNano()
+
+	step = h.execute(step)
+	h.fixture.Steps = append(h.fixture.Steps, step)
+
+	return step
+}
+
+// execute executes the given step and returns it with its results set. State changes made by a failing step are discarded.
+func (h *Harness) execute(step Step) Step {
+	header := cmtproto.Header{ChainID: ChainID, Height: step.BlockHeight, Time: time.Unix(0, step.BlockTime).UTC()}
+	ctx, writeCache := h.ctx.WithBlockHeader(header).WithGasMeter(storetypes.NewGasMeter(GasLimit)).WithEventManager(sdk.NewEventManager()).CacheContext()
+
+	heights, err := h.executeStep(ctx, clientStore(ctx, h.storeKey), step)
+	if err == nil {
+		writeCache()
+	}
+
+	step.ConsensusHeights = heights
+	step.Error = ""
+	if err != nil {
+		step.Error = err.Error()
+	}
+	step.GasUsed = ctx.GasMeter().GasConsumed()
+	step.StoreHash = hashStore(h.ClientStore())
+
+	return step
+}
+
+// executeStep calls the 08-wasm light client method corresponding to the type of the step.
+func (h *Harness) executeStep(ctx sdk.Context, store storetypes.KVStore, step Step) (heights []clienttypes.Height, err error) {
+	// out of gas and UpdateState errors are raised as panics
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %s", r)
+		}
+	}()
+
+	if step.Type == StepInitialize {
+		if step.Height == nil {
+			return nil, fmt.Errorf("step %s requires a height", step.Type)
+		}
+
+		clientState := types.NewClientState(step.ClientState, h.checksum, *step.Height)
+		return nil, clientState.Initialize(ctx, h.cdc, store, types.NewConsensusState(step.ConsensusState))
+	}
+
+	clientState, err := getClientState(store, h.cdc)
+	if err != nil {
+		return nil, err
+	}
+
+	switch step.Type {
+	case StepVerifyClientMessage:
+		return nil, clientState.VerifyClientMessage(ctx, h.cdc, store, &types.ClientMessage{Data: step.ClientMessage})
+	case StepUpdateState:
+		for _, height := range clientState.UpdateState(ctx, h.cdc, store, &types.ClientMessage{Data: step.ClientMessage}) {
+			heights = append(heights, height.(clienttypes.Height))
+		}
+
+		return heights, nil
+	case StepVerifyMembership:
+		if step.Height == nil {
+			return nil, fmt.Errorf("step %s requires a height", step.Type)
+		}
+
+		return nil, clientState.VerifyMembership(ctx, store, h.cdc, *step.Height, step.DelayTimePeriod, step.DelayBlockPeriod, step.Proof, commitmenttypes.NewMerklePath(step.Path...), step.Value)
+	default:
+		return nil, fmt.Errorf("unknown step type %q", step.Type)
+	}
+}
+
+// clientStore returns the store of the client driven by the harness. The store is prefixed in the same way
+// as the client stores of the 02-client keeper, so that the client identifier can be retrieved from it.
+func clientStore(ctx sdk.Context, storeKey storetypes.StoreKey) storetypes.KVStore {
+	return prefix.NewStore(ctx.KVStore(storeKey), []byte(fmt.Sprintf("%s/%s/", host.KeyClientStorePrefix, ClientID)))
+}
+
+// getClientState returns the wasm client state stored in the given client store.
+func getClientState(store storetypes.KVStore, cdc codec.BinaryCodec) (*types.ClientState, error) {
+	bz := store.Get(host.ClientStateKey())
+	if len(bz) == 0 {
+		return nil, clienttypes.ErrClientNotFound
+	}
+
+	clientState, err := clienttypes.UnmarshalClientState(cdc, bz)
+	if err != nil {
+		return nil, err
+	}
+
+	wasmClientState, ok := clientState.(*types.ClientState)
+	if !ok {
+		return nil, fmt.Errorf("expected client state type %T, got %T", (*types.ClientState)(nil), clientState)
+	}
+
+	return wasmClientState, nil
+}
+
+// hashStore returns the hex encoded sha256 hash of all the key value pairs of the given store.
+func hashStore(store storetypes.KVStore) string {
+	hash := sha256.New()
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		for _, bz := range [][]byte{iterator.Key(), iterator.Value()} {
+			hash.Write(binary.BigEndian.AppendUint64(nil, uint64(len(bz))))
+			hash.Write(bz)
+		}
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
@@ -0,0 +1,339 @@
+package harness_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"testing"
+	"time"
+
+	wasmvm "github.com/CosmWasm/wasmvm"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	"github.com/stretchr/testify/require"
+
+	wasmtesting "github.com/cosmos/ibc-go/modules/light-clients/08-wasm/testing"
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/testing/harness"
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// contractPath is the path of the gzip compressed grandpa light client contract used by the e2e tests.
+var contractPath = filepath.Join("..", "..", "..", "..", "..", "e2e", "tests", "wasm", "contracts", "ics10_grandpa_cw.wasm.gz")
+
+var (
+	clientStateData    = []byte("client state")
+	consensusStateData = []byte("consensus state")
+	proof              = []byte("proof")
+	path               = commitmenttypes.NewMerklePath("ibc", "key")
+)
+
+// newHarness returns a harness driving a mock contract. The contract treats client messages as decimal
+// encoded heights, adding the given offset to the height of the consensus states it stores on update.
+func newHarness(t *testing.T, heightOffset uint64) *harness.Harness {
+	t.Helper()
+
+	var h *harness.Harness
+
+	setClientState := func(store wasmvm.KVStore, height clienttypes.Height) {
+		clientState := types.NewClientState(clientStateData, h.Checksum(), height)
+		store.Set(host.ClientStateKey(), clienttypes.MustMarshalClientState(h.Codec(), clientState))
+		store.Set(host.ConsensusStateKey(height), clienttypes.MustMarshalConsensusState(h.Codec(), types.NewConsensusState(consensusStateData)))
+	}
+
+	mockVM := wasmtesting.NewMockWasmEngine()
+	mockVM.InstantiateFn = func(_ wasmvm.Checksum, env wasmvmtypes.Env, _ wasmvmtypes.MessageInfo, _ []byte, store wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+		require.Equal(t, harness.ChainID, env.Block.ChainID)
+		require.Equal(t, harness.ClientID, env.Contract.Address)
+
+		setClientState(store, clienttypes.NewHeight(0, 1))
+		return &wasmvmtypes.Response{}, wasmtesting.DefaultGasUsed, nil
+	}
+
+	mockVM.RegisterQueryCallback(types.VerifyClientMessageMsg{}, func(_ wasmvm.Checksum, _ wasmvmtypes.Env, queryMsg []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) ([]byte, uint64, error) {
+		var payload types.QueryMsg
+		require.NoError(t, json.Unmarshal(queryMsg, &payload))
+
+		if _, err := strconv.ParseUint(string(payload.VerifyClientMessage.ClientMessage), 10, 64); err != nil {
+			return nil, wasmtesting.DefaultGasUsed, wasmtesting.ErrMockContract
+		}
+
+		resp, err := json.Marshal(types.EmptyResult{})
+		require.NoError(t, err)
+
+		return resp, wasmtesting.DefaultGasUsed, nil
+	})
+
+	mockVM.RegisterSudoCallback(types.UpdateStateMsg{}, func(_ wasmvm.Checksum, _ wasmvmtypes.Env, sudoMsg []byte, store wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+		var payload types.SudoMsg
+		require.NoError(t, json.Unmarshal(sudoMsg, &payload))
+
+		revisionHeight, err := strconv.ParseUint(string(payload.UpdateState.ClientMessage), 10, 64)
+		if err != nil {
+			return nil, wasmtesting.DefaultGasUsed, wasmtesting.ErrMockContract
+		}
+
+		height := clienttypes.NewHeight(0, revisionHeight+heightOffset)
+		setClientState(store, height)
+
+		resp, err := json.Marshal(types.UpdateStateResult{Heights: []clienttypes.Height{height}})
+		require.NoError(t, err)
+
+		return &wasmvmtypes.Response{Data: resp}, wasmtesting.DefaultGasUsed, nil
+	})
+
+	mockVM.RegisterSudoCallback(types.VerifyMembershipMsg{}, func(_ wasmvm.Checksum, _ wasmvmtypes.Env, sudoMsg []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+		var payload types.SudoMsg
+		require.NoError(t, json.Unmarshal(sudoMsg, &payload))
+
+		if string(payload.VerifyMembership.Value) != "value" {
+			return nil, wasmtesting.DefaultGasUsed, wasmtesting.ErrMockContract
+		}
+
+		resp, err := json.Marshal(types.EmptyResult{})
+		require.NoError(t, err)
+
+		return &wasmvmtypes.Response{Data: resp}, wasmtesting.DefaultGasUsed, nil
+	})
+
+	h, err := harness.NewWithVM(mockVM, wasmtesting.Code)
+	require.NoError(t, err)
+
+	return h
+}
+
+// driveSteps drives the mock contract through a sequence of successful and failing steps.
+func driveSteps(t *testing.T, h *harness.Harness) {
+	t.Helper()
+
+	err := h.Initialize(clientStateData, consensusStateData, clienttypes.NewHeight(0, 1))
+	require.NoError(t, err)
+
+	h.SetBlock(2, harness.DefaultBlockTime.Add(time.Minute))
+
+	err = h.VerifyClientMessage([]byte("10"))
+	require.NoError(t, err)
+
+	err = h.VerifyClientMessage([]byte("invalid"))
+	require.ErrorContains(t, err, wasmtesting.ErrMockContract.Error())
+
+	_, err = h.UpdateState([]byte("10"))
+	require.NoError(t, err)
+
+	err = h.VerifyMembership(clienttypes.NewHeight(0, 10), 0, 0, proof, path, []byte("value"))
+	require.NoError(t, err)
+
+	err = h.VerifyMembership(clienttypes.NewHeight(0, 10), 0, 0, proof, path, []byte("other value"))
+	require.ErrorContains(t, err, wasmtesting.ErrMockContract.Error())
+}
+
+func TestHarness(t *testing.T) {
+	h := newHarness(t, 0)
+
+	_, err := h.ClientState()
+	require.ErrorIs(t, err, clienttypes.ErrClientNotFound)
+
+	err = h.Initialize(clientStateData, consensusStateData, clienttypes.NewHeight(0, 1))
+	require.NoError(t, err)
+
+	clientState, err := h.ClientState()
+	require.NoError(t, err)
+	require.Equal(t, clienttypes.NewHeight(0, 1), clientState.LatestHeight)
+
+	err = h.VerifyClientMessage([]byte("10"))
+	require.NoError(t, err)
+
+	// a failing update does not modify the client store
+	heights, err := h.UpdateState([]byte("invalid"))
+	require.Error(t, err)
+	require.Empty(t, heights)
+
+	heights, err = h.UpdateState([]byte("10"))
+	require.NoError(t, err)
+	require.Equal(t, []clienttypes.Height{clienttypes.NewHeight(0, 10)}, heights)
+
+	clientState, err = h.ClientState()
+	require.NoError(t, err)
+	require.Equal(t, clienttypes.NewHeight(0, 10), clientState.LatestHeight)
+
+	// the proof height cannot exceed the latest height of the client
+	err = h.VerifyMembership(clienttypes.NewHeight(0, 11), 0, 0, proof, path, []byte("value"))
+	require.Error(t, err)
+
+	err = h.VerifyMembership(clienttypes.NewHeight(0, 10), 0, 0, proof, path, []byte("value"))
+	require.NoError(t, err)
+
+	fixture := h.Fixture()
+	require.Len(t, fixture.Steps, 6)
+	require.Equal(t, harness.StepInitialize, fixture.Steps[0].Type)
+	require.Equal(t, fixture.Steps[1].StoreHash, fixture.Steps[2].StoreHash)
+	require.NotEmpty(t, fixture.Steps[2].Error)
+	require.NotEqual(t, fixture.Steps[2].StoreHash, fixture.Steps[3].StoreHash)
+	require.Equal(t, []clienttypes.Height{clienttypes.NewHeight(0, 10)}, fixture.Steps[3].ConsensusHeights)
+}
+
+func TestGolden(t *testing.T) {
+	goldenPath := filepath.Join(t.TempDir(), "testdata", "golden.json")
+
+	h := newHarness(t, 0)
+	driveSteps(t, h)
+	require.NoError(t, h.Golden(goldenPath, true))
+
+	fixture, err := harness.LoadFixture(goldenPath)
+	require.NoError(t, err)
+	require.Equal(t, h.Fixture(), fixture)
+
+	testCases := []struct {
+		name         string
+		heightOffset uint64
+		expPass      bool
+	}{
+		{"success: results match golden fixture", 0, true},
+		{"failure: consensus heights differ from golden fixture", 1, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t, tc.heightOffset)
+			driveSteps(t, h)
+
+			err := h.Golden(goldenPath, false)
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, "step 3 (update_state)")
+			}
+		})
+	}
+}
+
+func TestReplay(t *testing.T) {
+	recorder := newHarness(t, 0)
+	driveSteps(t, recorder)
+	fixture := recorder.Fixture()
+
+	testCases := []struct {
+		name         string
+		heightOffset uint64
+		expPass      bool
+	}{
+		{"success: replayed results match recorded results", 0, true},
+		{"failure: replayed consensus heights differ from recorded heights", 1, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := newHarness(t, tc.heightOffset).Replay(fixture)
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, "step 3 (update_state)")
+			}
+		})
+	}
+}
+
+func TestNewWithConfig(t *testing.T) {
+	wasmConfig := types.WasmConfig{
+		DataDir:               t.TempDir(),
+		SupportedCapabilities: "iterator",
+	}
+
+	_, err := harness.NewWithConfig(wasmConfig, []byte("invalid wasm code"))
+	require.Error(t, err)
+}
+
+func TestNewFromFile(t *testing.T) {
+	wasmConfig := types.WasmConfig{
+		DataDir:               t.TempDir(),
+		SupportedCapabilities: "iterator",
+	}
+
+	_, err := harness.NewFromFile(wasmConfig, filepath.Join(t.TempDir(), "missing.wasm"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	// invalid plain and gzip compressed code is rejected by the VM
+	plainPath := filepath.Join(t.TempDir(), "invalid.wasm")
+	require.NoError(t, os.WriteFile(plainPath, []byte("invalid wasm code"), 0o600))
+
+	_, err = harness.NewFromFile(wasmConfig, plainPath)
+	require.ErrorContains(t, err, "failed to store contract")
+
+	compressed, err := types.GzipIt([]byte("invalid wasm code"))
+	require.NoError(t, err)
+
+	gzipPath := filepath.Join(t.TempDir(), "invalid.wasm.gz")
+	require.NoError(t, os.WriteFile(gzipPath, compressed, 0o600))
+
+	_, err = harness.NewFromFile(wasmConfig, gzipPath)
+	require.ErrorContains(t, err, "failed to store contract")
+
+	if _, err := os.Stat(contractPath); os.IsNotExist(err) {
+		t.Skipf("skipping compiled contract: %s not found", contractPath)
+	}
+
+	compressed, err = os.ReadFile(contractPath)
+	require.NoError(t, err)
+
+	code, err := types.Uncompress(compressed, types.MaxWasmByteSize())
+	require.NoError(t, err)
+
+	checksum, err := types.CreateChecksum(code)
+	require.NoError(t, err)
+
+	// the checksum of a gzip compressed contract is computed over the uncompressed code
+	h, err := harness.NewFromFile(wasmConfig, contractPath)
+	require.NoError(t, err)
+	defer h.Close()
+
+	require.Equal(t, checksum, h.Checksum())
+}
+
+// TestReplayContractFixture drives a compiled light client contract through the wasm VM by replaying a fixture
+// recorded with it, covering the Initialize, UpdateState and VerifyMembership steps. The fixture in the testdata
+// directory was recorded with the grandpa contract used by the e2e tests: the client is initialized with a grandpa
+// client and consensus state, after which the contract rejects an undecodable header and membership proof. The
+// test is skipped when the contract or the fixture is absent. Their paths may be overridden with the
+// WASM_HARNESS_CONTRACT and WASM_HARNESS_FIXTURE environment variables to replay fixtures of other contracts.
+func TestReplayContractFixture(t *testing.T) {
+	contract := contractPath
+	if path, ok := os.LookupEnv("WASM_HARNESS_CONTRACT"); ok {
+		contract = path
+	}
+
+	fixturePath := filepath.Join("testdata", "ics10_grandpa_cw.json")
+	if path, ok := os.LookupEnv("WASM_HARNESS_FIXTURE"); ok {
+		fixturePath = path
+	}
+
+	for _, path := range []string{contract, fixturePath} {
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			t.Skipf("skipping contract fixture replay: %s not found", path)
+		}
+	}
+
+	fixture, err := harness.LoadFixture(fixturePath)
+	require.NoError(t, err)
+
+	for _, stepType := range []harness.StepType{harness.StepInitialize, harness.StepUpdateState, harness.StepVerifyMembership} {
+		require.True(t, slices.ContainsFunc(fixture.Steps, func(step harness.Step) bool {
+			return step.Type == stepType
+		}), "fixture has no %s step", stepType)
+	}
+
+	wasmConfig := types.WasmConfig{
+		DataDir:               t.TempDir(),
+		SupportedCapabilities: "iterator",
+	}
+
+	h, err := harness.NewFromFile(wasmConfig, contract)
+	require.NoError(t, err)
+	defer h.Close()
+
+	require.NoError(t, h.Replay(fixture))
+}
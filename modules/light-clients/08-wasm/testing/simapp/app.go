@@ -472,11 +472,13 @@ func NewSimApp(
 		app.WasmClientKeeper = wasmkeeper.NewKeeperWithVM(
 			appCodec, runtime.NewKVStoreService(keys[wasmtypes.StoreKey]), app.IBCKeeper.ClientKeeper,
 			authtypes.NewModuleAddress(govtypes.ModuleName).String(), mockVM, app.GRPCQueryRouter(),
+			wasmkeeper.WithMessageRouter(app.MsgServiceRouter()),
 		)
 	} else {
 		app.WasmClientKeeper = wasmkeeper.NewKeeperWithConfig(
 			appCodec, runtime.NewKVStoreService(keys[wasmtypes.StoreKey]), app.IBCKeeper.ClientKeeper,
 			authtypes.NewModuleAddress(govtypes.ModuleName).String(), wasmConfig, app.GRPCQueryRouter(),
+			wasmkeeper.WithMessageRouter(app.MsgServiceRouter()),
 		)
 	}
 
@@ -824,9 +826,9 @@ func NewSimApp(
 
 		ctx := app.BaseApp.NewUncachedContext(true, cmtproto.Header{})
 
-		// Initialize pinned codes in wasmvm as they are not persisted there
-		if err := wasmkeeper.InitializePinnedCodes(ctx); err != nil {
-			cmtos.Exit(fmt.Sprintf("failed initialize pinned codes %s", err))
+		// Initialize the wasm VM according to the 08-wasm params and pin codes in wasmvm as they are not persisted there
+		if err := app.WasmClientKeeper.InitializeVM(ctx); err != nil {
+			cmtos.Exit(fmt.Sprintf("failed initialize wasm VM %s", err))
 		}
 	}
 
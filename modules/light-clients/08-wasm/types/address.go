@@ -0,0 +1,13 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// ClientAddress returns the address on behalf of which the messages returned by the light client contract
+// of the client with the given identifier are dispatched. The address is derived from the 08-wasm module
+// name and the client identifier, it has no private key and may only be funded by transfers from other accounts.
+func ClientAddress(clientID string) sdk.AccAddress {
+	return address.Module(ModuleName, []byte(clientID))
+}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+
+	errorsmod "cosmossdk.io/errors"
+
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+)
+
+// Validate returns an error if the checksum status is unspecified or unknown.
+func (s ChecksumStatus) Validate() error {
+	switch s {
+	case ChecksumStatusStaging, ChecksumStatusActive, ChecksumStatusDeprecated:
+		return nil
+	default:
+		return errorsmod.Wrapf(ErrInvalidChecksumStatus, "checksum status must be one of %s, %s or %s, got %s", ChecksumStatusStaging, ChecksumStatusActive, ChecksumStatusDeprecated, s)
+	}
+}
+
+// ValidateTransition returns an error if a checksum may not transition from the current status to the given status.
+// Staged checksums may be activated or deprecated, active checksums may be deprecated and deprecated checksums may
+// be reactivated. Checksums may not be moved back to staging.
+func (s ChecksumStatus) ValidateTransition(status ChecksumStatus) error {
+	if err := status.Validate(); err != nil {
+		return err
+	}
+
+	switch {
+	case s == status:
+		return errorsmod.Wrapf(ErrInvalidChecksumStatus, "checksum status is already %s", s)
+	case s == ChecksumStatusStaging && (status == ChecksumStatusActive || status == ChecksumStatusDeprecated),
+		s == ChecksumStatusActive && status == ChecksumStatusDeprecated,
+		s == ChecksumStatusDeprecated && status == ChecksumStatusActive:
+		return nil
+	default:
+		return errorsmod.Wrapf(ErrInvalidChecksumStatus, "invalid checksum status transition from %s to %s", s, status)
+	}
+}
+
+// NewClientMigration creates a new ClientMigration instance.
+func NewClientMigration(fromChecksum, toChecksum Checksum, migrateMsg []byte) ClientMigration {
+	return ClientMigration{
+		FromChecksum: fromChecksum,
+		ToChecksum:   toChecksum,
+		Msg:          migrateMsg,
+	}
+}
+
+// Validate performs basic validation of the client migration.
+func (m ClientMigration) Validate() error {
+	if err := ValidateWasmChecksum(m.FromChecksum); err != nil {
+		return err
+	}
+
+	if err := ValidateWasmChecksum(m.ToChecksum); err != nil {
+		return err
+	}
+
+	if bytes.Equal(m.FromChecksum, m.ToChecksum) {
+		return errorsmod.Wrapf(ErrInvalidChecksum, "checksum (%s) cannot be migrated to itself", hex.EncodeToString(m.FromChecksum))
+	}
+
+	if len(m.Msg) == 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "migrate message cannot be empty")
+	}
+
+	if m.LastClientId != "" {
+		return ValidateClientID(m.LastClientId)
+	}
+
+	return nil
+}
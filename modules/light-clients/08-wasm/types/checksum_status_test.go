@@ -0,0 +1,39 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
+)
+
+func TestChecksumStatusValidateTransition(t *testing.T) {
+	testCases := []struct {
+		name    string
+		from    types.ChecksumStatus
+		to      types.ChecksumStatus
+		expPass bool
+	}{
+		{"staging to active", types.ChecksumStatusStaging, types.ChecksumStatusActive, true},
+		{"staging to deprecated", types.ChecksumStatusStaging, types.ChecksumStatusDeprecated, true},
+		{"active to deprecated", types.ChecksumStatusActive, types.ChecksumStatusDeprecated, true},
+		{"deprecated to active", types.ChecksumStatusDeprecated, types.ChecksumStatusActive, true},
+		{"active to staging", types.ChecksumStatusActive, types.ChecksumStatusStaging, false},
+		{"deprecated to staging", types.ChecksumStatusDeprecated, types.ChecksumStatusStaging, false},
+		{"active to active", types.ChecksumStatusActive, types.ChecksumStatusActive, false},
+		{"active to unspecified", types.ChecksumStatusActive, types.ChecksumStatusUnspecified, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.from.ValidateTransition(tc.to)
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, types.ErrInvalidChecksumStatus, tc.name)
+		}
+	}
+}
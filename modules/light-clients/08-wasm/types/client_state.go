@@ -120,6 +120,11 @@ func (cs ClientState) Initialize(ctx sdk.Context, cdc codec.BinaryCodec, clientS
 		return errorsmod.Wrapf(ErrInvalidChecksum, "checksum (%s) has not been previously stored", hex.EncodeToString(cs.Checksum))
 	}
 
+	// Do not allow initialization of a client with a checksum that is staged or deprecated.
+	if err := validateChecksumActive(ctx, cs.Checksum); err != nil {
+		return err
+	}
+
 	payload := InstantiateMessage{
 		ClientState:    cs.Data,
 		ConsensusState: consensusState.Data,
@@ -297,6 +297,22 @@ func (suite *TypesTestSuite) TestInitialize() {
 			},
 			types.ErrInvalidChecksum,
 		},
+		{
+			"failure: checksum is staged",
+			func() {
+				err := types.SetChecksumStatus(suite.chainA.GetContext(), suite.checksum, types.ChecksumStatusStaging)
+				suite.Require().NoError(err)
+			},
+			types.ErrChecksumNotActive,
+		},
+		{
+			"failure: checksum is deprecated",
+			func() {
+				err := types.SetChecksumStatus(suite.chainA.GetContext(), suite.checksum, types.ChecksumStatusDeprecated)
+				suite.Require().NoError(err)
+			},
+			types.ErrChecksumNotActive,
+		},
 		{
 			"failure: InstantiateFn returns error",
 			func() {
@@ -28,6 +28,9 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgStoreCode{},
 		&MsgMigrateContract{},
 		&MsgRemoveChecksum{},
+		&MsgUpdateParams{},
+		&MsgUpdateChecksumStatus{},
+		&MsgMigrateAllClients{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -19,4 +19,11 @@ var (
 	ErrWasmContractCallFailed          = errorsmod.Register(ModuleName, 14, "wasm contract call failed")
 	ErrWasmInvalidResponseData         = errorsmod.Register(ModuleName, 15, "wasm contract returned invalid response data")
 	ErrWasmInvalidContractModification = errorsmod.Register(ModuleName, 16, "wasm contract made invalid state modifications")
+	ErrInvalidParams                   = errorsmod.Register(ModuleName, 17, "invalid 08-wasm parameters")
+	ErrBlockGasLimitExceeded           = errorsmod.Register(ModuleName, 18, "block gas limit for wasm contract calls exceeded")
+	ErrChecksumNotActive               = errorsmod.Register(ModuleName, 19, "wasm checksum is not active")
+	ErrInvalidChecksumStatus           = errorsmod.Register(ModuleName, 20, "invalid wasm checksum status")
+	ErrClientMigrationExists           = errorsmod.Register(ModuleName, 21, "client migration already exists")
+	ErrInvalidSubMessage               = errorsmod.Register(ModuleName, 22, "invalid wasm contract sub message")
+	ErrSubMessageFailed                = errorsmod.Register(ModuleName, 23, "execution of wasm contract sub message failed")
 )
@@ -14,6 +14,16 @@ const (
 	EventTypeStoreWasmCode = "store_wasm_code"
 	// EventTypeMigrateContract defines the event type for a contract migration
 	EventTypeMigrateContract = "migrate_contract"
+	// EventTypeUpdateChecksumStatus defines the event type for a checksum lifecycle state update
+	EventTypeUpdateChecksumStatus = "update_checksum_status"
+	// EventTypeMigrateAllClients defines the event type for the start of a migration of all clients using a checksum
+	EventTypeMigrateAllClients = "migrate_all_clients"
+	// EventTypeClientMigrationFailed defines the event type for a client which failed to be migrated by a client migration
+	EventTypeClientMigrationFailed = "client_migration_failed"
+	// EventTypeClientMigrationCompleted defines the event type for the completion of a client migration
+	EventTypeClientMigrationCompleted = "client_migration_completed"
+	// EventTypeDispatchContractMessage defines the event type for a message dispatched by a light client contract
+	EventTypeDispatchContractMessage = "dispatch_contract_message"
 
 	// AttributeKeyWasmChecksum denotes the checksum of the wasm code that was stored or migrated
 	AttributeKeyWasmChecksum = "wasm_checksum"
@@ -21,6 +31,18 @@ const (
 	AttributeKeyClientID = "client_id"
 	// AttributeKeyNewChecksum denotes the checksum of the new wasm code.
 	AttributeKeyNewChecksum = "new_checksum"
+	// AttributeKeyChecksumStatus denotes the lifecycle state of the checksum.
+	AttributeKeyChecksumStatus = "checksum_status"
+	// AttributeKeyNewChecksumStatus denotes the new lifecycle state of the checksum.
+	AttributeKeyNewChecksumStatus = "new_checksum_status"
+	// AttributeKeyMigratedClients denotes the number of clients migrated by a client migration.
+	AttributeKeyMigratedClients = "migrated_clients"
+	// AttributeKeyFailedClients denotes the number of clients which failed to be migrated by a client migration.
+	AttributeKeyFailedClients = "failed_clients"
+	// AttributeKeyError denotes the error which caused a client migration to fail.
+	AttributeKeyError = "error"
+	// AttributeKeyMessageType denotes the type URL of a message dispatched by a light client contract.
+	AttributeKeyMessageType = "message_type"
 
 	AttributeValueCategory = ModuleName
 )
@@ -13,4 +13,5 @@ type ClientKeeper interface {
 	ClientStore(ctx sdk.Context, clientID string) storetypes.KVStore
 	GetClientState(ctx sdk.Context, clientID string) (exported.ClientState, bool)
 	SetClientState(ctx sdk.Context, clientID string, clientState exported.ClientState)
+	IterateClientStates(ctx sdk.Context, storeprefix []byte, cb func(clientID string, cs exported.ClientState) bool)
 }
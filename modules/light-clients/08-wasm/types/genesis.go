@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/hex"
 	"time"
 
 	errorsmod "cosmossdk.io/errors"
@@ -14,8 +15,8 @@ import (
 )
 
 // NewGenesisState creates an 08-wasm GenesisState instance.
-func NewGenesisState(contracts []Contract) *GenesisState {
-	return &GenesisState{Contracts: contracts}
+func NewGenesisState(contracts []Contract, params Params) *GenesisState {
+	return &GenesisState{Contracts: contracts, Params: params}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
@@ -25,9 +26,29 @@ func (gs GenesisState) Validate() error {
 		if err := ValidateWasmCode(contract.CodeBytes); err != nil {
 			return errorsmod.Wrap(err, "wasm bytecode validation failed")
 		}
+
+		// contracts exported prior to the introduction of checksum lifecycle states are active
+		if contract.Status != ChecksumStatusUnspecified {
+			if err := contract.Status.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+
+	seen := make(map[string]bool, len(gs.ClientMigrations))
+	for _, migration := range gs.ClientMigrations {
+		if err := migration.Validate(); err != nil {
+			return errorsmod.Wrap(err, "invalid client migration")
+		}
+
+		fromChecksum := hex.EncodeToString(migration.FromChecksum)
+		if seen[fromChecksum] {
+			return errorsmod.Wrapf(ErrClientMigrationExists, "duplicate client migration from checksum %s", fromChecksum)
+		}
+		seen[fromChecksum] = true
 	}
 
-	return nil
+	return gs.Params.Validate()
 }
 
 // ExportMetadata exports all the consensus metadata in the client store so they
@@ -27,6 +27,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// uploaded light client wasm contracts
 	Contracts []Contract `protobuf:"bytes,1,rep,name=contracts,proto3" json:"contracts"`
+	// params defines the 08-wasm parameters
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// pending client migrations
+	ClientMigrations []ClientMigration `protobuf:"bytes,3,rep,name=client_migrations,json=clientMigrations,proto3" json:"client_migrations"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -69,10 +73,26 @@ func (m *GenesisState) GetContracts() []Contract {
 	return nil
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetClientMigrations() []ClientMigration {
+	if m != nil {
+		return m.ClientMigrations
+	}
+	return nil
+}
+
 // Contract stores contract code
 type Contract struct {
 	// contract byte code
 	CodeBytes []byte `protobuf:"bytes,1,opt,name=code_bytes,json=codeBytes,proto3" json:"code_bytes,omitempty"`
+	// lifecycle state of the contract checksum
+	Status ChecksumStatus `protobuf:"varint,2,opt,name=status,proto3,enum=ibc.lightclients.wasm.v1.ChecksumStatus" json:"status,omitempty"`
 }
 
 func (m *Contract) Reset()         { *m = Contract{} }
@@ -118,24 +138,30 @@ func init() {
 }
 
 var fileDescriptor_05e250654f164e20 = []byte{
-	// 261 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcb, 0x4c, 0x4a, 0xd6,
-	0xcf, 0xc9, 0x4c, 0xcf, 0x28, 0x49, 0xce, 0xc9, 0x4c, 0xcd, 0x2b, 0x29, 0xd6, 0x2f, 0x4f, 0x2c,
-	0xce, 0xd5, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0x92, 0xc8, 0x4c, 0x4a, 0xd6, 0x43, 0x56, 0xa7, 0x07, 0x52, 0xa7, 0x57, 0x66,
-	0x28, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xa4, 0x0f, 0x62, 0x41, 0xd4, 0x2b, 0x85, 0x71,
-	0xf1, 0xb8, 0x43, 0x0c, 0x08, 0x2e, 0x49, 0x2c, 0x49, 0x15, 0x72, 0xe3, 0xe2, 0x4c, 0xce, 0xcf,
-	0x2b, 0x29, 0x4a, 0x4c, 0x2e, 0x29, 0x96, 0x60, 0x54, 0x60, 0xd6, 0xe0, 0x36, 0x52, 0xd2, 0xc3,
-	0x65, 0xa6, 0x9e, 0x33, 0x54, 0xa9, 0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41, 0x08, 0xad, 0x4a,
-	0xfa, 0x5c, 0x1c, 0x30, 0x49, 0x21, 0x59, 0x2e, 0xae, 0xe4, 0xfc, 0x94, 0xd4, 0xf8, 0xa4, 0xca,
-	0x92, 0x54, 0x90, 0xa1, 0x8c, 0x1a, 0x3c, 0x20, 0xa5, 0x29, 0xa9, 0x4e, 0x20, 0x01, 0x2b, 0x96,
-	0x8e, 0x05, 0xf2, 0x0c, 0x4e, 0x61, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0,
-	0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10,
-	0x65, 0x93, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x9f, 0x9c, 0x5f, 0x9c,
-	0x9b, 0x5f, 0xac, 0x9f, 0x99, 0x94, 0xac, 0x9b, 0x9e, 0xaf, 0x9f, 0x9b, 0x9f, 0x52, 0x9a, 0x93,
-	0x5a, 0x0c, 0x09, 0x17, 0x5d, 0x58, 0xc0, 0x18, 0x58, 0xe8, 0x82, 0xc3, 0xa6, 0xa4, 0xb2, 0x20,
-	0xb5, 0x38, 0x89, 0x0d, 0xec, 0x4f, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x06, 0xb3, 0x17,
-	0x15, 0x41, 0x01, 0x00, 0x00,
+	// 358 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xc1, 0x4a, 0xeb, 0x40,
+	0x14, 0x86, 0x93, 0xdb, 0x52, 0xee, 0x9d, 0x96, 0x8b, 0x06, 0x17, 0xa1, 0x60, 0x5a, 0x2a, 0x48,
+	0x5c, 0x34, 0x63, 0xeb, 0x46, 0x44, 0x44, 0x2a, 0xe8, 0x4a, 0x90, 0x16, 0x5c, 0x88, 0x50, 0x26,
+	0xd3, 0x61, 0x3a, 0xd8, 0xc9, 0xd4, 0x9c, 0x49, 0xa5, 0x6f, 0xe0, 0xd2, 0x47, 0xf0, 0x71, 0xba,
+	0xec, 0xd2, 0x95, 0x48, 0xfb, 0x0a, 0x3e, 0x80, 0x64, 0x92, 0xa2, 0x2e, 0xda, 0xdd, 0xcc, 0xe1,
+	0xfb, 0xff, 0x73, 0x7e, 0x7e, 0xb4, 0x2f, 0x42, 0x8a, 0x47, 0x82, 0x0f, 0x35, 0x1d, 0x09, 0x16,
+	0x69, 0xc0, 0x4f, 0x04, 0x24, 0x9e, 0xb4, 0x30, 0x67, 0x11, 0x03, 0x01, 0xc1, 0x38, 0x56, 0x5a,
+	0x39, 0xae, 0x08, 0x69, 0xf0, 0x93, 0x0b, 0x52, 0x2e, 0x98, 0xb4, 0xaa, 0x3b, 0x5c, 0x71, 0x65,
+	0x20, 0x9c, 0xbe, 0x32, 0xbe, 0xba, 0xb7, 0xd6, 0xd7, 0xe8, 0x0c, 0xd4, 0xf8, 0xb4, 0x51, 0xe5,
+	0x2a, 0x5b, 0xd3, 0xd3, 0x44, 0x33, 0xe7, 0x12, 0xfd, 0xa3, 0x2a, 0xd2, 0x31, 0xa1, 0x1a, 0x5c,
+	0xbb, 0x5e, 0xf0, 0xcb, 0xed, 0x46, 0xb0, 0x6e, 0x73, 0x70, 0x91, 0xa3, 0x9d, 0xe2, 0xec, 0xbd,
+	0x66, 0x75, 0xbf, 0xa5, 0xce, 0x19, 0x2a, 0x8d, 0x49, 0x4c, 0x24, 0xb8, 0x7f, 0xea, 0xb6, 0x5f,
+	0x6e, 0xd7, 0xd7, 0x9b, 0xdc, 0x18, 0x2e, 0xb7, 0xc8, 0x55, 0xce, 0x3d, 0xda, 0xce, 0xb8, 0xbe,
+	0x14, 0x3c, 0x26, 0x5a, 0xa8, 0x08, 0xdc, 0x82, 0xb9, 0xe7, 0x60, 0xc3, 0x3d, 0xe6, 0x7f, 0xbd,
+	0x52, 0xe4, 0x9e, 0x5b, 0xf4, 0xf7, 0x18, 0x1a, 0x8f, 0xe8, 0xef, 0xea, 0x74, 0x67, 0x17, 0x21,
+	0xaa, 0x06, 0xac, 0x1f, 0x4e, 0x35, 0x4b, 0x23, 0xdb, 0x7e, 0x25, 0x0d, 0x32, 0x60, 0x9d, 0x74,
+	0xe0, 0x9c, 0xa3, 0x12, 0x68, 0xa2, 0x93, 0x2c, 0xc8, 0xff, 0xb6, 0xbf, 0x61, 0xfb, 0x90, 0xd1,
+	0x07, 0x48, 0x64, 0xcf, 0xf0, 0xdd, 0x5c, 0x77, 0x52, 0x7c, 0x7e, 0xad, 0x59, 0x9d, 0xdb, 0xd9,
+	0xc2, 0xb3, 0xe7, 0x0b, 0xcf, 0xfe, 0x58, 0x78, 0xf6, 0xcb, 0xd2, 0xb3, 0xe6, 0x4b, 0xcf, 0x7a,
+	0x5b, 0x7a, 0xd6, 0xdd, 0x29, 0x17, 0x7a, 0x98, 0x84, 0x01, 0x55, 0x12, 0x53, 0x05, 0x52, 0x01,
+	0x16, 0x21, 0x6d, 0x72, 0x85, 0xa5, 0x1a, 0x24, 0x23, 0x06, 0x59, 0x8b, 0xcd, 0x55, 0x8d, 0x87,
+	0xc7, 0x4d, 0xd3, 0xa4, 0x9e, 0x8e, 0x19, 0x84, 0x25, 0x53, 0xe4, 0xd1, 0xd7, 0x00, 0xc3, 0xcc,
+	0x11, 0xf0, 0x47, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -158,6 +184,30 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ClientMigrations) > 0 {
+		for iNdEx := len(m.ClientMigrations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClientMigrations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.Contracts) > 0 {
 		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -195,6 +245,11 @@ func (m *Contract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Status != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.CodeBytes) > 0 {
 		i -= len(m.CodeBytes)
 		copy(dAtA[i:], m.CodeBytes)
@@ -228,6 +283,14 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ClientMigrations) > 0 {
+		for _, e := range m.ClientMigrations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -241,6 +304,9 @@ func (m *Contract) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if m.Status != 0 {
+		n += 1 + sovGenesis(uint64(m.Status))
+	}
 	return n
 }
 
@@ -313,6 +379,73 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientMigrations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientMigrations = append(m.ClientMigrations, ClientMigration{})
+			if err := m.ClientMigrations[len(m.ClientMigrations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -397,6 +530,25 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				m.CodeBytes = []byte{}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ChecksumStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
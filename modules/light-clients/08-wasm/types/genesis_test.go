@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"bytes"
 	"encoding/json"
 
 	wasmvm "github.com/CosmWasm/wasmvm"
@@ -34,6 +35,48 @@ func (suite *TypesTestSuite) TestValidateGenesis() {
 			},
 			false,
 		},
+		{
+			"valid genesis with checksum status and client migration",
+			&types.GenesisState{
+				Contracts:        []types.Contract{{CodeBytes: []byte{1}, Status: types.ChecksumStatusDeprecated}},
+				ClientMigrations: []types.ClientMigration{types.NewClientMigration(make([]byte, 32), bytes.Repeat([]byte{1}, 32), []byte("{}"))},
+			},
+			true,
+		},
+		{
+			"invalid checksum status",
+			&types.GenesisState{
+				Contracts: []types.Contract{{CodeBytes: []byte{1}, Status: types.ChecksumStatus(10)}},
+			},
+			false,
+		},
+		{
+			"invalid client migration",
+			&types.GenesisState{
+				Contracts:        []types.Contract{{CodeBytes: []byte{1}}},
+				ClientMigrations: []types.ClientMigration{types.NewClientMigration(make([]byte, 32), make([]byte, 32), []byte("{}"))},
+			},
+			false,
+		},
+		{
+			"duplicate client migration",
+			&types.GenesisState{
+				Contracts: []types.Contract{{CodeBytes: []byte{1}}},
+				ClientMigrations: []types.ClientMigration{
+					types.NewClientMigration(make([]byte, 32), bytes.Repeat([]byte{1}, 32), []byte("{}")),
+					types.NewClientMigration(make([]byte, 32), bytes.Repeat([]byte{2}, 32), []byte("{}")),
+				},
+			},
+			false,
+		},
+		{
+			"invalid params",
+			&types.GenesisState{
+				Contracts: []types.Contract{{CodeBytes: []byte{1}}},
+				Params:    types.NewParams(0, []types.ContractGasLimit{types.NewContractGasLimit([]byte{1}, 1)}, 0, 0, 0, 10),
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
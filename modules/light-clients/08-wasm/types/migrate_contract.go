@@ -13,7 +13,7 @@ import (
 
 // MigrateContract calls the migrate entry point on the contract with the given
 // migrateMsg. The contract must exist and the checksum must be found in the
-// store. If the checksum is the same as the current checksum, an error is returned.
+// store and be active. If the checksum is the same as the current checksum, an error is returned.
 // This does not update the checksum in the client state.
 func (cs ClientState) MigrateContract(
 	ctx sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore,
@@ -23,6 +23,10 @@ func (cs ClientState) MigrateContract(
 		return ErrWasmChecksumNotFound
 	}
 
+	if err := validateChecksumActive(ctx, newChecksum); err != nil {
+		return err
+	}
+
 	if bytes.Equal(cs.Checksum, newChecksum) {
 		return errorsmod.Wrapf(ErrWasmCodeExists, "new checksum (%s) is the same as current checksum (%s)", hex.EncodeToString(newChecksum), hex.EncodeToString(cs.Checksum))
 	}
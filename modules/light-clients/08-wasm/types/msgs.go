@@ -12,9 +12,11 @@ var (
 	_ sdk.Msg              = (*MsgStoreCode)(nil)
 	_ sdk.Msg              = (*MsgMigrateContract)(nil)
 	_ sdk.Msg              = (*MsgRemoveChecksum)(nil)
+	_ sdk.Msg              = (*MsgUpdateParams)(nil)
 	_ sdk.HasValidateBasic = (*MsgStoreCode)(nil)
 	_ sdk.HasValidateBasic = (*MsgMigrateContract)(nil)
 	_ sdk.HasValidateBasic = (*MsgRemoveChecksum)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdateParams)(nil)
 )
 
 // MsgStoreCode creates a new MsgStoreCode instance
@@ -92,3 +94,64 @@ func (m MsgMigrateContract) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgUpdateParams creates a new MsgUpdateParams instance
+func NewMsgUpdateParams(signer string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Signer: signer,
+		Params: params,
+	}
+}
+
+// ValidateBasic implements sdk.HasValidateBasic
+func (m MsgUpdateParams) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return m.Params.Validate()
+}
+
+// NewMsgUpdateChecksumStatus creates a new MsgUpdateChecksumStatus instance
+func NewMsgUpdateChecksumStatus(signer string, checksum []byte, status ChecksumStatus) *MsgUpdateChecksumStatus {
+	return &MsgUpdateChecksumStatus{
+		Signer:   signer,
+		Checksum: checksum,
+		Status:   status,
+	}
+}
+
+// ValidateBasic implements sdk.HasValidateBasic
+func (m MsgUpdateChecksumStatus) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if err := ValidateWasmChecksum(m.Checksum); err != nil {
+		return err
+	}
+
+	return m.Status.Validate()
+}
+
+// NewMsgMigrateAllClients creates a new MsgMigrateAllClients instance
+func NewMsgMigrateAllClients(signer string, fromChecksum, toChecksum, migrateMsg []byte) *MsgMigrateAllClients {
+	return &MsgMigrateAllClients{
+		Signer:       signer,
+		FromChecksum: fromChecksum,
+		ToChecksum:   toChecksum,
+		Msg:          migrateMsg,
+	}
+}
+
+// ValidateBasic implements sdk.HasValidateBasic
+func (m MsgMigrateAllClients) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return NewClientMigration(m.FromChecksum, m.ToChecksum, m.Msg).Validate()
+}
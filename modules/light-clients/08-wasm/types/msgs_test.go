@@ -266,3 +266,155 @@ func (suite *TypesTestSuite) TestMsgRemoveChecksumGetSigners() {
 		})
 	}
 }
+
+func TestMsgUpdateParamsValidateBasic(t *testing.T) {
+	signer := sdk.AccAddress(ibctesting.TestAccAddress).String()
+	checksum, err := types.CreateChecksum(wasmtesting.Code)
+	require.NoError(t, err, t.Name())
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgUpdateParams
+		expErr error
+	}{
+		{
+			"success: default params",
+			types.NewMsgUpdateParams(signer, types.DefaultParams()),
+			nil,
+		},
+		{
+			"success: contract gas limits",
+			types.NewMsgUpdateParams(signer, types.NewParams(1000, []types.ContractGasLimit{types.NewContractGasLimit(checksum, 500)}, 10_000, 100, 10, 10)),
+			nil,
+		},
+		{
+			"failure: invalid params",
+			types.NewMsgUpdateParams(signer, types.NewParams(1000, []types.ContractGasLimit{types.NewContractGasLimit(checksum, 0)}, 10_000, 100, 10, 10)),
+			types.ErrInvalidParams,
+		},
+		{
+			"failure: signer is invalid",
+			types.NewMsgUpdateParams(ibctesting.InvalidID, types.DefaultParams()),
+			ibcerrors.ErrInvalidAddress,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+
+		if tc.expErr == nil {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, tc.expErr, tc.name)
+		}
+	}
+}
+
+func TestMsgUpdateChecksumStatusValidateBasic(t *testing.T) {
+	signer := sdk.AccAddress(ibctesting.TestAccAddress).String()
+	checksum, err := types.CreateChecksum(wasmtesting.Code)
+	require.NoError(t, err, t.Name())
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgUpdateChecksumStatus
+		expErr error
+	}{
+		{
+			"success",
+			types.NewMsgUpdateChecksumStatus(signer, checksum, types.ChecksumStatusDeprecated),
+			nil,
+		},
+		{
+			"failure: unspecified status",
+			types.NewMsgUpdateChecksumStatus(signer, checksum, types.ChecksumStatusUnspecified),
+			types.ErrInvalidChecksumStatus,
+		},
+		{
+			"failure: unknown status",
+			types.NewMsgUpdateChecksumStatus(signer, checksum, types.ChecksumStatus(10)),
+			types.ErrInvalidChecksumStatus,
+		},
+		{
+			"failure: checksum is invalid",
+			types.NewMsgUpdateChecksumStatus(signer, []byte{1}, types.ChecksumStatusActive),
+			types.ErrInvalidChecksum,
+		},
+		{
+			"failure: signer is invalid",
+			types.NewMsgUpdateChecksumStatus(ibctesting.InvalidID, checksum, types.ChecksumStatusActive),
+			ibcerrors.ErrInvalidAddress,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+
+		if tc.expErr == nil {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, tc.expErr, tc.name)
+		}
+	}
+}
+
+func TestMsgMigrateAllClientsValidateBasic(t *testing.T) {
+	signer := sdk.AccAddress(ibctesting.TestAccAddress).String()
+	fromChecksum, err := types.CreateChecksum(wasmtesting.Code)
+	require.NoError(t, err, t.Name())
+	toChecksum, err := types.CreateChecksum(wasmtesting.CreateMockContract([]byte("MockByteCode-TestMsgMigrateAllClientsValidateBasic")))
+	require.NoError(t, err, t.Name())
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgMigrateAllClients
+		expErr error
+	}{
+		{
+			"success",
+			types.NewMsgMigrateAllClients(signer, fromChecksum, toChecksum, []byte("{}")),
+			nil,
+		},
+		{
+			"failure: same checksum",
+			types.NewMsgMigrateAllClients(signer, fromChecksum, fromChecksum, []byte("{}")),
+			types.ErrInvalidChecksum,
+		},
+		{
+			"failure: from checksum is invalid",
+			types.NewMsgMigrateAllClients(signer, []byte{1}, toChecksum, []byte("{}")),
+			types.ErrInvalidChecksum,
+		},
+		{
+			"failure: to checksum is invalid",
+			types.NewMsgMigrateAllClients(signer, fromChecksum, []byte{1}, []byte("{}")),
+			types.ErrInvalidChecksum,
+		},
+		{
+			"failure: empty migrate message",
+			types.NewMsgMigrateAllClients(signer, fromChecksum, toChecksum, nil),
+			ibcerrors.ErrInvalidRequest,
+		},
+		{
+			"failure: signer is invalid",
+			types.NewMsgMigrateAllClients(ibctesting.InvalidID, fromChecksum, toChecksum, []byte("{}")),
+			ibcerrors.ErrInvalidAddress,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+
+		if tc.expErr == nil {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, tc.expErr, tc.name)
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+	"slices"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+const (
+	// DefaultGasUsageHistoryBlocks is the default number of blocks for which the gas usage of each client is retained.
+	DefaultGasUsageHistoryBlocks uint64 = 100
+	// DefaultMaxClientMigrationsPerBlock is the default maximum number of clients migrated per block by the pending
+	// client migrations.
+	DefaultMaxClientMigrationsPerBlock uint64 = 10
+)
+
+// NewParams creates a new parameter configuration for the 08-wasm module.
+func NewParams(defaultContractGasLimit uint64, contractGasLimits []ContractGasLimit, blockGasLimit uint64, memoryCacheSize uint32, gasUsageHistoryBlocks, maxClientMigrationsPerBlock uint64) Params {
+	return Params{
+		DefaultContractGasLimit:     defaultContractGasLimit,
+		ContractGasLimits:           contractGasLimits,
+		BlockGasLimit:               blockGasLimit,
+		MemoryCacheSize:             memoryCacheSize,
+		GasUsageHistoryBlocks:       gasUsageHistoryBlocks,
+		MaxClientMigrationsPerBlock: maxClientMigrationsPerBlock,
+	}
+}
+
+// DefaultParams is the default parameter configuration for the 08-wasm module. Contract calls are
+// bounded only by the gas limit of the transaction.
+func DefaultParams() Params {
+	return NewParams(0, nil, 0, MemoryCacheSize, DefaultGasUsageHistoryBlocks, DefaultMaxClientMigrationsPerBlock)
+}
+
+// NewContractGasLimit creates a new ContractGasLimit instance.
+func NewContractGasLimit(checksum Checksum, gasLimit uint64) ContractGasLimit {
+	return ContractGasLimit{
+		Checksum: checksum,
+		GasLimit: gasLimit,
+	}
+}
+
+// Validate performs basic validation of the 08-wasm parameters.
+func (p Params) Validate() error {
+	seen := make(map[string]bool, len(p.ContractGasLimits))
+	for _, contractGasLimit := range p.ContractGasLimits {
+		if err := ValidateWasmChecksum(contractGasLimit.Checksum); err != nil {
+			return errorsmod.Wrap(ErrInvalidParams, err.Error())
+		}
+
+		checksum := hex.EncodeToString(contractGasLimit.Checksum)
+		if seen[checksum] {
+			return errorsmod.Wrapf(ErrInvalidParams, "duplicate gas limit for checksum %s", checksum)
+		}
+		seen[checksum] = true
+
+		if contractGasLimit.GasLimit == 0 {
+			return errorsmod.Wrapf(ErrInvalidParams, "gas limit for checksum %s cannot be zero", checksum)
+		}
+	}
+
+	seen = make(map[string]bool, len(p.ContractCapabilities))
+	for _, capabilities := range p.ContractCapabilities {
+		if err := capabilities.Validate(); err != nil {
+			return err
+		}
+
+		checksum := hex.EncodeToString(capabilities.Checksum)
+		if seen[checksum] {
+			return errorsmod.Wrapf(ErrInvalidParams, "duplicate capabilities for checksum %s", checksum)
+		}
+		seen[checksum] = true
+	}
+
+	return nil
+}
+
+// GetContractGasLimit returns the gas limit of a single call to the contract with the given checksum.
+// A value of zero signifies that calls are bounded only by the gas limit of the transaction.
+func (p Params) GetContractGasLimit(checksum Checksum) uint64 {
+	for _, contractGasLimit := range p.ContractGasLimits {
+		if bytes.Equal(contractGasLimit.Checksum, checksum) {
+			return contractGasLimit.GasLimit
+		}
+	}
+
+	return p.DefaultContractGasLimit
+}
+
+// GetCapabilities returns the capabilities granted to the contract with the given checksum.
+// False is returned if the contract has not been granted any capabilities.
+func (p Params) GetCapabilities(checksum Checksum) (ContractCapabilities, bool) {
+	for _, capabilities := range p.ContractCapabilities {
+		if bytes.Equal(capabilities.Checksum, checksum) {
+			return capabilities, true
+		}
+	}
+
+	return ContractCapabilities{}, false
+}
+
+// NewContractCapabilities creates a new ContractCapabilities instance.
+func NewContractCapabilities(checksum Checksum, allowedMessages []string, gasLimit uint64) ContractCapabilities {
+	return ContractCapabilities{
+		Checksum:        checksum,
+		AllowedMessages: allowedMessages,
+		GasLimit:        gasLimit,
+	}
+}
+
+// Validate performs basic validation of the capabilities granted to a contract.
+func (c ContractCapabilities) Validate() error {
+	if err := ValidateWasmChecksum(c.Checksum); err != nil {
+		return errorsmod.Wrap(ErrInvalidParams, err.Error())
+	}
+
+	checksum := hex.EncodeToString(c.Checksum)
+	if len(c.AllowedMessages) == 0 {
+		return errorsmod.Wrapf(ErrInvalidParams, "allowed messages for checksum %s cannot be empty", checksum)
+	}
+
+	seen := make(map[string]bool, len(c.AllowedMessages))
+	for _, typeURL := range c.AllowedMessages {
+		if !strings.HasPrefix(typeURL, "/") || strings.TrimSpace(typeURL) != typeURL {
+			return errorsmod.Wrapf(ErrInvalidParams, "invalid allowed message type URL %q for checksum %s", typeURL, checksum)
+		}
+
+		if seen[typeURL] {
+			return errorsmod.Wrapf(ErrInvalidParams, "duplicate allowed message %s for checksum %s", typeURL, checksum)
+		}
+		seen[typeURL] = true
+	}
+
+	if c.GasLimit == 0 {
+		return errorsmod.Wrapf(ErrInvalidParams, "capabilities gas limit for checksum %s cannot be zero", checksum)
+	}
+
+	return nil
+}
+
+// IsMessageAllowed returns true if the message with the given type URL may be dispatched by the contract.
+func (c ContractCapabilities) IsMessageAllowed(typeURL string) bool {
+	return slices.Contains(c.AllowedMessages, typeURL)
+}
+
+// Add returns the gas usage with the gas consumed by a single contract call at the given height added.
+func (u GasUsage) Add(height, gasUsed uint64) GasUsage {
+	return GasUsage{
+		Height:  height,
+		GasUsed: u.GasUsed + gasUsed,
+		Calls:   u.Calls + 1,
+	}
+}
@@ -0,0 +1,82 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	wasmtesting "github.com/cosmos/ibc-go/modules/light-clients/08-wasm/testing"
+	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
+)
+
+const msgSendTypeURL = "/cosmos.bank.v1beta1.MsgSend"
+
+// withCapabilities returns the default params with the given contract capabilities.
+func withCapabilities(capabilities ...types.ContractCapabilities) types.Params {
+	params := types.DefaultParams()
+	params.ContractCapabilities = capabilities
+	return params
+}
+
+func TestParamsValidate(t *testing.T) {
+	checksum, err := types.CreateChecksum(wasmtesting.Code)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name    string
+		params  types.Params
+		expPass bool
+	}{
+		{"success: default params", types.DefaultParams(), true},
+		{"success: contract gas limits", types.NewParams(1000, []types.ContractGasLimit{types.NewContractGasLimit(checksum, 500)}, 10_000, 100, 10, 10), true},
+		{"failure: invalid checksum", types.NewParams(1000, []types.ContractGasLimit{types.NewContractGasLimit([]byte{1}, 500)}, 0, 0, 0, 10), false},
+		{"failure: duplicate checksum", types.NewParams(1000, []types.ContractGasLimit{types.NewContractGasLimit(checksum, 500), types.NewContractGasLimit(checksum, 600)}, 0, 0, 0, 10), false},
+		{"failure: zero contract gas limit", types.NewParams(1000, []types.ContractGasLimit{types.NewContractGasLimit(checksum, 0)}, 0, 0, 0, 10), false},
+		{"success: contract capabilities", withCapabilities(types.NewContractCapabilities(checksum, []string{msgSendTypeURL, "/cosmos.bank.v1beta1.MsgMultiSend"}, 100_000)), true},
+		{"failure: contract capabilities invalid checksum", withCapabilities(types.NewContractCapabilities([]byte{1}, []string{msgSendTypeURL}, 100_000)), false},
+		{"failure: duplicate contract capabilities", withCapabilities(types.NewContractCapabilities(checksum, []string{msgSendTypeURL}, 100_000), types.NewContractCapabilities(checksum, []string{msgSendTypeURL}, 100_000)), false},
+		{"failure: empty allowed messages", withCapabilities(types.NewContractCapabilities(checksum, nil, 100_000)), false},
+		{"failure: invalid allowed message", withCapabilities(types.NewContractCapabilities(checksum, []string{"cosmos.bank.v1beta1.MsgSend"}, 100_000)), false},
+		{"failure: duplicate allowed message", withCapabilities(types.NewContractCapabilities(checksum, []string{msgSendTypeURL, msgSendTypeURL}, 100_000)), false},
+		{"failure: zero capabilities gas limit", withCapabilities(types.NewContractCapabilities(checksum, []string{msgSendTypeURL}, 0)), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.params.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, types.ErrInvalidParams, tc.name)
+		}
+	}
+}
+
+func TestParamsGetContractGasLimit(t *testing.T) {
+	checksum, err := types.CreateChecksum(wasmtesting.Code)
+	require.NoError(t, err)
+
+	otherChecksum, err := types.CreateChecksum(wasmtesting.CreateMockContract([]byte{1}))
+	require.NoError(t, err)
+
+	params := types.NewParams(1000, []types.ContractGasLimit{types.NewContractGasLimit(checksum, 500)}, 0, 0, 0, 10)
+	require.Equal(t, uint64(500), params.GetContractGasLimit(checksum))
+	require.Equal(t, uint64(1000), params.GetContractGasLimit(otherChecksum))
+}
+
+func TestParamsGetCapabilities(t *testing.T) {
+	checksum, err := types.CreateChecksum(wasmtesting.Code)
+	require.NoError(t, err)
+
+	otherChecksum, err := types.CreateChecksum(wasmtesting.CreateMockContract([]byte{1}))
+	require.NoError(t, err)
+
+	params := withCapabilities(types.NewContractCapabilities(checksum, []string{msgSendTypeURL}, 100_000))
+
+	capabilities, found := params.GetCapabilities(checksum)
+	require.True(t, found)
+	require.True(t, capabilities.IsMessageAllowed(msgSendTypeURL))
+	require.False(t, capabilities.IsMessageAllowed("/cosmos.bank.v1beta1.MsgMultiSend"))
+
+	_, found = params.GetCapabilities(otherChecksum)
+	require.False(t, found)
+}
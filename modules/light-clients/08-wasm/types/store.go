@@ -3,6 +3,7 @@ package types
 import (
 	"bytes"
 	"context"
+	"encoding/hex"
 	"errors"
 	"io"
 	"reflect"
@@ -11,6 +12,7 @@ import (
 	wasmvm "github.com/CosmWasm/wasmvm"
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 
+	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/store/cachekv"
 	storeprefix "cosmossdk.io/store/prefix"
@@ -72,6 +74,45 @@ func HasChecksum(ctx context.Context, checksum Checksum) bool {
 	return found
 }
 
+// GetChecksumStatus returns the lifecycle state of the given checksum. Checksums stored without
+// a lifecycle state, i.e. prior to the introduction of checksum lifecycle states, are active.
+// ChecksumStatusUnspecified is returned if the checksum is not found in the store.
+func GetChecksumStatus(ctx context.Context, checksum Checksum) (ChecksumStatus, error) {
+	if !HasChecksum(ctx, checksum) {
+		return ChecksumStatusUnspecified, nil
+	}
+
+	status, err := ibcwasm.ChecksumStatuses.Get(ctx, checksum)
+	if errors.Is(err, collections.ErrNotFound) {
+		return ChecksumStatusActive, nil
+	}
+	if err != nil {
+		return ChecksumStatusUnspecified, err
+	}
+
+	return ChecksumStatus(status), nil
+}
+
+// SetChecksumStatus sets the lifecycle state of the given checksum.
+func SetChecksumStatus(ctx context.Context, checksum Checksum, status ChecksumStatus) error {
+	return ibcwasm.ChecksumStatuses.Set(ctx, checksum, int32(status))
+}
+
+// validateChecksumActive returns an error if the given checksum is not active, in which case it may
+// not be used by new clients or as the target of a contract migration.
+func validateChecksumActive(ctx context.Context, checksum Checksum) error {
+	status, err := GetChecksumStatus(ctx, checksum)
+	if err != nil {
+		return err
+	}
+
+	if status != ChecksumStatusActive {
+		return errorsmod.Wrapf(ErrChecksumNotActive, "checksum (%s) has status %s", hex.EncodeToString(checksum), status)
+	}
+
+	return nil
+}
+
 // migrateClientWrappedStore combines two KVStores into one.
 //
 // Both stores are used for reads, but only the subjectStore is used for writes. For all operations, the key
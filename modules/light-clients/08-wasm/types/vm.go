@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"errors"
+	"math"
 
 	wasmvm "github.com/CosmWasm/wasmvm"
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
@@ -35,7 +36,6 @@ var (
 func instantiateContract(ctx sdk.Context, clientStore storetypes.KVStore, checksum Checksum, msg []byte) (*wasmvmtypes.Response, error) {
 	sdkGasMeter := ctx.GasMeter()
 	multipliedGasMeter := NewMultipliedGasMeter(sdkGasMeter, VMGasRegister)
-	gasLimit := VMGasRegister.runtimeGasForContract(ctx)
 
 	clientID, err := getClientID(clientStore)
 	if err != nil {
@@ -43,6 +43,11 @@ func instantiateContract(ctx sdk.Context, clientStore storetypes.KVStore, checks
 	}
 	env := getEnv(ctx, clientID)
 
+	gasLimit, err := contractGasLimit(ctx, checksum)
+	if err != nil {
+		return nil, err
+	}
+
 	msgInfo := wasmvmtypes.MessageInfo{
 		Sender: "",
 		Funds:  nil,
@@ -50,7 +55,9 @@ func instantiateContract(ctx sdk.Context, clientStore storetypes.KVStore, checks
 
 	ctx.GasMeter().ConsumeGas(VMGasRegister.NewContractInstanceCosts(true, len(msg)), "Loading CosmWasm module: instantiate")
 	response, gasUsed, err := ibcwasm.GetVM().Instantiate(checksum, env, msgInfo, msg, newStoreAdapter(clientStore), wasmvmAPI, newQueryHandler(ctx, clientID), multipliedGasMeter, gasLimit, costJSONDeserialization)
-	VMGasRegister.consumeRuntimeGas(ctx, gasUsed)
+	if consumeErr := consumeContractGas(ctx, clientID, gasUsed); consumeErr != nil {
+		return nil, consumeErr
+	}
 	return response, err
 }
 
@@ -58,7 +65,6 @@ func instantiateContract(ctx sdk.Context, clientStore storetypes.KVStore, checks
 func callContract(ctx sdk.Context, clientStore storetypes.KVStore, checksum Checksum, msg []byte) (*wasmvmtypes.Response, error) {
 	sdkGasMeter := ctx.GasMeter()
 	multipliedGasMeter := NewMultipliedGasMeter(sdkGasMeter, VMGasRegister)
-	gasLimit := VMGasRegister.runtimeGasForContract(ctx)
 
 	clientID, err := getClientID(clientStore)
 	if err != nil {
@@ -66,9 +72,16 @@ func callContract(ctx sdk.Context, clientStore storetypes.KVStore, checksum Chec
 	}
 	env := getEnv(ctx, clientID)
 
+	gasLimit, err := contractGasLimit(ctx, checksum)
+	if err != nil {
+		return nil, err
+	}
+
 	ctx.GasMeter().ConsumeGas(VMGasRegister.InstantiateContractCosts(true, len(msg)), "Loading CosmWasm module: sudo")
 	resp, gasUsed, err := ibcwasm.GetVM().Sudo(checksum, env, msg, newStoreAdapter(clientStore), wasmvmAPI, newQueryHandler(ctx, clientID), multipliedGasMeter, gasLimit, costJSONDeserialization)
-	VMGasRegister.consumeRuntimeGas(ctx, gasUsed)
+	if consumeErr := consumeContractGas(ctx, clientID, gasUsed); consumeErr != nil {
+		return nil, consumeErr
+	}
 	return resp, err
 }
 
@@ -90,7 +103,6 @@ func migrateContract(ctx sdk.Context, clientID string, clientStore storetypes.KV
 func queryContract(ctx sdk.Context, clientStore storetypes.KVStore, checksum Checksum, msg []byte) ([]byte, error) {
 	sdkGasMeter := ctx.GasMeter()
 	multipliedGasMeter := NewMultipliedGasMeter(sdkGasMeter, VMGasRegister)
-	gasLimit := VMGasRegister.runtimeGasForContract(ctx)
 
 	clientID, err := getClientID(clientStore)
 	if err != nil {
@@ -98,12 +110,57 @@ func queryContract(ctx sdk.Context, clientStore storetypes.KVStore, checksum Che
 	}
 	env := getEnv(ctx, clientID)
 
+	gasLimit, err := contractGasLimit(ctx, checksum)
+	if err != nil {
+		return nil, err
+	}
+
 	ctx.GasMeter().ConsumeGas(VMGasRegister.InstantiateContractCosts(true, len(msg)), "Loading CosmWasm module: query")
 	resp, gasUsed, err := ibcwasm.GetVM().Query(checksum, env, msg, newStoreAdapter(clientStore), wasmvmAPI, newQueryHandler(ctx, clientID), multipliedGasMeter, gasLimit, costJSONDeserialization)
-	VMGasRegister.consumeRuntimeGas(ctx, gasUsed)
+	if consumeErr := consumeContractGas(ctx, clientID, gasUsed); consumeErr != nil {
+		return nil, consumeErr
+	}
 	return resp, err
 }
 
+// contractGasLimit returns the gas limit, in wasm VM gas units, of an instantiate, sudo or query call to the contract
+// with the given checksum. The gas limit is bounded by the remaining gas of the transaction as well as the contract
+// and block gas limits of the 08-wasm params. Contract calls made outside of a store backed context, such as during
+// the export of genesis metadata, are not subject to the 08-wasm params.
+func contractGasLimit(ctx sdk.Context, checksum Checksum) (uint64, error) {
+	gasLimit := VMGasRegister.runtimeGasForContract(ctx)
+
+	gasManager := ibcwasm.GetGasManager()
+	if gasManager == nil || ctx.MultiStore() == nil {
+		return gasLimit, nil
+	}
+
+	contractGasLimit, err := gasManager.GetContractGasLimit(ctx, checksum)
+	if err != nil {
+		return 0, err
+	}
+
+	// gas limits which overflow when converted to wasm VM gas units do not bound the call further
+	if contractGasLimit != 0 && contractGasLimit <= math.MaxUint64/VMGasRegister.c.GasMultiplier {
+		gasLimit = min(gasLimit, VMGasRegister.ToWasmVMGas(contractGasLimit))
+	}
+
+	return gasLimit, nil
+}
+
+// consumeContractGas consumes the gas used by a contract call from the gas meter of the context and records
+// it as gas consumed by the client.
+func consumeContractGas(ctx sdk.Context, clientID string, gasUsed uint64) error {
+	VMGasRegister.consumeRuntimeGas(ctx, gasUsed)
+
+	gasManager := ibcwasm.GetGasManager()
+	if gasManager == nil || ctx.MultiStore() == nil {
+		return nil
+	}
+
+	return gasManager.ConsumeContractGas(ctx, clientID, VMGasRegister.FromWasmVMGas(gasUsed))
+}
+
 // wasmInstantiate accepts a message to instantiate a wasm contract, JSON encodes it and calls instantiateContract.
 func wasmInstantiate(ctx sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore, cs *ClientState, payload InstantiateMessage) error {
 	encodedData, err := json.Marshal(payload)
@@ -131,14 +188,15 @@ func wasmInstantiate(ctx sdk.Context, cdc codec.BinaryCodec, clientStore storety
 		return errorsmod.Wrapf(ErrWasmInvalidContractModification, "expected checksum %s, got %s", hex.EncodeToString(checksum), hex.EncodeToString(newClientState.Checksum))
 	}
 
-	return nil
+	return dispatchContractMessages(ctx, clientStore, checksum, resp.Messages)
 }
 
 // wasmSudo calls the contract with the given payload and returns the result.
 // wasmSudo returns an error if:
 // - the payload cannot be marshaled to JSON
 // - the contract call returns an error
-// - the response of the contract call contains non-empty messages
+// - the response of the contract call contains messages which the contract is not allowed to dispatch
+// - the execution of the messages contained in the response of the contract call fails
 // - the response of the contract call contains non-empty events
 // - the response of the contract call contains non-empty attributes
 // - the data bytes of the response cannot be unmarshaled into the result type
@@ -174,12 +232,18 @@ func wasmSudo[T ContractResult](ctx sdk.Context, cdc codec.BinaryCodec, clientSt
 		return result, errorsmod.Wrapf(ErrWasmInvalidContractModification, "expected checksum %s, got %s", hex.EncodeToString(checksum), hex.EncodeToString(newClientState.Checksum))
 	}
 
+	if err := dispatchContractMessages(ctx, clientStore, checksum, resp.Messages); err != nil {
+		return result, err
+	}
+
 	return result, nil
 }
 
 // wasmMigrate migrate calls the migrate entry point of the contract with the given payload and returns the result.
 // wasmMigrate returns an error if:
 // - the contract migration returns an error
+// - the response of the contract migration contains messages which the contract is not allowed to dispatch
+// - the execution of the messages contained in the response of the contract migration fails
 func wasmMigrate(ctx sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore, cs *ClientState, clientID string, payload []byte) error {
 	resp, err := migrateContract(ctx, clientID, clientStore, cs.Checksum, payload)
 	if err != nil {
@@ -190,8 +254,42 @@ func wasmMigrate(ctx sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.
 		return errorsmod.Wrapf(err, "checksum (%s)", hex.EncodeToString(cs.Checksum))
 	}
 
-	_, err = validatePostExecutionClientState(clientStore, cdc)
-	return err
+	if _, err = validatePostExecutionClientState(clientStore, cdc); err != nil {
+		return err
+	}
+
+	return dispatchMessages(ctx, clientID, cs.Checksum, resp.Messages)
+}
+
+// dispatchContractMessages dispatches the messages returned by a call to the contract with the given checksum
+// on behalf of the client owning the client store.
+func dispatchContractMessages(ctx sdk.Context, clientStore storetypes.KVStore, checksum Checksum, msgs []wasmvmtypes.SubMsg) error {
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	clientID, err := getClientID(clientStore)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to retrieve clientID for dispatching wasm contract messages")
+	}
+
+	return dispatchMessages(ctx, clientID, checksum, msgs)
+}
+
+// dispatchMessages dispatches the messages returned by a call to the contract with the given checksum on
+// behalf of the given client. Messages may only be dispatched by contracts which have been granted the
+// capability to do so through the 08-wasm params.
+func dispatchMessages(ctx sdk.Context, clientID string, checksum Checksum, msgs []wasmvmtypes.SubMsg) error {
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	dispatcher := ibcwasm.GetMessageDispatcher()
+	if dispatcher == nil || ctx.MultiStore() == nil {
+		return errorsmod.Wrapf(ErrWasmSubMessagesNotAllowed, "checksum (%s)", hex.EncodeToString(checksum))
+	}
+
+	return dispatcher.DispatchMessages(ctx, clientID, checksum, msgs)
 }
 
 // wasmQuery queries the contract with the given payload and returns the result.
@@ -296,12 +394,10 @@ func canonicalAddress(human string) ([]byte, uint64, error) {
 }
 
 // checkResponse returns an error if the response from a sudo, instantiate or migrate call
-// to the Wasm VM contains messages, events or attributes.
+// to the Wasm VM contains events or attributes. Messages are checked against the capabilities
+// granted to the contract when they are dispatched.
 func checkResponse(response *wasmvmtypes.Response) error {
-	// Only allow Data to flow back to us. SubMessages, Events and Attributes are not allowed.
-	if len(response.Messages) > 0 {
-		return ErrWasmSubMessagesNotAllowed
-	}
+	// Only allow Data and SubMessages to flow back to us. Events and Attributes are not allowed.
 	if len(response.Events) > 0 {
 		return ErrWasmEventsNotAllowed
 	}
@@ -2,10 +2,14 @@ package types_test
 
 import (
 	"encoding/json"
+	"math"
 
 	wasmvm "github.com/CosmWasm/wasmvm"
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
 	wasmtesting "github.com/cosmos/ibc-go/modules/light-clients/08-wasm/testing"
 	"github.com/cosmos/ibc-go/modules/light-clients/08-wasm/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
@@ -57,10 +61,17 @@ func (suite *TypesTestSuite) TestWasmInstantiate() {
 			types.ErrWasmContractCallFailed,
 		},
 		{
-			"failure: contract returns non-empty messages",
+			"failure: contract returns messages without capabilities",
 			func() {
-				suite.mockVM.InstantiateFn = func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ wasmvmtypes.MessageInfo, _ []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
-					resp := wasmvmtypes.Response{Messages: []wasmvmtypes.SubMsg{{}}}
+				suite.mockVM.InstantiateFn = func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ wasmvmtypes.MessageInfo, initMsg []byte, store wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+					var payload types.InstantiateMessage
+					err := json.Unmarshal(initMsg, &payload)
+					suite.Require().NoError(err)
+
+					clientState := types.NewClientState(payload.ClientState, payload.Checksum, clienttypes.NewHeight(0, 1))
+					store.Set(host.ClientStateKey(), clienttypes.MustMarshalClientState(suite.chainA.App.AppCodec(), clientState))
+
+					resp := wasmvmtypes.Response{Messages: []wasmvmtypes.SubMsg{{ReplyOn: wasmvmtypes.ReplyNever}}}
 
 					return &resp, wasmtesting.DefaultGasUsed, nil
 				}
@@ -208,10 +219,10 @@ func (suite *TypesTestSuite) TestWasmMigrate() {
 			types.ErrWasmContractCallFailed,
 		},
 		{
-			"failure: contract returns non-empty messages",
+			"failure: contract returns messages without capabilities",
 			func() {
 				suite.mockVM.MigrateFn = func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, store wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
-					resp := wasmvmtypes.Response{Messages: []wasmvmtypes.SubMsg{{}}}
+					resp := wasmvmtypes.Response{Messages: []wasmvmtypes.SubMsg{{ReplyOn: wasmvmtypes.ReplyNever}}}
 
 					return &resp, wasmtesting.DefaultGasUsed, nil
 				}
@@ -414,16 +425,56 @@ func (suite *TypesTestSuite) TestWasmSudo() {
 			types.ErrWasmContractCallFailed,
 		},
 		{
-			"failure: contract returns non-empty messages",
+			"failure: contract returns messages without capabilities",
 			func() {
 				suite.mockVM.RegisterSudoCallback(types.UpdateStateMsg{}, func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
-					resp := wasmvmtypes.Response{Messages: []wasmvmtypes.SubMsg{{}}}
+					data, err := json.Marshal(types.UpdateStateResult{})
+					suite.Require().NoError(err)
+
+					resp := wasmvmtypes.Response{Data: data, Messages: []wasmvmtypes.SubMsg{{ReplyOn: wasmvmtypes.ReplyNever}}}
 
 					return &resp, wasmtesting.DefaultGasUsed, nil
 				})
 			},
 			types.ErrWasmSubMessagesNotAllowed,
 		},
+		{
+			"success: contract returns messages allowed by its capabilities",
+			func() {
+				ctx := suite.chainA.GetContext()
+				clientAddress := types.ClientAddress(defaultWasmClientID)
+				coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+
+				err := GetSimApp(suite.chainA).BankKeeper.SendCoins(ctx, suite.chainA.SenderAccount.GetAddress(), clientAddress, coins)
+				suite.Require().NoError(err)
+
+				params := GetSimApp(suite.chainA).WasmClientKeeper.GetParams(ctx)
+				params.ContractCapabilities = []types.ContractCapabilities{
+					types.NewContractCapabilities(suite.checksum, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}, 1_000_000),
+				}
+				GetSimApp(suite.chainA).WasmClientKeeper.SetParams(ctx, params)
+
+				suite.mockVM.RegisterSudoCallback(types.UpdateStateMsg{}, func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+					data, err := json.Marshal(types.UpdateStateResult{})
+					suite.Require().NoError(err)
+
+					msg := wasmvmtypes.SubMsg{
+						Msg: wasmvmtypes.CosmosMsg{
+							Bank: &wasmvmtypes.BankMsg{
+								Send: &wasmvmtypes.SendMsg{
+									ToAddress: suite.chainA.SenderAccount.GetAddress().String(),
+									Amount:    wasmvmtypes.Coins{wasmvmtypes.NewCoin(100, sdk.DefaultBondDenom)},
+								},
+							},
+						},
+						ReplyOn: wasmvmtypes.ReplyNever,
+					}
+
+					return &wasmvmtypes.Response{Data: data, Messages: []wasmvmtypes.SubMsg{msg}}, wasmtesting.DefaultGasUsed, nil
+				})
+			},
+			nil,
+		},
 		{
 			"failure: contract returns non-empty events",
 			func() {
@@ -547,3 +598,112 @@ func (suite *TypesTestSuite) TestWasmSudo() {
 		})
 	}
 }
+
+func (suite *TypesTestSuite) TestContractGasLimits() {
+	var (
+		params      types.Params
+		expGasLimit uint64
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: no gas limits",
+			func() {
+				expGasLimit = math.MaxUint64
+			},
+			nil,
+		},
+		{
+			"success: default contract gas limit",
+			func() {
+				params.DefaultContractGasLimit = 1000
+				expGasLimit = types.VMGasRegister.ToWasmVMGas(1000)
+			},
+			nil,
+		},
+		{
+			"success: checksum gas limit overrides default contract gas limit",
+			func() {
+				params.DefaultContractGasLimit = 1000
+				params.ContractGasLimits = []types.ContractGasLimit{types.NewContractGasLimit(suite.checksum, 500)}
+				expGasLimit = types.VMGasRegister.ToWasmVMGas(500)
+			},
+			nil,
+		},
+		{
+			"success: contract gas limit bounded by block gas limit",
+			func() {
+				params.DefaultContractGasLimit = 1000
+				params.BlockGasLimit = 300
+				expGasLimit = types.VMGasRegister.ToWasmVMGas(300)
+			},
+			nil,
+		},
+		{
+			"failure: block gas limit exhausted",
+			func() {
+				params.BlockGasLimit = 300
+
+				err := GetSimApp(suite.chainA).WasmClientKeeper.ConsumeContractGas(suite.chainA.GetContext(), defaultWasmClientID, 300)
+				suite.Require().NoError(err)
+			},
+			types.ErrBlockGasLimitExceeded,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupWasmWithMockVM()
+
+			endpoint := wasmtesting.NewWasmEndpoint(suite.chainA)
+			err := endpoint.CreateClient()
+			suite.Require().NoError(err)
+
+			params = types.DefaultParams()
+
+			tc.malleate()
+
+			wasmClientKeeper := GetSimApp(suite.chainA).WasmClientKeeper
+			wasmClientKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			gasUsed := types.VMGasRegister.ToWasmVMGas(100)
+			suite.mockVM.RegisterQueryCallback(types.StatusMsg{}, func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, gasLimit uint64, _ wasmvmtypes.UFraction) ([]byte, uint64, error) {
+				suite.Require().Equal(expGasLimit, gasLimit)
+
+				resp, err := json.Marshal(types.StatusResult{Status: exported.Active.String()})
+				suite.Require().NoError(err)
+
+				return resp, gasUsed, nil
+			})
+
+			clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), endpoint.ClientID)
+			wasmClientState, ok := endpoint.GetClientState().(*types.ClientState)
+			suite.Require().True(ok)
+
+			totalBefore, err := wasmClientKeeper.GetClientGasUsage(suite.chainA.GetContext(), endpoint.ClientID)
+			suite.Require().NoError(err)
+
+			_, err = types.WasmQuery[types.StatusResult](suite.chainA.GetContext(), clientStore, wasmClientState, types.QueryMsg{Status: &types.StatusMsg{}})
+
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+
+				total, err := wasmClientKeeper.GetClientGasUsage(suite.chainA.GetContext(), endpoint.ClientID)
+				suite.Require().NoError(err)
+				suite.Require().Equal(totalBefore.GasUsed+100, total.GasUsed)
+				suite.Require().Equal(totalBefore.Calls+1, total.Calls)
+				suite.Require().Equal(uint64(suite.chainA.GetContext().BlockHeight()), total.Height)
+			} else {
+				// contract call errors are wrapped as ErrWasmContractCallFailed
+				suite.Require().ErrorIs(err, types.ErrWasmContractCallFailed)
+				suite.Require().ErrorContains(err, tc.expError.Error())
+			}
+		})
+	}
+}
@@ -24,6 +24,42 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ChecksumStatus defines the lifecycle state of a stored checksum.
+type ChecksumStatus int32
+
+const (
+	// Default zero value enumeration
+	ChecksumStatusUnspecified ChecksumStatus = 0
+	// The code has been stored for review, clients may not be created with or migrated to the code.
+	ChecksumStatusStaging ChecksumStatus = 1
+	// The code may be used by new and existing clients.
+	ChecksumStatusActive ChecksumStatus = 2
+	// The code may only be used by existing clients, clients may not be created with or migrated to the code.
+	ChecksumStatusDeprecated ChecksumStatus = 3
+)
+
+var ChecksumStatus_name = map[int32]string{
+	0: "CHECKSUM_STATUS_UNSPECIFIED",
+	1: "CHECKSUM_STATUS_STAGING",
+	2: "CHECKSUM_STATUS_ACTIVE",
+	3: "CHECKSUM_STATUS_DEPRECATED",
+}
+
+var ChecksumStatus_value = map[string]int32{
+	"CHECKSUM_STATUS_UNSPECIFIED": 0,
+	"CHECKSUM_STATUS_STAGING":     1,
+	"CHECKSUM_STATUS_ACTIVE":      2,
+	"CHECKSUM_STATUS_DEPRECATED":  3,
+}
+
+func (x ChecksumStatus) String() string {
+	return proto.EnumName(ChecksumStatus_name, int32(x))
+}
+
+func (ChecksumStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_678928ebbdee1807, []int{0}
+}
+
 // Wasm light client's Client state
 type ClientState struct {
 	// bytes encoding the client state of the underlying light client
@@ -194,11 +230,402 @@ func (m *Checksums) GetChecksums() [][]byte {
 	return nil
 }
 
+// Params defines the governance controlled parameters of the 08-wasm light client module.
+type Params struct {
+	// default_contract_gas_limit is the maximum amount of gas, in SDK gas units, which may be consumed
+	// by a single instantiate, sudo or query call to a light client contract. A value of zero leaves
+	// contract calls bounded only by the gas limit of the transaction.
+	DefaultContractGasLimit uint64 `protobuf:"varint,1,opt,name=default_contract_gas_limit,json=defaultContractGasLimit,proto3" json:"default_contract_gas_limit,omitempty"`
+	// contract_gas_limits overrides the default contract gas limit for the contracts with the given checksums.
+	ContractGasLimits []ContractGasLimit `protobuf:"bytes,2,rep,name=contract_gas_limits,json=contractGasLimits,proto3" json:"contract_gas_limits"`
+	// block_gas_limit is the aggregate amount of gas, in SDK gas units, which may be consumed by calls
+	// to light client contracts within a single block. A value of zero disables the block gas limit.
+	BlockGasLimit uint64 `protobuf:"varint,3,opt,name=block_gas_limit,json=blockGasLimit,proto3" json:"block_gas_limit,omitempty"`
+	// memory_cache_size is the size of the in-memory cache of the wasm VM in MiB. It is applied by nodes
+	// when the wasm VM instantiated by 08-wasm is initialized, i.e. upon restart.
+	MemoryCacheSize uint32 `protobuf:"varint,4,opt,name=memory_cache_size,json=memoryCacheSize,proto3" json:"memory_cache_size,omitempty"`
+	// gas_usage_history_blocks is the number of blocks for which the gas usage of each client is retained.
+	// A value of zero disables the gas usage history, the total gas usage of each client is always tracked.
+	GasUsageHistoryBlocks uint64 `protobuf:"varint,5,opt,name=gas_usage_history_blocks,json=gasUsageHistoryBlocks,proto3" json:"gas_usage_history_blocks,omitempty"`
+	// max_client_migrations_per_block is the maximum number of clients migrated per block by the pending
+	// client migrations initiated through MsgMigrateAllClients.
+	MaxClientMigrationsPerBlock uint64 `protobuf:"varint,6,opt,name=max_client_migrations_per_block,json=maxClientMigrationsPerBlock,proto3" json:"max_client_migrations_per_block,omitempty"`
+	// contract_capabilities grants the light client contracts with the given checksums the capability to
+	// dispatch messages to the chain. Messages returned by contracts without a capability grant are rejected.
+	ContractCapabilities []ContractCapabilities `protobuf:"bytes,7,rep,name=contract_capabilities,json=contractCapabilities,proto3" json:"contract_capabilities"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678928ebbdee1807, []int{4}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetDefaultContractGasLimit() uint64 {
+	if m != nil {
+		return m.DefaultContractGasLimit
+	}
+	return 0
+}
+
+func (m *Params) GetContractGasLimits() []ContractGasLimit {
+	if m != nil {
+		return m.ContractGasLimits
+	}
+	return nil
+}
+
+func (m *Params) GetBlockGasLimit() uint64 {
+	if m != nil {
+		return m.BlockGasLimit
+	}
+	return 0
+}
+
+func (m *Params) GetMemoryCacheSize() uint32 {
+	if m != nil {
+		return m.MemoryCacheSize
+	}
+	return 0
+}
+
+func (m *Params) GetGasUsageHistoryBlocks() uint64 {
+	if m != nil {
+		return m.GasUsageHistoryBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMaxClientMigrationsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxClientMigrationsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetContractCapabilities() []ContractCapabilities {
+	if m != nil {
+		return m.ContractCapabilities
+	}
+	return nil
+}
+
+// ContractGasLimit defines the gas limit of calls to the light client contract with the given checksum.
+type ContractGasLimit struct {
+	// checksum is the sha256 hash of the contract code.
+	Checksum []byte `protobuf:"bytes,1,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	// gas_limit is the maximum amount of gas, in SDK gas units, which may be consumed by a single call.
+	GasLimit uint64 `protobuf:"varint,2,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+}
+
+func (m *ContractGasLimit) Reset()         { *m = ContractGasLimit{} }
+func (m *ContractGasLimit) String() string { return proto.CompactTextString(m) }
+func (*ContractGasLimit) ProtoMessage()    {}
+func (*ContractGasLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678928ebbdee1807, []int{5}
+}
+func (m *ContractGasLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractGasLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractGasLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractGasLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractGasLimit.Merge(m, src)
+}
+func (m *ContractGasLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractGasLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractGasLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractGasLimit proto.InternalMessageInfo
+
+func (m *ContractGasLimit) GetChecksum() []byte {
+	if m != nil {
+		return m.Checksum
+	}
+	return nil
+}
+
+func (m *ContractGasLimit) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+// ContractCapabilities defines the messages the light client contract with the given checksum may dispatch
+// to the chain. Messages are dispatched on behalf of the client which called the contract.
+type ContractCapabilities struct {
+	// checksum is the sha256 hash of the contract code.
+	Checksum []byte `protobuf:"bytes,1,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	// allowed_messages is the list of type URLs of the messages which may be dispatched,
+	// e.g. /cosmos.bank.v1beta1.MsgSend.
+	AllowedMessages []string `protobuf:"bytes,2,rep,name=allowed_messages,json=allowedMessages,proto3" json:"allowed_messages,omitempty"`
+	// gas_limit is the maximum amount of gas, in SDK gas units, which may be consumed by all messages
+	// dispatched in response to a single contract call.
+	GasLimit uint64 `protobuf:"varint,3,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+}
+
+func (m *ContractCapabilities) Reset()         { *m = ContractCapabilities{} }
+func (m *ContractCapabilities) String() string { return proto.CompactTextString(m) }
+func (*ContractCapabilities) ProtoMessage()    {}
+func (*ContractCapabilities) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678928ebbdee1807, []int{6}
+}
+func (m *ContractCapabilities) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractCapabilities) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractCapabilities.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractCapabilities) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractCapabilities.Merge(m, src)
+}
+func (m *ContractCapabilities) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractCapabilities) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractCapabilities.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractCapabilities proto.InternalMessageInfo
+
+func (m *ContractCapabilities) GetChecksum() []byte {
+	if m != nil {
+		return m.Checksum
+	}
+	return nil
+}
+
+func (m *ContractCapabilities) GetAllowedMessages() []string {
+	if m != nil {
+		return m.AllowedMessages
+	}
+	return nil
+}
+
+func (m *ContractCapabilities) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+// GasUsage defines the gas consumed by calls to light client contracts.
+type GasUsage struct {
+	// height is the block height at which gas was last consumed.
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// gas_used is the amount of gas consumed in SDK gas units.
+	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// calls is the number of contract calls which consumed the gas.
+	Calls uint64 `protobuf:"varint,3,opt,name=calls,proto3" json:"calls,omitempty"`
+}
+
+func (m *GasUsage) Reset()         { *m = GasUsage{} }
+func (m *GasUsage) String() string { return proto.CompactTextString(m) }
+func (*GasUsage) ProtoMessage()    {}
+func (*GasUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678928ebbdee1807, []int{7}
+}
+func (m *GasUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasUsage.Merge(m, src)
+}
+func (m *GasUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasUsage proto.InternalMessageInfo
+
+func (m *GasUsage) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *GasUsage) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *GasUsage) GetCalls() uint64 {
+	if m != nil {
+		return m.Calls
+	}
+	return 0
+}
+
+// ClientMigration defines a pending migration of all clients using a checksum to a new checksum.
+// Clients are migrated in batches across blocks.
+type ClientMigration struct {
+	// from_checksum is the checksum of the code the clients are migrated from.
+	FromChecksum []byte `protobuf:"bytes,1,opt,name=from_checksum,json=fromChecksum,proto3" json:"from_checksum,omitempty"`
+	// to_checksum is the checksum of the code the clients are migrated to.
+	ToChecksum []byte `protobuf:"bytes,2,opt,name=to_checksum,json=toChecksum,proto3" json:"to_checksum,omitempty"`
+	// msg is the json encoded message passed to the contract of each client on migration.
+	Msg []byte `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	// last_client_id is the identifier of the last client processed by the migration.
+	LastClientId string `protobuf:"bytes,4,opt,name=last_client_id,json=lastClientId,proto3" json:"last_client_id,omitempty"`
+	// migrated is the number of clients successfully migrated.
+	Migrated uint64 `protobuf:"varint,5,opt,name=migrated,proto3" json:"migrated,omitempty"`
+	// failed is the number of clients which failed to be migrated.
+	Failed uint64 `protobuf:"varint,6,opt,name=failed,proto3" json:"failed,omitempty"`
+}
+
+func (m *ClientMigration) Reset()         { *m = ClientMigration{} }
+func (m *ClientMigration) String() string { return proto.CompactTextString(m) }
+func (*ClientMigration) ProtoMessage()    {}
+func (*ClientMigration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678928ebbdee1807, []int{8}
+}
+func (m *ClientMigration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClientMigration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClientMigration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClientMigration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClientMigration.Merge(m, src)
+}
+func (m *ClientMigration) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClientMigration) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClientMigration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClientMigration proto.InternalMessageInfo
+
+func (m *ClientMigration) GetFromChecksum() []byte {
+	if m != nil {
+		return m.FromChecksum
+	}
+	return nil
+}
+
+func (m *ClientMigration) GetToChecksum() []byte {
+	if m != nil {
+		return m.ToChecksum
+	}
+	return nil
+}
+
+func (m *ClientMigration) GetMsg() []byte {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+func (m *ClientMigration) GetLastClientId() string {
+	if m != nil {
+		return m.LastClientId
+	}
+	return ""
+}
+
+func (m *ClientMigration) GetMigrated() uint64 {
+	if m != nil {
+		return m.Migrated
+	}
+	return 0
+}
+
+func (m *ClientMigration) GetFailed() uint64 {
+	if m != nil {
+		return m.Failed
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("ibc.lightclients.wasm.v1.ChecksumStatus", ChecksumStatus_name, ChecksumStatus_value)
 	proto.RegisterType((*ClientState)(nil), "ibc.lightclients.wasm.v1.ClientState")
 	proto.RegisterType((*ConsensusState)(nil), "ibc.lightclients.wasm.v1.ConsensusState")
 	proto.RegisterType((*ClientMessage)(nil), "ibc.lightclients.wasm.v1.ClientMessage")
 	proto.RegisterType((*Checksums)(nil), "ibc.lightclients.wasm.v1.Checksums")
+	proto.RegisterType((*Params)(nil), "ibc.lightclients.wasm.v1.Params")
+	proto.RegisterType((*ContractGasLimit)(nil), "ibc.lightclients.wasm.v1.ContractGasLimit")
+	proto.RegisterType((*ContractCapabilities)(nil), "ibc.lightclients.wasm.v1.ContractCapabilities")
+	proto.RegisterType((*GasUsage)(nil), "ibc.lightclients.wasm.v1.GasUsage")
+	proto.RegisterType((*ClientMigration)(nil), "ibc.lightclients.wasm.v1.ClientMigration")
 }
 
 func init() {
@@ -206,29 +633,64 @@ func init() {
 }
 
 var fileDescriptor_678928ebbdee1807 = []byte{
-	// 339 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xb1, 0x4e, 0xc3, 0x30,
-	0x10, 0x86, 0xe3, 0xb6, 0x42, 0xd4, 0x6d, 0x19, 0x22, 0x86, 0x28, 0x42, 0x69, 0x55, 0x96, 0x82,
-	0x14, 0x9b, 0xc2, 0x82, 0x2a, 0xa6, 0x56, 0x48, 0x2c, 0x2c, 0x45, 0x62, 0x60, 0x41, 0x89, 0x6b,
-	0x25, 0x16, 0x49, 0x5d, 0xf5, 0x9c, 0x22, 0xde, 0x00, 0x31, 0xf1, 0x08, 0x3c, 0x4e, 0xc7, 0x8e,
-	0x4c, 0x08, 0xb5, 0x2f, 0x82, 0x6c, 0xa7, 0xc0, 0x02, 0x53, 0x2e, 0xbf, 0x3f, 0xdf, 0x7d, 0xf2,
-	0xe1, 0x43, 0x11, 0x33, 0x9a, 0x89, 0x24, 0x55, 0x2c, 0x13, 0x7c, 0xaa, 0x80, 0x3e, 0x46, 0x90,
-	0xd3, 0x45, 0xdf, 0x7c, 0xc9, 0x6c, 0x2e, 0x95, 0x74, 0x3d, 0x11, 0x33, 0xf2, 0x1b, 0x22, 0xe6,
-	0x70, 0xd1, 0xf7, 0xf7, 0x13, 0x99, 0x48, 0x03, 0x51, 0x5d, 0x59, 0xde, 0x6f, 0xeb, 0xa6, 0x4c,
-	0xce, 0x39, 0xb5, 0xbc, 0x6e, 0x67, 0x2b, 0x0b, 0x74, 0x5f, 0x10, 0x6e, 0x8c, 0x4c, 0x70, 0xa3,
-	0x22, 0xc5, 0x5d, 0x17, 0xd7, 0x26, 0x91, 0x8a, 0x3c, 0xd4, 0x41, 0xbd, 0xe6, 0xd8, 0xd4, 0xae,
-	0x8f, 0x77, 0x59, 0xca, 0xd9, 0x03, 0x14, 0xb9, 0x57, 0x31, 0xf9, 0xf7, 0xbf, 0x7b, 0x89, 0x5b,
-	0x59, 0xa4, 0x38, 0xa8, 0xfb, 0x94, 0x6b, 0x2d, 0xaf, 0xda, 0x41, 0xbd, 0xc6, 0xa9, 0x4f, 0xb4,
-	0xa8, 0x1e, 0x4c, 0xca, 0x71, 0x8b, 0x3e, 0xb9, 0x32, 0xc4, 0xb0, 0xb6, 0xfc, 0x68, 0x3b, 0xe3,
-	0xa6, 0xbd, 0x66, 0xb3, 0x41, 0xed, 0xf9, 0xad, 0xed, 0x74, 0x8f, 0xf1, 0xde, 0x48, 0x4e, 0x81,
-	0x4f, 0xa1, 0x80, 0x3f, 0x75, 0x4a, 0xf6, 0x08, 0xb7, 0xac, 0xf7, 0x35, 0x07, 0x88, 0x92, 0xff,
-	0xd0, 0x10, 0xd7, 0x47, 0xa5, 0x2f, 0xb8, 0x07, 0xb8, 0xbe, 0x95, 0x07, 0x0f, 0x75, 0xaa, 0xbd,
-	0xe6, 0xf8, 0x27, 0x18, 0x54, 0x3c, 0x34, 0xbc, 0x5d, 0xae, 0x03, 0xb4, 0x5a, 0x07, 0xe8, 0x73,
-	0x1d, 0xa0, 0xd7, 0x4d, 0xe0, 0xac, 0x36, 0x81, 0xf3, 0xbe, 0x09, 0x9c, 0xbb, 0x8b, 0x44, 0xa8,
-	0xb4, 0x88, 0x09, 0x93, 0x39, 0x65, 0x12, 0x72, 0x09, 0x54, 0xc4, 0x2c, 0x4c, 0x24, 0xcd, 0xe5,
-	0xa4, 0xc8, 0x38, 0xd8, 0xfd, 0x85, 0xdb, 0x05, 0x9e, 0x9c, 0x87, 0x66, 0x87, 0xea, 0x69, 0xc6,
-	0x21, 0xde, 0x31, 0x2f, 0x7e, 0xf6, 0x15, 0x00, 0x00, 0xff, 0xff, 0xdb, 0x78, 0x4d, 0x63, 0xe9,
-	0x01, 0x00, 0x00,
+	// 901 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0x4d, 0x6f, 0x1b, 0x37,
+	0x13, 0xd6, 0x4a, 0x8a, 0x63, 0xd3, 0x96, 0xad, 0xf0, 0x95, 0x93, 0xf5, 0x3a, 0xaf, 0x24, 0x28,
+	0x45, 0xa1, 0x18, 0xf0, 0xaa, 0x4e, 0x8b, 0xb6, 0x48, 0x83, 0x02, 0xf2, 0x4a, 0xb5, 0x85, 0x34,
+	0x81, 0xa1, 0x8f, 0x1c, 0x7a, 0xd9, 0x52, 0x5c, 0x7a, 0x45, 0x64, 0x57, 0x14, 0x96, 0x94, 0x93,
+	0xf8, 0xd8, 0x53, 0xa0, 0x53, 0xff, 0x80, 0x81, 0x02, 0xfd, 0x29, 0xbd, 0xe4, 0x98, 0x63, 0x4f,
+	0x45, 0x61, 0xa3, 0xff, 0xa3, 0xe0, 0x87, 0x14, 0x6b, 0xf3, 0xd1, 0xd3, 0x92, 0x33, 0xcf, 0x33,
+	0xf3, 0x70, 0x66, 0x96, 0x04, 0xf7, 0xe8, 0x10, 0x37, 0x22, 0x1a, 0x8e, 0x04, 0x8e, 0x28, 0x19,
+	0x0b, 0xde, 0x78, 0x81, 0x78, 0xdc, 0x38, 0x3b, 0x50, 0x5f, 0x77, 0x92, 0x30, 0xc1, 0xa0, 0x4d,
+	0x87, 0xd8, 0xbd, 0x0e, 0x72, 0x95, 0xf3, 0xec, 0xc0, 0x29, 0x85, 0x2c, 0x64, 0x0a, 0xd4, 0x90,
+	0x2b, 0x8d, 0x77, 0x2a, 0x32, 0x28, 0x66, 0x09, 0x69, 0x68, 0xbc, 0x0c, 0xa7, 0x57, 0x1a, 0x50,
+	0x9b, 0x59, 0x60, 0xdd, 0x53, 0x86, 0x9e, 0x40, 0x82, 0x40, 0x08, 0xf2, 0x01, 0x12, 0xc8, 0xb6,
+	0xaa, 0x56, 0x7d, 0xa3, 0xab, 0xd6, 0xd0, 0x01, 0xab, 0x78, 0x44, 0xf0, 0x73, 0x3e, 0x8d, 0xed,
+	0xac, 0xb2, 0x2f, 0xf6, 0xb0, 0x0d, 0x0a, 0x11, 0x12, 0x84, 0x0b, 0x7f, 0x44, 0xa4, 0x2c, 0x3b,
+	0x57, 0xb5, 0xea, 0xeb, 0x0f, 0x1c, 0x57, 0x0a, 0x95, 0x89, 0x5d, 0x93, 0xee, 0xec, 0xc0, 0x3d,
+	0x56, 0x88, 0xc3, 0xfc, 0x9b, 0xbf, 0x2a, 0x99, 0xee, 0x86, 0xa6, 0x69, 0xdb, 0xc3, 0xfc, 0xeb,
+	0xdf, 0x2a, 0x99, 0xda, 0x1e, 0xd8, 0xf4, 0xd8, 0x98, 0x93, 0x31, 0x9f, 0xf2, 0x8f, 0xca, 0x31,
+	0xd8, 0xfb, 0xa0, 0xa0, 0x75, 0x3f, 0x21, 0x9c, 0xa3, 0xf0, 0x53, 0xd0, 0x7d, 0xb0, 0xe6, 0x19,
+	0xbd, 0x1c, 0xde, 0x05, 0x6b, 0x73, 0xf1, 0xdc, 0xb6, 0xaa, 0xb9, 0xfa, 0x46, 0xf7, 0x9d, 0xe1,
+	0x61, 0xd6, 0xb6, 0x6a, 0xff, 0xe4, 0xc0, 0xca, 0x09, 0x4a, 0x50, 0xcc, 0xe1, 0x77, 0xc0, 0x09,
+	0xc8, 0x29, 0x9a, 0x46, 0xc2, 0xc7, 0x6c, 0x2c, 0x12, 0x84, 0x85, 0x1f, 0x22, 0xee, 0x47, 0x34,
+	0xa6, 0x42, 0x65, 0xca, 0x77, 0xef, 0x18, 0x84, 0x67, 0x00, 0x47, 0x88, 0xff, 0x28, 0xdd, 0xf0,
+	0x67, 0xf0, 0xbf, 0xf7, 0x49, 0xdc, 0xce, 0x56, 0x73, 0xf5, 0xf5, 0x07, 0x7b, 0xee, 0xc7, 0x3a,
+	0xe9, 0xa6, 0x03, 0x99, 0x82, 0xdd, 0xc2, 0x29, 0x3b, 0x87, 0x9f, 0x83, 0xad, 0x61, 0xc4, 0xf0,
+	0xf3, 0x6b, 0x9a, 0x72, 0x4a, 0x53, 0x41, 0x99, 0x17, 0x4a, 0xf6, 0xc0, 0xad, 0x98, 0xc4, 0x2c,
+	0x79, 0xe5, 0x63, 0x84, 0x47, 0xc4, 0xe7, 0xf4, 0x9c, 0xd8, 0xf9, 0xaa, 0x55, 0x2f, 0x74, 0xb7,
+	0xb4, 0xc3, 0x93, 0xf6, 0x1e, 0x3d, 0x27, 0xf0, 0x1b, 0x60, 0xcb, 0x68, 0x53, 0x59, 0x53, 0x7f,
+	0x44, 0xb9, 0x90, 0x34, 0x15, 0x8e, 0xdb, 0x37, 0x54, 0xf0, 0xed, 0x10, 0xf1, 0x81, 0x74, 0x1f,
+	0x6b, 0xef, 0xa1, 0x72, 0xc2, 0x16, 0xa8, 0xc4, 0xe8, 0xa5, 0xaf, 0x4f, 0xe3, 0xc7, 0x34, 0x4c,
+	0x90, 0xa0, 0x6c, 0xcc, 0xfd, 0x09, 0x49, 0x74, 0x00, 0x7b, 0x45, 0xf1, 0x77, 0x63, 0xf4, 0xd2,
+	0xb4, 0x6e, 0x01, 0x3a, 0x21, 0x89, 0x0a, 0x03, 0x29, 0xd8, 0x5e, 0x14, 0x0d, 0xa3, 0x09, 0x1a,
+	0xd2, 0x88, 0x0a, 0x4a, 0xb8, 0x7d, 0x53, 0x95, 0xcd, 0xfd, 0xef, 0xb2, 0x79, 0xd7, 0x58, 0xa6,
+	0x74, 0x25, 0xfc, 0x01, 0x5f, 0xed, 0x31, 0x28, 0xbe, 0xd7, 0xb3, 0xeb, 0xa3, 0x6e, 0xa5, 0x46,
+	0x7d, 0x17, 0xac, 0xbd, 0xab, 0x73, 0x56, 0x1d, 0x65, 0x35, 0x34, 0xc4, 0xda, 0x39, 0x28, 0x7d,
+	0x48, 0xc0, 0x27, 0x03, 0xde, 0x07, 0x45, 0x14, 0x45, 0xec, 0x05, 0x09, 0xfc, 0x58, 0x0f, 0xb1,
+	0x9e, 0x8e, 0xb5, 0xee, 0x96, 0xb1, 0x9b, 0xd9, 0xe6, 0xcb, 0xb9, 0x73, 0xa9, 0xdc, 0x3d, 0xb0,
+	0x7a, 0x64, 0x5a, 0x02, 0x6f, 0x83, 0x15, 0xf3, 0x23, 0xea, 0xe9, 0x34, 0x3b, 0xb8, 0x03, 0x56,
+	0x75, 0x5b, 0x49, 0x60, 0xb4, 0xdf, 0x54, 0x6d, 0x24, 0x01, 0x2c, 0x81, 0x1b, 0x18, 0x45, 0x11,
+	0x37, 0x71, 0xf5, 0xa6, 0xf6, 0x87, 0x05, 0xb6, 0x52, 0x5d, 0x82, 0xf7, 0x40, 0xe1, 0x34, 0x61,
+	0xb1, 0x9f, 0x3a, 0xd1, 0x86, 0x34, 0xce, 0xff, 0x30, 0x58, 0x01, 0xeb, 0x82, 0xf9, 0xa9, 0x0b,
+	0x03, 0x08, 0xb6, 0x00, 0x14, 0x41, 0x2e, 0xe6, 0xa1, 0xca, 0xb6, 0xd1, 0x95, 0x4b, 0xf8, 0x19,
+	0xd8, 0x8c, 0x10, 0x17, 0xf3, 0xd9, 0xa1, 0x81, 0x1a, 0xce, 0x35, 0x79, 0x47, 0x70, 0xa1, 0x45,
+	0x74, 0x02, 0x59, 0x4a, 0x3d, 0x55, 0x24, 0x30, 0x93, 0xb8, 0xd8, 0xcb, 0x63, 0x9f, 0x22, 0x1a,
+	0x91, 0xc0, 0xcc, 0x98, 0xd9, 0xed, 0xfd, 0x92, 0x05, 0x9b, 0xf3, 0xc4, 0xf2, 0x46, 0x99, 0x72,
+	0xf8, 0x3d, 0xd8, 0xf5, 0x8e, 0xdb, 0xde, 0xe3, 0xde, 0xe0, 0x89, 0xdf, 0xeb, 0x37, 0xfb, 0x83,
+	0x9e, 0x3f, 0x78, 0xda, 0x3b, 0x69, 0x7b, 0x9d, 0x1f, 0x3a, 0xed, 0x56, 0x31, 0xe3, 0xfc, 0x7f,
+	0x76, 0x51, 0xdd, 0x59, 0x26, 0x0d, 0xc6, 0x7c, 0x42, 0x30, 0x3d, 0xa5, 0x24, 0x80, 0x5f, 0x83,
+	0x3b, 0x69, 0x7e, 0xaf, 0xdf, 0x3c, 0xea, 0x3c, 0x3d, 0x2a, 0x5a, 0xce, 0xce, 0xec, 0xa2, 0xba,
+	0xbd, 0xcc, 0xed, 0x09, 0x14, 0xd2, 0x71, 0x08, 0xbf, 0x02, 0xb7, 0xd3, 0xbc, 0xa6, 0xd7, 0xef,
+	0x3c, 0x6b, 0x17, 0xb3, 0x8e, 0x3d, 0xbb, 0xa8, 0x96, 0x96, 0x69, 0x4d, 0x2c, 0xe8, 0x19, 0x81,
+	0x8f, 0x80, 0x93, 0x66, 0xb5, 0xda, 0x27, 0xdd, 0xb6, 0xd7, 0xec, 0xb7, 0x5b, 0xc5, 0x9c, 0x73,
+	0x77, 0x76, 0x51, 0xb5, 0x97, 0x99, 0x2d, 0x32, 0x49, 0x08, 0x96, 0x65, 0x71, 0xf2, 0xaf, 0x7f,
+	0x2f, 0x67, 0x0e, 0x9f, 0xbd, 0xb9, 0x2c, 0x5b, 0x6f, 0x2f, 0xcb, 0xd6, 0xdf, 0x97, 0x65, 0xeb,
+	0xd7, 0xab, 0x72, 0xe6, 0xed, 0x55, 0x39, 0xf3, 0xe7, 0x55, 0x39, 0xf3, 0xd3, 0xa3, 0x90, 0x8a,
+	0xd1, 0x74, 0xe8, 0x62, 0x16, 0x37, 0x30, 0xe3, 0x31, 0xe3, 0x0d, 0x3a, 0xc4, 0xfb, 0x21, 0x6b,
+	0xc4, 0x2c, 0x98, 0x46, 0x84, 0xeb, 0x07, 0x69, 0x7f, 0xfe, 0x22, 0x7d, 0xf1, 0xed, 0xbe, 0x7a,
+	0x94, 0xc4, 0xab, 0x09, 0xe1, 0xc3, 0x15, 0xf5, 0x84, 0x7c, 0xf9, 0xef, 0x00, 0xd6, 0x2d, 0xde,
+	0x1c, 0xba, 0x06, 0x00, 0x00,
 }
 
 func (m *ClientState) Marshal() (dAtA []byte, err error) {
@@ -370,41 +832,295 @@ func (m *Checksums) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintWasm(dAtA []byte, offset int, v uint64) int {
-	offset -= sovWasm(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ClientState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovWasm(uint64(l))
+	if len(m.ContractCapabilities) > 0 {
+		for iNdEx := len(m.ContractCapabilities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractCapabilities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintWasm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
 	}
-	l = len(m.Checksum)
-	if l > 0 {
-		n += 1 + l + sovWasm(uint64(l))
+	if m.MaxClientMigrationsPerBlock != 0 {
+		i = encodeVarintWasm(dAtA, i, uint64(m.MaxClientMigrationsPerBlock))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = m.LatestHeight.Size()
-	n += 1 + l + sovWasm(uint64(l))
-	return n
-}
-
-func (m *ConsensusState) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.GasUsageHistoryBlocks != 0 {
+		i = encodeVarintWasm(dAtA, i, uint64(m.GasUsageHistoryBlocks))
+		i--
+		dAtA[i] = 0x28
 	}
-	var l int
+	if m.MemoryCacheSize != 0 {
+		i = encodeVarintWasm(dAtA, i, uint64(m.MemoryCacheSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BlockGasLimit != 0 {
+		i = encodeVarintWasm(dAtA, i, uint64(m.BlockGasLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ContractGasLimits) > 0 {
+		for iNdEx := len(m.ContractGasLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractGasLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintWasm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.DefaultContractGasLimit != 0 {
+		i = encodeVarintWasm(dAtA, i, uint64(m.DefaultContractGasLimit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractGasLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractGasLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractGasLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintWasm(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintWasm(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractCapabilities) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractCapabilities) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractCapabilities) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintWasm(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.AllowedMessages) > 0 {
+		for iNdEx := len(m.AllowedMessages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedMessages[iNdEx])
+			copy(dAtA[i:], m.AllowedMessages[iNdEx])
+			i = encodeVarintWasm(dAtA, i, uint64(len(m.AllowedMessages[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintWasm(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Calls != 0 {
+		i = encodeVarintWasm(dAtA, i, uint64(m.Calls))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintWasm(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintWasm(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClientMigration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClientMigration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClientMigration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Failed != 0 {
+		i = encodeVarintWasm(dAtA, i, uint64(m.Failed))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Migrated != 0 {
+		i = encodeVarintWasm(dAtA, i, uint64(m.Migrated))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.LastClientId) > 0 {
+		i -= len(m.LastClientId)
+		copy(dAtA[i:], m.LastClientId)
+		i = encodeVarintWasm(dAtA, i, uint64(len(m.LastClientId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintWasm(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ToChecksum) > 0 {
+		i -= len(m.ToChecksum)
+		copy(dAtA[i:], m.ToChecksum)
+		i = encodeVarintWasm(dAtA, i, uint64(len(m.ToChecksum)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromChecksum) > 0 {
+		i -= len(m.FromChecksum)
+		copy(dAtA[i:], m.FromChecksum)
+		i = encodeVarintWasm(dAtA, i, uint64(len(m.FromChecksum)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintWasm(dAtA []byte, offset int, v uint64) int {
+	offset -= sovWasm(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ClientState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovWasm(uint64(l))
+	}
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovWasm(uint64(l))
+	}
+	l = m.LatestHeight.Size()
+	n += 1 + l + sovWasm(uint64(l))
+	return n
+}
+
+func (m *ConsensusState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	l = len(m.Data)
 	if l > 0 {
@@ -441,6 +1157,129 @@ func (m *Checksums) Size() (n int) {
 	return n
 }
 
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DefaultContractGasLimit != 0 {
+		n += 1 + sovWasm(uint64(m.DefaultContractGasLimit))
+	}
+	if len(m.ContractGasLimits) > 0 {
+		for _, e := range m.ContractGasLimits {
+			l = e.Size()
+			n += 1 + l + sovWasm(uint64(l))
+		}
+	}
+	if m.BlockGasLimit != 0 {
+		n += 1 + sovWasm(uint64(m.BlockGasLimit))
+	}
+	if m.MemoryCacheSize != 0 {
+		n += 1 + sovWasm(uint64(m.MemoryCacheSize))
+	}
+	if m.GasUsageHistoryBlocks != 0 {
+		n += 1 + sovWasm(uint64(m.GasUsageHistoryBlocks))
+	}
+	if m.MaxClientMigrationsPerBlock != 0 {
+		n += 1 + sovWasm(uint64(m.MaxClientMigrationsPerBlock))
+	}
+	if len(m.ContractCapabilities) > 0 {
+		for _, e := range m.ContractCapabilities {
+			l = e.Size()
+			n += 1 + l + sovWasm(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContractGasLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovWasm(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovWasm(uint64(m.GasLimit))
+	}
+	return n
+}
+
+func (m *ContractCapabilities) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovWasm(uint64(l))
+	}
+	if len(m.AllowedMessages) > 0 {
+		for _, s := range m.AllowedMessages {
+			l = len(s)
+			n += 1 + l + sovWasm(uint64(l))
+		}
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovWasm(uint64(m.GasLimit))
+	}
+	return n
+}
+
+func (m *GasUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovWasm(uint64(m.Height))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovWasm(uint64(m.GasUsed))
+	}
+	if m.Calls != 0 {
+		n += 1 + sovWasm(uint64(m.Calls))
+	}
+	return n
+}
+
+func (m *ClientMigration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromChecksum)
+	if l > 0 {
+		n += 1 + l + sovWasm(uint64(l))
+	}
+	l = len(m.ToChecksum)
+	if l > 0 {
+		n += 1 + l + sovWasm(uint64(l))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovWasm(uint64(l))
+	}
+	l = len(m.LastClientId)
+	if l > 0 {
+		n += 1 + l + sovWasm(uint64(l))
+	}
+	if m.Migrated != 0 {
+		n += 1 + sovWasm(uint64(m.Migrated))
+	}
+	if m.Failed != 0 {
+		n += 1 + sovWasm(uint64(m.Failed))
+	}
+	return n
+}
+
 func sovWasm(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -848,6 +1687,786 @@ func (m *Checksums) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWasm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultContractGasLimit", wireType)
+			}
+			m.DefaultContractGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DefaultContractGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractGasLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWasm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractGasLimits = append(m.ContractGasLimits, ContractGasLimit{})
+			if err := m.ContractGasLimits[len(m.ContractGasLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockGasLimit", wireType)
+			}
+			m.BlockGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryCacheSize", wireType)
+			}
+			m.MemoryCacheSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemoryCacheSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsageHistoryBlocks", wireType)
+			}
+			m.GasUsageHistoryBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsageHistoryBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxClientMigrationsPerBlock", wireType)
+			}
+			m.MaxClientMigrationsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxClientMigrationsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractCapabilities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWasm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractCapabilities = append(m.ContractCapabilities, ContractCapabilities{})
+			if err := m.ContractCapabilities[len(m.ContractCapabilities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWasm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractGasLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWasm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractGasLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractGasLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWasm
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = append(m.Checksum[:0], dAtA[iNdEx:postIndex]...)
+			if m.Checksum == nil {
+				m.Checksum = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWasm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractCapabilities) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWasm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractCapabilities: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractCapabilities: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWasm
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = append(m.Checksum[:0], dAtA[iNdEx:postIndex]...)
+			if m.Checksum == nil {
+				m.Checksum = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMessages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWasm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMessages = append(m.AllowedMessages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWasm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWasm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calls", wireType)
+			}
+			m.Calls = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Calls |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWasm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClientMigration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWasm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClientMigration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClientMigration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromChecksum", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWasm
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromChecksum = append(m.FromChecksum[:0], dAtA[iNdEx:postIndex]...)
+			if m.FromChecksum == nil {
+				m.FromChecksum = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToChecksum", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWasm
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToChecksum = append(m.ToChecksum[:0], dAtA[iNdEx:postIndex]...)
+			if m.ToChecksum == nil {
+				m.ToChecksum = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWasm
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
+			if m.Msg == nil {
+				m.Msg = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWasm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Migrated", wireType)
+			}
+			m.Migrated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Migrated |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			m.Failed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWasm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWasm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWasm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipWasm(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,219 @@
+package ibctesting
+
+import (
+	"fmt"
+	"time"
+
+	ics23 "github.com/cosmos/ics23/go"
+	"github.com/stretchr/testify/require"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+)
+
+// CreateForkHeaders creates two valid 07-tendermint headers for the proposed height of the chain which
+// are signed by the current validator set but commit to different block times. Submitted together they
+// are evidence of a fork of the chain.
+func (chain *TestChain) CreateForkHeaders(trustedHeight clienttypes.Height) (*ibctm.Header, *ibctm.Header) {
+	trustedVals := chain.mustGetValsAtHeight(trustedHeight)
+
+	height := chain.ProposedHeader.Height
+	header1 := chain.CreateTMClientHeader(chain.ChainID, height, trustedHeight, chain.ProposedHeader.Time.Add(time.Second), chain.Vals, chain.NextVals, trustedVals, chain.Signers)
+	header2 := chain.CreateTMClientHeader(chain.ChainID, height, trustedHeight, chain.ProposedHeader.Time, chain.Vals, chain.NextVals, trustedVals, chain.Signers)
+
+	return header1, header2
+}
+
+// CreateTimeViolationHeaders creates two valid 07-tendermint headers signed by the current validator set
+// where the first header is one block higher than the second header but has the same block time.
+// Submitted together they are evidence of a violation of monotonic block time.
+func (chain *TestChain) CreateTimeViolationHeaders(trustedHeight clienttypes.Height) (*ibctm.Header, *ibctm.Header) {
+	trustedVals := chain.mustGetValsAtHeight(trustedHeight)
+
+	height := chain.ProposedHeader.Height
+	header1 := chain.CreateTMClientHeader(chain.ChainID, height+1, trustedHeight, chain.ProposedHeader.Time, chain.Vals, chain.NextVals, trustedVals, chain.Signers)
+	header2 := chain.CreateTMClientHeader(chain.ChainID, height, trustedHeight, chain.ProposedHeader.Time, chain.Vals, chain.NextVals, trustedVals, chain.Signers)
+
+	return header1, header2
+}
+
+// CreateEquivocationHeaders creates two valid 07-tendermint headers for the proposed height of the chain
+// which are signed by the current validator set at the same block time but commit to different next
+// validator sets. Submitted together they are evidence of the validators equivocating on the validator set.
+func (chain *TestChain) CreateEquivocationHeaders(trustedHeight clienttypes.Height) (*ibctm.Header, *ibctm.Header) {
+	trustedVals := chain.mustGetValsAtHeight(trustedHeight)
+
+	altPubKey, err := cmttypes.NewMockPV().GetPubKey()
+	require.NoError(chain.TB, err)
+	altNextVals := cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(altPubKey, 1)})
+
+	height := chain.ProposedHeader.Height
+	header1 := chain.CreateTMClientHeader(chain.ChainID, height, trustedHeight, chain.ProposedHeader.Time, chain.Vals, chain.NextVals, trustedVals, chain.Signers)
+	header2 := chain.CreateTMClientHeader(chain.ChainID, height, trustedHeight, chain.ProposedHeader.Time, chain.Vals, altNextVals, trustedVals, chain.Signers)
+
+	return header1, header2
+}
+
+// mustGetValsAtHeight returns the trusted validator set of the chain for the given trusted height and
+// fails the test if it does not exist.
+func (chain *TestChain) mustGetValsAtHeight(trustedHeight clienttypes.Height) *cmttypes.ValidatorSet {
+	trustedVals, found := chain.GetValsAtHeight(int64(trustedHeight.RevisionHeight))
+	require.True(chain.TB, found, "could not retrieve trusted validators at trusted height: %s", trustedHeight)
+
+	return trustedVals
+}
+
+// SubmitMisbehaviour submits the provided misbehaviour for the client of the endpoint with a MsgSubmitMisbehaviour.
+func (endpoint *Endpoint) SubmitMisbehaviour(misbehaviour exported.ClientMessage) error {
+	msg, err := clienttypes.NewMsgSubmitMisbehaviour(endpoint.ClientID, misbehaviour, endpoint.Chain.SenderAccount.GetAddress().String())
+	require.NoError(endpoint.Chain.TB, err)
+
+	return endpoint.Chain.sendMsgs(msg)
+}
+
+// UpdateClientWithHeader updates the client of the endpoint with the provided client message using a MsgUpdateClient.
+// Unlike UpdateClient, the client message is submitted as is.
+func (endpoint *Endpoint) UpdateClientWithHeader(clientMsg exported.ClientMessage) error {
+	msg, err := clienttypes.NewMsgUpdateClient(endpoint.ClientID, clientMsg, endpoint.Chain.SenderAccount.GetAddress().String())
+	require.NoError(endpoint.Chain.TB, err)
+
+	return endpoint.Chain.sendMsgs(msg)
+}
+
+// SubmitForkMisbehaviour submits evidence of a fork of the counterparty chain, trusted from the latest height
+// of the 07-tendermint client of the endpoint. The client is frozen if the misbehaviour is accepted.
+func (endpoint *Endpoint) SubmitForkMisbehaviour() error {
+	if err := endpoint.requireTendermintClient(); err != nil {
+		return err
+	}
+
+	header1, header2 := endpoint.Counterparty.Chain.CreateForkHeaders(endpoint.GetClientLatestHeight())
+	return endpoint.SubmitMisbehaviour(ibctm.NewMisbehaviour(endpoint.ClientID, header1, header2))
+}
+
+// SubmitTimeMisbehaviour submits evidence of a violation of monotonic block time on the counterparty chain,
+// trusted from the latest height of the 07-tendermint client of the endpoint. The client is frozen if the
+// misbehaviour is accepted.
+func (endpoint *Endpoint) SubmitTimeMisbehaviour() error {
+	if err := endpoint.requireTendermintClient(); err != nil {
+		return err
+	}
+
+	header1, header2 := endpoint.Counterparty.Chain.CreateTimeViolationHeaders(endpoint.GetClientLatestHeight())
+	return endpoint.SubmitMisbehaviour(ibctm.NewMisbehaviour(endpoint.ClientID, header1, header2))
+}
+
+// SubmitEquivocationMisbehaviour submits evidence of the counterparty validators equivocating on the next
+// validator set, trusted from the latest height of the 07-tendermint client of the endpoint. The client is
+// frozen if the misbehaviour is accepted.
+func (endpoint *Endpoint) SubmitEquivocationMisbehaviour() error {
+	if err := endpoint.requireTendermintClient(); err != nil {
+		return err
+	}
+
+	header1, header2 := endpoint.Counterparty.Chain.CreateEquivocationHeaders(endpoint.GetClientLatestHeight())
+	return endpoint.SubmitMisbehaviour(ibctm.NewMisbehaviour(endpoint.ClientID, header1, header2))
+}
+
+// UpdateClientWithConflictingHeader updates the 07-tendermint client of the endpoint and then submits a
+// MsgUpdateClient with a valid header for the new latest height of the client which conflicts with the
+// stored consensus state. The client is frozen if the header is accepted.
+func (endpoint *Endpoint) UpdateClientWithConflictingHeader() error {
+	if err := endpoint.requireTendermintClient(); err != nil {
+		return err
+	}
+
+	trustedHeight := endpoint.GetClientLatestHeight()
+	if err := endpoint.UpdateClient(); err != nil {
+		return err
+	}
+
+	height := endpoint.GetClientLatestHeight()
+	consensusState, ok := endpoint.GetConsensusState(height).(*ibctm.ConsensusState)
+	require.True(endpoint.Chain.TB, ok)
+
+	counterparty := endpoint.Counterparty.Chain
+	header := counterparty.CreateTMClientHeader(
+		counterparty.ChainID, int64(height.RevisionHeight), trustedHeight, consensusState.Timestamp.Add(time.Second),
+		counterparty.Vals, counterparty.NextVals, counterparty.mustGetValsAtHeight(trustedHeight), counterparty.Signers,
+	)
+
+	return endpoint.UpdateClientWithHeader(header)
+}
+
+// UpdateClientWithTimeViolation updates the 07-tendermint client of the endpoint and then submits a
+// MsgUpdateClient with a valid header for a height above the new latest height of the client whose block
+// time is not after the stored consensus state. The client is frozen if the header is accepted.
+func (endpoint *Endpoint) UpdateClientWithTimeViolation() error {
+	if err := endpoint.requireTendermintClient(); err != nil {
+		return err
+	}
+
+	trustedHeight := endpoint.GetClientLatestHeight()
+	if err := endpoint.UpdateClient(); err != nil {
+		return err
+	}
+
+	height := endpoint.GetClientLatestHeight()
+	consensusState, ok := endpoint.GetConsensusState(height).(*ibctm.ConsensusState)
+	require.True(endpoint.Chain.TB, ok)
+
+	counterparty := endpoint.Counterparty.Chain
+	header := counterparty.CreateTMClientHeader(
+		counterparty.ChainID, int64(height.RevisionHeight)+1, trustedHeight, consensusState.Timestamp,
+		counterparty.Vals, counterparty.NextVals, counterparty.mustGetValsAtHeight(trustedHeight), counterparty.Signers,
+	)
+
+	return endpoint.UpdateClientWithHeader(header)
+}
+
+// QueryForgedProof queries the proof for the provided key like QueryProof and then forges it by changing the
+// value of an existence proof, or the key of a non-existence proof. The forged proof is well formed but fails
+// verification against the commitment root of the proof height.
+func (endpoint *Endpoint) QueryForgedProof(key []byte) ([]byte, clienttypes.Height) {
+	proof, height := endpoint.QueryProof(key)
+
+	var merkleProof commitmenttypes.MerkleProof
+	require.NoError(endpoint.Chain.TB, endpoint.Chain.Codec.Unmarshal(proof, &merkleProof))
+	require.NotEmpty(endpoint.Chain.TB, merkleProof.Proofs)
+
+	switch commitmentProof := merkleProof.Proofs[0].Proof.(type) {
+	case *ics23.CommitmentProof_Exist:
+		commitmentProof.Exist.Value = append([]byte("forged"), commitmentProof.Exist.Value...)
+	case *ics23.CommitmentProof_Nonexist:
+		commitmentProof.Nonexist.Key = append([]byte("forged"), commitmentProof.Nonexist.Key...)
+	default:
+		require.FailNow(endpoint.Chain.TB, fmt.Sprintf("unsupported commitment proof type %T", commitmentProof))
+	}
+
+	forgedProof, err := endpoint.Chain.Codec.Marshal(&merkleProof)
+	require.NoError(endpoint.Chain.TB, err)
+
+	return forgedProof, height
+}
+
+// GetClientLatestHeight returns the latest height of the client of the endpoint.
+func (endpoint *Endpoint) GetClientLatestHeight() clienttypes.Height {
+	height, ok := endpoint.GetClientState().GetLatestHeight().(clienttypes.Height)
+	require.True(endpoint.Chain.TB, ok)
+
+	return height
+}
+
+// GetClientStatus returns the status of the client of the endpoint.
+func (endpoint *Endpoint) GetClientStatus() exported.Status {
+	return endpoint.Chain.App.GetIBCKeeper().ClientKeeper.GetClientStatus(endpoint.Chain.GetContext(), endpoint.GetClientState(), endpoint.ClientID)
+}
+
+// requireTendermintClient returns an error if the client of the endpoint is not a 07-tendermint client.
+func (endpoint *Endpoint) requireTendermintClient() error {
+	if clientType := endpoint.ClientConfig.GetClientType(); clientType != exported.Tendermint {
+		return fmt.Errorf("client type %s is not supported", clientType)
+	}
+
+	return nil
+}
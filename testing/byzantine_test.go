@@ -0,0 +1,146 @@
+package ibctesting_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	solomachine "github.com/cosmos/ibc-go/v8/modules/light-clients/06-solomachine"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func TestTendermintMisbehaviourFreezesClient(t *testing.T) {
+	testCases := []struct {
+		name      string
+		misbehave func(endpoint *ibctesting.Endpoint) error
+		expFrozen bool
+		expErr    bool
+	}{
+		{"fork", (*ibctesting.Endpoint).SubmitForkMisbehaviour, true, false},
+		{"time violation", (*ibctesting.Endpoint).SubmitTimeMisbehaviour, true, false},
+		{"validator set equivocation", (*ibctesting.Endpoint).SubmitEquivocationMisbehaviour, true, false},
+		{"conflicting header update", (*ibctesting.Endpoint).UpdateClientWithConflictingHeader, true, false},
+		{"time violation update", (*ibctesting.Endpoint).UpdateClientWithTimeViolation, true, false},
+		{
+			"honest update", func(endpoint *ibctesting.Endpoint) error {
+				return endpoint.UpdateClient()
+			}, false, false,
+		},
+		{
+			"misbehaviour without conflicting headers", func(endpoint *ibctesting.Endpoint) error {
+				header1, _ := endpoint.Counterparty.Chain.CreateForkHeaders(endpoint.GetClientLatestHeight())
+				return endpoint.SubmitMisbehaviour(ibctm.NewMisbehaviour(endpoint.ClientID, header1, header1))
+			}, false, true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			coord := ibctesting.NewCoordinator(t, 2)
+			path := ibctesting.NewPath(coord.GetChain(ibctesting.GetChainID(1)), coord.GetChain(ibctesting.GetChainID(2)))
+			path.SetupClients()
+
+			err := tc.misbehave(path.EndpointA)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			if tc.expFrozen {
+				require.Equal(t, exported.Frozen, path.EndpointA.GetClientStatus())
+			} else {
+				require.Equal(t, exported.Active, path.EndpointA.GetClientStatus())
+			}
+		})
+	}
+}
+
+func TestForgedProofFailsVerification(t *testing.T) {
+	coord := ibctesting.NewCoordinator(t, 2)
+	path := ibctesting.NewPath(coord.GetChain(ibctesting.GetChainID(1)), coord.GetChain(ibctesting.GetChainID(2)))
+	path.Setup()
+
+	sequence, err := path.EndpointA.SendPacket(clienttypes.NewHeight(1, 1000), 0, ibctesting.MockPacketData)
+	require.NoError(t, err)
+	packet := channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(1, 1000), 0)
+
+	require.NoError(t, path.EndpointB.UpdateClient())
+
+	packetKey := host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	proof, proofHeight := path.EndpointA.QueryForgedProof(packetKey)
+
+	msg := channeltypes.NewMsgRecvPacket(packet, proof, proofHeight, path.EndpointB.Chain.SenderAccount.GetAddress().String())
+	_, err = path.EndpointB.Chain.SendMsgs(msg)
+	require.Error(t, err)
+
+	// the honest proof is accepted
+	require.NoError(t, path.EndpointB.RecvPacket(packet))
+	require.Equal(t, exported.Active, path.EndpointB.GetClientStatus())
+}
+
+func TestSolomachineMisbehaviourFreezesClient(t *testing.T) {
+	testCases := []struct {
+		name      string
+		misbehave func(solo *ibctesting.Solomachine, chain *ibctesting.TestChain, clientID string)
+	}{
+		{"submit misbehaviour", (*ibctesting.Solomachine).SubmitMisbehaviour},
+		{"update client with misbehaviour", (*ibctesting.Solomachine).UpdateClientWithMisbehaviour},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			coord := ibctesting.NewCoordinator(t, 1)
+			chain := coord.GetChain(ibctesting.GetChainID(1))
+
+			solo := ibctesting.NewSolomachine(t, chain.Codec, "solomachine", "testing", 1)
+			clientID := solo.CreateClient(chain)
+
+			tc.misbehave(solo, chain, clientID)
+
+			clientState := chain.GetClientState(clientID)
+			status := chain.App.GetIBCKeeper().ClientKeeper.GetClientStatus(chain.GetContext(), clientState, clientID)
+			require.Equal(t, exported.Frozen, status)
+		})
+	}
+}
+
+func TestSolomachineForgedProofFailsVerification(t *testing.T) {
+	coord := ibctesting.NewCoordinator(t, 1)
+	chain := coord.GetChain(ibctesting.GetChainID(1))
+
+	solo := ibctesting.NewSolomachine(t, chain.Codec, "solomachine", "testing", 1)
+	clientID := solo.CreateClient(chain)
+
+	key := host.ClientStateKey()
+	value := []byte("value")
+	signBytes := &solomachine.SignBytes{
+		Sequence:    solo.Sequence,
+		Timestamp:   solo.Time,
+		Diversifier: solo.Diversifier,
+		Path:        key,
+		Data:        value,
+	}
+
+	prefix := commitmenttypes.NewMerklePrefix([]byte(exported.StoreKey))
+	path, err := commitmenttypes.ApplyPrefix(prefix, commitmenttypes.NewMerklePath(string(key)))
+	require.NoError(t, err)
+
+	verify := func(proof []byte) error {
+		ctx := chain.GetContext()
+		clientStore := chain.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, clientID)
+		clientState := chain.GetClientState(clientID)
+		return clientState.VerifyMembership(ctx, clientStore, chain.Codec, solo.GetHeight(), 0, 0, proof, path, value)
+	}
+
+	require.Error(t, verify(solo.GenerateForgedProof(signBytes)))
+	require.NoError(t, verify(solo.GenerateProof(signBytes)))
+}
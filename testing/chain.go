@@ -304,6 +304,10 @@ func (chain *TestChain) commitBlock(res *abci.ResponseFinalizeBlock) {
 	_, err := chain.App.Commit()
 	require.NoError(chain.TB, err)
 
+	if chain.Coordinator != nil && chain.Coordinator.Relayer != nil {
+		chain.Coordinator.Relayer.collectBlockEvents(chain, res)
+	}
+
 	// set the last header to the current header
 	// use nil trusted fields
 	chain.LatestCommittedHeader = chain.CurrentTMClientHeader()
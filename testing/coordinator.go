@@ -24,6 +24,10 @@ type Coordinator struct {
 
 	CurrentTime time.Time
 	Chains      map[string]*TestChain
+
+	// Relayer relays the packets of its registered paths after each CommitBlock. It is nil unless
+	// enabled with EnableRelayer.
+	Relayer *Relayer
 }
 
 // NewCoordinator initializes Coordinator with N TestChain's
@@ -145,6 +149,7 @@ func GetChainID(index int) string {
 }
 
 // CommitBlock commits a block on the provided indexes and then increments the global time.
+// If a Relayer is enabled, pending packets, acknowledgements and timeouts are relayed afterwards.
 //
 // CONTRACT: the passed in list of indexes must not contain duplicates
 func (coord *Coordinator) CommitBlock(chains ...*TestChain) {
@@ -152,12 +157,21 @@ func (coord *Coordinator) CommitBlock(chains ...*TestChain) {
 		chain.NextBlock()
 	}
 	coord.IncrementTime()
+
+	if coord.Relayer != nil {
+		coord.Relayer.relayAfterCommit()
+	}
 }
 
 // CommitNBlocks commits n blocks to state and updates the block height by 1 for each commit.
+// If a Relayer is enabled, pending packets, acknowledgements and timeouts are relayed afterwards.
 func (coord *Coordinator) CommitNBlocks(chain *TestChain, n uint64) {
 	for i := uint64(0); i < n; i++ {
 		chain.NextBlock()
 		coord.IncrementTime()
 	}
+
+	if coord.Relayer != nil {
+		coord.Relayer.relayAfterCommit()
+	}
 }
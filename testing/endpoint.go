@@ -316,6 +316,116 @@ func (endpoint *Endpoint) QueryConnectionHandshakeProof() (
 	return clientState, clientProof, consensusProof, consensusHeight, connectioProof, proofHeight
 }
 
+// ConnUpgradeInit initializes a connection upgrade with the provided upgrade fields on the
+// associated endpoint by submitting and passing a governance proposal.
+func (endpoint *Endpoint) ConnUpgradeInit(upgradeFields connectiontypes.ConnectionUpgradeFields) error {
+	msg := connectiontypes.NewMsgConnectionUpgradeInit(
+		endpoint.ConnectionID,
+		upgradeFields,
+		endpoint.Chain.GetSimApp().IBCKeeper.GetAuthority(),
+	)
+
+	proposal, err := govtypesv1.NewMsgSubmitProposal(
+		[]sdk.Msg{msg},
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, govtypesv1.DefaultMinDepositTokens)),
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+		endpoint.ConnectionID,
+		"connection-upgrade-init",
+		fmt.Sprintf("gov proposal for initializing connection upgrade: %s", endpoint.ConnectionID),
+		false,
+	)
+	require.NoError(endpoint.Chain.TB, err)
+
+	res, err := endpoint.Chain.SendMsgs(proposal)
+	if err != nil {
+		return err
+	}
+
+	proposalID, err := ParseProposalIDFromEvents(res.Events)
+	require.NoError(endpoint.Chain.TB, err)
+
+	return VoteAndCheckProposalStatus(endpoint, proposalID)
+}
+
+// ConnUpgradeTry sends a MsgConnectionUpgradeTry on the associated endpoint.
+func (endpoint *Endpoint) ConnUpgradeTry() error {
+	err := endpoint.UpdateClient()
+	require.NoError(endpoint.Chain.TB, err)
+
+	counterpartyConnection := endpoint.Counterparty.GetConnection()
+	if counterpartyConnection.ProposedUpgrade == nil {
+		return fmt.Errorf("could not find upgrade for connection %s", endpoint.Counterparty.ConnectionID)
+	}
+
+	connectionProof, height := endpoint.Counterparty.QueryProof(host.ConnectionKey(endpoint.Counterparty.ConnectionID))
+
+	msg := connectiontypes.NewMsgConnectionUpgradeTry(
+		endpoint.ConnectionID,
+		*counterpartyConnection.ProposedUpgrade,
+		counterpartyConnection.UpgradeSequence,
+		connectionProof, height,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+
+	return endpoint.Chain.sendMsgs(msg)
+}
+
+// ConnUpgradeAck sends a MsgConnectionUpgradeAck on the associated endpoint.
+func (endpoint *Endpoint) ConnUpgradeAck() error {
+	err := endpoint.UpdateClient()
+	require.NoError(endpoint.Chain.TB, err)
+
+	counterpartyConnection := endpoint.Counterparty.GetConnection()
+	if counterpartyConnection.ProposedUpgrade == nil {
+		return fmt.Errorf("could not find upgrade for connection %s", endpoint.Counterparty.ConnectionID)
+	}
+
+	connectionProof, height := endpoint.Counterparty.QueryProof(host.ConnectionKey(endpoint.Counterparty.ConnectionID))
+
+	msg := connectiontypes.NewMsgConnectionUpgradeAck(
+		endpoint.ConnectionID,
+		*counterpartyConnection.ProposedUpgrade,
+		connectionProof, height,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+
+	return endpoint.Chain.sendMsgs(msg)
+}
+
+// ConnUpgradeConfirm sends a MsgConnectionUpgradeConfirm on the associated endpoint.
+func (endpoint *Endpoint) ConnUpgradeConfirm() error {
+	err := endpoint.UpdateClient()
+	require.NoError(endpoint.Chain.TB, err)
+
+	connectionProof, height := endpoint.Counterparty.QueryProof(host.ConnectionKey(endpoint.Counterparty.ConnectionID))
+
+	msg := connectiontypes.NewMsgConnectionUpgradeConfirm(
+		endpoint.ConnectionID,
+		connectionProof, height,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+
+	return endpoint.Chain.sendMsgs(msg)
+}
+
+// ConnUpgradeTimeout sends a MsgConnectionUpgradeTimeout on the associated endpoint.
+func (endpoint *Endpoint) ConnUpgradeTimeout() error {
+	err := endpoint.UpdateClient()
+	require.NoError(endpoint.Chain.TB, err)
+
+	counterpartyConnection := endpoint.Counterparty.GetConnection()
+	connectionProof, height := endpoint.Counterparty.QueryProof(host.ConnectionKey(endpoint.Counterparty.ConnectionID))
+
+	msg := connectiontypes.NewMsgConnectionUpgradeTimeout(
+		endpoint.ConnectionID,
+		counterpartyConnection,
+		connectionProof, height,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+
+	return endpoint.Chain.sendMsgs(msg)
+}
+
 // ChanOpenInit will construct and execute a MsgChannelOpenInit on the associated endpoint.
 func (endpoint *Endpoint) ChanOpenInit() error {
 	msg := channeltypes.NewMsgChannelOpenInit(
@@ -434,11 +544,16 @@ func (endpoint *Endpoint) SendPacket(
 	channelCap := endpoint.Chain.GetChannelCapability(endpoint.ChannelConfig.PortID, endpoint.ChannelID)
 
 	// no need to send message, acting as a module
-	sequence, err := endpoint.Chain.App.GetIBCKeeper().ChannelKeeper.SendPacket(endpoint.Chain.GetContext(), channelCap, endpoint.ChannelConfig.PortID, endpoint.ChannelID, timeoutHeight, timeoutTimestamp, data)
+	ctx := endpoint.Chain.GetContext()
+	sequence, err := endpoint.Chain.App.GetIBCKeeper().ChannelKeeper.SendPacket(ctx, channelCap, endpoint.ChannelConfig.PortID, endpoint.ChannelID, timeoutHeight, timeoutTimestamp, data)
 	if err != nil {
 		return 0, err
 	}
 
+	if endpoint.Chain.Coordinator.Relayer != nil {
+		endpoint.Chain.Coordinator.Relayer.collectEvents(endpoint.Chain, ctx.EventManager().ABCIEvents())
+	}
+
 	// commit changes since no message was sent
 	endpoint.Chain.Coordinator.CommitBlock(endpoint.Chain)
 
@@ -489,11 +604,16 @@ func (endpoint *Endpoint) WriteAcknowledgement(ack exported.Acknowledgement, pac
 	channelCap := endpoint.Chain.GetChannelCapability(packet.GetDestPort(), packet.GetDestChannel())
 
 	// no need to send message, acting as a handler
-	err := endpoint.Chain.App.GetIBCKeeper().ChannelKeeper.WriteAcknowledgement(endpoint.Chain.GetContext(), channelCap, packet, ack)
+	ctx := endpoint.Chain.GetContext()
+	err := endpoint.Chain.App.GetIBCKeeper().ChannelKeeper.WriteAcknowledgement(ctx, channelCap, packet, ack)
 	if err != nil {
 		return err
 	}
 
+	if endpoint.Chain.Coordinator.Relayer != nil {
+		endpoint.Chain.Coordinator.Relayer.collectEvents(endpoint.Chain, ctx.EventManager().ABCIEvents())
+	}
+
 	// commit changes since no message was sent
 	endpoint.Chain.Coordinator.CommitBlock(endpoint.Chain)
 
@@ -513,22 +633,25 @@ func (endpoint *Endpoint) AcknowledgePacket(packet channeltypes.Packet, ack []by
 
 // TimeoutPacket sends a MsgTimeout to the channel associated with the endpoint.
 func (endpoint *Endpoint) TimeoutPacket(packet channeltypes.Packet) error {
+	counterparty := endpoint.Counterparty
+	nextSeqRecv, found := counterparty.Chain.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(counterparty.Chain.GetContext(), counterparty.ChannelConfig.PortID, counterparty.ChannelID)
+	require.True(endpoint.Chain.TB, found)
+
 	// get proof for timeout based on channel order
 	var packetKey []byte
 
 	switch endpoint.ChannelConfig.Order {
 	case channeltypes.ORDERED:
 		packetKey = host.NextSequenceRecvKey(packet.GetDestPort(), packet.GetDestChannel())
+	case channeltypes.ORDERED_ALLOW_TIMEOUT:
+		packetKey = timeoutProofKeyAllowTimeout(packet, nextSeqRecv)
 	case channeltypes.UNORDERED:
 		packetKey = host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
 	default:
 		return fmt.Errorf("unsupported order type %s", endpoint.ChannelConfig.Order)
 	}
 
-	counterparty := endpoint.Counterparty
 	proof, proofHeight := counterparty.QueryProof(packetKey)
-	nextSeqRecv, found := counterparty.Chain.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(counterparty.Chain.GetContext(), counterparty.ChannelConfig.PortID, counterparty.ChannelID)
-	require.True(endpoint.Chain.TB, found)
 
 	timeoutMsg := channeltypes.NewMsgTimeout(
 		packet, nextSeqRecv,
@@ -540,12 +663,17 @@ func (endpoint *Endpoint) TimeoutPacket(packet channeltypes.Packet) error {
 
 // TimeoutOnClose sends a MsgTimeoutOnClose to the channel associated with the endpoint.
 func (endpoint *Endpoint) TimeoutOnClose(packet channeltypes.Packet) error {
+	nextSeqRecv, found := endpoint.Counterparty.Chain.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(endpoint.Counterparty.Chain.GetContext(), endpoint.ChannelConfig.PortID, endpoint.ChannelID)
+	require.True(endpoint.Chain.TB, found)
+
 	// get proof for timeout based on channel order
 	var packetKey []byte
 
 	switch endpoint.ChannelConfig.Order {
 	case channeltypes.ORDERED:
 		packetKey = host.NextSequenceRecvKey(packet.GetDestPort(), packet.GetDestChannel())
+	case channeltypes.ORDERED_ALLOW_TIMEOUT:
+		packetKey = timeoutProofKeyAllowTimeout(packet, nextSeqRecv)
 	case channeltypes.UNORDERED:
 		packetKey = host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
 	default:
@@ -557,9 +685,6 @@ func (endpoint *Endpoint) TimeoutOnClose(packet channeltypes.Packet) error {
 	channelKey := host.ChannelKey(packet.GetDestPort(), packet.GetDestChannel())
 	closedProof, _ := endpoint.Counterparty.QueryProof(channelKey)
 
-	nextSeqRecv, found := endpoint.Counterparty.Chain.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(endpoint.Counterparty.Chain.GetContext(), endpoint.ChannelConfig.PortID, endpoint.ChannelID)
-	require.True(endpoint.Chain.TB, found)
-
 	timeoutOnCloseMsg := channeltypes.NewMsgTimeoutOnClose(
 		packet, nextSeqRecv,
 		proof, closedProof, proofHeight, endpoint.Chain.SenderAccount.GetAddress().String(),
@@ -569,6 +694,17 @@ func (endpoint *Endpoint) TimeoutOnClose(packet channeltypes.Packet) error {
 	return endpoint.Chain.sendMsgs(timeoutOnCloseMsg)
 }
 
+// timeoutProofKeyAllowTimeout returns the key proven to time out a packet sent on an ORDERED_ALLOW_TIMEOUT channel.
+// The next sequence receive is proven if the counterparty has not yet processed the packet, otherwise the timeout
+// receipt written by the counterparty when skipping the packet is proven.
+func timeoutProofKeyAllowTimeout(packet channeltypes.Packet, nextSeqRecv uint64) []byte {
+	if nextSeqRecv > packet.GetSequence() {
+		return host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	}
+
+	return host.NextSequenceRecvKey(packet.GetDestPort(), packet.GetDestChannel())
+}
+
 // QueryChannelUpgradeProof returns all the proofs necessary to execute UpgradeTry/UpgradeAck/UpgradeOpen.
 // It returns the proof for the channel on the endpoint's chain, the proof for the upgrade attempt on the
 // endpoint's chain, and the height at which the proof was queried.
@@ -83,60 +83,71 @@ func ParsePacketsFromEvents(events []abci.Event) ([]channeltypes.Packet, error)
 	var packets []channeltypes.Packet
 	for _, ev := range events {
 		if ev.Type == channeltypes.EventTypeSendPacket {
-			var packet channeltypes.Packet
-			for _, attr := range ev.Attributes {
-				switch attr.Key {
-				case channeltypes.AttributeKeyData: //nolint:staticcheck // DEPRECATED
-					packet.Data = []byte(attr.Value)
-
-				case channeltypes.AttributeKeySequence:
-					seq, err := strconv.ParseUint(attr.Value, 10, 64)
-					if err != nil {
-						return ferr(err)
-					}
+			packet, err := parsePacketFromEvent(ev)
+			if err != nil {
+				return ferr(err)
+			}
 
-					packet.Sequence = seq
+			packets = append(packets, packet)
+		}
+	}
+	if len(packets) == 0 {
+		return ferr(fmt.Errorf("acknowledgement event attribute not found"))
+	}
+	return packets, nil
+}
 
-				case channeltypes.AttributeKeySrcPort:
-					packet.SourcePort = attr.Value
+// parsePacketFromEvent parses the packet from the attributes of a send_packet or
+// write_acknowledgement event.
+func parsePacketFromEvent(ev abci.Event) (channeltypes.Packet, error) {
+	var packet channeltypes.Packet
+	for _, attr := range ev.Attributes {
+		switch attr.Key {
+		case channeltypes.AttributeKeyData: //nolint:staticcheck // DEPRECATED
+			packet.Data = []byte(attr.Value)
+
+		case channeltypes.AttributeKeySequence:
+			seq, err := strconv.ParseUint(attr.Value, 10, 64)
+			if err != nil {
+				return channeltypes.Packet{}, err
+			}
 
-				case channeltypes.AttributeKeySrcChannel:
-					packet.SourceChannel = attr.Value
+			packet.Sequence = seq
 
-				case channeltypes.AttributeKeyDstPort:
-					packet.DestinationPort = attr.Value
+		case channeltypes.AttributeKeySrcPort:
+			packet.SourcePort = attr.Value
 
-				case channeltypes.AttributeKeyDstChannel:
-					packet.DestinationChannel = attr.Value
+		case channeltypes.AttributeKeySrcChannel:
+			packet.SourceChannel = attr.Value
 
-				case channeltypes.AttributeKeyTimeoutHeight:
-					height, err := clienttypes.ParseHeight(attr.Value)
-					if err != nil {
-						return ferr(err)
-					}
+		case channeltypes.AttributeKeyDstPort:
+			packet.DestinationPort = attr.Value
 
-					packet.TimeoutHeight = height
+		case channeltypes.AttributeKeyDstChannel:
+			packet.DestinationChannel = attr.Value
 
-				case channeltypes.AttributeKeyTimeoutTimestamp:
-					timestamp, err := strconv.ParseUint(attr.Value, 10, 64)
-					if err != nil {
-						return ferr(err)
-					}
+		case channeltypes.AttributeKeyTimeoutHeight:
+			height, err := clienttypes.ParseHeight(attr.Value)
+			if err != nil {
+				return channeltypes.Packet{}, err
+			}
 
-					packet.TimeoutTimestamp = timestamp
+			packet.TimeoutHeight = height
 
-				default:
-					continue
-				}
+		case channeltypes.AttributeKeyTimeoutTimestamp:
+			timestamp, err := strconv.ParseUint(attr.Value, 10, 64)
+			if err != nil {
+				return channeltypes.Packet{}, err
 			}
 
-			packets = append(packets, packet)
+			packet.TimeoutTimestamp = timestamp
+
+		default:
+			continue
 		}
 	}
-	if len(packets) == 0 {
-		return ferr(fmt.Errorf("acknowledgement event attribute not found"))
-	}
-	return packets, nil
+
+	return packet, nil
 }
 
 // ParseAckFromEvents parses events emitted from a MsgRecvPacket and returns the
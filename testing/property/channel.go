@@ -0,0 +1,579 @@
+package property
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	"github.com/cosmos/ibc-go/v8/testing/mock"
+)
+
+// ChannelActionKind defines the kind of a ChannelAction.
+type ChannelActionKind int
+
+const (
+	ChanOpenInit ChannelActionKind = iota
+	ChanOpenTry
+	ChanOpenAck
+	ChanOpenConfirm
+	ChanUpgradeInit
+	ChanUpgradeTry
+	ChanUpgradeAck
+	ChanUpgradeConfirm
+	ChanUpgradeOpen
+	SendPacket
+	RecvPacket
+	AcknowledgePacket
+	TimeoutPacket
+	ChanCloseInit
+	ChanCloseConfirm
+	AdvanceBlocks
+)
+
+var channelActionKindNames = map[ChannelActionKind]string{
+	ChanOpenInit:       "ChanOpenInit",
+	ChanOpenTry:        "ChanOpenTry",
+	ChanOpenAck:        "ChanOpenAck",
+	ChanOpenConfirm:    "ChanOpenConfirm",
+	ChanUpgradeInit:    "ChanUpgradeInit",
+	ChanUpgradeTry:     "ChanUpgradeTry",
+	ChanUpgradeAck:     "ChanUpgradeAck",
+	ChanUpgradeConfirm: "ChanUpgradeConfirm",
+	ChanUpgradeOpen:    "ChanUpgradeOpen",
+	SendPacket:         "SendPacket",
+	RecvPacket:         "RecvPacket",
+	AcknowledgePacket:  "AcknowledgePacket",
+	TimeoutPacket:      "TimeoutPacket",
+	ChanCloseInit:      "ChanCloseInit",
+	ChanCloseConfirm:   "ChanCloseConfirm",
+	AdvanceBlocks:      "AdvanceBlocks",
+}
+
+// String implements the fmt.Stringer interface.
+func (k ChannelActionKind) String() string {
+	if name, ok := channelActionKindNames[k]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("unknown (%d)", int(k))
+}
+
+// ChannelAction is an action of the channel state machine executed on one of its two endpoints.
+type ChannelAction struct {
+	Kind ChannelActionKind
+	// Side is the index of the endpoint the action is executed on: 0 for endpoint A and 1 for endpoint B.
+	Side int
+	// Sequence is the sequence of the packet for RecvPacket, AcknowledgePacket and TimeoutPacket actions.
+	// The packet is sent by the counterparty for RecvPacket actions and by the endpoint otherwise.
+	Sequence uint64
+	// TimeoutOffset is the number of blocks after the current height of the counterparty at which a packet
+	// sent by a SendPacket action times out.
+	TimeoutOffset uint64
+	// Blocks is the number of blocks committed by an AdvanceBlocks action.
+	Blocks uint64
+}
+
+// String implements the fmt.Stringer interface.
+func (a ChannelAction) String() string {
+	side := []string{"A", "B"}[a.Side]
+	switch a.Kind {
+	case RecvPacket, AcknowledgePacket, TimeoutPacket:
+		return fmt.Sprintf("%s(%s, sequence=%d)", a.Kind, side, a.Sequence)
+	case SendPacket:
+		return fmt.Sprintf("%s(%s, timeout offset=%d)", a.Kind, side, a.TimeoutOffset)
+	case AdvanceBlocks:
+		return fmt.Sprintf("%s(%s, blocks=%d)", a.Kind, side, a.Blocks)
+	default:
+		return fmt.Sprintf("%s(%s)", a.Kind, side)
+	}
+}
+
+// packetStatus defines the status of a packet in the reference model.
+type packetStatus int
+
+const (
+	packetSent packetStatus = iota
+	packetReceived
+	packetAcknowledged
+	packetTimedOut
+)
+
+// modelPacket is a packet tracked by the reference model.
+type modelPacket struct {
+	packet channeltypes.Packet
+	status packetStatus
+}
+
+// channelModel is the reference model of a channel between two endpoints.
+type channelModel struct {
+	order    channeltypes.Order
+	states   [2]channeltypes.State
+	versions [2]string
+
+	upgradeSequences [2]uint64
+	// upgradeInitiator is the side which initialized the upgrade in progress, or -1 if no upgrade is in progress.
+	upgradeInitiator int
+	// upgradeStep is the last step of the upgrade in progress.
+	upgradeStep    ChannelActionKind
+	upgradeVersion string
+
+	// packets contains the packets sent by each side, indexed by sequence - 1.
+	packets [2][]*modelPacket
+}
+
+// ChannelStateMachine is a StateMachine for the channel handshake, upgrade, packet, timeout and closing
+// state machines of 04-channel. The system under test is a channel between the mock applications of two
+// test chains, starting from an open connection. The reference model only enables the actions it can
+// predict the outcome of: packets are only sent, received, acknowledged and timed out while both channel
+// ends are OPEN and no upgrade is in progress, and upgrades are only initialized once every packet has been
+// acknowledged or timed out.
+type ChannelStateMachine struct {
+	path      *ibctesting.Path
+	endpoints [2]*ibctesting.Endpoint
+	model     *channelModel
+}
+
+var _ StateMachine = (*ChannelStateMachine)(nil)
+
+// NewChannelStateMachine creates a coordinator with two chains joined by an open connection and returns
+// a ChannelStateMachine for a channel with the provided ordering between them. Only ORDERED and UNORDERED
+// channels are supported.
+func NewChannelStateMachine(t *testing.T, order channeltypes.Order) *ChannelStateMachine {
+	t.Helper()
+
+	if order != channeltypes.ORDERED && order != channeltypes.UNORDERED {
+		t.Fatalf("unsupported channel ordering %s", order)
+	}
+
+	coord := ibctesting.NewCoordinator(t, 2)
+	path := ibctesting.NewPath(coord.GetChain(ibctesting.GetChainID(1)), coord.GetChain(ibctesting.GetChainID(2)))
+	path.EndpointA.ChannelConfig.Order = order
+	path.EndpointB.ChannelConfig.Order = order
+	path.SetupConnections()
+
+	return &ChannelStateMachine{
+		path:      path,
+		endpoints: [2]*ibctesting.Endpoint{path.EndpointA, path.EndpointB},
+		model: &channelModel{
+			order:            order,
+			upgradeInitiator: -1,
+		},
+	}
+}
+
+// Path returns the path of the system under test.
+func (sm *ChannelStateMachine) Path() *ibctesting.Path {
+	return sm.path
+}
+
+// Actions implements StateMachine.
+func (sm *ChannelStateMachine) Actions(rng *rand.Rand) []Action {
+	candidates := []ChannelAction{}
+	for side := 0; side < 2; side++ {
+		for _, kind := range []ChannelActionKind{
+			ChanOpenInit, ChanOpenTry, ChanOpenAck, ChanOpenConfirm,
+			ChanUpgradeInit, ChanUpgradeTry, ChanUpgradeAck, ChanUpgradeConfirm, ChanUpgradeOpen,
+			ChanCloseInit, ChanCloseConfirm,
+		} {
+			candidates = append(candidates, ChannelAction{Kind: kind, Side: side})
+		}
+
+		candidates = append(candidates,
+			ChannelAction{Kind: SendPacket, Side: side, TimeoutOffset: uint64(rng.Intn(8) + 2)},
+			ChannelAction{Kind: AdvanceBlocks, Side: side, Blocks: uint64(rng.Intn(3) + 1)},
+		)
+
+		for _, p := range sm.model.packets[1-side] {
+			candidates = append(candidates, ChannelAction{Kind: RecvPacket, Side: side, Sequence: p.packet.Sequence})
+		}
+
+		for _, p := range sm.model.packets[side] {
+			candidates = append(candidates,
+				ChannelAction{Kind: AcknowledgePacket, Side: side, Sequence: p.packet.Sequence},
+				ChannelAction{Kind: TimeoutPacket, Side: side, Sequence: p.packet.Sequence},
+			)
+		}
+	}
+
+	var actions []Action
+	for _, action := range candidates {
+		if sm.Enabled(action) {
+			actions = append(actions, action)
+		}
+	}
+
+	return actions
+}
+
+// Enabled implements StateMachine.
+func (sm *ChannelStateMachine) Enabled(action Action) bool {
+	a, ok := action.(ChannelAction)
+	if !ok || a.Side < 0 || a.Side > 1 {
+		return false
+	}
+
+	m := sm.model
+	self, counterparty := a.Side, 1-a.Side
+	open := m.states[self] == channeltypes.OPEN && m.states[counterparty] == channeltypes.OPEN
+	idle := open && m.upgradeInitiator == -1
+
+	switch a.Kind {
+	case ChanOpenInit:
+		return m.states[self] == channeltypes.UNINITIALIZED && m.states[counterparty] == channeltypes.UNINITIALIZED
+	case ChanOpenTry:
+		return m.states[self] == channeltypes.UNINITIALIZED && m.states[counterparty] == channeltypes.INIT
+	case ChanOpenAck:
+		return m.states[self] == channeltypes.INIT && m.states[counterparty] == channeltypes.TRYOPEN
+	case ChanOpenConfirm:
+		return m.states[self] == channeltypes.TRYOPEN && m.states[counterparty] == channeltypes.OPEN
+	case ChanUpgradeInit:
+		return idle && !m.hasInFlightPackets()
+	case ChanUpgradeTry:
+		return m.upgradeInitiator == counterparty && m.upgradeStep == ChanUpgradeInit
+	case ChanUpgradeAck:
+		return m.upgradeInitiator == self && m.upgradeStep == ChanUpgradeTry
+	case ChanUpgradeConfirm:
+		return m.upgradeInitiator == counterparty && m.upgradeStep == ChanUpgradeAck
+	case ChanUpgradeOpen:
+		return m.upgradeInitiator == self && m.upgradeStep == ChanUpgradeConfirm
+	case ChanCloseInit:
+		return idle
+	case ChanCloseConfirm:
+		return m.upgradeInitiator == -1 && m.states[self] == channeltypes.OPEN && m.states[counterparty] == channeltypes.CLOSED
+	case SendPacket:
+		return idle && a.TimeoutOffset > 0
+	case AdvanceBlocks:
+		return a.Blocks > 0 && m.hasInFlightPackets() && !m.isClosed()
+	case RecvPacket:
+		p := m.packet(counterparty, a.Sequence)
+		if !idle || p == nil || p.status != packetSent || sm.mayHaveTimedOut(p.packet, self) {
+			return false
+		}
+
+		// packets on ordered channels must be received in order
+		return m.order != channeltypes.ORDERED || m.isFirstWithStatus(counterparty, a.Sequence, packetSent)
+	case AcknowledgePacket:
+		p := m.packet(self, a.Sequence)
+		if !idle || p == nil || p.status != packetReceived {
+			return false
+		}
+
+		// acknowledgements on ordered channels must be processed in order
+		return m.order != channeltypes.ORDERED || m.isFirstWithStatus(self, a.Sequence, packetSent, packetReceived)
+	case TimeoutPacket:
+		p := m.packet(self, a.Sequence)
+		return idle && p != nil && p.status == packetSent && sm.hasTimedOut(p.packet, counterparty)
+	default:
+		return false
+	}
+}
+
+// Apply implements StateMachine.
+func (sm *ChannelStateMachine) Apply(action Action) error {
+	a, ok := action.(ChannelAction)
+	if !ok {
+		return fmt.Errorf("unexpected action type %T", action)
+	}
+
+	if err := sm.execute(a); err != nil {
+		return fmt.Errorf("%s failed: %w", a, err)
+	}
+
+	sm.transition(a)
+
+	if err := sm.verify(); err != nil {
+		return fmt.Errorf("state diverged from model after %s: %w", a, err)
+	}
+
+	return nil
+}
+
+// execute executes the action on the system under test.
+func (sm *ChannelStateMachine) execute(a ChannelAction) error {
+	endpoint := sm.endpoints[a.Side]
+
+	switch a.Kind {
+	case ChanOpenInit:
+		return endpoint.ChanOpenInit()
+	case ChanOpenTry:
+		return endpoint.ChanOpenTry()
+	case ChanOpenAck:
+		return endpoint.ChanOpenAck()
+	case ChanOpenConfirm:
+		return endpoint.ChanOpenConfirm()
+	case ChanUpgradeInit:
+		for _, ep := range sm.endpoints {
+			ep.ChannelConfig.ProposedUpgrade.Fields.Version = sm.model.nextVersion(a.Side)
+		}
+
+		return endpoint.ChanUpgradeInit()
+	case ChanUpgradeTry:
+		return endpoint.ChanUpgradeTry()
+	case ChanUpgradeAck:
+		return endpoint.ChanUpgradeAck()
+	case ChanUpgradeConfirm:
+		return endpoint.ChanUpgradeConfirm()
+	case ChanUpgradeOpen:
+		return endpoint.ChanUpgradeOpen()
+	case ChanCloseInit:
+		return endpoint.ChanCloseInit()
+	case ChanCloseConfirm:
+		if err := endpoint.UpdateClient(); err != nil {
+			return err
+		}
+
+		counterparty := endpoint.Counterparty
+		proof, proofHeight := counterparty.Chain.QueryProof(host.ChannelKey(counterparty.ChannelConfig.PortID, counterparty.ChannelID))
+		msg := channeltypes.NewMsgChannelCloseConfirm(
+			endpoint.ChannelConfig.PortID, endpoint.ChannelID, proof, proofHeight,
+			endpoint.Chain.SenderAccount.GetAddress().String(), counterparty.GetChannel().UpgradeSequence,
+		)
+
+		_, err := endpoint.Chain.SendMsgs(msg)
+		return err
+	case SendPacket:
+		selfHeight := clienttypes.GetSelfHeight(endpoint.Counterparty.Chain.GetContext())
+		timeoutHeight := clienttypes.NewHeight(selfHeight.RevisionNumber, selfHeight.RevisionHeight+a.TimeoutOffset)
+
+		sequence, err := endpoint.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+		if err != nil {
+			return err
+		}
+
+		packet := channeltypes.NewPacket(
+			ibctesting.MockPacketData, sequence,
+			endpoint.ChannelConfig.PortID, endpoint.ChannelID,
+			endpoint.Counterparty.ChannelConfig.PortID, endpoint.Counterparty.ChannelID,
+			timeoutHeight, 0,
+		)
+
+		sm.model.packets[a.Side] = append(sm.model.packets[a.Side], &modelPacket{packet: packet, status: packetSent})
+		return nil
+	case RecvPacket:
+		if err := endpoint.UpdateClient(); err != nil {
+			return err
+		}
+
+		return endpoint.RecvPacket(sm.model.packet(1-a.Side, a.Sequence).packet)
+	case AcknowledgePacket:
+		if err := endpoint.UpdateClient(); err != nil {
+			return err
+		}
+
+		return endpoint.AcknowledgePacket(sm.model.packet(a.Side, a.Sequence).packet, mock.MockAcknowledgement.Acknowledgement())
+	case TimeoutPacket:
+		if err := endpoint.UpdateClient(); err != nil {
+			return err
+		}
+
+		return endpoint.TimeoutPacket(sm.model.packet(a.Side, a.Sequence).packet)
+	case AdvanceBlocks:
+		endpoint.Chain.Coordinator.CommitNBlocks(endpoint.Chain, a.Blocks)
+		return nil
+	default:
+		return fmt.Errorf("unknown action kind %s", a.Kind)
+	}
+}
+
+// transition applies the action to the reference model.
+func (sm *ChannelStateMachine) transition(a ChannelAction) {
+	m := sm.model
+	self, counterparty := a.Side, 1-a.Side
+
+	switch a.Kind {
+	case ChanOpenInit:
+		m.states[self] = channeltypes.INIT
+		m.versions[self] = mock.Version
+	case ChanOpenTry:
+		m.states[self] = channeltypes.TRYOPEN
+		m.versions[self] = mock.Version
+	case ChanOpenAck, ChanOpenConfirm:
+		m.states[self] = channeltypes.OPEN
+	case ChanUpgradeInit:
+		m.upgradeInitiator = self
+		m.upgradeStep = a.Kind
+		m.upgradeVersion = m.nextVersion(self)
+		m.upgradeSequences[self]++
+	case ChanUpgradeTry:
+		m.upgradeStep = a.Kind
+		m.states[self] = channeltypes.FLUSHING
+		m.upgradeSequences[self] = m.upgradeSequences[counterparty]
+	case ChanUpgradeAck:
+		// no packets are in flight, so flushing completes immediately
+		m.upgradeStep = a.Kind
+		m.states[self] = channeltypes.FLUSHCOMPLETE
+	case ChanUpgradeConfirm:
+		// both channel ends have completed flushing, so the channel is opened immediately
+		m.upgradeStep = a.Kind
+		m.states[self] = channeltypes.OPEN
+		m.versions[self] = m.upgradeVersion
+	case ChanUpgradeOpen:
+		m.upgradeInitiator = -1
+		m.states[self] = channeltypes.OPEN
+		m.versions[self] = m.upgradeVersion
+	case ChanCloseInit, ChanCloseConfirm:
+		m.states[self] = channeltypes.CLOSED
+	case RecvPacket:
+		m.packet(counterparty, a.Sequence).status = packetReceived
+	case AcknowledgePacket:
+		m.packet(self, a.Sequence).status = packetAcknowledged
+	case TimeoutPacket:
+		m.packet(self, a.Sequence).status = packetTimedOut
+		if m.order == channeltypes.ORDERED {
+			m.states[self] = channeltypes.CLOSED
+		}
+	}
+}
+
+// verify returns an error if the state of the system under test diverges from the reference model.
+func (sm *ChannelStateMachine) verify() error {
+	m := sm.model
+	for side, endpoint := range sm.endpoints {
+		if endpoint.ChannelID == "" {
+			if m.states[side] != channeltypes.UNINITIALIZED {
+				return fmt.Errorf("expected channel on side %d to be %s, but it does not exist", side, m.states[side])
+			}
+			continue
+		}
+
+		channel := endpoint.GetChannel()
+		if channel.State != m.states[side] {
+			return fmt.Errorf("expected channel %s to be %s, got %s", endpoint.ChannelID, m.states[side], channel.State)
+		}
+
+		if channel.Version != m.versions[side] {
+			return fmt.Errorf("expected channel %s to have version %s, got %s", endpoint.ChannelID, m.versions[side], channel.Version)
+		}
+
+		if channel.UpgradeSequence != m.upgradeSequences[side] {
+			return fmt.Errorf("expected channel %s to have upgrade sequence %d, got %d", endpoint.ChannelID, m.upgradeSequences[side], channel.UpgradeSequence)
+		}
+
+		if err := sm.verifyPackets(side); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// verifyPackets returns an error if the packet state of the packets sent by the provided side diverges
+// from the reference model.
+func (sm *ChannelStateMachine) verifyPackets(side int) error {
+	source, destination := sm.endpoints[side], sm.endpoints[1-side]
+	sourceCtx := source.Chain.GetContext()
+	sourceKeeper := source.Chain.App.GetIBCKeeper().ChannelKeeper
+
+	nextSequenceSend, _ := sourceKeeper.GetNextSequenceSend(sourceCtx, source.ChannelConfig.PortID, source.ChannelID)
+	if expected := uint64(len(sm.model.packets[side]) + 1); nextSequenceSend != expected {
+		return fmt.Errorf("expected next sequence send %d on channel %s, got %d", expected, source.ChannelID, nextSequenceSend)
+	}
+
+	if len(sm.model.packets[side]) == 0 {
+		return nil
+	}
+
+	destinationCtx := destination.Chain.GetContext()
+	destinationKeeper := destination.Chain.App.GetIBCKeeper().ChannelKeeper
+
+	var received uint64
+	for _, p := range sm.model.packets[side] {
+		sequence := p.packet.Sequence
+		isReceived := p.status == packetReceived || p.status == packetAcknowledged
+		if isReceived {
+			received++
+		}
+
+		expCommitment := p.status == packetSent || p.status == packetReceived
+		if hasCommitment := sourceKeeper.HasPacketCommitment(sourceCtx, source.ChannelConfig.PortID, source.ChannelID, sequence); hasCommitment != expCommitment {
+			return fmt.Errorf("expected packet commitment for sequence %d on channel %s to exist: %t", sequence, source.ChannelID, expCommitment)
+		}
+
+		if hasAck := destinationKeeper.HasPacketAcknowledgement(destinationCtx, destination.ChannelConfig.PortID, destination.ChannelID, sequence); hasAck != isReceived {
+			return fmt.Errorf("expected packet acknowledgement for sequence %d on channel %s to exist: %t", sequence, destination.ChannelID, isReceived)
+		}
+
+		if sm.model.order == channeltypes.UNORDERED {
+			if _, hasReceipt := destinationKeeper.GetPacketReceipt(destinationCtx, destination.ChannelConfig.PortID, destination.ChannelID, sequence); hasReceipt != isReceived {
+				return fmt.Errorf("expected packet receipt for sequence %d on channel %s to exist: %t", sequence, destination.ChannelID, isReceived)
+			}
+		}
+	}
+
+	if sm.model.order == channeltypes.ORDERED {
+		nextSequenceRecv, _ := destinationKeeper.GetNextSequenceRecv(destinationCtx, destination.ChannelConfig.PortID, destination.ChannelID)
+		if nextSequenceRecv != received+1 {
+			return fmt.Errorf("expected next sequence recv %d on channel %s, got %d", received+1, destination.ChannelID, nextSequenceRecv)
+		}
+	}
+
+	return nil
+}
+
+// mayHaveTimedOut returns true if the packet may time out before it is received on the provided side.
+// A margin of blocks is kept for the blocks committed while relaying the packet.
+func (sm *ChannelStateMachine) mayHaveTimedOut(packet channeltypes.Packet, side int) bool {
+	selfHeight := clienttypes.GetSelfHeight(sm.endpoints[side].Chain.GetContext())
+	return selfHeight.RevisionHeight+2 >= packet.TimeoutHeight.RevisionHeight
+}
+
+// hasTimedOut returns true if the packet has timed out on the provided side with a margin of one block.
+func (sm *ChannelStateMachine) hasTimedOut(packet channeltypes.Packet, side int) bool {
+	selfHeight := clienttypes.GetSelfHeight(sm.endpoints[side].Chain.GetContext())
+	return selfHeight.RevisionHeight > packet.TimeoutHeight.RevisionHeight
+}
+
+// packet returns the packet with the given sequence sent by the provided side, or nil if it does not exist.
+func (m *channelModel) packet(side int, sequence uint64) *modelPacket {
+	if sequence == 0 || sequence > uint64(len(m.packets[side])) {
+		return nil
+	}
+
+	return m.packets[side][sequence-1]
+}
+
+// nextVersion returns the version an upgrade initialized by the provided side upgrades the channel to. Upgrades
+// alternate between the default and the upgrade version of the mock application.
+func (m *channelModel) nextVersion(side int) string {
+	if m.versions[side] == mock.UpgradeVersion {
+		return mock.Version
+	}
+
+	return mock.UpgradeVersion
+}
+
+// isFirstWithStatus returns true if the packet with the given sequence is the first packet sent by the
+// provided side with one of the given statuses.
+func (m *channelModel) isFirstWithStatus(side int, sequence uint64, statuses ...packetStatus) bool {
+	for _, p := range m.packets[side] {
+		for _, status := range statuses {
+			if p.status == status {
+				return p.packet.Sequence == sequence
+			}
+		}
+	}
+
+	return false
+}
+
+// hasInFlightPackets returns true if any packet has not been acknowledged or timed out.
+func (m *channelModel) hasInFlightPackets() bool {
+	for side := 0; side < 2; side++ {
+		for _, p := range m.packets[side] {
+			if p.status == packetSent || p.status == packetReceived {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// isClosed returns true if either channel end is CLOSED.
+func (m *channelModel) isClosed() bool {
+	return m.states[0] == channeltypes.CLOSED || m.states[1] == channeltypes.CLOSED
+}
@@ -0,0 +1,165 @@
+package property
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+// Action is a single step of a trace. An action must contain all of its parameters so that a trace
+// can be replayed deterministically against a new state machine.
+type Action interface {
+	fmt.Stringer
+}
+
+// StateMachine pairs a system under test with a reference model of it. The reference model decides
+// which actions are enabled, and every enabled action is expected to succeed on the system and leave
+// it in the state predicted by the model.
+type StateMachine interface {
+	// Actions returns the actions enabled by the reference model in its current state. The source of
+	// randomness may be used to choose the parameters of the returned actions.
+	Actions(rng *rand.Rand) []Action
+	// Enabled returns true if the action is enabled by the reference model in its current state.
+	Enabled(action Action) bool
+	// Apply executes the action on the system and the reference model. An error is returned if the
+	// action fails on the system or the state of the system diverges from the reference model.
+	Apply(action Action) error
+}
+
+// Config defines the parameters of a property check.
+type Config struct {
+	// Seed is the seed of the first run. Each subsequent run increments the seed.
+	Seed int64
+	// Runs is the number of random traces which are generated and checked.
+	Runs int
+	// Steps is the maximum number of actions of each trace. A trace ends early if no actions are enabled.
+	Steps int
+}
+
+// DefaultConfig returns a Config of 10 runs of at most 30 actions, starting from seed 1.
+func DefaultConfig() Config {
+	return Config{
+		Seed:  1,
+		Runs:  10,
+		Steps: 30,
+	}
+}
+
+// Failure describes a trace for which the system diverged from the reference model.
+type Failure struct {
+	// Seed is the seed of the run which generated the original trace.
+	Seed int64
+	// Trace is the minimal trace found by shrinking which reproduces the failure. The last action of
+	// the trace is the action which failed.
+	Trace []Action
+	// Err is the error returned by the last action of the trace.
+	Err error
+}
+
+// String implements the fmt.Stringer interface.
+func (f Failure) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "trace generated with seed %d failed after %d actions: %s", f.Seed, len(f.Trace), f.Err)
+	for i, action := range f.Trace {
+		fmt.Fprintf(&sb, "\n  %d: %s", i+1, action)
+	}
+
+	return sb.String()
+}
+
+// Run checks the state machines created by newStateMachine with Check and fails the test with the
+// minimal failing trace if the system diverges from the reference model.
+func Run(t *testing.T, cfg Config, newStateMachine func() StateMachine) {
+	t.Helper()
+
+	if failure := Check(cfg, newStateMachine); failure != nil {
+		t.Fatal(failure.String())
+	}
+}
+
+// Check generates cfg.Runs random traces of at most cfg.Steps actions, each applied to a new state machine
+// created by newStateMachine. The first failing trace is shrunk to a minimal reproduction and returned.
+// Nil is returned if every trace passes.
+func Check(cfg Config, newStateMachine func() StateMachine) *Failure {
+	for run := 0; run < cfg.Runs; run++ {
+		seed := cfg.Seed + int64(run)
+		rng := rand.New(rand.NewSource(seed))
+
+		trace, err := generate(rng, cfg.Steps, newStateMachine())
+		if err == nil {
+			continue
+		}
+
+		trace, err = Shrink(trace, err, newStateMachine)
+		return &Failure{Seed: seed, Trace: trace, Err: err}
+	}
+
+	return nil
+}
+
+// Shrink minimises a failing trace by repeatedly removing chunks of actions, from halves of the trace down
+// to single actions, and keeping every reduced trace which still fails when replayed against a new state
+// machine. Actions which are no longer enabled during a replay are skipped. The minimal trace and the error
+// returned by its last action are returned.
+func Shrink(trace []Action, err error, newStateMachine func() StateMachine) ([]Action, error) {
+	for size := len(trace) / 2; size >= 1; {
+		reduced := false
+		for start := 0; start+size <= len(trace); {
+			candidate := append(append([]Action{}, trace[:start]...), trace[start+size:]...)
+
+			replayed, replayErr := Replay(candidate, newStateMachine())
+			if replayErr != nil && len(replayed) < len(trace) {
+				trace, err = replayed, replayErr
+				reduced = true
+				continue
+			}
+
+			start += size
+		}
+
+		if !reduced {
+			size /= 2
+		}
+	}
+
+	return trace, err
+}
+
+// Replay applies the enabled actions of the trace to the state machine in order until an action fails.
+// The applied actions are returned together with the error of the failing action, if any.
+func Replay(trace []Action, sm StateMachine) ([]Action, error) {
+	applied := make([]Action, 0, len(trace))
+	for _, action := range trace {
+		if !sm.Enabled(action) {
+			continue
+		}
+
+		applied = append(applied, action)
+		if err := sm.Apply(action); err != nil {
+			return applied, err
+		}
+	}
+
+	return applied, nil
+}
+
+// generate applies up to steps randomly chosen enabled actions to the state machine. The applied actions
+// are returned together with the error of the failing action, if any.
+func generate(rng *rand.Rand, steps int, sm StateMachine) ([]Action, error) {
+	trace := make([]Action, 0, steps)
+	for i := 0; i < steps; i++ {
+		actions := sm.Actions(rng)
+		if len(actions) == 0 {
+			break
+		}
+
+		action := actions[rng.Intn(len(actions))]
+		trace = append(trace, action)
+		if err := sm.Apply(action); err != nil {
+			return trace, err
+		}
+	}
+
+	return trace, nil
+}
@@ -0,0 +1,81 @@
+package property_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v8/testing/property"
+)
+
+// counterAction is an action of the counterStateMachine.
+type counterAction string
+
+func (a counterAction) String() string { return string(a) }
+
+// counterStateMachine is a counter whose decrement is broken when the counter is two if broken is true.
+type counterStateMachine struct {
+	broken        bool
+	model, system int
+}
+
+func (*counterStateMachine) Actions(_ *rand.Rand) []property.Action {
+	return []property.Action{counterAction("inc"), counterAction("dec"), counterAction("noop")}
+}
+
+func (sm *counterStateMachine) Enabled(action property.Action) bool {
+	return action != counterAction("dec") || sm.model > 0
+}
+
+func (sm *counterStateMachine) Apply(action property.Action) error {
+	switch action {
+	case counterAction("inc"):
+		sm.model++
+		sm.system++
+	case counterAction("dec"):
+		sm.model--
+		if !sm.broken || sm.system != 2 {
+			sm.system--
+		}
+	}
+
+	if sm.model != sm.system {
+		return fmt.Errorf("expected %d, got %d", sm.model, sm.system)
+	}
+
+	return nil
+}
+
+func TestCheckShrinksFailingTrace(t *testing.T) {
+	cfg := property.Config{Seed: 1, Runs: 20, Steps: 50}
+	failure := property.Check(cfg, func() property.StateMachine { return &counterStateMachine{broken: true} })
+	require.NotNil(t, failure)
+
+	expTrace := []property.Action{counterAction("inc"), counterAction("inc"), counterAction("dec")}
+	require.Equal(t, expTrace, failure.Trace)
+	require.EqualError(t, failure.Err, "expected 1, got 2")
+
+	// the minimal trace reproduces the failure
+	_, err := property.Replay(failure.Trace, &counterStateMachine{broken: true})
+	require.Equal(t, failure.Err, err)
+}
+
+func TestCheckPasses(t *testing.T) {
+	cfg := property.Config{Seed: 1, Runs: 5, Steps: 50}
+	failure := property.Check(cfg, func() property.StateMachine { return &counterStateMachine{} })
+	require.Nil(t, failure)
+}
+
+func TestChannelStateMachine(t *testing.T) {
+	for _, order := range []channeltypes.Order{channeltypes.UNORDERED, channeltypes.ORDERED} {
+		order := order
+		t.Run(order.String(), func(t *testing.T) {
+			property.Run(t, property.DefaultConfig(), func() property.StateMachine {
+				return property.NewChannelStateMachine(t, order)
+			})
+		})
+	}
+}
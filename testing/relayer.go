@@ -0,0 +1,300 @@
+package ibctesting
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// RelayEventType defines the message the Relayer submits for a RelayEvent.
+type RelayEventType int
+
+const (
+	// RelayRecvPacket delivers a packet to the destination chain with a MsgRecvPacket.
+	RelayRecvPacket RelayEventType = iota
+	// RelayAcknowledgement delivers an acknowledgement to the source chain with a MsgAcknowledgement.
+	RelayAcknowledgement
+	// RelayTimeout times out a packet on the source chain with a MsgTimeout.
+	RelayTimeout
+)
+
+// String implements the fmt.Stringer interface.
+func (t RelayEventType) String() string {
+	switch t {
+	case RelayRecvPacket:
+		return "recv_packet"
+	case RelayAcknowledgement:
+		return "acknowledgement"
+	case RelayTimeout:
+		return "timeout"
+	default:
+		return fmt.Sprintf("unknown (%d)", int(t))
+	}
+}
+
+// RelayAction defines how the Relayer handles a RelayEvent.
+type RelayAction int
+
+const (
+	// RelayActionSubmit submits the message for the relay event.
+	RelayActionSubmit RelayAction = iota
+	// RelayActionDrop discards the relay event. A dropped packet is never received or timed out
+	// by the Relayer.
+	RelayActionDrop
+	// RelayActionDelay keeps the relay event pending until the next relay round. A delayed packet
+	// which times out on the destination chain is timed out on the source chain.
+	RelayActionDelay
+)
+
+// RelayEvent is a pending message collected by the Relayer for a packet sent over a registered path.
+type RelayEvent struct {
+	Type   RelayEventType
+	Packet channeltypes.Packet
+	// Acknowledgement is only set for RelayAcknowledgement events.
+	Acknowledgement []byte
+	// Source is the endpoint the packet was sent from.
+	Source *Endpoint
+}
+
+// Relayer is an in-process relayer for the paths registered with it. It collects the send_packet and
+// write_acknowledgement events emitted on the chains of its paths and relays the matching messages
+// after each Coordinator.CommitBlock, timing out packets which can no longer be received.
+//
+// The Relayer assumes it is the only relayer of its paths. Packets relayed manually on a registered
+// path are skipped once their packet commitment no longer exists on the source chain.
+type Relayer struct {
+	coord *Coordinator
+	paths []*Path
+
+	pending  []*RelayEvent
+	delayed  []*RelayEvent
+	paused   bool
+	relaying bool
+
+	// Filter is called for each pending relay event before its message is submitted and returns how the
+	// event should be handled. All events are submitted if Filter is nil.
+	Filter func(event *RelayEvent) RelayAction
+	// Reorder is called with the pending relay events of each relay round and returns the events in the
+	// order in which they should be handled. Events are handled in the order they were collected if
+	// Reorder is nil.
+	Reorder func(events []*RelayEvent) []*RelayEvent
+
+	// Relayed contains the relay events whose messages were successfully submitted.
+	Relayed []*RelayEvent
+}
+
+// EnableRelayer creates a Relayer for the provided paths which relays pending packets, acknowledgements
+// and timeouts after each CommitBlock. Packets sent before the Relayer is enabled are not relayed.
+func (coord *Coordinator) EnableRelayer(paths ...*Path) *Relayer {
+	coord.Relayer = &Relayer{coord: coord}
+	for _, path := range paths {
+		coord.Relayer.AddPath(path)
+	}
+
+	return coord.Relayer
+}
+
+// DisableRelayer removes the Relayer from the Coordinator. Pending relay events are discarded.
+func (coord *Coordinator) DisableRelayer() {
+	coord.Relayer = nil
+}
+
+// AddPath registers a path with the Relayer. The channel of each endpoint must be set before packets
+// are sent over the path.
+func (r *Relayer) AddPath(path *Path) {
+	r.paths = append(r.paths, path)
+}
+
+// Pause stops the Relayer from relaying after each CommitBlock. Events are still collected and may be
+// relayed with Relay.
+func (r *Relayer) Pause() {
+	r.paused = true
+}
+
+// Resume resumes relaying after each CommitBlock.
+func (r *Relayer) Resume() {
+	r.paused = false
+}
+
+// Pending returns the relay events which have been collected but not yet handled, including delayed
+// relay events.
+func (r *Relayer) Pending() []*RelayEvent {
+	return append(append([]*RelayEvent{}, r.delayed...), r.pending...)
+}
+
+// Relay handles all pending relay events, including the relay events collected while relaying, until
+// no relay events remain other than those which were delayed or dropped. It relays regardless of
+// whether the Relayer is paused. An error is returned if a message could not be submitted.
+func (r *Relayer) Relay() error {
+	if r.relaying {
+		return nil
+	}
+
+	r.relaying = true
+	defer func() { r.relaying = false }()
+
+	r.pending = append(r.delayed, r.pending...)
+	r.delayed = nil
+
+	for len(r.pending) > 0 {
+		events := r.pending
+		r.pending = nil
+
+		if r.Reorder != nil {
+			events = r.Reorder(events)
+		}
+
+		for i, event := range events {
+			if !r.hasPacketCommitment(event) {
+				continue
+			}
+
+			if event.Type == RelayRecvPacket && r.hasTimedOut(event) {
+				event.Type = RelayTimeout
+			}
+
+			action := RelayActionSubmit
+			if r.Filter != nil {
+				action = r.Filter(event)
+			}
+
+			switch action {
+			case RelayActionDrop:
+				continue
+			case RelayActionDelay:
+				r.delayed = append(r.delayed, event)
+				continue
+			}
+
+			if err := r.submit(event); err != nil {
+				// keep the failed and unhandled relay events pending
+				r.pending = append(events[i:], r.pending...)
+				return fmt.Errorf("failed to relay %s for packet with sequence %d on %s/%s: %w", event.Type, event.Packet.Sequence, event.Packet.SourcePort, event.Packet.SourceChannel, err)
+			}
+
+			r.Relayed = append(r.Relayed, event)
+		}
+	}
+
+	return nil
+}
+
+// relayAfterCommit relays pending relay events unless the Relayer is paused or already relaying.
+func (r *Relayer) relayAfterCommit() {
+	if r.paused || r.relaying {
+		return
+	}
+
+	err := r.Relay()
+	require.NoError(r.coord.T, err)
+}
+
+// submit submits the message for the relay event. The client on the chain receiving the message is
+// updated first.
+func (*Relayer) submit(event *RelayEvent) error {
+	source := event.Source
+	switch event.Type {
+	case RelayRecvPacket:
+		if err := source.Counterparty.UpdateClient(); err != nil {
+			return err
+		}
+
+		_, err := source.Counterparty.RecvPacketWithResult(event.Packet)
+		return err
+	case RelayAcknowledgement:
+		if err := source.UpdateClient(); err != nil {
+			return err
+		}
+
+		return source.AcknowledgePacket(event.Packet, event.Acknowledgement)
+	case RelayTimeout:
+		if err := source.UpdateClient(); err != nil {
+			return err
+		}
+
+		return source.TimeoutPacket(event.Packet)
+	default:
+		return fmt.Errorf("unknown relay event type %s", event.Type)
+	}
+}
+
+// hasPacketCommitment returns true if the packet commitment for the relay event still exists on the
+// source chain.
+func (*Relayer) hasPacketCommitment(event *RelayEvent) bool {
+	chain := event.Source.Chain
+	commitment := chain.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(chain.GetContext(), event.Packet.SourcePort, event.Packet.SourceChannel, event.Packet.Sequence)
+	return bytes.Equal(commitment, channeltypes.CommitPacket(chain.App.AppCodec(), event.Packet))
+}
+
+// hasTimedOut returns true if the packet has timed out on the latest committed block of the
+// destination chain.
+func (*Relayer) hasTimedOut(event *RelayEvent) bool {
+	header := event.Source.Counterparty.Chain.LatestCommittedHeader
+	height := header.GetHeight().(clienttypes.Height)
+
+	if !event.Packet.TimeoutHeight.IsZero() && height.GTE(event.Packet.TimeoutHeight) {
+		return true
+	}
+
+	return event.Packet.TimeoutTimestamp != 0 && uint64(header.GetTime().UnixNano()) >= event.Packet.TimeoutTimestamp
+}
+
+// collectEvents collects the relay events for the send_packet and write_acknowledgement events emitted
+// by the provided chain on the registered paths.
+func (r *Relayer) collectEvents(chain *TestChain, events []abci.Event) {
+	for _, ev := range events {
+		switch ev.Type {
+		case channeltypes.EventTypeSendPacket:
+			packet, err := parsePacketFromEvent(ev)
+			if err != nil {
+				continue
+			}
+
+			if source := r.findEndpoint(chain, packet.SourcePort, packet.SourceChannel); source != nil {
+				r.pending = append(r.pending, &RelayEvent{Type: RelayRecvPacket, Packet: packet, Source: source})
+			}
+		case channeltypes.EventTypeWriteAck:
+			packet, err := parsePacketFromEvent(ev)
+			if err != nil {
+				continue
+			}
+
+			ack, err := ParseAckFromEvents([]abci.Event{ev})
+			if err != nil {
+				continue
+			}
+
+			if destination := r.findEndpoint(chain, packet.DestinationPort, packet.DestinationChannel); destination != nil {
+				r.pending = append(r.pending, &RelayEvent{Type: RelayAcknowledgement, Packet: packet, Acknowledgement: ack, Source: destination.Counterparty})
+			}
+		}
+	}
+}
+
+// collectBlockEvents collects the relay events for the events emitted in a block committed by the provided chain.
+func (r *Relayer) collectBlockEvents(chain *TestChain, res *abci.ResponseFinalizeBlock) {
+	r.collectEvents(chain, res.Events)
+	for _, txResult := range res.TxResults {
+		r.collectEvents(chain, txResult.Events)
+	}
+}
+
+// findEndpoint returns the endpoint of a registered path on the provided chain with the given port and
+// channel identifiers, or nil if none exists.
+func (r *Relayer) findEndpoint(chain *TestChain, portID, channelID string) *Endpoint {
+	for _, path := range r.paths {
+		for _, endpoint := range []*Endpoint{path.EndpointA, path.EndpointB} {
+			if endpoint.Chain == chain && endpoint.ChannelConfig.PortID == portID && endpoint.ChannelID == channelID {
+				return endpoint
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,199 @@
+package ibctesting_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	"github.com/cosmos/ibc-go/v8/testing/mock"
+)
+
+var relayerTimeoutHeight = clienttypes.NewHeight(1, 1000)
+
+func setupRelayerPath(t *testing.T) (*ibctesting.Coordinator, *ibctesting.Path, *ibctesting.Relayer) {
+	t.Helper()
+
+	coord := ibctesting.NewCoordinator(t, 2)
+	path := ibctesting.NewPath(coord.GetChain(ibctesting.GetChainID(1)), coord.GetChain(ibctesting.GetChainID(2)))
+	path.Setup()
+
+	return coord, path, coord.EnableRelayer(path)
+}
+
+func hasPacketCommitment(endpoint *ibctesting.Endpoint, sequence uint64) bool {
+	return endpoint.Chain.App.GetIBCKeeper().ChannelKeeper.HasPacketCommitment(endpoint.Chain.GetContext(), endpoint.ChannelConfig.PortID, endpoint.ChannelID, sequence)
+}
+
+func relayedSequences(relayer *ibctesting.Relayer, eventType ibctesting.RelayEventType) []uint64 {
+	var sequences []uint64
+	for _, event := range relayer.Relayed {
+		if event.Type == eventType {
+			sequences = append(sequences, event.Packet.Sequence)
+		}
+	}
+
+	return sequences
+}
+
+func TestRelayerRelaysPacketsAndAcknowledgements(t *testing.T) {
+	_, path, relayer := setupRelayerPath(t)
+
+	for i := 0; i < 3; i++ {
+		_, err := path.EndpointA.SendPacket(relayerTimeoutHeight, 0, ibctesting.MockPacketData)
+		require.NoError(t, err)
+	}
+
+	_, err := path.EndpointB.SendPacket(relayerTimeoutHeight, 0, ibctesting.MockPacketData)
+	require.NoError(t, err)
+
+	for sequence := uint64(1); sequence <= 3; sequence++ {
+		require.False(t, hasPacketCommitment(path.EndpointA, sequence))
+	}
+	require.False(t, hasPacketCommitment(path.EndpointB, 1))
+
+	require.Equal(t, []uint64{1, 2, 3, 1}, relayedSequences(relayer, ibctesting.RelayRecvPacket))
+	require.Equal(t, []uint64{1, 2, 3, 1}, relayedSequences(relayer, ibctesting.RelayAcknowledgement))
+	require.Empty(t, relayer.Pending())
+}
+
+func TestRelayerPause(t *testing.T) {
+	coord, path, relayer := setupRelayerPath(t)
+	relayer.Pause()
+
+	sequence, err := path.EndpointA.SendPacket(relayerTimeoutHeight, 0, ibctesting.MockPacketData)
+	require.NoError(t, err)
+
+	coord.CommitBlock(path.EndpointA.Chain, path.EndpointB.Chain)
+	require.True(t, hasPacketCommitment(path.EndpointA, sequence))
+	require.Len(t, relayer.Pending(), 1)
+
+	// relaying explicitly is possible while paused
+	require.NoError(t, relayer.Relay())
+	require.False(t, hasPacketCommitment(path.EndpointA, sequence))
+	require.Empty(t, relayer.Pending())
+
+	relayer.Resume()
+
+	sequence, err = path.EndpointA.SendPacket(relayerTimeoutHeight, 0, ibctesting.MockPacketData)
+	require.NoError(t, err)
+	require.False(t, hasPacketCommitment(path.EndpointA, sequence))
+}
+
+func TestRelayerTimeout(t *testing.T) {
+	coord, path, relayer := setupRelayerPath(t)
+	relayer.Pause()
+
+	timeoutTimestamp := uint64(coord.CurrentTime.Add(time.Minute).UnixNano())
+	sequence, err := path.EndpointA.SendPacket(clienttypes.ZeroHeight(), timeoutTimestamp, ibctesting.MockPacketData)
+	require.NoError(t, err)
+
+	coord.IncrementTimeBy(time.Hour)
+	relayer.Resume()
+	coord.CommitBlock(path.EndpointB.Chain)
+
+	require.False(t, hasPacketCommitment(path.EndpointA, sequence))
+	require.Equal(t, []uint64{sequence}, relayedSequences(relayer, ibctesting.RelayTimeout))
+	require.Empty(t, relayedSequences(relayer, ibctesting.RelayRecvPacket))
+}
+
+func TestRelayerFilter(t *testing.T) {
+	coord, path, relayer := setupRelayerPath(t)
+	relayer.Pause()
+
+	for i := 0; i < 3; i++ {
+		_, err := path.EndpointA.SendPacket(relayerTimeoutHeight, 0, ibctesting.MockPacketData)
+		require.NoError(t, err)
+	}
+
+	relayer.Filter = func(event *ibctesting.RelayEvent) ibctesting.RelayAction {
+		switch event.Packet.Sequence {
+		case 1:
+			return ibctesting.RelayActionDrop
+		case 2:
+			return ibctesting.RelayActionDelay
+		default:
+			return ibctesting.RelayActionSubmit
+		}
+	}
+
+	require.NoError(t, relayer.Relay())
+	require.True(t, hasPacketCommitment(path.EndpointA, 1))
+	require.True(t, hasPacketCommitment(path.EndpointA, 2))
+	require.False(t, hasPacketCommitment(path.EndpointA, 3))
+	require.Len(t, relayer.Pending(), 1)
+
+	// the delayed packet is relayed in the next relay round
+	relayer.Filter = nil
+	relayer.Resume()
+	coord.CommitBlock(path.EndpointA.Chain)
+
+	require.True(t, hasPacketCommitment(path.EndpointA, 1))
+	require.False(t, hasPacketCommitment(path.EndpointA, 2))
+	require.Empty(t, relayer.Pending())
+}
+
+func TestRelayerReorder(t *testing.T) {
+	_, path, relayer := setupRelayerPath(t)
+	relayer.Pause()
+
+	for i := 0; i < 3; i++ {
+		_, err := path.EndpointA.SendPacket(relayerTimeoutHeight, 0, ibctesting.MockPacketData)
+		require.NoError(t, err)
+	}
+
+	relayer.Reorder = func(events []*ibctesting.RelayEvent) []*ibctesting.RelayEvent {
+		reversed := make([]*ibctesting.RelayEvent, len(events))
+		for i, event := range events {
+			reversed[len(events)-1-i] = event
+		}
+		return reversed
+	}
+
+	require.NoError(t, relayer.Relay())
+	require.Equal(t, []uint64{3, 2, 1}, relayedSequences(relayer, ibctesting.RelayRecvPacket))
+}
+
+func TestRelayerReorderOrderedChannel(t *testing.T) {
+	coord := ibctesting.NewCoordinator(t, 2)
+	path := ibctesting.NewPath(coord.GetChain(ibctesting.GetChainID(1)), coord.GetChain(ibctesting.GetChainID(2)))
+	path.SetChannelOrdered()
+	path.Setup()
+
+	relayer := coord.EnableRelayer(path)
+	relayer.Pause()
+
+	for i := 0; i < 2; i++ {
+		_, err := path.EndpointA.SendPacket(relayerTimeoutHeight, 0, ibctesting.MockPacketData)
+		require.NoError(t, err)
+	}
+
+	relayer.Reorder = func(events []*ibctesting.RelayEvent) []*ibctesting.RelayEvent {
+		return []*ibctesting.RelayEvent{events[1], events[0]}
+	}
+
+	// packets on ordered channels cannot be received out of order
+	err := relayer.Relay()
+	require.ErrorContains(t, err, channeltypes.ErrPacketSequenceOutOfOrder.Error())
+	require.Len(t, relayer.Pending(), 2)
+}
+
+func TestRelayerAsyncAcknowledgement(t *testing.T) {
+	_, path, relayer := setupRelayerPath(t)
+
+	sequence, err := path.EndpointA.SendPacket(relayerTimeoutHeight, 0, mock.MockAsyncPacketData)
+	require.NoError(t, err)
+
+	require.True(t, hasPacketCommitment(path.EndpointA, sequence))
+	require.Equal(t, []uint64{sequence}, relayedSequences(relayer, ibctesting.RelayRecvPacket))
+	require.Empty(t, relayedSequences(relayer, ibctesting.RelayAcknowledgement))
+
+	packet := channeltypes.NewPacket(mock.MockAsyncPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, relayerTimeoutHeight, 0)
+	require.NoError(t, path.EndpointB.WriteAcknowledgement(mock.MockAcknowledgement, packet))
+
+	require.False(t, hasPacketCommitment(path.EndpointA, sequence))
+	require.Equal(t, []uint64{sequence}, relayedSequences(relayer, ibctesting.RelayAcknowledgement))
+}
@@ -127,6 +127,7 @@ import (
 	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
 	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
+	ibcmetrics "github.com/cosmos/ibc-go/v8/modules/core/metrics"
 	solomachine "github.com/cosmos/ibc-go/v8/modules/light-clients/06-solomachine"
 	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 	ibcmock "github.com/cosmos/ibc-go/v8/testing/mock"
@@ -407,6 +408,9 @@ func NewSimApp(
 	// set the governance module account as the authority for conducting upgrades
 	app.UpgradeKeeper = upgradekeeper.NewKeeper(skipUpgradeHeights, runtime.NewKVStoreService(keys[upgradetypes.StoreKey]), appCodec, homePath, app.BaseApp, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
+	// configure the ibc metrics using the ibc section of app.toml
+	ibcmetrics.Configure(ibcmetrics.NewConfigFromAppOptions(appOpts))
+
 	app.IBCKeeper = ibckeeper.NewKeeper(
 		appCodec, keys[ibcexported.StoreKey], app.GetSubspace(ibcexported.ModuleName), app.StakingKeeper, app.UpgradeKeeper, scopedIBCKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
@@ -561,6 +565,15 @@ func NewSimApp(
 	// Seal the IBC Router
 	app.IBCKeeper.SetRouter(ibcRouter)
 
+	// Register the packet data schemas of the IBC applications so packet data can be decoded
+	packetDataRegistry := porttypes.NewPacketDataRegistry()
+	ibctransfertypes.RegisterPacketDataSchemas(packetDataRegistry, ibctransfertypes.PortID)
+	icatypes.RegisterPacketDataSchemas(packetDataRegistry)
+	ibcfeetypes.RegisterPacketDataSchemas(packetDataRegistry)
+
+	// Seal the packet data registry
+	app.IBCKeeper.SetPacketDataRegistry(packetDataRegistry)
+
 	// create evidence keeper with router
 	evidenceKeeper := evidencekeeper.NewKeeper(
 		appCodec, runtime.NewKVStoreService(keys[evidencetypes.StoreKey]), app.StakingKeeper, app.SlashingKeeper, app.AccountKeeper.AddressCodec(), runtime.ProvideCometInfoService(),
@@ -717,6 +730,9 @@ func NewSimApp(
 	overrideModules := map[string]module.AppModuleSimulation{
 		authtypes.ModuleName: auth.NewAppModule(app.appCodec, app.AccountKeeper, authsims.RandomGenesisAccounts, app.GetSubspace(authtypes.ModuleName)),
 	}
+	for name, simModule := range app.ibcSimulationModules() {
+		overrideModules[name] = simModule
+	}
 	app.simulationManager = module.NewSimulationManagerFromAppModules(app.ModuleManager.Modules, overrideModules)
 
 	app.simulationManager.RegisterStoreDecoders()
@@ -40,6 +40,7 @@ import (
 
 	cmtcfg "github.com/cometbft/cometbft/config"
 
+	ibcmetrics "github.com/cosmos/ibc-go/v8/modules/core/metrics"
 	"github.com/cosmos/ibc-go/v8/testing/simapp"
 	"github.com/cosmos/ibc-go/v8/testing/simapp/params"
 )
@@ -178,7 +179,8 @@ func initAppConfig() (string, interface{}) {
 	type CustomAppConfig struct {
 		serverconfig.Config
 
-		WASM WASMConfig `mapstructure:"wasm"`
+		WASM WASMConfig        `mapstructure:"wasm"`
+		IBC  ibcmetrics.Config `mapstructure:"ibc"`
 	}
 
 	// Optionally allow the chain developer to overwrite the SDK's default
@@ -205,6 +207,7 @@ func initAppConfig() (string, interface{}) {
 			LruSize:       1,
 			QueryGasLimit: 300000,
 		},
+		IBC: ibcmetrics.DefaultConfig(),
 	}
 
 	customAppTemplate := serverconfig.DefaultConfigTemplate + `
@@ -213,7 +216,7 @@ func initAppConfig() (string, interface{}) {
 query_gas_limit = 300000
 # This is the number of wasm vm instances we keep cached in memory for speed-up
 # Warning: this is currently unstable and may lead to crashes, best to keep for 0 unless testing locally
-lru_size = 0`
+lru_size = 0` + ibcmetrics.DefaultConfigTemplate
 
 	return customAppTemplate, customAppConfig
 }
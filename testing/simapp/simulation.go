@@ -0,0 +1,58 @@
+package simapp
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	ibcfee "github.com/cosmos/ibc-go/v8/modules/apps/29-fee"
+	ibcfeesimulation "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/simulation"
+	ibcfeetypes "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer"
+	ibctransfersimulation "github.com/cosmos/ibc-go/v8/modules/apps/transfer/simulation"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	ibc "github.com/cosmos/ibc-go/v8/modules/core"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibcsimulation "github.com/cosmos/ibc-go/v8/modules/core/simulation"
+)
+
+// simulationModule overrides the weighted operations of a module whose simulation operations depend on the
+// keepers of other modules.
+type simulationModule struct {
+	module.AppModuleSimulation
+
+	weightedOperations func(simState module.SimulationState) []simtypes.WeightedOperation
+}
+
+// WeightedOperations implements the AppModuleSimulation interface.
+func (sm simulationModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	return sm.weightedOperations(simState)
+}
+
+// ibcSimulationModules returns the simulation modules of the ibc, transfer and 29-fee modules. Transfer channels
+// are opened over the 09-localhost connection and upgraded between the transfer version and the fee enabled transfer
+// version, packets are sent and relayed on them and fees are paid for packets in flight.
+func (app *SimApp) ibcSimulationModules() map[string]module.AppModuleSimulation {
+	feeVersion := string(ibcfeetypes.ModuleCdc.MustMarshalJSON(&ibcfeetypes.Metadata{FeeVersion: ibcfeetypes.Version, AppVersion: ibctransfertypes.Version}))
+	versions := []string{ibctransfertypes.Version, feeVersion}
+
+	return map[string]module.AppModuleSimulation{
+		ibcexported.ModuleName: simulationModule{
+			AppModuleSimulation: ibc.NewAppModule(app.IBCKeeper),
+			weightedOperations: func(simState module.SimulationState) []simtypes.WeightedOperation {
+				return ibcsimulation.WeightedOperations(simState.AppParams, app.IBCKeeper, app.TransferKeeper, versions)
+			},
+		},
+		ibctransfertypes.ModuleName: simulationModule{
+			AppModuleSimulation: transfer.NewAppModule(app.TransferKeeper),
+			weightedOperations: func(simState module.SimulationState) []simtypes.WeightedOperation {
+				return ibctransfersimulation.WeightedOperations(simState.AppParams, app.TransferKeeper, app.IBCKeeper, app.BankKeeper)
+			},
+		},
+		ibcfeetypes.ModuleName: simulationModule{
+			AppModuleSimulation: ibcfee.NewAppModule(app.IBCFeeKeeper),
+			weightedOperations: func(simState module.SimulationState) []simtypes.WeightedOperation {
+				return ibcfeesimulation.WeightedOperations(simState.AppParams, app.IBCFeeKeeper, app.IBCKeeper, app.BankKeeper)
+			},
+		},
+	}
+}
@@ -264,6 +264,28 @@ func (solo *Solomachine) CreateMisbehaviour() *solomachine.Misbehaviour {
 	}
 }
 
+// SubmitMisbehaviour submits misbehaviour created with CreateMisbehaviour for the given clientID on the
+// provided chain with a MsgSubmitMisbehaviour. The solo machine client is frozen.
+func (solo *Solomachine) SubmitMisbehaviour(chain *TestChain, clientID string) {
+	msgSubmitMisbehaviour, err := clienttypes.NewMsgSubmitMisbehaviour(clientID, solo.CreateMisbehaviour(), chain.SenderAccount.GetAddress().String())
+	require.NoError(solo.t, err)
+
+	res, err := chain.SendMsgs(msgSubmitMisbehaviour)
+	require.NoError(solo.t, err)
+	require.NotNil(solo.t, res)
+}
+
+// UpdateClientWithMisbehaviour submits misbehaviour created with CreateMisbehaviour for the given clientID on
+// the provided chain with a MsgUpdateClient. The solo machine client is frozen.
+func (solo *Solomachine) UpdateClientWithMisbehaviour(chain *TestChain, clientID string) {
+	msgUpdateClient, err := clienttypes.NewMsgUpdateClient(clientID, solo.CreateMisbehaviour(), chain.SenderAccount.GetAddress().String())
+	require.NoError(solo.t, err)
+
+	res, err := chain.SendMsgs(msgUpdateClient)
+	require.NoError(solo.t, err)
+	require.NotNil(solo.t, res)
+}
+
 // ConnOpenInit initializes a connection on the provided chain given a solo machine clientID.
 func (solo *Solomachine) ConnOpenInit(chain *TestChain, clientID string) string {
 	msgConnOpenInit := connectiontypes.NewMsgConnectionOpenInit(
@@ -512,6 +534,29 @@ func (solo *Solomachine) GenerateProof(signBytes *solomachine.SignBytes) []byte
 	return proof
 }
 
+// GenerateForgedProof takes in solo machine sign bytes and marshals a signature over them as a proof, like
+// GenerateProof, but signs with newly generated keys which are not registered with the solo machine client.
+// The forged proof fails verification. The solo machine sequence is not incremented.
+func (solo *Solomachine) GenerateForgedProof(signBytes *solomachine.SignBytes) []byte {
+	privateKeys := solo.PrivateKeys
+	defer func() { solo.PrivateKeys = privateKeys }()
+
+	solo.PrivateKeys, _, _ = GenerateKeys(solo.t, uint64(len(privateKeys)))
+
+	bz, err := solo.cdc.Marshal(signBytes)
+	require.NoError(solo.t, err)
+
+	sig := solo.GenerateSignature(bz)
+	signatureDoc := &solomachine.TimestampedSignatureData{
+		SignatureData: sig,
+		Timestamp:     solo.Time,
+	}
+	proof, err := solo.cdc.Marshal(signatureDoc)
+	require.NoError(solo.t, err)
+
+	return proof
+}
+
 // GenerateClientStateProof generates the proof of the client state required for the connection open try and ack handshake steps.
 // The client state should be the self client states of the tendermint chain.
 func (solo *Solomachine) GenerateClientStateProof(clientState exported.ClientState) []byte {
@@ -0,0 +1,220 @@
+package ibctesting
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+)
+
+// Topology is a graph of chains joined by transfer channels. Each edge of the graph is a Path,
+// which allows hubs, star topologies and routes of several hops to be declared and set up at once.
+type Topology struct {
+	Coordinator *Coordinator
+	Paths       []*Path
+}
+
+// NewTopology returns a Topology without any edges for the chains of the provided Coordinator.
+// Edges are declared with Connect and created with Setup.
+func NewTopology(coord *Coordinator) *Topology {
+	return &Topology{Coordinator: coord}
+}
+
+// NewLinearTopology creates a Coordinator with n chains, where each chain is connected to the next
+// one, and sets up the clients, connections and transfer channels of every edge.
+func NewLinearTopology(t *testing.T, n int) *Topology {
+	t.Helper()
+
+	topology := NewTopology(NewCoordinator(t, n))
+	for i := 1; i < n; i++ {
+		topology.Connect(topology.Chain(i), topology.Chain(i+1))
+	}
+
+	topology.Setup()
+
+	return topology
+}
+
+// NewStarTopology creates a Coordinator with n chains, where the first chain is a hub connected to
+// every other chain, and sets up the clients, connections and transfer channels of every edge.
+func NewStarTopology(t *testing.T, n int) *Topology {
+	t.Helper()
+
+	topology := NewTopology(NewCoordinator(t, n))
+	for i := 2; i <= n; i++ {
+		topology.Connect(topology.Chain(1), topology.Chain(i))
+	}
+
+	topology.Setup()
+
+	return topology
+}
+
+// Chain returns the chain of the Coordinator with the given index, starting at 1.
+func (t *Topology) Chain(index int) *TestChain {
+	return t.Coordinator.GetChain(GetChainID(index))
+}
+
+// Connect declares a transfer path between the provided chains. The path is created by Setup.
+func (t *Topology) Connect(chainA, chainB *TestChain) *Path {
+	path := NewTransferPath(chainA, chainB)
+	t.Paths = append(t.Paths, path)
+
+	return path
+}
+
+// Setup creates the clients, connections and channels of every path of the topology in the order
+// in which they were declared.
+func (t *Topology) Setup() {
+	for _, path := range t.Paths {
+		path.Setup()
+	}
+}
+
+// Endpoint returns the endpoint on the source chain of the first path joining the source chain to
+// the destination chain. The test fails if the chains are not connected.
+func (t *Topology) Endpoint(source, destination *TestChain) *Endpoint {
+	for _, path := range t.Paths {
+		switch {
+		case path.EndpointA.Chain == source && path.EndpointB.Chain == destination:
+			return path.EndpointA
+		case path.EndpointB.Chain == source && path.EndpointA.Chain == destination:
+			return path.EndpointB
+		}
+	}
+
+	require.FailNow(t.Coordinator.T, fmt.Sprintf("no path between %s and %s", source.ChainID, destination.ChainID))
+	return nil
+}
+
+// Route returns the source endpoint of each hop of the route through the provided chains.
+func (t *Topology) Route(chains ...*TestChain) []*Endpoint {
+	route := make([]*Endpoint, 0, len(chains))
+	for i := 0; i+1 < len(chains); i++ {
+		route = append(route, t.Endpoint(chains[i], chains[i+1]))
+	}
+
+	return route
+}
+
+// TraceDenom returns the denomination trace of a token with the provided denomination trace on the
+// first chain of the route once it has been transferred along the route through the provided chains.
+// Hops returning a token towards its source remove the matching prefix, all other hops add the prefix
+// of the receiving channel.
+func (t *Topology) TraceDenom(denom string, chains ...*TestChain) transfertypes.DenomTrace {
+	fullPath := denom
+	for _, source := range t.Route(chains...) {
+		destination := source.Counterparty
+		if transfertypes.ReceiverChainIsSource(source.ChannelConfig.PortID, source.ChannelID, fullPath) {
+			fullPath = strings.TrimPrefix(fullPath, transfertypes.GetDenomPrefix(source.ChannelConfig.PortID, source.ChannelID))
+		} else {
+			fullPath = transfertypes.GetPrefixedDenom(destination.ChannelConfig.PortID, destination.ChannelID, fullPath)
+		}
+	}
+
+	return transfertypes.ParseDenomTrace(fullPath)
+}
+
+// TransferAlongRoute transfers the provided token from the sender account of the first chain along
+// the route through the provided chains, relaying each packet and acknowledgement. The token is
+// denominated as on the first chain and the token received on the last chain is returned.
+func (t *Topology) TransferAlongRoute(token sdk.Coin, chains ...*TestChain) sdk.Coin {
+	require.GreaterOrEqual(t.Coordinator.T, len(chains), 2, "a route must contain at least two chains")
+
+	fullPath := t.fullDenomPath(chains[0], token.Denom)
+	for i, source := range t.Route(chains...) {
+		msg := transfertypes.NewMsgTransfer(
+			source.ChannelConfig.PortID, source.ChannelID, token,
+			source.Chain.SenderAccount.GetAddress().String(), source.Counterparty.Chain.SenderAccount.GetAddress().String(),
+			clienttypes.ZeroHeight(), uint64(t.Coordinator.CurrentTime.Add(TimeIncrement*100).UnixNano()), "",
+		)
+
+		res, err := source.Chain.SendMsgs(msg)
+		require.NoError(t.Coordinator.T, err)
+
+		packet, err := ParsePacketFromEvents(res.Events)
+		require.NoError(t.Coordinator.T, err)
+
+		path := &Path{EndpointA: source, EndpointB: source.Counterparty}
+		require.NoError(t.Coordinator.T, path.RelayPacket(packet))
+
+		token = sdk.NewCoin(t.TraceDenom(fullPath, chains[i], chains[i+1]).IBCDenom(), token.Amount)
+		fullPath = t.TraceDenom(fullPath, chains[i], chains[i+1]).GetFullDenomPath()
+	}
+
+	return token
+}
+
+// AssertTransferInvariants asserts the escrow and supply invariants of every transfer channel of the
+// topology. For each channel end, the amount of every token held by the escrow account must equal the
+// supply of the matching voucher on the counterparty chain, and the supply of every voucher on a
+// chain must equal the amount held in escrow by the counterparty channel end it was received from.
+// The total escrow tracked by the transfer module of each chain must equal the sum of its escrow
+// account balances. The invariants only hold once all packets have been relayed.
+func (t *Topology) AssertTransferInvariants() {
+	totalEscrow := make(map[*TestChain]sdk.Coins)
+
+	for _, path := range t.Paths {
+		for _, endpoint := range []*Endpoint{path.EndpointA, path.EndpointB} {
+			counterparty := endpoint.Counterparty
+
+			escrowAddress := transfertypes.GetEscrowAddress(endpoint.ChannelConfig.PortID, endpoint.ChannelID)
+			escrowed := endpoint.Chain.GetSimApp().BankKeeper.GetAllBalances(endpoint.Chain.GetContext(), escrowAddress)
+			totalEscrow[endpoint.Chain] = totalEscrow[endpoint.Chain].Add(escrowed...)
+
+			// every escrowed token is backed by vouchers on the counterparty
+			for _, coin := range escrowed {
+				fullPath := t.fullDenomPath(endpoint.Chain, coin.Denom)
+				voucher := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(counterparty.ChannelConfig.PortID, counterparty.ChannelID, fullPath))
+
+				supply := counterparty.Chain.GetSimApp().BankKeeper.GetSupply(counterparty.Chain.GetContext(), voucher.IBCDenom())
+				require.True(t.Coordinator.T, coin.Amount.Equal(supply.Amount),
+					"escrow of %s on %s (%s) does not match supply of %s on %s (%s)", fullPath, endpoint.Chain.ChainID, coin.Amount, voucher.GetFullDenomPath(), counterparty.Chain.ChainID, supply.Amount)
+			}
+
+			// every voucher received over the counterparty channel end is backed by escrowed tokens
+			counterparty.Chain.GetSimApp().TransferKeeper.IterateDenomTraces(counterparty.Chain.GetContext(), func(denomTrace transfertypes.DenomTrace) bool {
+				prefix := transfertypes.GetDenomPrefix(counterparty.ChannelConfig.PortID, counterparty.ChannelID)
+				if !strings.HasPrefix(denomTrace.GetFullDenomPath(), prefix) {
+					return false
+				}
+
+				escrowedDenom := transfertypes.ParseDenomTrace(strings.TrimPrefix(denomTrace.GetFullDenomPath(), prefix)).IBCDenom()
+				supply := counterparty.Chain.GetSimApp().BankKeeper.GetSupply(counterparty.Chain.GetContext(), denomTrace.IBCDenom())
+				require.True(t.Coordinator.T, escrowed.AmountOf(escrowedDenom).Equal(supply.Amount),
+					"supply of %s on %s (%s) does not match escrow on %s (%s)", denomTrace.GetFullDenomPath(), counterparty.Chain.ChainID, supply.Amount, endpoint.Chain.ChainID, escrowed.AmountOf(escrowedDenom))
+
+				return false
+			})
+		}
+	}
+
+	for chain, escrowed := range totalEscrow {
+		for _, coin := range escrowed {
+			tracked := chain.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(chain.GetContext(), coin.Denom)
+			require.True(t.Coordinator.T, coin.Amount.Equal(tracked.Amount),
+				"total escrow of %s on %s (%s) does not match escrow account balances (%s)", coin.Denom, chain.ChainID, tracked.Amount, coin.Amount)
+		}
+	}
+}
+
+// fullDenomPath returns the full denomination trace path of the provided denomination on the chain.
+func (*Topology) fullDenomPath(chain *TestChain, denom string) string {
+	if !strings.HasPrefix(denom, transfertypes.DenomPrefix+"/") {
+		return denom
+	}
+
+	hash, err := transfertypes.ParseHexHash(strings.TrimPrefix(denom, transfertypes.DenomPrefix+"/"))
+	require.NoError(chain.TB, err)
+
+	denomTrace, found := chain.GetSimApp().TransferKeeper.GetDenomTrace(chain.GetContext(), hash)
+	require.True(chain.TB, found, "denomination trace not found for %s", denom)
+
+	return denomTrace.GetFullDenomPath()
+}
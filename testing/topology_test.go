@@ -0,0 +1,63 @@
+package ibctesting_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func TestLinearTopology(t *testing.T) {
+	topology := ibctesting.NewLinearTopology(t, 3)
+	chainA, chainB, chainC := topology.Chain(1), topology.Chain(2), topology.Chain(3)
+
+	require.Len(t, topology.Paths, 2)
+	require.Len(t, topology.Route(chainA, chainB, chainC), 2)
+
+	token := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))
+	received := topology.TransferAlongRoute(token, chainA, chainB, chainC)
+
+	endpointBC := topology.Endpoint(chainB, chainC)
+	endpointAB := topology.Endpoint(chainA, chainB)
+	expTrace := transfertypes.DenomTrace{
+		Path:      endpointBC.Counterparty.ChannelConfig.PortID + "/" + endpointBC.Counterparty.ChannelID + "/" + endpointAB.Counterparty.ChannelConfig.PortID + "/" + endpointAB.Counterparty.ChannelID,
+		BaseDenom: sdk.DefaultBondDenom,
+	}
+	require.Equal(t, expTrace, topology.TraceDenom(sdk.DefaultBondDenom, chainA, chainB, chainC))
+	require.Equal(t, expTrace.IBCDenom(), received.Denom)
+
+	balance := chainC.GetSimApp().BankKeeper.GetBalance(chainC.GetContext(), chainC.SenderAccount.GetAddress(), received.Denom)
+	require.Equal(t, token.Amount, balance.Amount)
+
+	topology.AssertTransferInvariants()
+
+	// returning the token along the reverse route unwinds the denomination trace
+	returned := topology.TransferAlongRoute(received, chainC, chainB, chainA)
+	require.Equal(t, token, returned)
+	require.Equal(t, transfertypes.ParseDenomTrace(sdk.DefaultBondDenom), topology.TraceDenom(expTrace.GetFullDenomPath(), chainC, chainB, chainA))
+
+	topology.AssertTransferInvariants()
+}
+
+func TestStarTopology(t *testing.T) {
+	topology := ibctesting.NewStarTopology(t, 4)
+	hub := topology.Chain(1)
+
+	require.Len(t, topology.Paths, 3)
+
+	// tokens from each spoke are routed through the hub to the next spoke
+	token := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(50))
+	for i := 2; i <= 4; i++ {
+		spoke, next := topology.Chain(i), topology.Chain(i%3+2)
+		received := topology.TransferAlongRoute(token, spoke, hub, next)
+		require.Equal(t, topology.TraceDenom(sdk.DefaultBondDenom, spoke, hub, next).IBCDenom(), received.Denom)
+	}
+
+	topology.AssertTransferInvariants()
+}
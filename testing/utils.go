@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"math/rand"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/go-metrics"
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -13,6 +15,8 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmttypes "github.com/cometbft/cometbft/types"
+
+	ibcmetrics "github.com/cosmos/ibc-go/v8/modules/core/metrics"
 )
 
 // ApplyValSetChanges takes in cmttypes.ValidatorSet and []abci.ValidatorUpdate and will return a new cmttypes.ValidatorSet which has the
@@ -81,3 +85,29 @@ func UnmarshalMsgResponses(cdc codec.Codec, data []byte, msgs ...codec.ProtoMars
 
 	return nil
 }
+
+// EnableMetrics enables the ibc metrics and reports all telemetry metrics to an in-memory sink for the duration
+// of the test. The returned sink may be used to assert on the reported metrics. The metrics are reported with
+// keys joined by "." and labels appended as ";name=value" in order, e.g. "ibc.clients;status=Active".
+func EnableMetrics(tb testing.TB) *metrics.InmemSink {
+	tb.Helper()
+
+	cfg := metrics.DefaultConfig("")
+	cfg.EnableHostname = false
+	cfg.EnableRuntimeMetrics = false
+
+	sink := metrics.NewInmemSink(time.Hour, time.Hour)
+	_, err := metrics.NewGlobal(cfg, sink)
+	require.NoError(tb, err)
+
+	ibcmetrics.Configure(ibcmetrics.Config{Enabled: true, MaxLabelValues: ibcmetrics.DefaultMaxLabelValues})
+
+	tb.Cleanup(func() {
+		ibcmetrics.Configure(ibcmetrics.DefaultConfig())
+
+		_, err := metrics.NewGlobal(cfg, &metrics.BlackholeSink{})
+		require.NoError(tb, err)
+	})
+
+	return sink
+}